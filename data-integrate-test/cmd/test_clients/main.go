@@ -137,7 +137,7 @@ func main() {
 		} else {
 			fmt.Printf("   ✓ 调用成功\n")
 			fmt.Printf("     表名: %s\n", tableInfoResp.TableName)
-			fmt.Printf("     记录数: %d\n", tableInfoResp.RecordCount)
+			fmt.Printf("     记录数: %d\n", tableInfoResp.RecordCount64)
 			fmt.Printf("     记录大小: %d 字节\n", tableInfoResp.RecordSize)
 			fmt.Printf("     列数: %d\n", len(tableInfoResp.Columns))
 		}
@@ -262,6 +262,225 @@ func (DataSourceType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{3}
 }
 
+// 表统计信息的获取方式
+type TableStatsMethod int32
+
+const (
+	TableStatsMethod_TABLE_STATS_METHOD_UNKNOWN            TableStatsMethod = 0
+	TableStatsMethod_TABLE_STATS_METHOD_COUNT              TableStatsMethod = 1 // COUNT(*) 精确统计行数
+	TableStatsMethod_TABLE_STATS_METHOD_INFORMATION_SCHEMA TableStatsMethod = 2 // information_schema.tables 中的估算值
+	TableStatsMethod_TABLE_STATS_METHOD_PG_CLASS           TableStatsMethod = 3 // pg_class.reltuples 中的估算值
+	TableStatsMethod_TABLE_STATS_METHOD_SAMPLING           TableStatsMethod = 4 // COUNT(*) 统计行数，按采样数据估算大小
+)
+
+// Enum value maps for TableStatsMethod.
+var (
+	TableStatsMethod_name = map[int32]string{
+		0: "TABLE_STATS_METHOD_UNKNOWN",
+		1: "TABLE_STATS_METHOD_COUNT",
+		2: "TABLE_STATS_METHOD_INFORMATION_SCHEMA",
+		3: "TABLE_STATS_METHOD_PG_CLASS",
+		4: "TABLE_STATS_METHOD_SAMPLING",
+	}
+	TableStatsMethod_value = map[string]int32{
+		"TABLE_STATS_METHOD_UNKNOWN":            0,
+		"TABLE_STATS_METHOD_COUNT":              1,
+		"TABLE_STATS_METHOD_INFORMATION_SCHEMA": 2,
+		"TABLE_STATS_METHOD_PG_CLASS":           3,
+		"TABLE_STATS_METHOD_SAMPLING":           4,
+	}
+)
+
+func (x TableStatsMethod) Enum() *TableStatsMethod {
+	p := new(TableStatsMethod)
+	*p = x
+	return p
+}
+
+func (x TableStatsMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TableStatsMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_data_source_proto_enumTypes[4].Descriptor()
+}
+
+func (TableStatsMethod) Type() protoreflect.EnumType {
+	return &file_proto_data_source_proto_enumTypes[4]
+}
+
+func (x TableStatsMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TableStatsMethod.Descriptor instead.
+func (TableStatsMethod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{4}
+}
+
+type ResultSinkType int32
+
+const (
+	ResultSinkType_RESULT_SINK_TYPE_DB      ResultSinkType = 0 // mira-gateway 配置的结果数据库，存储类型为 MinIO 时跳过
+	ResultSinkType_RESULT_SINK_TYPE_KAFKA   ResultSinkType = 1 // Kafka topic，经 Kafka REST Proxy 写入
+	ResultSinkType_RESULT_SINK_TYPE_S3      ResultSinkType = 2 // 合作方的 S3 兼容对象存储
+	ResultSinkType_RESULT_SINK_TYPE_WEBHOOK ResultSinkType = 3 // HTTP webhook
+)
+
+// Enum value maps for ResultSinkType.
+var (
+	ResultSinkType_name = map[int32]string{
+		0: "RESULT_SINK_TYPE_DB",
+		1: "RESULT_SINK_TYPE_KAFKA",
+		2: "RESULT_SINK_TYPE_S3",
+		3: "RESULT_SINK_TYPE_WEBHOOK",
+	}
+	ResultSinkType_value = map[string]int32{
+		"RESULT_SINK_TYPE_DB":      0,
+		"RESULT_SINK_TYPE_KAFKA":   1,
+		"RESULT_SINK_TYPE_S3":      2,
+		"RESULT_SINK_TYPE_WEBHOOK": 3,
+	}
+)
+
+func (x ResultSinkType) Enum() *ResultSinkType {
+	p := new(ResultSinkType)
+	*p = x
+	return p
+}
+
+func (x ResultSinkType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResultSinkType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_data_source_proto_enumTypes[5].Descriptor()
+}
+
+func (ResultSinkType) Type() protoreflect.EnumType {
+	return &file_proto_data_source_proto_enumTypes[5]
+}
+
+func (x ResultSinkType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResultSinkType.Descriptor instead.
+func (ResultSinkType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{5}
+}
+
+type ResultDeliveryStatus int32
+
+const (
+	ResultDeliveryStatus_RESULT_DELIVERY_STATUS_UNKNOWN    ResultDeliveryStatus = 0
+	ResultDeliveryStatus_RESULT_DELIVERY_STATUS_DELIVERING ResultDeliveryStatus = 1
+	ResultDeliveryStatus_RESULT_DELIVERY_STATUS_SUCCEEDED  ResultDeliveryStatus = 2
+	ResultDeliveryStatus_RESULT_DELIVERY_STATUS_FAILED     ResultDeliveryStatus = 3
+)
+
+// Enum value maps for ResultDeliveryStatus.
+var (
+	ResultDeliveryStatus_name = map[int32]string{
+		0: "RESULT_DELIVERY_STATUS_UNKNOWN",
+		1: "RESULT_DELIVERY_STATUS_DELIVERING",
+		2: "RESULT_DELIVERY_STATUS_SUCCEEDED",
+		3: "RESULT_DELIVERY_STATUS_FAILED",
+	}
+	ResultDeliveryStatus_value = map[string]int32{
+		"RESULT_DELIVERY_STATUS_UNKNOWN":    0,
+		"RESULT_DELIVERY_STATUS_DELIVERING": 1,
+		"RESULT_DELIVERY_STATUS_SUCCEEDED":  2,
+		"RESULT_DELIVERY_STATUS_FAILED":     3,
+	}
+)
+
+func (x ResultDeliveryStatus) Enum() *ResultDeliveryStatus {
+	p := new(ResultDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x ResultDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResultDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_data_source_proto_enumTypes[6].Descriptor()
+}
+
+func (ResultDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_proto_data_source_proto_enumTypes[6]
+}
+
+func (x ResultDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResultDeliveryStatus.Descriptor instead.
+func (ResultDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{6}
+}
+
+// 导入目标所处阶段
+type ImportStage int32
+
+const (
+	ImportStage_IMPORT_STAGE_UNKNOWN     ImportStage = 0
+	ImportStage_IMPORT_STAGE_IMPORTING   ImportStage = 1 // 开始导入
+	ImportStage_IMPORT_STAGE_STAGED      ImportStage = 2 // 原子模式下已导入临时表，等待替换
+	ImportStage_IMPORT_STAGE_SUCCEEDED   ImportStage = 3 // 导入完成
+	ImportStage_IMPORT_STAGE_FAILED      ImportStage = 4 // 导入失败
+	ImportStage_IMPORT_STAGE_ROLLED_BACK ImportStage = 5 // 原子模式下因其他目标失败而丢弃
+)
+
+// Enum value maps for ImportStage.
+var (
+	ImportStage_name = map[int32]string{
+		0: "IMPORT_STAGE_UNKNOWN",
+		1: "IMPORT_STAGE_IMPORTING",
+		2: "IMPORT_STAGE_STAGED",
+		3: "IMPORT_STAGE_SUCCEEDED",
+		4: "IMPORT_STAGE_FAILED",
+		5: "IMPORT_STAGE_ROLLED_BACK",
+	}
+	ImportStage_value = map[string]int32{
+		"IMPORT_STAGE_UNKNOWN":     0,
+		"IMPORT_STAGE_IMPORTING":   1,
+		"IMPORT_STAGE_STAGED":      2,
+		"IMPORT_STAGE_SUCCEEDED":   3,
+		"IMPORT_STAGE_FAILED":      4,
+		"IMPORT_STAGE_ROLLED_BACK": 5,
+	}
+)
+
+func (x ImportStage) Enum() *ImportStage {
+	p := new(ImportStage)
+	*p = x
+	return p
+}
+
+func (x ImportStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_data_source_proto_enumTypes[7].Descriptor()
+}
+
+func (ImportStage) Type() protoreflect.EnumType {
+	return &file_proto_data_source_proto_enumTypes[7]
+}
+
+func (x ImportStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportStage.Descriptor instead.
+func (ImportStage) EnumDescriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{7}
+}
+
 // 数据库常量
 type DbConstant int32
 
@@ -290,11 +509,11 @@ func (x DbConstant) String() string {
 }
 
 func (DbConstant) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_data_source_proto_enumTypes[4].Descriptor()
+	return file_proto_data_source_proto_enumTypes[8].Descriptor()
 }
 
 func (DbConstant) Type() protoreflect.EnumType {
-	return &file_proto_data_source_proto_enumTypes[4]
+	return &file_proto_data_source_proto_enumTypes[8]
 }
 
 func (x DbConstant) Number() protoreflect.EnumNumber {
@@ -303,7 +522,7 @@ func (x DbConstant) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DbConstant.Descriptor instead.
 func (DbConstant) EnumDescriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{4}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{8}
 }
 
 // spark功能
@@ -358,11 +577,11 @@ func (x OperationMode) String() string {
 }
 
 func (OperationMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_data_source_proto_enumTypes[5].Descriptor()
+	return file_proto_data_source_proto_enumTypes[9].Descriptor()
 }
 
 func (OperationMode) Type() protoreflect.EnumType {
-	return &file_proto_data_source_proto_enumTypes[5]
+	return &file_proto_data_source_proto_enumTypes[9]
 }
 
 func (x OperationMode) Number() protoreflect.EnumNumber {
@@ -371,7 +590,7 @@ func (x OperationMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OperationMode.Descriptor instead.
 func (OperationMode) EnumDescriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{5}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{9}
 }
 
 // JOIN类型
@@ -414,11 +633,11 @@ func (x JoinType) String() string {
 }
 
 func (JoinType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_data_source_proto_enumTypes[6].Descriptor()
+	return file_proto_data_source_proto_enumTypes[10].Descriptor()
 }
 
 func (JoinType) Type() protoreflect.EnumType {
-	return &file_proto_data_source_proto_enumTypes[6]
+	return &file_proto_data_source_proto_enumTypes[10]
 }
 
 func (x JoinType) Number() protoreflect.EnumNumber {
@@ -427,7 +646,7 @@ func (x JoinType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use JoinType.Descriptor instead.
 func (JoinType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{6}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{10}
 }
 
 // 作业状态枚举
@@ -439,6 +658,7 @@ const (
 	JobStatus_JOB_STATUS_RUNNING   JobStatus = 2 // 运行中
 	JobStatus_JOB_STATUS_SUCCEEDED JobStatus = 3 // 成功完成
 	JobStatus_JOB_STATUS_FAILED    JobStatus = 4 // 失败
+	JobStatus_JOB_STATUS_CANCELLED JobStatus = 5 // 已取消
 )
 
 // Enum value maps for JobStatus.
@@ -449,6 +669,7 @@ var (
 		2: "JOB_STATUS_RUNNING",
 		3: "JOB_STATUS_SUCCEEDED",
 		4: "JOB_STATUS_FAILED",
+		5: "JOB_STATUS_CANCELLED",
 	}
 	JobStatus_value = map[string]int32{
 		"JOB_STATUS_UNKNOWN":   0,
@@ -456,6 +677,7 @@ var (
 		"JOB_STATUS_RUNNING":   2,
 		"JOB_STATUS_SUCCEEDED": 3,
 		"JOB_STATUS_FAILED":    4,
+		"JOB_STATUS_CANCELLED": 5,
 	}
 )
 
@@ -470,11 +692,11 @@ func (x JobStatus) String() string {
 }
 
 func (JobStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_data_source_proto_enumTypes[7].Descriptor()
+	return file_proto_data_source_proto_enumTypes[11].Descriptor()
 }
 
 func (JobStatus) Type() protoreflect.EnumType {
-	return &file_proto_data_source_proto_enumTypes[7]
+	return &file_proto_data_source_proto_enumTypes[11]
 }
 
 func (x JobStatus) Number() protoreflect.EnumNumber {
@@ -483,7 +705,7 @@ func (x JobStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use JobStatus.Descriptor instead.
 func (JobStatus) EnumDescriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{7}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{11}
 }
 
 // 存储类型枚举
@@ -520,11 +742,11 @@ func (x StorageType) String() string {
 }
 
 func (StorageType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_data_source_proto_enumTypes[8].Descriptor()
+	return file_proto_data_source_proto_enumTypes[12].Descriptor()
 }
 
 func (StorageType) Type() protoreflect.EnumType {
-	return &file_proto_data_source_proto_enumTypes[8]
+	return &file_proto_data_source_proto_enumTypes[12]
 }
 
 func (x StorageType) Number() protoreflect.EnumNumber {
@@ -533,7 +755,7 @@ func (x StorageType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StorageType.Descriptor instead.
 func (StorageType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{8}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{12}
 }
 
 // 表键类型枚举
@@ -579,11 +801,11 @@ func (x KeyType) String() string {
 }
 
 func (KeyType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_data_source_proto_enumTypes[9].Descriptor()
+	return file_proto_data_source_proto_enumTypes[13].Descriptor()
 }
 
 func (KeyType) Type() protoreflect.EnumType {
-	return &file_proto_data_source_proto_enumTypes[9]
+	return &file_proto_data_source_proto_enumTypes[13]
 }
 
 func (x KeyType) Number() protoreflect.EnumNumber {
@@ -592,7 +814,7 @@ func (x KeyType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use KeyType.Descriptor instead.
 func (KeyType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{9}
+	return file_proto_data_source_proto_rawDescGZIP(), []int{13}
 }
 
 // 连接响应，返回连接成功与否的信息
@@ -601,8 +823,10 @@ type Response struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功连接
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 错误或成功信息
+	Success       bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`            // 是否成功连接
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`             // 错误或成功信息
+	RejectedRows  int64  `protobuf:"varint,3,opt,name=rejectedRows,proto3" json:"rejectedRows,omitempty"`  // 导入类接口：被拒绝的行数
+	RejectsObject string `protobuf:"bytes,4,opt,name=rejectsObject,proto3" json:"rejectsObject,omitempty"` // 导入类接口：被拒绝行及原因所在的对象（BATCH_DATA_BUCKET_NAME 桶），无拒绝行时为空
 }
 
 func (x *Response) Reset() {
@@ -651,16 +875,32 @@ func (x *Response) GetMessage() string {
 	return ""
 }
 
+func (x *Response) GetRejectedRows() int64 {
+	if x != nil {
+		return x.RejectedRows
+	}
+	return 0
+}
+
+func (x *Response) GetRejectsObject() string {
+	if x != nil {
+		return x.RejectsObject
+	}
+	return ""
+}
+
 type BatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status JobStatus `protobuf:"varint,1,opt,name=status,proto3,enum=datasource.JobStatus" json:"status,omitempty"` // 作业状态：success/error
-	Mode   string    `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`                                // 操作模式
-	Data   string    `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                                // 动态数据字段
-	Error  string    `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`                              // 错误信息
-	JobId  string    `protobuf:"bytes,5,opt,name=jobId,proto3" json:"jobId,omitempty"`                              // pod名称
+	Status   JobStatus    `protobuf:"varint,1,opt,name=status,proto3,enum=datasource.JobStatus" json:"status,omitempty"` // 作业状态：success/error
+	Mode     string       `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`                                // 操作模式
+	Data     string       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                                // 动态数据字段
+	Error    string       `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`                              // 错误信息
+	JobId    string       `protobuf:"bytes,5,opt,name=jobId,proto3" json:"jobId,omitempty"`                              // pod名称
+	Attempt  int32        `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`                         // 当前尝试次数，从1开始
+	Callback *JobCallback `protobuf:"bytes,7,opt,name=callback,proto3" json:"callback,omitempty"`                        // 作业最近一次完成回调，未收到回调时为空
 }
 
 func (x *BatchResponse) Reset() {
@@ -730,16 +970,35 @@ func (x *BatchResponse) GetJobId() string {
 	return ""
 }
 
-type JobStatusRequest struct {
+func (x *BatchResponse) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *BatchResponse) GetCallback() *JobCallback {
+	if x != nil {
+		return x.Callback
+	}
+	return nil
+}
+
+// JobCallback Spark 作业通过 /api/job/completed 上报的完成回调
+type JobCallback struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobId string `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"` // 作业ID
+	JobId      string    `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	Status     JobStatus `protobuf:"varint,2,opt,name=status,proto3,enum=datasource.JobStatus" json:"status,omitempty"`
+	Data       string    `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"` // 回调内容（JSON），不含 status 和 mode
+	Error      string    `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	ReceivedAt int64     `protobuf:"varint,5,opt,name=receivedAt,proto3" json:"receivedAt,omitempty"` // 接收时间（Unix毫秒）
 }
 
-func (x *JobStatusRequest) Reset() {
-	*x = JobStatusRequest{}
+func (x *JobCallback) Reset() {
+	*x = JobCallback{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -747,13 +1006,13 @@ func (x *JobStatusRequest) Reset() {
 	}
 }
 
-func (x *JobStatusRequest) String() string {
+func (x *JobCallback) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobStatusRequest) ProtoMessage() {}
+func (*JobCallback) ProtoMessage() {}
 
-func (x *JobStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *JobCallback) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -765,28 +1024,56 @@ func (x *JobStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobStatusRequest.ProtoReflect.Descriptor instead.
-func (*JobStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use JobCallback.ProtoReflect.Descriptor instead.
+func (*JobCallback) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *JobStatusRequest) GetJobId() string {
+func (x *JobCallback) GetJobId() string {
 	if x != nil {
 		return x.JobId
 	}
 	return ""
 }
 
-type ArrowResponse struct {
+func (x *JobCallback) GetStatus() JobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JobStatus_JOB_STATUS_UNKNOWN
+}
+
+func (x *JobCallback) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *JobCallback) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *JobCallback) GetReceivedAt() int64 {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return 0
+}
+
+type JobStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ArrowBatch []byte `protobuf:"bytes,1,opt,name=arrow_batch,json=arrowBatch,proto3" json:"arrow_batch,omitempty"`
+	JobId string `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"` // 作业ID
 }
 
-func (x *ArrowResponse) Reset() {
-	*x = ArrowResponse{}
+func (x *JobStatusRequest) Reset() {
+	*x = JobStatusRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -794,13 +1081,13 @@ func (x *ArrowResponse) Reset() {
 	}
 }
 
-func (x *ArrowResponse) String() string {
+func (x *JobStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArrowResponse) ProtoMessage() {}
+func (*JobStatusRequest) ProtoMessage() {}
 
-func (x *ArrowResponse) ProtoReflect() protoreflect.Message {
+func (x *JobStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -812,30 +1099,29 @@ func (x *ArrowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArrowResponse.ProtoReflect.Descriptor instead.
-func (*ArrowResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use JobStatusRequest.ProtoReflect.Descriptor instead.
+func (*JobStatusRequest) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ArrowResponse) GetArrowBatch() []byte {
+func (x *JobStatusRequest) GetJobId() string {
 	if x != nil {
-		return x.ArrowBatch
+		return x.JobId
 	}
-	return nil
+	return ""
 }
 
-type WriterDataRequest struct {
+type CancelBatchJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ArrowBatch  []byte `protobuf:"bytes,1,opt,name=arrow_batch,json=arrowBatch,proto3" json:"arrow_batch,omitempty"`
-	AssetName   string `protobuf:"bytes,2,opt,name=assetName,proto3" json:"assetName,omitempty"` // 资产名称
-	ChainInfoId string `protobuf:"bytes,3,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
+	JobId  string `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // 取消原因，记录到作业历史
 }
 
-func (x *WriterDataRequest) Reset() {
-	*x = WriterDataRequest{}
+func (x *CancelBatchJobRequest) Reset() {
+	*x = CancelBatchJobRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -843,13 +1129,13 @@ func (x *WriterDataRequest) Reset() {
 	}
 }
 
-func (x *WriterDataRequest) String() string {
+func (x *CancelBatchJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriterDataRequest) ProtoMessage() {}
+func (*CancelBatchJobRequest) ProtoMessage() {}
 
-func (x *WriterDataRequest) ProtoReflect() protoreflect.Message {
+func (x *CancelBatchJobRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -861,43 +1147,38 @@ func (x *WriterDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriterDataRequest.ProtoReflect.Descriptor instead.
-func (*WriterDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelBatchJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelBatchJobRequest) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *WriterDataRequest) GetArrowBatch() []byte {
-	if x != nil {
-		return x.ArrowBatch
-	}
-	return nil
-}
-
-func (x *WriterDataRequest) GetAssetName() string {
+func (x *CancelBatchJobRequest) GetJobId() string {
 	if x != nil {
-		return x.AssetName
+		return x.JobId
 	}
 	return ""
 }
 
-func (x *WriterDataRequest) GetChainInfoId() string {
+func (x *CancelBatchJobRequest) GetReason() string {
 	if x != nil {
-		return x.ChainInfoId
+		return x.Reason
 	}
 	return ""
 }
 
-type WrappedWriterDataRequest struct {
+type GetBatchJobLogsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Request   *WriterDataRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	RequestId string             `protobuf:"bytes,2,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	JobId     string `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	Attempt   int32  `protobuf:"varint,2,opt,name=attempt,proto3" json:"attempt,omitempty"`     // 读取第几次尝试的日志，0 为最近一次
+	Follow    bool   `protobuf:"varint,3,opt,name=follow,proto3" json:"follow,omitempty"`       // 持续读取直到 Pod 结束
+	TailLines int64  `protobuf:"varint,4,opt,name=tailLines,proto3" json:"tailLines,omitempty"` // 只返回最后 N 行，0 返回全部
 }
 
-func (x *WrappedWriterDataRequest) Reset() {
-	*x = WrappedWriterDataRequest{}
+func (x *GetBatchJobLogsRequest) Reset() {
+	*x = GetBatchJobLogsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -905,13 +1186,13 @@ func (x *WrappedWriterDataRequest) Reset() {
 	}
 }
 
-func (x *WrappedWriterDataRequest) String() string {
+func (x *GetBatchJobLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WrappedWriterDataRequest) ProtoMessage() {}
+func (*GetBatchJobLogsRequest) ProtoMessage() {}
 
-func (x *WrappedWriterDataRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBatchJobLogsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -923,38 +1204,50 @@ func (x *WrappedWriterDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WrappedWriterDataRequest.ProtoReflect.Descriptor instead.
-func (*WrappedWriterDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBatchJobLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetBatchJobLogsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *WrappedWriterDataRequest) GetRequest() *WriterDataRequest {
+func (x *GetBatchJobLogsRequest) GetJobId() string {
 	if x != nil {
-		return x.Request
+		return x.JobId
 	}
-	return nil
+	return ""
 }
 
-func (x *WrappedWriterDataRequest) GetRequestId() string {
+func (x *GetBatchJobLogsRequest) GetAttempt() int32 {
 	if x != nil {
-		return x.RequestId
+		return x.Attempt
 	}
-	return ""
+	return 0
 }
 
-type WriterInternalDataRequest struct {
-	state         protoimpl.MessageState
+func (x *GetBatchJobLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *GetBatchJobLogsRequest) GetTailLines() int64 {
+	if x != nil {
+		return x.TailLines
+	}
+	return 0
+}
+
+type BatchJobLogChunk struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ArrowBatch    []byte `protobuf:"bytes,1,opt,name=arrow_batch,json=arrowBatch,proto3" json:"arrow_batch,omitempty"`
-	DbName        string `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	TableName     string `protobuf:"bytes,3,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	JobInstanceId string `protobuf:"bytes,4,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
+	PodName string `protobuf:"bytes,1,opt,name=podName,proto3" json:"podName,omitempty"`
+	Data    []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *WriterInternalDataRequest) Reset() {
-	*x = WriterInternalDataRequest{}
+func (x *BatchJobLogChunk) Reset() {
+	*x = BatchJobLogChunk{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -962,13 +1255,13 @@ func (x *WriterInternalDataRequest) Reset() {
 	}
 }
 
-func (x *WriterInternalDataRequest) String() string {
+func (x *BatchJobLogChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriterInternalDataRequest) ProtoMessage() {}
+func (*BatchJobLogChunk) ProtoMessage() {}
 
-func (x *WriterInternalDataRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchJobLogChunk) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -980,54 +1273,39 @@ func (x *WriterInternalDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriterInternalDataRequest.ProtoReflect.Descriptor instead.
-func (*WriterInternalDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchJobLogChunk.ProtoReflect.Descriptor instead.
+func (*BatchJobLogChunk) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *WriterInternalDataRequest) GetArrowBatch() []byte {
-	if x != nil {
-		return x.ArrowBatch
-	}
-	return nil
-}
-
-func (x *WriterInternalDataRequest) GetDbName() string {
-	if x != nil {
-		return x.DbName
-	}
-	return ""
-}
-
-func (x *WriterInternalDataRequest) GetTableName() string {
+func (x *BatchJobLogChunk) GetPodName() string {
 	if x != nil {
-		return x.TableName
+		return x.PodName
 	}
 	return ""
 }
 
-func (x *WriterInternalDataRequest) GetJobInstanceId() string {
+func (x *BatchJobLogChunk) GetData() []byte {
 	if x != nil {
-		return x.JobInstanceId
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type WriterExternalDataRequest struct {
+type ListBatchJobHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ArrowBatch  []byte `protobuf:"bytes,1,opt,name=arrow_batch,json=arrowBatch,proto3" json:"arrow_batch,omitempty"`
-	AssetName   string `protobuf:"bytes,2,opt,name=assetName,proto3" json:"assetName,omitempty"`
-	ChainInfoId string `protobuf:"bytes,3,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
-	RequestId   string `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`
-	TableName   string `protobuf:"bytes,5,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	Alias       string `protobuf:"bytes,6,opt,name=alias,proto3" json:"alias,omitempty"`
+	JobId     string    `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	RequestId string    `protobuf:"bytes,2,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Status    JobStatus `protobuf:"varint,3,opt,name=status,proto3,enum=datasource.JobStatus" json:"status,omitempty"` // 为 UNKNOWN 时不过滤
+	Page      int32     `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize  int32     `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 }
 
-func (x *WriterExternalDataRequest) Reset() {
-	*x = WriterExternalDataRequest{}
+func (x *ListBatchJobHistoryRequest) Reset() {
+	*x = ListBatchJobHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1035,13 +1313,13 @@ func (x *WriterExternalDataRequest) Reset() {
 	}
 }
 
-func (x *WriterExternalDataRequest) String() string {
+func (x *ListBatchJobHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriterExternalDataRequest) ProtoMessage() {}
+func (*ListBatchJobHistoryRequest) ProtoMessage() {}
 
-func (x *WriterExternalDataRequest) ProtoReflect() protoreflect.Message {
+func (x *ListBatchJobHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1053,70 +1331,68 @@ func (x *WriterExternalDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriterExternalDataRequest.ProtoReflect.Descriptor instead.
-func (*WriterExternalDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBatchJobHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListBatchJobHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *WriterExternalDataRequest) GetArrowBatch() []byte {
-	if x != nil {
-		return x.ArrowBatch
-	}
-	return nil
-}
-
-func (x *WriterExternalDataRequest) GetAssetName() string {
+func (x *ListBatchJobHistoryRequest) GetJobId() string {
 	if x != nil {
-		return x.AssetName
+		return x.JobId
 	}
 	return ""
 }
 
-func (x *WriterExternalDataRequest) GetChainInfoId() string {
+func (x *ListBatchJobHistoryRequest) GetRequestId() string {
 	if x != nil {
-		return x.ChainInfoId
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *WriterExternalDataRequest) GetRequestId() string {
+func (x *ListBatchJobHistoryRequest) GetStatus() JobStatus {
 	if x != nil {
-		return x.RequestId
+		return x.Status
 	}
-	return ""
+	return JobStatus_JOB_STATUS_UNKNOWN
 }
 
-func (x *WriterExternalDataRequest) GetTableName() string {
+func (x *ListBatchJobHistoryRequest) GetPage() int32 {
 	if x != nil {
-		return x.TableName
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *WriterExternalDataRequest) GetAlias() string {
+func (x *ListBatchJobHistoryRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Alias
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-type InternalReadRequest struct {
+type BatchJobHistoryRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableName       string           `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	DbFields        []string         `protobuf:"bytes,2,rep,name=dbFields,proto3" json:"dbFields,omitempty"`
-	DbName          string           `protobuf:"bytes,3,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	FilterNames     []string         `protobuf:"bytes,4,rep,name=filterNames,proto3" json:"filterNames,omitempty"`                                                // 过滤字段
-	FilterValues    []*FilterValue   `protobuf:"bytes,5,rep,name=filterValues,proto3" json:"filterValues,omitempty"`                                              // 过滤字段值
-	SortRules       []*SortRule      `protobuf:"bytes,6,rep,name=sortRules,proto3" json:"sortRules,omitempty"`                                                    // 排序规则
-	FilterOperators []FilterOperator `protobuf:"varint,7,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"` // 过滤操作符
-	JobInstanceId   string           `protobuf:"bytes,8,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`                                            // 作业实例ID
-}
-
-func (x *InternalReadRequest) Reset() {
-	*x = InternalReadRequest{}
+	JobId       string       `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	RequestId   string       `protobuf:"bytes,2,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Mode        string       `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"` // 操作模式
+	Status      JobStatus    `protobuf:"varint,4,opt,name=status,proto3,enum=datasource.JobStatus" json:"status,omitempty"`
+	Attempt     int32        `protobuf:"varint,5,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	MaxRetries  int32        `protobuf:"varint,6,opt,name=maxRetries,proto3" json:"maxRetries,omitempty"`
+	PodName     string       `protobuf:"bytes,7,opt,name=podName,proto3" json:"podName,omitempty"`         // 最近一次尝试的 Pod
+	SparkConfig *SparkConfig `protobuf:"bytes,8,opt,name=sparkConfig,proto3" json:"sparkConfig,omitempty"` // 按表信息调整后实际使用的 Spark 配置
+	RecordCount int64        `protobuf:"varint,9,opt,name=recordCount,proto3" json:"recordCount,omitempty"`
+	Error       string       `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	SubmittedAt int64        `protobuf:"varint,11,opt,name=submittedAt,proto3" json:"submittedAt,omitempty"` // 提交时间（Unix毫秒）
+	FinishedAt  int64        `protobuf:"varint,12,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`   // 结束时间（Unix毫秒），未结束为0
+	Backend     string       `protobuf:"bytes,13,opt,name=backend,proto3" json:"backend,omitempty"`          // 执行后端：spark/doris
+}
+
+func (x *BatchJobHistoryRecord) Reset() {
+	*x = BatchJobHistoryRecord{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1124,13 +1400,13 @@ func (x *InternalReadRequest) Reset() {
 	}
 }
 
-func (x *InternalReadRequest) String() string {
+func (x *BatchJobHistoryRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalReadRequest) ProtoMessage() {}
+func (*BatchJobHistoryRecord) ProtoMessage() {}
 
-func (x *InternalReadRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchJobHistoryRecord) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1142,100 +1418,115 @@ func (x *InternalReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalReadRequest.ProtoReflect.Descriptor instead.
-func (*InternalReadRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchJobHistoryRecord.ProtoReflect.Descriptor instead.
+func (*BatchJobHistoryRecord) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *InternalReadRequest) GetTableName() string {
+func (x *BatchJobHistoryRecord) GetJobId() string {
 	if x != nil {
-		return x.TableName
+		return x.JobId
 	}
 	return ""
 }
 
-func (x *InternalReadRequest) GetDbFields() []string {
+func (x *BatchJobHistoryRecord) GetRequestId() string {
 	if x != nil {
-		return x.DbFields
+		return x.RequestId
 	}
-	return nil
+	return ""
 }
 
-func (x *InternalReadRequest) GetDbName() string {
+func (x *BatchJobHistoryRecord) GetMode() string {
 	if x != nil {
-		return x.DbName
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *InternalReadRequest) GetFilterNames() []string {
+func (x *BatchJobHistoryRecord) GetStatus() JobStatus {
 	if x != nil {
-		return x.FilterNames
+		return x.Status
 	}
-	return nil
+	return JobStatus_JOB_STATUS_UNKNOWN
 }
 
-func (x *InternalReadRequest) GetFilterValues() []*FilterValue {
+func (x *BatchJobHistoryRecord) GetAttempt() int32 {
 	if x != nil {
-		return x.FilterValues
+		return x.Attempt
 	}
-	return nil
+	return 0
 }
 
-func (x *InternalReadRequest) GetSortRules() []*SortRule {
+func (x *BatchJobHistoryRecord) GetMaxRetries() int32 {
 	if x != nil {
-		return x.SortRules
+		return x.MaxRetries
 	}
-	return nil
+	return 0
 }
 
-func (x *InternalReadRequest) GetFilterOperators() []FilterOperator {
+func (x *BatchJobHistoryRecord) GetPodName() string {
 	if x != nil {
-		return x.FilterOperators
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *BatchJobHistoryRecord) GetSparkConfig() *SparkConfig {
+	if x != nil {
+		return x.SparkConfig
 	}
 	return nil
 }
 
-func (x *InternalReadRequest) GetJobInstanceId() string {
+func (x *BatchJobHistoryRecord) GetRecordCount() int64 {
 	if x != nil {
-		return x.JobInstanceId
+		return x.RecordCount
+	}
+	return 0
+}
+
+func (x *BatchJobHistoryRecord) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-// 批处理请求，包含查询条件或读取参数
-type BatchReadRequest struct {
+func (x *BatchJobHistoryRecord) GetSubmittedAt() int64 {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return 0
+}
+
+func (x *BatchJobHistoryRecord) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *BatchJobHistoryRecord) GetBackend() string {
+	if x != nil {
+		return x.Backend
+	}
+	return ""
+}
+
+type ListBatchJobHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 公共字段
-	RequestId   string       `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
-	SparkConfig *SparkConfig `protobuf:"bytes,2,opt,name=sparkConfig,proto3" json:"sparkConfig,omitempty"`
-	// 数据源标识（二选一）
-	//
-	// Types that are assignable to DataSource:
-	//
-	//	*BatchReadRequest_External
-	//	*BatchReadRequest_Internal
-	DataSource isBatchReadRequest_DataSource `protobuf_oneof:"data_source"`
-	// 根据操作模式使用不同的参数
-	//
-	// Types that are assignable to Operation:
-	//
-	//	*BatchReadRequest_Query
-	//	*BatchReadRequest_Write
-	//	*BatchReadRequest_Sort
-	//	*BatchReadRequest_Count
-	//	*BatchReadRequest_GroupbyCount
-	//	*BatchReadRequest_Join
-	//	*BatchReadRequest_AddHashColumn
-	//	*BatchReadRequest_PsiJoin
-	Operation isBatchReadRequest_Operation `protobuf_oneof:"operation"`
+	Records    []*BatchJobHistoryRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	TotalCount int64                    `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page       int32                    `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize   int32                    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 }
 
-func (x *BatchReadRequest) Reset() {
-	*x = BatchReadRequest{}
+func (x *ListBatchJobHistoryResponse) Reset() {
+	*x = ListBatchJobHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1243,13 +1534,13 @@ func (x *BatchReadRequest) Reset() {
 	}
 }
 
-func (x *BatchReadRequest) String() string {
+func (x *ListBatchJobHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchReadRequest) ProtoMessage() {}
+func (*ListBatchJobHistoryResponse) ProtoMessage() {}
 
-func (x *BatchReadRequest) ProtoReflect() protoreflect.Message {
+func (x *ListBatchJobHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1261,205 +1552,123 @@ func (x *BatchReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchReadRequest.ProtoReflect.Descriptor instead.
-func (*BatchReadRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBatchJobHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListBatchJobHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *BatchReadRequest) GetRequestId() string {
+func (x *ListBatchJobHistoryResponse) GetRecords() []*BatchJobHistoryRecord {
 	if x != nil {
-		return x.RequestId
+		return x.Records
 	}
-	return ""
+	return nil
 }
 
-func (x *BatchReadRequest) GetSparkConfig() *SparkConfig {
+func (x *ListBatchJobHistoryResponse) GetTotalCount() int64 {
 	if x != nil {
-		return x.SparkConfig
+		return x.TotalCount
 	}
-	return nil
+	return 0
 }
 
-func (m *BatchReadRequest) GetDataSource() isBatchReadRequest_DataSource {
-	if m != nil {
-		return m.DataSource
+func (x *ListBatchJobHistoryResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-func (x *BatchReadRequest) GetExternal() *ExternalDataSource {
-	if x, ok := x.GetDataSource().(*BatchReadRequest_External); ok {
-		return x.External
+func (x *ListBatchJobHistoryResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *BatchReadRequest) GetInternal() *InternalDataSource {
-	if x, ok := x.GetDataSource().(*BatchReadRequest_Internal); ok {
-		return x.Internal
-	}
-	return nil
+type ArrowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArrowBatch []byte         `protobuf:"bytes,1,opt,name=arrow_batch,json=arrowBatch,proto3" json:"arrow_batch,omitempty"`
+	Plan       *ExecutionPlan `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"` // 试运行时返回的执行计划，此时不返回数据
 }
 
-func (m *BatchReadRequest) GetOperation() isBatchReadRequest_Operation {
-	if m != nil {
-		return m.Operation
+func (x *ArrowResponse) Reset() {
+	*x = ArrowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *BatchReadRequest) GetQuery() *QueryOperation {
-	if x, ok := x.GetOperation().(*BatchReadRequest_Query); ok {
-		return x.Query
-	}
-	return nil
+func (x *ArrowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *BatchReadRequest) GetWrite() *WriteOperation {
-	if x, ok := x.GetOperation().(*BatchReadRequest_Write); ok {
-		return x.Write
+func (*ArrowResponse) ProtoMessage() {}
+
+func (x *ArrowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *BatchReadRequest) GetSort() *SortOperation {
-	if x, ok := x.GetOperation().(*BatchReadRequest_Sort); ok {
-		return x.Sort
-	}
-	return nil
-}
-
-func (x *BatchReadRequest) GetCount() *CountOperation {
-	if x, ok := x.GetOperation().(*BatchReadRequest_Count); ok {
-		return x.Count
-	}
-	return nil
-}
-
-func (x *BatchReadRequest) GetGroupbyCount() *GroupByCountOperation {
-	if x, ok := x.GetOperation().(*BatchReadRequest_GroupbyCount); ok {
-		return x.GroupbyCount
-	}
-	return nil
-}
-
-func (x *BatchReadRequest) GetJoin() *JoinOperation {
-	if x, ok := x.GetOperation().(*BatchReadRequest_Join); ok {
-		return x.Join
-	}
-	return nil
+// Deprecated: Use ArrowResponse.ProtoReflect.Descriptor instead.
+func (*ArrowResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *BatchReadRequest) GetAddHashColumn() *AddHashColumnOperation {
-	if x, ok := x.GetOperation().(*BatchReadRequest_AddHashColumn); ok {
-		return x.AddHashColumn
+func (x *ArrowResponse) GetArrowBatch() []byte {
+	if x != nil {
+		return x.ArrowBatch
 	}
 	return nil
 }
 
-func (x *BatchReadRequest) GetPsiJoin() *PSIJoinOperation {
-	if x, ok := x.GetOperation().(*BatchReadRequest_PsiJoin); ok {
-		return x.PsiJoin
+func (x *ArrowResponse) GetPlan() *ExecutionPlan {
+	if x != nil {
+		return x.Plan
 	}
 	return nil
 }
 
-type isBatchReadRequest_DataSource interface {
-	isBatchReadRequest_DataSource()
-}
-
-type BatchReadRequest_External struct {
-	External *ExternalDataSource `protobuf:"bytes,3,opt,name=external,proto3,oneof"` // 外部数据源
-}
-
-type BatchReadRequest_Internal struct {
-	Internal *InternalDataSource `protobuf:"bytes,4,opt,name=internal,proto3,oneof"` // 内部数据源
-}
-
-func (*BatchReadRequest_External) isBatchReadRequest_DataSource() {}
-
-func (*BatchReadRequest_Internal) isBatchReadRequest_DataSource() {}
-
-type isBatchReadRequest_Operation interface {
-	isBatchReadRequest_Operation()
-}
-
-type BatchReadRequest_Query struct {
-	Query *QueryOperation `protobuf:"bytes,10,opt,name=query,proto3,oneof"`
-}
-
-type BatchReadRequest_Write struct {
-	Write *WriteOperation `protobuf:"bytes,11,opt,name=write,proto3,oneof"`
-}
-
-type BatchReadRequest_Sort struct {
-	Sort *SortOperation `protobuf:"bytes,12,opt,name=sort,proto3,oneof"`
-}
-
-type BatchReadRequest_Count struct {
-	Count *CountOperation `protobuf:"bytes,13,opt,name=count,proto3,oneof"`
-}
-
-type BatchReadRequest_GroupbyCount struct {
-	GroupbyCount *GroupByCountOperation `protobuf:"bytes,14,opt,name=groupby_count,json=groupbyCount,proto3,oneof"`
-}
-
-type BatchReadRequest_Join struct {
-	Join *JoinOperation `protobuf:"bytes,15,opt,name=join,proto3,oneof"`
-}
-
-type BatchReadRequest_AddHashColumn struct {
-	AddHashColumn *AddHashColumnOperation `protobuf:"bytes,16,opt,name=add_hash_column,json=addHashColumn,proto3,oneof"`
-}
-
-type BatchReadRequest_PsiJoin struct {
-	PsiJoin *PSIJoinOperation `protobuf:"bytes,17,opt,name=psi_join,json=psiJoin,proto3,oneof"`
-}
-
-func (*BatchReadRequest_Query) isBatchReadRequest_Operation() {}
-
-func (*BatchReadRequest_Write) isBatchReadRequest_Operation() {}
-
-func (*BatchReadRequest_Sort) isBatchReadRequest_Operation() {}
-
-func (*BatchReadRequest_Count) isBatchReadRequest_Operation() {}
-
-func (*BatchReadRequest_GroupbyCount) isBatchReadRequest_Operation() {}
-
-func (*BatchReadRequest_Join) isBatchReadRequest_Operation() {}
-
-func (*BatchReadRequest_AddHashColumn) isBatchReadRequest_Operation() {}
-
-func (*BatchReadRequest_PsiJoin) isBatchReadRequest_Operation() {}
-
-// 外部数据源标识
-type ExternalDataSource struct {
+// 试运行计划中的一步
+type PlanStep struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssetName   string `protobuf:"bytes,1,opt,name=assetName,proto3" json:"assetName,omitempty"`     // 资产名称
-	ChainInfoId string `protobuf:"bytes,2,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"` // 链信息ID
-	Alias       string `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`             // 链账户别名
+	Action      string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"` // resolve_source、source_query、create_database、create_resource、create_table、insert、export、stream、query、execute、cleanup
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Sql         string `protobuf:"bytes,3,opt,name=sql,proto3" json:"sql,omitempty"`         // 该步骤执行的 SQL，凭证已隐藏
+	Explain     string `protobuf:"bytes,4,opt,name=explain,proto3" json:"explain,omitempty"` // Doris EXPLAIN 输出
 }
 
-func (x *ExternalDataSource) Reset() {
-	*x = ExternalDataSource{}
+func (x *PlanStep) Reset() {
+	*x = PlanStep{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[10]
+		mi := &file_proto_data_source_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExternalDataSource) String() string {
+func (x *PlanStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExternalDataSource) ProtoMessage() {}
+func (*PlanStep) ProtoMessage() {}
 
-func (x *ExternalDataSource) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[10]
+func (x *PlanStep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1470,59 +1679,66 @@ func (x *ExternalDataSource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExternalDataSource.ProtoReflect.Descriptor instead.
-func (*ExternalDataSource) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use PlanStep.ProtoReflect.Descriptor instead.
+func (*PlanStep) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ExternalDataSource) GetAssetName() string {
+func (x *PlanStep) GetAction() string {
 	if x != nil {
-		return x.AssetName
+		return x.Action
 	}
 	return ""
 }
 
-func (x *ExternalDataSource) GetChainInfoId() string {
+func (x *PlanStep) GetDescription() string {
 	if x != nil {
-		return x.ChainInfoId
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ExternalDataSource) GetAlias() string {
+func (x *PlanStep) GetSql() string {
 	if x != nil {
-		return x.Alias
+		return x.Sql
 	}
 	return ""
 }
 
-// 内部数据源标识
-type InternalDataSource struct {
+func (x *PlanStep) GetExplain() string {
+	if x != nil {
+		return x.Explain
+	}
+	return ""
+}
+
+// 试运行将创建的临时对象
+type PlanObject struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableName string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"` // 表名
-	DbName    string `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`       // 数据库名（可选）
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // doris_database、doris_resource、doris_external_table、doris_table、minio_export
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *InternalDataSource) Reset() {
-	*x = InternalDataSource{}
+func (x *PlanObject) Reset() {
+	*x = PlanObject{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[11]
+		mi := &file_proto_data_source_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InternalDataSource) String() string {
+func (x *PlanObject) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalDataSource) ProtoMessage() {}
+func (*PlanObject) ProtoMessage() {}
 
-func (x *InternalDataSource) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[11]
+func (x *PlanObject) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1533,52 +1749,56 @@ func (x *InternalDataSource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalDataSource.ProtoReflect.Descriptor instead.
-func (*InternalDataSource) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use PlanObject.ProtoReflect.Descriptor instead.
+func (*PlanObject) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *InternalDataSource) GetTableName() string {
+func (x *PlanObject) GetKind() string {
 	if x != nil {
-		return x.TableName
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *InternalDataSource) GetDbName() string {
+func (x *PlanObject) GetName() string {
 	if x != nil {
-		return x.DbName
+		return x.Name
 	}
 	return ""
 }
 
-// doris数据源标识
-type DorisDataSource struct {
+// 试运行计划：只读取元数据、生成 SQL 并执行 EXPLAIN，不创建任何对象
+type ExecutionPlan struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableName string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"` // 表名
-	DbName    string `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`       // 数据库名（可选）
+	Path           string        `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"` // 执行路径，如 external->doris->minio->arrow
+	Steps          []*PlanStep   `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+	Objects        []*PlanObject `protobuf:"bytes,3,rep,name=objects,proto3" json:"objects,omitempty"`                // 执行时将创建的库、表、资源和导出对象
+	EstimatedRows  int64         `protobuf:"varint,4,opt,name=estimatedRows,proto3" json:"estimatedRows,omitempty"`   // 估算行数，-1 表示未知
+	EstimatedBytes int64         `protobuf:"varint,5,opt,name=estimatedBytes,proto3" json:"estimatedBytes,omitempty"` // 估算字节数，-1 表示未知
+	Warnings       []string      `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`              // 生成计划时未能获取的信息
 }
 
-func (x *DorisDataSource) Reset() {
-	*x = DorisDataSource{}
+func (x *ExecutionPlan) Reset() {
+	*x = ExecutionPlan{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[12]
+		mi := &file_proto_data_source_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DorisDataSource) String() string {
+func (x *ExecutionPlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DorisDataSource) ProtoMessage() {}
+func (*ExecutionPlan) ProtoMessage() {}
 
-func (x *DorisDataSource) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[12]
+func (x *ExecutionPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1589,57 +1809,80 @@ func (x *DorisDataSource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DorisDataSource.ProtoReflect.Descriptor instead.
-func (*DorisDataSource) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ExecutionPlan.ProtoReflect.Descriptor instead.
+func (*ExecutionPlan) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DorisDataSource) GetTableName() string {
+func (x *ExecutionPlan) GetPath() string {
 	if x != nil {
-		return x.TableName
+		return x.Path
 	}
 	return ""
 }
 
-func (x *DorisDataSource) GetDbName() string {
+func (x *ExecutionPlan) GetSteps() []*PlanStep {
 	if x != nil {
-		return x.DbName
+		return x.Steps
 	}
-	return ""
+	return nil
 }
 
-type QueryOperation struct {
+func (x *ExecutionPlan) GetObjects() []*PlanObject {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *ExecutionPlan) GetEstimatedRows() int64 {
+	if x != nil {
+		return x.EstimatedRows
+	}
+	return 0
+}
+
+func (x *ExecutionPlan) GetEstimatedBytes() int64 {
+	if x != nil {
+		return x.EstimatedBytes
+	}
+	return 0
+}
+
+func (x *ExecutionPlan) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type WriterDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DbFields        []string         `protobuf:"bytes,1,rep,name=dbFields,proto3" json:"dbFields,omitempty"`
-	DataObject      string           `protobuf:"bytes,2,opt,name=dataObject,proto3" json:"dataObject,omitempty"`
-	FilterNames     []string         `protobuf:"bytes,3,rep,name=filterNames,proto3" json:"filterNames,omitempty"`
-	FilterValues    []*FilterValue   `protobuf:"bytes,4,rep,name=filterValues,proto3" json:"filterValues,omitempty"`
-	FilterOperators []FilterOperator `protobuf:"varint,5,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"`
-	SortRules       []*SortRule      `protobuf:"bytes,6,rep,name=sortRules,proto3" json:"sortRules,omitempty"`
-	TableName       string           `protobuf:"bytes,7,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	JobInstanceId   string           `protobuf:"bytes,8,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"` // 作业实例ID
+	ArrowBatch  []byte `protobuf:"bytes,1,opt,name=arrow_batch,json=arrowBatch,proto3" json:"arrow_batch,omitempty"`
+	AssetName   string `protobuf:"bytes,2,opt,name=assetName,proto3" json:"assetName,omitempty"` // 资产名称
+	ChainInfoId string `protobuf:"bytes,3,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
 }
 
-func (x *QueryOperation) Reset() {
-	*x = QueryOperation{}
+func (x *WriterDataRequest) Reset() {
+	*x = WriterDataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[13]
+		mi := &file_proto_data_source_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryOperation) String() string {
+func (x *WriterDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryOperation) ProtoMessage() {}
+func (*WriterDataRequest) ProtoMessage() {}
 
-func (x *QueryOperation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[13]
+func (x *WriterDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1650,93 +1893,58 @@ func (x *QueryOperation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryOperation.ProtoReflect.Descriptor instead.
-func (*QueryOperation) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use WriterDataRequest.ProtoReflect.Descriptor instead.
+func (*WriterDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *QueryOperation) GetDbFields() []string {
+func (x *WriterDataRequest) GetArrowBatch() []byte {
 	if x != nil {
-		return x.DbFields
+		return x.ArrowBatch
 	}
 	return nil
 }
 
-func (x *QueryOperation) GetDataObject() string {
+func (x *WriterDataRequest) GetAssetName() string {
 	if x != nil {
-		return x.DataObject
+		return x.AssetName
 	}
 	return ""
 }
 
-func (x *QueryOperation) GetFilterNames() []string {
-	if x != nil {
-		return x.FilterNames
-	}
-	return nil
-}
-
-func (x *QueryOperation) GetFilterValues() []*FilterValue {
+func (x *WriterDataRequest) GetChainInfoId() string {
 	if x != nil {
-		return x.FilterValues
+		return x.ChainInfoId
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryOperation) GetFilterOperators() []FilterOperator {
-	if x != nil {
-		return x.FilterOperators
-	}
-	return nil
-}
-
-func (x *QueryOperation) GetSortRules() []*SortRule {
-	if x != nil {
-		return x.SortRules
-	}
-	return nil
-}
-
-func (x *QueryOperation) GetTableName() string {
-	if x != nil {
-		return x.TableName
-	}
-	return ""
-}
-
-func (x *QueryOperation) GetJobInstanceId() string {
-	if x != nil {
-		return x.JobInstanceId
-	}
-	return ""
-}
-
-type WriteOperation struct {
+type WrappedWriterDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DataObject  string `protobuf:"bytes,1,opt,name=dataObject,proto3" json:"dataObject,omitempty"`
-	TargetTable string `protobuf:"bytes,2,opt,name=targetTable,proto3" json:"targetTable,omitempty"`
+	Request   *WriterDataRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	RequestId string             `protobuf:"bytes,2,opt,name=requestId,proto3" json:"requestId,omitempty"`
 }
 
-func (x *WriteOperation) Reset() {
-	*x = WriteOperation{}
+func (x *WrappedWriterDataRequest) Reset() {
+	*x = WrappedWriterDataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[14]
+		mi := &file_proto_data_source_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WriteOperation) String() string {
+func (x *WrappedWriterDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteOperation) ProtoMessage() {}
+func (*WrappedWriterDataRequest) ProtoMessage() {}
 
-func (x *WriteOperation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[14]
+func (x *WrappedWriterDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1747,52 +1955,53 @@ func (x *WriteOperation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteOperation.ProtoReflect.Descriptor instead.
-func (*WriteOperation) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use WrappedWriterDataRequest.ProtoReflect.Descriptor instead.
+func (*WrappedWriterDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *WriteOperation) GetDataObject() string {
+func (x *WrappedWriterDataRequest) GetRequest() *WriterDataRequest {
 	if x != nil {
-		return x.DataObject
+		return x.Request
 	}
-	return ""
+	return nil
 }
 
-func (x *WriteOperation) GetTargetTable() string {
+func (x *WrappedWriterDataRequest) GetRequestId() string {
 	if x != nil {
-		return x.TargetTable
+		return x.RequestId
 	}
 	return ""
 }
 
-type SortOperation struct {
+type WriterInternalDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DataObject    string `protobuf:"bytes,1,opt,name=dataObject,proto3" json:"dataObject,omitempty"`
-	OrderByColumn string `protobuf:"bytes,2,opt,name=orderByColumn,proto3" json:"orderByColumn,omitempty"`
-	TargetTable   string `protobuf:"bytes,3,opt,name=targetTable,proto3" json:"targetTable,omitempty"`
+	ArrowBatch    []byte `protobuf:"bytes,1,opt,name=arrow_batch,json=arrowBatch,proto3" json:"arrow_batch,omitempty"`
+	DbName        string `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	TableName     string `protobuf:"bytes,3,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	JobInstanceId string `protobuf:"bytes,4,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
 }
 
-func (x *SortOperation) Reset() {
-	*x = SortOperation{}
+func (x *WriterInternalDataRequest) Reset() {
+	*x = WriterInternalDataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[15]
+		mi := &file_proto_data_source_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SortOperation) String() string {
+func (x *WriterInternalDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SortOperation) ProtoMessage() {}
+func (*WriterInternalDataRequest) ProtoMessage() {}
 
-func (x *SortOperation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[15]
+func (x *WriterInternalDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1803,60 +2012,69 @@ func (x *SortOperation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SortOperation.ProtoReflect.Descriptor instead.
-func (*SortOperation) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use WriterInternalDataRequest.ProtoReflect.Descriptor instead.
+func (*WriterInternalDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SortOperation) GetDataObject() string {
+func (x *WriterInternalDataRequest) GetArrowBatch() []byte {
 	if x != nil {
-		return x.DataObject
+		return x.ArrowBatch
+	}
+	return nil
+}
+
+func (x *WriterInternalDataRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
 	}
 	return ""
 }
 
-func (x *SortOperation) GetOrderByColumn() string {
+func (x *WriterInternalDataRequest) GetTableName() string {
 	if x != nil {
-		return x.OrderByColumn
+		return x.TableName
 	}
 	return ""
 }
 
-func (x *SortOperation) GetTargetTable() string {
+func (x *WriterInternalDataRequest) GetJobInstanceId() string {
 	if x != nil {
-		return x.TargetTable
+		return x.JobInstanceId
 	}
 	return ""
 }
 
-type CountOperation struct {
+type WriterExternalDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableName       string           `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	FilterNames     []string         `protobuf:"bytes,2,rep,name=filterNames,proto3" json:"filterNames,omitempty"`
-	FilterValues    []*FilterValue   `protobuf:"bytes,3,rep,name=filterValues,proto3" json:"filterValues,omitempty"`
-	FilterOperators []FilterOperator `protobuf:"varint,4,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"`
+	ArrowBatch  []byte `protobuf:"bytes,1,opt,name=arrow_batch,json=arrowBatch,proto3" json:"arrow_batch,omitempty"`
+	AssetName   string `protobuf:"bytes,2,opt,name=assetName,proto3" json:"assetName,omitempty"`
+	ChainInfoId string `protobuf:"bytes,3,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
+	RequestId   string `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	TableName   string `protobuf:"bytes,5,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	Alias       string `protobuf:"bytes,6,opt,name=alias,proto3" json:"alias,omitempty"`
 }
 
-func (x *CountOperation) Reset() {
-	*x = CountOperation{}
+func (x *WriterExternalDataRequest) Reset() {
+	*x = WriterExternalDataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[16]
+		mi := &file_proto_data_source_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CountOperation) String() string {
+func (x *WriterExternalDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountOperation) ProtoMessage() {}
+func (*WriterExternalDataRequest) ProtoMessage() {}
 
-func (x *CountOperation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[16]
+func (x *WriterExternalDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1867,68 +2085,88 @@ func (x *CountOperation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountOperation.ProtoReflect.Descriptor instead.
-func (*CountOperation) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use WriterExternalDataRequest.ProtoReflect.Descriptor instead.
+func (*WriterExternalDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CountOperation) GetTableName() string {
+func (x *WriterExternalDataRequest) GetArrowBatch() []byte {
 	if x != nil {
-		return x.TableName
+		return x.ArrowBatch
+	}
+	return nil
+}
+
+func (x *WriterExternalDataRequest) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
 	}
 	return ""
 }
 
-func (x *CountOperation) GetFilterNames() []string {
+func (x *WriterExternalDataRequest) GetChainInfoId() string {
 	if x != nil {
-		return x.FilterNames
+		return x.ChainInfoId
 	}
-	return nil
+	return ""
 }
 
-func (x *CountOperation) GetFilterValues() []*FilterValue {
+func (x *WriterExternalDataRequest) GetRequestId() string {
 	if x != nil {
-		return x.FilterValues
+		return x.RequestId
 	}
-	return nil
+	return ""
 }
 
-func (x *CountOperation) GetFilterOperators() []FilterOperator {
+func (x *WriterExternalDataRequest) GetTableName() string {
 	if x != nil {
-		return x.FilterOperators
+		return x.TableName
 	}
-	return nil
+	return ""
 }
 
-type GroupByCountOperation struct {
+func (x *WriterExternalDataRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type InternalReadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	TableName       string           `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	FilterNames     []string         `protobuf:"bytes,2,rep,name=filterNames,proto3" json:"filterNames,omitempty"`
-	FilterValues    []*FilterValue   `protobuf:"bytes,3,rep,name=filterValues,proto3" json:"filterValues,omitempty"`
-	FilterOperators []FilterOperator `protobuf:"varint,4,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"`
-	GroupByFields   []string         `protobuf:"bytes,5,rep,name=groupByFields,proto3" json:"groupByFields,omitempty"`
+	DbFields        []string         `protobuf:"bytes,2,rep,name=dbFields,proto3" json:"dbFields,omitempty"`
+	DbName          string           `protobuf:"bytes,3,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	FilterNames     []string         `protobuf:"bytes,4,rep,name=filterNames,proto3" json:"filterNames,omitempty"`                                                // 过滤字段
+	FilterValues    []*FilterValue   `protobuf:"bytes,5,rep,name=filterValues,proto3" json:"filterValues,omitempty"`                                              // 过滤字段值
+	SortRules       []*SortRule      `protobuf:"bytes,6,rep,name=sortRules,proto3" json:"sortRules,omitempty"`                                                    // 排序规则
+	FilterOperators []FilterOperator `protobuf:"varint,7,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"` // 过滤操作符
+	JobInstanceId   string           `protobuf:"bytes,8,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`                                            // 作业实例ID
+	Limit           int64            `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`                                                           // 最多返回的行数，0 表示不限制
+	Offset          int64            `protobuf:"varint,10,opt,name=offset,proto3" json:"offset,omitempty"`                                                        // 排序后跳过的行数
+	Sample          *ReadSample      `protobuf:"bytes,11,opt,name=sample,proto3" json:"sample,omitempty"`                                                         // 采样，在过滤之后、排序分页之前进行
 }
 
-func (x *GroupByCountOperation) Reset() {
-	*x = GroupByCountOperation{}
+func (x *InternalReadRequest) Reset() {
+	*x = InternalReadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[17]
+		mi := &file_proto_data_source_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupByCountOperation) String() string {
+func (x *InternalReadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupByCountOperation) ProtoMessage() {}
+func (*InternalReadRequest) ProtoMessage() {}
 
-func (x *GroupByCountOperation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[17]
+func (x *InternalReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1939,138 +2177,101 @@ func (x *GroupByCountOperation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupByCountOperation.ProtoReflect.Descriptor instead.
-func (*GroupByCountOperation) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use InternalReadRequest.ProtoReflect.Descriptor instead.
+func (*InternalReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GroupByCountOperation) GetTableName() string {
+func (x *InternalReadRequest) GetTableName() string {
 	if x != nil {
 		return x.TableName
 	}
 	return ""
 }
 
-func (x *GroupByCountOperation) GetFilterNames() []string {
+func (x *InternalReadRequest) GetDbFields() []string {
+	if x != nil {
+		return x.DbFields
+	}
+	return nil
+}
+
+func (x *InternalReadRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *InternalReadRequest) GetFilterNames() []string {
 	if x != nil {
 		return x.FilterNames
 	}
 	return nil
 }
 
-func (x *GroupByCountOperation) GetFilterValues() []*FilterValue {
+func (x *InternalReadRequest) GetFilterValues() []*FilterValue {
 	if x != nil {
 		return x.FilterValues
 	}
 	return nil
 }
 
-func (x *GroupByCountOperation) GetFilterOperators() []FilterOperator {
+func (x *InternalReadRequest) GetSortRules() []*SortRule {
 	if x != nil {
-		return x.FilterOperators
+		return x.SortRules
 	}
 	return nil
 }
 
-func (x *GroupByCountOperation) GetGroupByFields() []string {
+func (x *InternalReadRequest) GetFilterOperators() []FilterOperator {
 	if x != nil {
-		return x.GroupByFields
+		return x.FilterOperators
 	}
 	return nil
 }
 
-type JoinOperation struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	JoinColumns   []string `protobuf:"bytes,1,rep,name=joinColumns,proto3" json:"joinColumns,omitempty"`
-	JoinType      JoinType `protobuf:"varint,2,opt,name=joinType,proto3,enum=datasource.JoinType" json:"joinType,omitempty"`
-	TempTable     string   `protobuf:"bytes,3,opt,name=tempTable,proto3" json:"tempTable,omitempty"`
-	DataObject    string   `protobuf:"bytes,4,opt,name=dataObject,proto3" json:"dataObject,omitempty"`
-	JobInstanceId string   `protobuf:"bytes,5,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"` // 作业实例ID
+func (x *InternalReadRequest) GetJobInstanceId() string {
+	if x != nil {
+		return x.JobInstanceId
+	}
+	return ""
 }
 
-func (x *JoinOperation) Reset() {
-	*x = JoinOperation{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *JoinOperation) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*JoinOperation) ProtoMessage() {}
-
-func (x *JoinOperation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use JoinOperation.ProtoReflect.Descriptor instead.
-func (*JoinOperation) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *JoinOperation) GetJoinColumns() []string {
-	if x != nil {
-		return x.JoinColumns
-	}
-	return nil
-}
-
-func (x *JoinOperation) GetJoinType() JoinType {
+func (x *InternalReadRequest) GetLimit() int64 {
 	if x != nil {
-		return x.JoinType
-	}
-	return JoinType_JOIN_TYPE_UNKNOWN
-}
-
-func (x *JoinOperation) GetTempTable() string {
-	if x != nil {
-		return x.TempTable
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *JoinOperation) GetDataObject() string {
+func (x *InternalReadRequest) GetOffset() int64 {
 	if x != nil {
-		return x.DataObject
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *JoinOperation) GetJobInstanceId() string {
+func (x *InternalReadRequest) GetSample() *ReadSample {
 	if x != nil {
-		return x.JobInstanceId
+		return x.Sample
 	}
-	return ""
+	return nil
 }
 
-type AddHashColumnOperation struct {
+// 读取采样：percent 与 rows 二选一，seed 相同且数据不变时样本相同
+type ReadSample struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TempTable     string   `protobuf:"bytes,1,opt,name=tempTable,proto3" json:"tempTable,omitempty"`         // 临时表
-	JoinColumns   []string `protobuf:"bytes,2,rep,name=joinColumns,proto3" json:"joinColumns,omitempty"`     // join字段
-	JobInstanceId string   `protobuf:"bytes,3,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"` // 作业实例ID
-	Columns       []string `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`             // 列名
+	Percent float64 `protobuf:"fixed64,1,opt,name=percent,proto3" json:"percent,omitempty"` // 按比例采样，取值 (0, 100]
+	Rows    int64   `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`        // 随机抽取的行数
+	Seed    int64   `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`        // 随机种子
 }
 
-func (x *AddHashColumnOperation) Reset() {
-	*x = AddHashColumnOperation{}
+func (x *ReadSample) Reset() {
+	*x = ReadSample{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2078,13 +2279,13 @@ func (x *AddHashColumnOperation) Reset() {
 	}
 }
 
-func (x *AddHashColumnOperation) String() string {
+func (x *ReadSample) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddHashColumnOperation) ProtoMessage() {}
+func (*ReadSample) ProtoMessage() {}
 
-func (x *AddHashColumnOperation) ProtoReflect() protoreflect.Message {
+func (x *ReadSample) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2096,55 +2297,47 @@ func (x *AddHashColumnOperation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddHashColumnOperation.ProtoReflect.Descriptor instead.
-func (*AddHashColumnOperation) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadSample.ProtoReflect.Descriptor instead.
+func (*ReadSample) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *AddHashColumnOperation) GetTempTable() string {
-	if x != nil {
-		return x.TempTable
-	}
-	return ""
-}
-
-func (x *AddHashColumnOperation) GetJoinColumns() []string {
+func (x *ReadSample) GetPercent() float64 {
 	if x != nil {
-		return x.JoinColumns
+		return x.Percent
 	}
-	return nil
+	return 0
 }
 
-func (x *AddHashColumnOperation) GetJobInstanceId() string {
+func (x *ReadSample) GetRows() int64 {
 	if x != nil {
-		return x.JobInstanceId
+		return x.Rows
 	}
-	return ""
+	return 0
 }
 
-func (x *AddHashColumnOperation) GetColumns() []string {
+func (x *ReadSample) GetSeed() int64 {
 	if x != nil {
-		return x.Columns
+		return x.Seed
 	}
-	return nil
+	return 0
 }
 
-type PSIJoinOperation struct {
+// 读取窗口：过滤后采样，再按排序跳过 offset 行、最多取 limit 行
+type ReadWindow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InObjects     []string `protobuf:"bytes,1,rep,name=inObjects,proto3" json:"inObjects,omitempty"`         // 输入minio数据文件
-	JoinColumns   []string `protobuf:"bytes,2,rep,name=joinColumns,proto3" json:"joinColumns,omitempty"`     // join字段
-	TargetTable   string   `protobuf:"bytes,3,opt,name=targetTable,proto3" json:"targetTable,omitempty"`     // 目标表
-	OrderByColumn string   `protobuf:"bytes,4,opt,name=orderByColumn,proto3" json:"orderByColumn,omitempty"` // 排序字段
-	TempTable     string   `protobuf:"bytes,5,opt,name=tempTable,proto3" json:"tempTable,omitempty"`         // 临时表
-	DataObject    string   `protobuf:"bytes,6,opt,name=dataObject,proto3" json:"dataObject,omitempty"`       // join数据文件
-	JobInstanceId string   `protobuf:"bytes,7,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"` // 作业实例ID
+	FilterConditions []*FilterCondition `protobuf:"bytes,1,rep,name=filterConditions,proto3" json:"filterConditions,omitempty"` // 过滤条件
+	SortRules        []*SortRule        `protobuf:"bytes,2,rep,name=sortRules,proto3" json:"sortRules,omitempty"`               // 排序规则
+	Limit            int64              `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                      // 最多读取的行数，0 表示不限制
+	Offset           int64              `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`                    // 排序后跳过的行数
+	Sample           *ReadSample        `protobuf:"bytes,5,opt,name=sample,proto3" json:"sample,omitempty"`                     // 采样
 }
 
-func (x *PSIJoinOperation) Reset() {
-	*x = PSIJoinOperation{}
+func (x *ReadWindow) Reset() {
+	*x = ReadWindow{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2152,13 +2345,13 @@ func (x *PSIJoinOperation) Reset() {
 	}
 }
 
-func (x *PSIJoinOperation) String() string {
+func (x *ReadWindow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PSIJoinOperation) ProtoMessage() {}
+func (*ReadWindow) ProtoMessage() {}
 
-func (x *PSIJoinOperation) ProtoReflect() protoreflect.Message {
+func (x *ReadWindow) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2170,81 +2363,79 @@ func (x *PSIJoinOperation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PSIJoinOperation.ProtoReflect.Descriptor instead.
-func (*PSIJoinOperation) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadWindow.ProtoReflect.Descriptor instead.
+func (*ReadWindow) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *PSIJoinOperation) GetInObjects() []string {
+func (x *ReadWindow) GetFilterConditions() []*FilterCondition {
 	if x != nil {
-		return x.InObjects
+		return x.FilterConditions
 	}
 	return nil
 }
 
-func (x *PSIJoinOperation) GetJoinColumns() []string {
+func (x *ReadWindow) GetSortRules() []*SortRule {
 	if x != nil {
-		return x.JoinColumns
+		return x.SortRules
 	}
 	return nil
 }
 
-func (x *PSIJoinOperation) GetTargetTable() string {
-	if x != nil {
-		return x.TargetTable
-	}
-	return ""
-}
-
-func (x *PSIJoinOperation) GetOrderByColumn() string {
-	if x != nil {
-		return x.OrderByColumn
-	}
-	return ""
-}
-
-func (x *PSIJoinOperation) GetTempTable() string {
+func (x *ReadWindow) GetLimit() int64 {
 	if x != nil {
-		return x.TempTable
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *PSIJoinOperation) GetDataObject() string {
+func (x *ReadWindow) GetOffset() int64 {
 	if x != nil {
-		return x.DataObject
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *PSIJoinOperation) GetJobInstanceId() string {
+func (x *ReadWindow) GetSample() *ReadSample {
 	if x != nil {
-		return x.JobInstanceId
+		return x.Sample
 	}
-	return ""
+	return nil
 }
 
-// 流式任务请求
-type StreamReadRequest struct {
+// 批处理请求，包含查询条件或读取参数
+type BatchReadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssetName       string           `protobuf:"bytes,1,opt,name=assetName,proto3" json:"assetName,omitempty"` // 资产名称
-	ChainInfoId     string           `protobuf:"bytes,2,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
-	DbFields        []string         `protobuf:"bytes,3,rep,name=dbFields,proto3" json:"dbFields,omitempty"` // 数据库字段名的字符串数组
-	RequestId       string           `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`
-	FileType        FileType         `protobuf:"varint,5,opt,name=fileType,proto3,enum=datasource.FileType" json:"fileType,omitempty"`
-	FilePath        string           `protobuf:"bytes,6,opt,name=filePath,proto3" json:"filePath,omitempty"`
-	FilterNames     []string         `protobuf:"bytes,7,rep,name=filterNames,proto3" json:"filterNames,omitempty"`                                                 // 过滤字段
-	FilterValues    []*FilterValue   `protobuf:"bytes,8,rep,name=filterValues,proto3" json:"filterValues,omitempty"`                                               // 过滤字段值
-	SortRules       []*SortRule      `protobuf:"bytes,9,rep,name=sortRules,proto3" json:"sortRules,omitempty"`                                                     // 排序规则
-	FilterOperators []FilterOperator `protobuf:"varint,10,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"` // 过滤操作符
-	Alias           string           `protobuf:"bytes,11,opt,name=alias,proto3" json:"alias,omitempty"`                                                            // 链账户别名
+	// 公共字段
+	RequestId   string       `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	SparkConfig *SparkConfig `protobuf:"bytes,2,opt,name=sparkConfig,proto3" json:"sparkConfig,omitempty"`
+	// 数据源标识（二选一）
+	//
+	// Types that are assignable to DataSource:
+	//
+	//	*BatchReadRequest_External
+	//	*BatchReadRequest_Internal
+	DataSource isBatchReadRequest_DataSource `protobuf_oneof:"data_source"`
+	// 根据操作模式使用不同的参数
+	//
+	// Types that are assignable to Operation:
+	//
+	//	*BatchReadRequest_Query
+	//	*BatchReadRequest_Write
+	//	*BatchReadRequest_Sort
+	//	*BatchReadRequest_Count
+	//	*BatchReadRequest_GroupbyCount
+	//	*BatchReadRequest_Join
+	//	*BatchReadRequest_AddHashColumn
+	//	*BatchReadRequest_PsiJoin
+	Operation isBatchReadRequest_Operation `protobuf_oneof:"operation"`
 }
 
-func (x *StreamReadRequest) Reset() {
-	*x = StreamReadRequest{}
+func (x *BatchReadRequest) Reset() {
+	*x = BatchReadRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2252,13 +2443,13 @@ func (x *StreamReadRequest) Reset() {
 	}
 }
 
-func (x *StreamReadRequest) String() string {
+func (x *BatchReadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamReadRequest) ProtoMessage() {}
+func (*BatchReadRequest) ProtoMessage() {}
 
-func (x *StreamReadRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchReadRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2270,105 +2461,190 @@ func (x *StreamReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamReadRequest.ProtoReflect.Descriptor instead.
-func (*StreamReadRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchReadRequest.ProtoReflect.Descriptor instead.
+func (*BatchReadRequest) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *StreamReadRequest) GetAssetName() string {
+func (x *BatchReadRequest) GetRequestId() string {
 	if x != nil {
-		return x.AssetName
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *StreamReadRequest) GetChainInfoId() string {
+func (x *BatchReadRequest) GetSparkConfig() *SparkConfig {
 	if x != nil {
-		return x.ChainInfoId
+		return x.SparkConfig
 	}
-	return ""
+	return nil
 }
 
-func (x *StreamReadRequest) GetDbFields() []string {
-	if x != nil {
-		return x.DbFields
+func (m *BatchReadRequest) GetDataSource() isBatchReadRequest_DataSource {
+	if m != nil {
+		return m.DataSource
 	}
 	return nil
 }
 
-func (x *StreamReadRequest) GetRequestId() string {
-	if x != nil {
-		return x.RequestId
+func (x *BatchReadRequest) GetExternal() *ExternalDataSource {
+	if x, ok := x.GetDataSource().(*BatchReadRequest_External); ok {
+		return x.External
 	}
-	return ""
+	return nil
 }
 
-func (x *StreamReadRequest) GetFileType() FileType {
-	if x != nil {
-		return x.FileType
+func (x *BatchReadRequest) GetInternal() *InternalDataSource {
+	if x, ok := x.GetDataSource().(*BatchReadRequest_Internal); ok {
+		return x.Internal
 	}
-	return FileType_FILE_TYPE_UNKNOWN
+	return nil
 }
 
-func (x *StreamReadRequest) GetFilePath() string {
-	if x != nil {
-		return x.FilePath
+func (m *BatchReadRequest) GetOperation() isBatchReadRequest_Operation {
+	if m != nil {
+		return m.Operation
 	}
-	return ""
+	return nil
 }
 
-func (x *StreamReadRequest) GetFilterNames() []string {
-	if x != nil {
-		return x.FilterNames
+func (x *BatchReadRequest) GetQuery() *QueryOperation {
+	if x, ok := x.GetOperation().(*BatchReadRequest_Query); ok {
+		return x.Query
 	}
 	return nil
 }
 
-func (x *StreamReadRequest) GetFilterValues() []*FilterValue {
-	if x != nil {
-		return x.FilterValues
+func (x *BatchReadRequest) GetWrite() *WriteOperation {
+	if x, ok := x.GetOperation().(*BatchReadRequest_Write); ok {
+		return x.Write
 	}
 	return nil
 }
 
-func (x *StreamReadRequest) GetSortRules() []*SortRule {
-	if x != nil {
-		return x.SortRules
+func (x *BatchReadRequest) GetSort() *SortOperation {
+	if x, ok := x.GetOperation().(*BatchReadRequest_Sort); ok {
+		return x.Sort
 	}
 	return nil
 }
 
-func (x *StreamReadRequest) GetFilterOperators() []FilterOperator {
-	if x != nil {
-		return x.FilterOperators
+func (x *BatchReadRequest) GetCount() *CountOperation {
+	if x, ok := x.GetOperation().(*BatchReadRequest_Count); ok {
+		return x.Count
 	}
 	return nil
 }
 
-func (x *StreamReadRequest) GetAlias() string {
-	if x != nil {
-		return x.Alias
+func (x *BatchReadRequest) GetGroupbyCount() *GroupByCountOperation {
+	if x, ok := x.GetOperation().(*BatchReadRequest_GroupbyCount); ok {
+		return x.GroupbyCount
 	}
-	return ""
+	return nil
 }
 
-type FilterValue struct {
+func (x *BatchReadRequest) GetJoin() *JoinOperation {
+	if x, ok := x.GetOperation().(*BatchReadRequest_Join); ok {
+		return x.Join
+	}
+	return nil
+}
+
+func (x *BatchReadRequest) GetAddHashColumn() *AddHashColumnOperation {
+	if x, ok := x.GetOperation().(*BatchReadRequest_AddHashColumn); ok {
+		return x.AddHashColumn
+	}
+	return nil
+}
+
+func (x *BatchReadRequest) GetPsiJoin() *PSIJoinOperation {
+	if x, ok := x.GetOperation().(*BatchReadRequest_PsiJoin); ok {
+		return x.PsiJoin
+	}
+	return nil
+}
+
+type isBatchReadRequest_DataSource interface {
+	isBatchReadRequest_DataSource()
+}
+
+type BatchReadRequest_External struct {
+	External *ExternalDataSource `protobuf:"bytes,3,opt,name=external,proto3,oneof"` // 外部数据源
+}
+
+type BatchReadRequest_Internal struct {
+	Internal *InternalDataSource `protobuf:"bytes,4,opt,name=internal,proto3,oneof"` // 内部数据源
+}
+
+func (*BatchReadRequest_External) isBatchReadRequest_DataSource() {}
+
+func (*BatchReadRequest_Internal) isBatchReadRequest_DataSource() {}
+
+type isBatchReadRequest_Operation interface {
+	isBatchReadRequest_Operation()
+}
+
+type BatchReadRequest_Query struct {
+	Query *QueryOperation `protobuf:"bytes,10,opt,name=query,proto3,oneof"`
+}
+
+type BatchReadRequest_Write struct {
+	Write *WriteOperation `protobuf:"bytes,11,opt,name=write,proto3,oneof"`
+}
+
+type BatchReadRequest_Sort struct {
+	Sort *SortOperation `protobuf:"bytes,12,opt,name=sort,proto3,oneof"`
+}
+
+type BatchReadRequest_Count struct {
+	Count *CountOperation `protobuf:"bytes,13,opt,name=count,proto3,oneof"`
+}
+
+type BatchReadRequest_GroupbyCount struct {
+	GroupbyCount *GroupByCountOperation `protobuf:"bytes,14,opt,name=groupby_count,json=groupbyCount,proto3,oneof"`
+}
+
+type BatchReadRequest_Join struct {
+	Join *JoinOperation `protobuf:"bytes,15,opt,name=join,proto3,oneof"`
+}
+
+type BatchReadRequest_AddHashColumn struct {
+	AddHashColumn *AddHashColumnOperation `protobuf:"bytes,16,opt,name=add_hash_column,json=addHashColumn,proto3,oneof"`
+}
+
+type BatchReadRequest_PsiJoin struct {
+	PsiJoin *PSIJoinOperation `protobuf:"bytes,17,opt,name=psi_join,json=psiJoin,proto3,oneof"`
+}
+
+func (*BatchReadRequest_Query) isBatchReadRequest_Operation() {}
+
+func (*BatchReadRequest_Write) isBatchReadRequest_Operation() {}
+
+func (*BatchReadRequest_Sort) isBatchReadRequest_Operation() {}
+
+func (*BatchReadRequest_Count) isBatchReadRequest_Operation() {}
+
+func (*BatchReadRequest_GroupbyCount) isBatchReadRequest_Operation() {}
+
+func (*BatchReadRequest_Join) isBatchReadRequest_Operation() {}
+
+func (*BatchReadRequest_AddHashColumn) isBatchReadRequest_Operation() {}
+
+func (*BatchReadRequest_PsiJoin) isBatchReadRequest_Operation() {}
+
+// 外部数据源标识
+type ExternalDataSource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StrValue    string    `protobuf:"bytes,1,opt,name=str_value,json=strValue,proto3" json:"str_value,omitempty"`                   // 字符串类型（单个值）
-	IntValue    int32     `protobuf:"varint,2,opt,name=int_value,json=intValue,proto3" json:"int_value,omitempty"`                  // 整型类型（单个值）
-	FloatValue  float64   `protobuf:"fixed64,3,opt,name=float_value,json=floatValue,proto3" json:"float_value,omitempty"`           // 浮动类型（单个值）
-	BoolValue   bool      `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3" json:"bool_value,omitempty"`               // 布尔类型（单个值）
-	StrValues   []string  `protobuf:"bytes,5,rep,name=str_values,json=strValues,proto3" json:"str_values,omitempty"`                // 字符串数组（支持 IN 操作）
-	IntValues   []int32   `protobuf:"varint,6,rep,packed,name=int_values,json=intValues,proto3" json:"int_values,omitempty"`        // 整型数组（支持 IN 操作）
-	FloatValues []float64 `protobuf:"fixed64,7,rep,packed,name=float_values,json=floatValues,proto3" json:"float_values,omitempty"` // 浮动数组（支持 IN 操作）
-	BoolValues  []bool    `protobuf:"varint,8,rep,packed,name=bool_values,json=boolValues,proto3" json:"bool_values,omitempty"`     // 布尔数组（支持 IN 操作）
+	AssetName   string `protobuf:"bytes,1,opt,name=assetName,proto3" json:"assetName,omitempty"`     // 资产名称
+	ChainInfoId string `protobuf:"bytes,2,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"` // 链信息ID
+	Alias       string `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`             // 链账户别名
 }
 
-func (x *FilterValue) Reset() {
-	*x = FilterValue{}
+func (x *ExternalDataSource) Reset() {
+	*x = ExternalDataSource{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2376,13 +2652,13 @@ func (x *FilterValue) Reset() {
 	}
 }
 
-func (x *FilterValue) String() string {
+func (x *ExternalDataSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilterValue) ProtoMessage() {}
+func (*ExternalDataSource) ProtoMessage() {}
 
-func (x *FilterValue) ProtoReflect() protoreflect.Message {
+func (x *ExternalDataSource) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2394,78 +2670,44 @@ func (x *FilterValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilterValue.ProtoReflect.Descriptor instead.
-func (*FilterValue) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExternalDataSource.ProtoReflect.Descriptor instead.
+func (*ExternalDataSource) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *FilterValue) GetStrValue() string {
+func (x *ExternalDataSource) GetAssetName() string {
 	if x != nil {
-		return x.StrValue
+		return x.AssetName
 	}
 	return ""
 }
 
-func (x *FilterValue) GetIntValue() int32 {
-	if x != nil {
-		return x.IntValue
-	}
-	return 0
-}
-
-func (x *FilterValue) GetFloatValue() float64 {
-	if x != nil {
-		return x.FloatValue
-	}
-	return 0
-}
-
-func (x *FilterValue) GetBoolValue() bool {
-	if x != nil {
-		return x.BoolValue
-	}
-	return false
-}
-
-func (x *FilterValue) GetStrValues() []string {
-	if x != nil {
-		return x.StrValues
-	}
-	return nil
-}
-
-func (x *FilterValue) GetIntValues() []int32 {
-	if x != nil {
-		return x.IntValues
-	}
-	return nil
-}
-
-func (x *FilterValue) GetFloatValues() []float64 {
+func (x *ExternalDataSource) GetChainInfoId() string {
 	if x != nil {
-		return x.FloatValues
+		return x.ChainInfoId
 	}
-	return nil
+	return ""
 }
 
-func (x *FilterValue) GetBoolValues() []bool {
+func (x *ExternalDataSource) GetAlias() string {
 	if x != nil {
-		return x.BoolValues
+		return x.Alias
 	}
-	return nil
+	return ""
 }
 
-type SortRule struct {
+// 内部数据源标识
+type InternalDataSource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FieldName string    `protobuf:"bytes,1,opt,name=fieldName,proto3" json:"fieldName,omitempty"`                            // 排序字段名
-	SortOrder SortOrder `protobuf:"varint,2,opt,name=sortOrder,proto3,enum=datasource.SortOrder" json:"sortOrder,omitempty"` // 排序方向
+	TableName string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"` // 表名
+	DbName    string `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`       // 数据库名（可选）
 }
 
-func (x *SortRule) Reset() {
-	*x = SortRule{}
+func (x *InternalDataSource) Reset() {
+	*x = InternalDataSource{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2473,13 +2715,13 @@ func (x *SortRule) Reset() {
 	}
 }
 
-func (x *SortRule) String() string {
+func (x *InternalDataSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SortRule) ProtoMessage() {}
+func (*InternalDataSource) ProtoMessage() {}
 
-func (x *SortRule) ProtoReflect() protoreflect.Message {
+func (x *InternalDataSource) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2491,43 +2733,37 @@ func (x *SortRule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SortRule.ProtoReflect.Descriptor instead.
-func (*SortRule) Descriptor() ([]byte, []int) {
+// Deprecated: Use InternalDataSource.ProtoReflect.Descriptor instead.
+func (*InternalDataSource) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *SortRule) GetFieldName() string {
+func (x *InternalDataSource) GetTableName() string {
 	if x != nil {
-		return x.FieldName
+		return x.TableName
 	}
 	return ""
 }
 
-func (x *SortRule) GetSortOrder() SortOrder {
+func (x *InternalDataSource) GetDbName() string {
 	if x != nil {
-		return x.SortOrder
+		return x.DbName
 	}
-	return SortOrder_ASC
+	return ""
 }
 
-type ConnectionInfo struct {
+// doris数据源标识
+type DorisDataSource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Dbtype    int32                `protobuf:"varint,1,opt,name=dbtype,proto3" json:"dbtype,omitempty"`      //数据库类型
-	Host      string               `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`           // 数据库地址
-	Port      int32                `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`          // 数据库端口
-	TableName string               `protobuf:"bytes,4,opt,name=tableName,proto3" json:"tableName,omitempty"` // 表名
-	TlsConfig *DatasourceTlsConfig `protobuf:"bytes,5,opt,name=tlsConfig,proto3" json:"tlsConfig,omitempty"` // TLS配置
-	User      string               `protobuf:"bytes,8,opt,name=user,proto3" json:"user,omitempty"`
-	Password  string               `protobuf:"bytes,9,opt,name=password,proto3" json:"password,omitempty"`
-	DbName    string               `protobuf:"bytes,10,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	Columns   []*ColumnItem        `protobuf:"bytes,11,rep,name=columns,proto3" json:"columns,omitempty"`
+	TableName string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"` // 表名
+	DbName    string `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`       // 数据库名（可选）
 }
 
-func (x *ConnectionInfo) Reset() {
-	*x = ConnectionInfo{}
+func (x *DorisDataSource) Reset() {
+	*x = DorisDataSource{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2535,13 +2771,13 @@ func (x *ConnectionInfo) Reset() {
 	}
 }
 
-func (x *ConnectionInfo) String() string {
+func (x *DorisDataSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectionInfo) ProtoMessage() {}
+func (*DorisDataSource) ProtoMessage() {}
 
-func (x *ConnectionInfo) ProtoReflect() protoreflect.Message {
+func (x *DorisDataSource) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2553,85 +2789,42 @@ func (x *ConnectionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectionInfo.ProtoReflect.Descriptor instead.
-func (*ConnectionInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use DorisDataSource.ProtoReflect.Descriptor instead.
+func (*DorisDataSource) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ConnectionInfo) GetDbtype() int32 {
-	if x != nil {
-		return x.Dbtype
-	}
-	return 0
-}
-
-func (x *ConnectionInfo) GetHost() string {
+func (x *DorisDataSource) GetTableName() string {
 	if x != nil {
-		return x.Host
+		return x.TableName
 	}
 	return ""
 }
 
-func (x *ConnectionInfo) GetPort() int32 {
-	if x != nil {
-		return x.Port
-	}
-	return 0
-}
-
-func (x *ConnectionInfo) GetTableName() string {
+func (x *DorisDataSource) GetDbName() string {
 	if x != nil {
-		return x.TableName
+		return x.DbName
 	}
 	return ""
 }
 
-func (x *ConnectionInfo) GetTlsConfig() *DatasourceTlsConfig {
-	if x != nil {
-		return x.TlsConfig
-	}
-	return nil
-}
-
-func (x *ConnectionInfo) GetUser() string {
-	if x != nil {
-		return x.User
-	}
-	return ""
-}
-
-func (x *ConnectionInfo) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
-}
-
-func (x *ConnectionInfo) GetDbName() string {
-	if x != nil {
-		return x.DbName
-	}
-	return ""
-}
-
-func (x *ConnectionInfo) GetColumns() []*ColumnItem {
-	if x != nil {
-		return x.Columns
-	}
-	return nil
-}
-
-type ColumnItem struct {
+type QueryOperation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                         // 字段名称
-	DataType string `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"` // 字段类型
+	DbFields        []string         `protobuf:"bytes,1,rep,name=dbFields,proto3" json:"dbFields,omitempty"`
+	DataObject      string           `protobuf:"bytes,2,opt,name=dataObject,proto3" json:"dataObject,omitempty"`
+	FilterNames     []string         `protobuf:"bytes,3,rep,name=filterNames,proto3" json:"filterNames,omitempty"`
+	FilterValues    []*FilterValue   `protobuf:"bytes,4,rep,name=filterValues,proto3" json:"filterValues,omitempty"`
+	FilterOperators []FilterOperator `protobuf:"varint,5,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"`
+	SortRules       []*SortRule      `protobuf:"bytes,6,rep,name=sortRules,proto3" json:"sortRules,omitempty"`
+	TableName       string           `protobuf:"bytes,7,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	JobInstanceId   string           `protobuf:"bytes,8,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"` // 作业实例ID
 }
 
-func (x *ColumnItem) Reset() {
-	*x = ColumnItem{}
+func (x *QueryOperation) Reset() {
+	*x = QueryOperation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2639,13 +2832,13 @@ func (x *ColumnItem) Reset() {
 	}
 }
 
-func (x *ColumnItem) String() string {
+func (x *QueryOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColumnItem) ProtoMessage() {}
+func (*QueryOperation) ProtoMessage() {}
 
-func (x *ColumnItem) ProtoReflect() protoreflect.Message {
+func (x *QueryOperation) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2657,37 +2850,78 @@ func (x *ColumnItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColumnItem.ProtoReflect.Descriptor instead.
-func (*ColumnItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueryOperation.ProtoReflect.Descriptor instead.
+func (*QueryOperation) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ColumnItem) GetName() string {
+func (x *QueryOperation) GetDbFields() []string {
 	if x != nil {
-		return x.Name
+		return x.DbFields
+	}
+	return nil
+}
+
+func (x *QueryOperation) GetDataObject() string {
+	if x != nil {
+		return x.DataObject
 	}
 	return ""
 }
 
-func (x *ColumnItem) GetDataType() string {
+func (x *QueryOperation) GetFilterNames() []string {
 	if x != nil {
-		return x.DataType
+		return x.FilterNames
+	}
+	return nil
+}
+
+func (x *QueryOperation) GetFilterValues() []*FilterValue {
+	if x != nil {
+		return x.FilterValues
+	}
+	return nil
+}
+
+func (x *QueryOperation) GetFilterOperators() []FilterOperator {
+	if x != nil {
+		return x.FilterOperators
+	}
+	return nil
+}
+
+func (x *QueryOperation) GetSortRules() []*SortRule {
+	if x != nil {
+		return x.SortRules
+	}
+	return nil
+}
+
+func (x *QueryOperation) GetTableName() string {
+	if x != nil {
+		return x.TableName
 	}
 	return ""
 }
 
-type ServerInfo struct {
+func (x *QueryOperation) GetJobInstanceId() string {
+	if x != nil {
+		return x.JobInstanceId
+	}
+	return ""
+}
+
+type WriteOperation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Namespace   string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	ServiceName string `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
-	ServicePort string `protobuf:"bytes,3,opt,name=servicePort,proto3" json:"servicePort,omitempty"`
+	DataObject  string `protobuf:"bytes,1,opt,name=dataObject,proto3" json:"dataObject,omitempty"`
+	TargetTable string `protobuf:"bytes,2,opt,name=targetTable,proto3" json:"targetTable,omitempty"`
 }
 
-func (x *ServerInfo) Reset() {
-	*x = ServerInfo{}
+func (x *WriteOperation) Reset() {
+	*x = WriteOperation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2695,13 +2929,13 @@ func (x *ServerInfo) Reset() {
 	}
 }
 
-func (x *ServerInfo) String() string {
+func (x *WriteOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerInfo) ProtoMessage() {}
+func (*WriteOperation) ProtoMessage() {}
 
-func (x *ServerInfo) ProtoReflect() protoreflect.Message {
+func (x *WriteOperation) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2713,44 +2947,37 @@ func (x *ServerInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerInfo.ProtoReflect.Descriptor instead.
-func (*ServerInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use WriteOperation.ProtoReflect.Descriptor instead.
+func (*WriteOperation) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ServerInfo) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
-	}
-	return ""
-}
-
-func (x *ServerInfo) GetServiceName() string {
+func (x *WriteOperation) GetDataObject() string {
 	if x != nil {
-		return x.ServiceName
+		return x.DataObject
 	}
 	return ""
 }
 
-func (x *ServerInfo) GetServicePort() string {
+func (x *WriteOperation) GetTargetTable() string {
 	if x != nil {
-		return x.ServicePort
+		return x.TargetTable
 	}
 	return ""
 }
 
-type OSSWriteRequest struct {
+type SortOperation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BucketName string `protobuf:"bytes,1,opt,name=bucketName,proto3" json:"bucketName,omitempty"` // 存储桶名称
-	ObjectName string `protobuf:"bytes,2,opt,name=objectName,proto3" json:"objectName,omitempty"` // 对象名称（文件名）
-	Chunk      []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`           // 文件内容块
+	DataObject    string `protobuf:"bytes,1,opt,name=dataObject,proto3" json:"dataObject,omitempty"`
+	OrderByColumn string `protobuf:"bytes,2,opt,name=orderByColumn,proto3" json:"orderByColumn,omitempty"`
+	TargetTable   string `protobuf:"bytes,3,opt,name=targetTable,proto3" json:"targetTable,omitempty"`
 }
 
-func (x *OSSWriteRequest) Reset() {
-	*x = OSSWriteRequest{}
+func (x *SortOperation) Reset() {
+	*x = SortOperation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2758,13 +2985,13 @@ func (x *OSSWriteRequest) Reset() {
 	}
 }
 
-func (x *OSSWriteRequest) String() string {
+func (x *SortOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OSSWriteRequest) ProtoMessage() {}
+func (*SortOperation) ProtoMessage() {}
 
-func (x *OSSWriteRequest) ProtoReflect() protoreflect.Message {
+func (x *SortOperation) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2776,43 +3003,45 @@ func (x *OSSWriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OSSWriteRequest.ProtoReflect.Descriptor instead.
-func (*OSSWriteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SortOperation.ProtoReflect.Descriptor instead.
+func (*SortOperation) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *OSSWriteRequest) GetBucketName() string {
+func (x *SortOperation) GetDataObject() string {
 	if x != nil {
-		return x.BucketName
+		return x.DataObject
 	}
 	return ""
 }
 
-func (x *OSSWriteRequest) GetObjectName() string {
+func (x *SortOperation) GetOrderByColumn() string {
 	if x != nil {
-		return x.ObjectName
+		return x.OrderByColumn
 	}
 	return ""
 }
 
-func (x *OSSWriteRequest) GetChunk() []byte {
+func (x *SortOperation) GetTargetTable() string {
 	if x != nil {
-		return x.Chunk
+		return x.TargetTable
 	}
-	return nil
+	return ""
 }
 
-type OSSReadRequest struct {
+type CountOperation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BucketName string `protobuf:"bytes,1,opt,name=bucketName,proto3" json:"bucketName,omitempty"`
-	ObjectName string `protobuf:"bytes,2,opt,name=objectName,proto3" json:"objectName,omitempty"`
+	TableName       string           `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	FilterNames     []string         `protobuf:"bytes,2,rep,name=filterNames,proto3" json:"filterNames,omitempty"`
+	FilterValues    []*FilterValue   `protobuf:"bytes,3,rep,name=filterValues,proto3" json:"filterValues,omitempty"`
+	FilterOperators []FilterOperator `protobuf:"varint,4,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"`
 }
 
-func (x *OSSReadRequest) Reset() {
-	*x = OSSReadRequest{}
+func (x *CountOperation) Reset() {
+	*x = CountOperation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2820,13 +3049,13 @@ func (x *OSSReadRequest) Reset() {
 	}
 }
 
-func (x *OSSReadRequest) String() string {
+func (x *CountOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OSSReadRequest) ProtoMessage() {}
+func (*CountOperation) ProtoMessage() {}
 
-func (x *OSSReadRequest) ProtoReflect() protoreflect.Message {
+func (x *CountOperation) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2838,37 +3067,53 @@ func (x *OSSReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OSSReadRequest.ProtoReflect.Descriptor instead.
-func (*OSSReadRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CountOperation.ProtoReflect.Descriptor instead.
+func (*CountOperation) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *OSSReadRequest) GetBucketName() string {
+func (x *CountOperation) GetTableName() string {
 	if x != nil {
-		return x.BucketName
+		return x.TableName
 	}
 	return ""
 }
 
-func (x *OSSReadRequest) GetObjectName() string {
+func (x *CountOperation) GetFilterNames() []string {
 	if x != nil {
-		return x.ObjectName
+		return x.FilterNames
 	}
-	return ""
+	return nil
 }
 
-type OSSReadResponse struct {
+func (x *CountOperation) GetFilterValues() []*FilterValue {
+	if x != nil {
+		return x.FilterValues
+	}
+	return nil
+}
+
+func (x *CountOperation) GetFilterOperators() []FilterOperator {
+	if x != nil {
+		return x.FilterOperators
+	}
+	return nil
+}
+
+type GroupByCountOperation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Chunk   []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	TableName       string           `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	FilterNames     []string         `protobuf:"bytes,2,rep,name=filterNames,proto3" json:"filterNames,omitempty"`
+	FilterValues    []*FilterValue   `protobuf:"bytes,3,rep,name=filterValues,proto3" json:"filterValues,omitempty"`
+	FilterOperators []FilterOperator `protobuf:"varint,4,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"`
+	GroupByFields   []string         `protobuf:"bytes,5,rep,name=groupByFields,proto3" json:"groupByFields,omitempty"`
 }
 
-func (x *OSSReadResponse) Reset() {
-	*x = OSSReadResponse{}
+func (x *GroupByCountOperation) Reset() {
+	*x = GroupByCountOperation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2876,13 +3121,13 @@ func (x *OSSReadResponse) Reset() {
 	}
 }
 
-func (x *OSSReadResponse) String() string {
+func (x *GroupByCountOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OSSReadResponse) ProtoMessage() {}
+func (*GroupByCountOperation) ProtoMessage() {}
 
-func (x *OSSReadResponse) ProtoReflect() protoreflect.Message {
+func (x *GroupByCountOperation) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2894,61 +3139,60 @@ func (x *OSSReadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OSSReadResponse.ProtoReflect.Descriptor instead.
-func (*OSSReadResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupByCountOperation.ProtoReflect.Descriptor instead.
+func (*GroupByCountOperation) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *OSSReadResponse) GetSuccess() bool {
+func (x *GroupByCountOperation) GetTableName() string {
 	if x != nil {
-		return x.Success
+		return x.TableName
 	}
-	return false
+	return ""
 }
 
-func (x *OSSReadResponse) GetMessage() string {
+func (x *GroupByCountOperation) GetFilterNames() []string {
 	if x != nil {
-		return x.Message
+		return x.FilterNames
 	}
-	return ""
+	return nil
 }
 
-func (x *OSSReadResponse) GetChunk() []byte {
+func (x *GroupByCountOperation) GetFilterValues() []*FilterValue {
 	if x != nil {
-		return x.Chunk
+		return x.FilterValues
 	}
 	return nil
 }
 
-type SparkDBConnInfo struct {
+func (x *GroupByCountOperation) GetFilterOperators() []FilterOperator {
+	if x != nil {
+		return x.FilterOperators
+	}
+	return nil
+}
+
+func (x *GroupByCountOperation) GetGroupByFields() []string {
+	if x != nil {
+		return x.GroupByFields
+	}
+	return nil
+}
+
+type JoinOperation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DbType        string        `protobuf:"bytes,1,opt,name=dbType,proto3" json:"dbType,omitempty"`
-	Host          string        `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
-	Port          int32         `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
-	Database      string        `protobuf:"bytes,4,opt,name=database,proto3" json:"database,omitempty"`
-	Username      string        `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string        `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
-	Query         string        `protobuf:"bytes,7,opt,name=query,proto3" json:"query,omitempty"`           // 执行的查询语句
-	DataObject    string        `protobuf:"bytes,8,opt,name=dataObject,proto3" json:"dataObject,omitempty"` // 存放的数据文件
-	InColumns     []string      `protobuf:"bytes,9,rep,name=inColumns,proto3" json:"inColumns,omitempty"`   // IN字段
-	InObjects     []string      `protobuf:"bytes,10,rep,name=inObjects,proto3" json:"inObjects,omitempty"`  // IN数据文件
-	BucketName    string        `protobuf:"bytes,11,opt,name=bucketName,proto3" json:"bucketName,omitempty"`
-	DbFields      []string      `protobuf:"bytes,12,rep,name=dbFields,proto3" json:"dbFields,omitempty"`                        // 数据库字段
-	JoinColumns   []string      `protobuf:"bytes,13,rep,name=joinColumns,proto3" json:"joinColumns,omitempty"`                  // join字段
-	Mode          OperationMode `protobuf:"varint,14,opt,name=mode,proto3,enum=datasource.OperationMode" json:"mode,omitempty"` // 执行的spark功能函数
-	JoinType      JoinType      `protobuf:"varint,15,opt,name=joinType,proto3,enum=datasource.JoinType" json:"joinType,omitempty"`
-	TempTable     string        `protobuf:"bytes,16,opt,name=tempTable,proto3" json:"tempTable,omitempty"`                                  // 存储的中间表
-	OrderByColumn string        `protobuf:"bytes,17,opt,name=orderByColumn,proto3" json:"orderByColumn,omitempty"`                          // 排序字段
-	TargetTable   string        `protobuf:"bytes,18,opt,name=targetTable,proto3" json:"targetTable,omitempty"`                              // 目标表
-	StorageType   StorageType   `protobuf:"varint,19,opt,name=storageType,proto3,enum=datasource.StorageType" json:"storageType,omitempty"` // 存储类型
-	SortRules     []*SortRule   `protobuf:"bytes,20,rep,name=sortRules,proto3" json:"sortRules,omitempty"`                                  // 排序规则
+	JoinColumns   []string `protobuf:"bytes,1,rep,name=joinColumns,proto3" json:"joinColumns,omitempty"`
+	JoinType      JoinType `protobuf:"varint,2,opt,name=joinType,proto3,enum=datasource.JoinType" json:"joinType,omitempty"`
+	TempTable     string   `protobuf:"bytes,3,opt,name=tempTable,proto3" json:"tempTable,omitempty"`
+	DataObject    string   `protobuf:"bytes,4,opt,name=dataObject,proto3" json:"dataObject,omitempty"`
+	JobInstanceId string   `protobuf:"bytes,5,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"` // 作业实例ID
 }
 
-func (x *SparkDBConnInfo) Reset() {
-	*x = SparkDBConnInfo{}
+func (x *JoinOperation) Reset() {
+	*x = JoinOperation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2956,13 +3200,13 @@ func (x *SparkDBConnInfo) Reset() {
 	}
 }
 
-func (x *SparkDBConnInfo) String() string {
+func (x *JoinOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SparkDBConnInfo) ProtoMessage() {}
+func (*JoinOperation) ProtoMessage() {}
 
-func (x *SparkDBConnInfo) ProtoReflect() protoreflect.Message {
+func (x *JoinOperation) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2974,184 +3218,164 @@ func (x *SparkDBConnInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SparkDBConnInfo.ProtoReflect.Descriptor instead.
-func (*SparkDBConnInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinOperation.ProtoReflect.Descriptor instead.
+func (*JoinOperation) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SparkDBConnInfo) GetDbType() string {
-	if x != nil {
-		return x.DbType
-	}
-	return ""
-}
-
-func (x *SparkDBConnInfo) GetHost() string {
+func (x *JoinOperation) GetJoinColumns() []string {
 	if x != nil {
-		return x.Host
+		return x.JoinColumns
 	}
-	return ""
+	return nil
 }
 
-func (x *SparkDBConnInfo) GetPort() int32 {
+func (x *JoinOperation) GetJoinType() JoinType {
 	if x != nil {
-		return x.Port
+		return x.JoinType
 	}
-	return 0
+	return JoinType_JOIN_TYPE_UNKNOWN
 }
 
-func (x *SparkDBConnInfo) GetDatabase() string {
+func (x *JoinOperation) GetTempTable() string {
 	if x != nil {
-		return x.Database
+		return x.TempTable
 	}
 	return ""
 }
 
-func (x *SparkDBConnInfo) GetUsername() string {
+func (x *JoinOperation) GetDataObject() string {
 	if x != nil {
-		return x.Username
+		return x.DataObject
 	}
 	return ""
 }
 
-func (x *SparkDBConnInfo) GetPassword() string {
+func (x *JoinOperation) GetJobInstanceId() string {
 	if x != nil {
-		return x.Password
+		return x.JobInstanceId
 	}
 	return ""
 }
 
-func (x *SparkDBConnInfo) GetQuery() string {
-	if x != nil {
-		return x.Query
-	}
-	return ""
-}
+type AddHashColumnOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *SparkDBConnInfo) GetDataObject() string {
-	if x != nil {
-		return x.DataObject
-	}
-	return ""
+	TempTable     string   `protobuf:"bytes,1,opt,name=tempTable,proto3" json:"tempTable,omitempty"`         // 临时表
+	JoinColumns   []string `protobuf:"bytes,2,rep,name=joinColumns,proto3" json:"joinColumns,omitempty"`     // join字段
+	JobInstanceId string   `protobuf:"bytes,3,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"` // 作业实例ID
+	Columns       []string `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`             // 列名
+	HashAlgorithm string   `protobuf:"bytes,5,opt,name=hashAlgorithm,proto3" json:"hashAlgorithm,omitempty"` // 哈希算法：sha256（默认）/sm3
+	Salt          string   `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty"`                   // 哈希盐值，拼接在join字段值之前，为空不加盐
 }
 
-func (x *SparkDBConnInfo) GetInColumns() []string {
-	if x != nil {
-		return x.InColumns
+func (x *AddHashColumnOperation) Reset() {
+	*x = AddHashColumnOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *SparkDBConnInfo) GetInObjects() []string {
-	if x != nil {
-		return x.InObjects
-	}
-	return nil
+func (x *AddHashColumnOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SparkDBConnInfo) GetBucketName() string {
-	if x != nil {
-		return x.BucketName
-	}
-	return ""
-}
+func (*AddHashColumnOperation) ProtoMessage() {}
 
-func (x *SparkDBConnInfo) GetDbFields() []string {
-	if x != nil {
-		return x.DbFields
+func (x *AddHashColumnOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *SparkDBConnInfo) GetJoinColumns() []string {
-	if x != nil {
-		return x.JoinColumns
-	}
-	return nil
+// Deprecated: Use AddHashColumnOperation.ProtoReflect.Descriptor instead.
+func (*AddHashColumnOperation) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SparkDBConnInfo) GetMode() OperationMode {
+func (x *AddHashColumnOperation) GetTempTable() string {
 	if x != nil {
-		return x.Mode
+		return x.TempTable
 	}
-	return OperationMode_OPERATION_MODE_UNKNOWN
+	return ""
 }
 
-func (x *SparkDBConnInfo) GetJoinType() JoinType {
+func (x *AddHashColumnOperation) GetJoinColumns() []string {
 	if x != nil {
-		return x.JoinType
+		return x.JoinColumns
 	}
-	return JoinType_JOIN_TYPE_UNKNOWN
+	return nil
 }
 
-func (x *SparkDBConnInfo) GetTempTable() string {
+func (x *AddHashColumnOperation) GetJobInstanceId() string {
 	if x != nil {
-		return x.TempTable
+		return x.JobInstanceId
 	}
 	return ""
 }
 
-func (x *SparkDBConnInfo) GetOrderByColumn() string {
+func (x *AddHashColumnOperation) GetColumns() []string {
 	if x != nil {
-		return x.OrderByColumn
+		return x.Columns
 	}
-	return ""
+	return nil
 }
 
-func (x *SparkDBConnInfo) GetTargetTable() string {
+func (x *AddHashColumnOperation) GetHashAlgorithm() string {
 	if x != nil {
-		return x.TargetTable
+		return x.HashAlgorithm
 	}
 	return ""
 }
 
-func (x *SparkDBConnInfo) GetStorageType() StorageType {
-	if x != nil {
-		return x.StorageType
-	}
-	return StorageType_STORAGE_TYPE_UNKNOWN
-}
-
-func (x *SparkDBConnInfo) GetSortRules() []*SortRule {
+func (x *AddHashColumnOperation) GetSalt() string {
 	if x != nil {
-		return x.SortRules
+		return x.Salt
 	}
-	return nil
+	return ""
 }
 
-type SparkConfig struct {
+type PSIJoinOperation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DynamicAllocationEnabled      bool  `protobuf:"varint,1,opt,name=dynamicAllocationEnabled,proto3" json:"dynamicAllocationEnabled,omitempty"`           // 启用动态分配
-	DynamicAllocationMinExecutors int32 `protobuf:"varint,2,opt,name=dynamicAllocationMinExecutors,proto3" json:"dynamicAllocationMinExecutors,omitempty"` // 执行器最小数量
-	DynamicAllocationMaxExecutors int32 `protobuf:"varint,3,opt,name=dynamicAllocationMaxExecutors,proto3" json:"dynamicAllocationMaxExecutors,omitempty"` // 执行器最大数量
-	ExecutorMemoryMB              int32 `protobuf:"varint,4,opt,name=executorMemoryMB,proto3" json:"executorMemoryMB,omitempty"`
-	ExecutorCores                 int32 `protobuf:"varint,5,opt,name=executorCores,proto3" json:"executorCores,omitempty"`
-	DriverMemoryMB                int32 `protobuf:"varint,6,opt,name=driverMemoryMB,proto3" json:"driverMemoryMB,omitempty"`
-	DriverCores                   int32 `protobuf:"varint,7,opt,name=driverCores,proto3" json:"driverCores,omitempty"`
-	Parallelism                   int32 `protobuf:"varint,8,opt,name=parallelism,proto3" json:"parallelism,omitempty"`     // 并行度
-	NumPartitions                 int32 `protobuf:"varint,9,opt,name=numPartitions,proto3" json:"numPartitions,omitempty"` // 分区数量
+	InObjects     []string `protobuf:"bytes,1,rep,name=inObjects,proto3" json:"inObjects,omitempty"`         // 输入minio数据文件
+	JoinColumns   []string `protobuf:"bytes,2,rep,name=joinColumns,proto3" json:"joinColumns,omitempty"`     // join字段
+	TargetTable   string   `protobuf:"bytes,3,opt,name=targetTable,proto3" json:"targetTable,omitempty"`     // 目标表
+	OrderByColumn string   `protobuf:"bytes,4,opt,name=orderByColumn,proto3" json:"orderByColumn,omitempty"` // 排序字段
+	TempTable     string   `protobuf:"bytes,5,opt,name=tempTable,proto3" json:"tempTable,omitempty"`         // 临时表
+	DataObject    string   `protobuf:"bytes,6,opt,name=dataObject,proto3" json:"dataObject,omitempty"`       // join数据文件
+	JobInstanceId string   `protobuf:"bytes,7,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"` // 作业实例ID
 }
 
-func (x *SparkConfig) Reset() {
-	*x = SparkConfig{}
+func (x *PSIJoinOperation) Reset() {
+	*x = PSIJoinOperation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[31]
+		mi := &file_proto_data_source_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SparkConfig) String() string {
+func (x *PSIJoinOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SparkConfig) ProtoMessage() {}
+func (*PSIJoinOperation) ProtoMessage() {}
 
-func (x *SparkConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[31]
+func (x *PSIJoinOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3162,103 +3386,96 @@ func (x *SparkConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SparkConfig.ProtoReflect.Descriptor instead.
-func (*SparkConfig) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use PSIJoinOperation.ProtoReflect.Descriptor instead.
+func (*PSIJoinOperation) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *SparkConfig) GetDynamicAllocationEnabled() bool {
+func (x *PSIJoinOperation) GetInObjects() []string {
 	if x != nil {
-		return x.DynamicAllocationEnabled
+		return x.InObjects
 	}
-	return false
+	return nil
 }
 
-func (x *SparkConfig) GetDynamicAllocationMinExecutors() int32 {
+func (x *PSIJoinOperation) GetJoinColumns() []string {
 	if x != nil {
-		return x.DynamicAllocationMinExecutors
+		return x.JoinColumns
 	}
-	return 0
+	return nil
 }
 
-func (x *SparkConfig) GetDynamicAllocationMaxExecutors() int32 {
+func (x *PSIJoinOperation) GetTargetTable() string {
 	if x != nil {
-		return x.DynamicAllocationMaxExecutors
+		return x.TargetTable
 	}
-	return 0
+	return ""
 }
 
-func (x *SparkConfig) GetExecutorMemoryMB() int32 {
+func (x *PSIJoinOperation) GetOrderByColumn() string {
 	if x != nil {
-		return x.ExecutorMemoryMB
+		return x.OrderByColumn
 	}
-	return 0
+	return ""
 }
 
-func (x *SparkConfig) GetExecutorCores() int32 {
+func (x *PSIJoinOperation) GetTempTable() string {
 	if x != nil {
-		return x.ExecutorCores
+		return x.TempTable
 	}
-	return 0
+	return ""
 }
 
-func (x *SparkConfig) GetDriverMemoryMB() int32 {
+func (x *PSIJoinOperation) GetDataObject() string {
 	if x != nil {
-		return x.DriverMemoryMB
-	}
-	return 0
-}
-
-func (x *SparkConfig) GetDriverCores() int32 {
-	if x != nil {
-		return x.DriverCores
-	}
-	return 0
-}
-
-func (x *SparkConfig) GetParallelism() int32 {
-	if x != nil {
-		return x.Parallelism
+		return x.DataObject
 	}
-	return 0
+	return ""
 }
 
-func (x *SparkConfig) GetNumPartitions() int32 {
+func (x *PSIJoinOperation) GetJobInstanceId() string {
 	if x != nil {
-		return x.NumPartitions
+		return x.JobInstanceId
 	}
-	return 0
+	return ""
 }
 
-type TableInfoRequest struct {
+// 流式任务请求
+type StreamReadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssetName    string `protobuf:"bytes,1,opt,name=assetName,proto3" json:"assetName,omitempty"`
-	ChainInfoId  string `protobuf:"bytes,2,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
-	RequestId    string `protobuf:"bytes,3,opt,name=requestId,proto3" json:"requestId,omitempty"`
-	IsExactQuery bool   `protobuf:"varint,4,opt,name=isExactQuery,proto3" json:"isExactQuery,omitempty"`
-	Alias        string `protobuf:"bytes,5,opt,name=alias,proto3" json:"alias,omitempty"`
+	AssetName       string           `protobuf:"bytes,1,opt,name=assetName,proto3" json:"assetName,omitempty"` // 资产名称
+	ChainInfoId     string           `protobuf:"bytes,2,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
+	DbFields        []string         `protobuf:"bytes,3,rep,name=dbFields,proto3" json:"dbFields,omitempty"` // 数据库字段名的字符串数组
+	RequestId       string           `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	FileType        FileType         `protobuf:"varint,5,opt,name=fileType,proto3,enum=datasource.FileType" json:"fileType,omitempty"`
+	FilePath        string           `protobuf:"bytes,6,opt,name=filePath,proto3" json:"filePath,omitempty"`
+	FilterNames     []string         `protobuf:"bytes,7,rep,name=filterNames,proto3" json:"filterNames,omitempty"`                                                 // 过滤字段
+	FilterValues    []*FilterValue   `protobuf:"bytes,8,rep,name=filterValues,proto3" json:"filterValues,omitempty"`                                               // 过滤字段值
+	SortRules       []*SortRule      `protobuf:"bytes,9,rep,name=sortRules,proto3" json:"sortRules,omitempty"`                                                     // 排序规则
+	FilterOperators []FilterOperator `protobuf:"varint,10,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"` // 过滤操作符
+	Alias           string           `protobuf:"bytes,11,opt,name=alias,proto3" json:"alias,omitempty"`                                                            // 链账户别名
 }
 
-func (x *TableInfoRequest) Reset() {
-	*x = TableInfoRequest{}
+func (x *StreamReadRequest) Reset() {
+	*x = StreamReadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[32]
+		mi := &file_proto_data_source_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TableInfoRequest) String() string {
+func (x *StreamReadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TableInfoRequest) ProtoMessage() {}
+func (*StreamReadRequest) ProtoMessage() {}
 
-func (x *TableInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[32]
+func (x *StreamReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3269,132 +3486,105 @@ func (x *TableInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TableInfoRequest.ProtoReflect.Descriptor instead.
-func (*TableInfoRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use StreamReadRequest.ProtoReflect.Descriptor instead.
+func (*StreamReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *TableInfoRequest) GetAssetName() string {
+func (x *StreamReadRequest) GetAssetName() string {
 	if x != nil {
 		return x.AssetName
 	}
 	return ""
 }
 
-func (x *TableInfoRequest) GetChainInfoId() string {
+func (x *StreamReadRequest) GetChainInfoId() string {
 	if x != nil {
 		return x.ChainInfoId
 	}
 	return ""
 }
 
-func (x *TableInfoRequest) GetRequestId() string {
+func (x *StreamReadRequest) GetDbFields() []string {
 	if x != nil {
-		return x.RequestId
+		return x.DbFields
 	}
-	return ""
+	return nil
 }
 
-func (x *TableInfoRequest) GetIsExactQuery() bool {
+func (x *StreamReadRequest) GetRequestId() string {
 	if x != nil {
-		return x.IsExactQuery
+		return x.RequestId
 	}
-	return false
+	return ""
 }
 
-func (x *TableInfoRequest) GetAlias() string {
+func (x *StreamReadRequest) GetFileType() FileType {
 	if x != nil {
-		return x.Alias
+		return x.FileType
 	}
-	return ""
-}
-
-type TableInfoResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	TableName   string        `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	RecordCount int32         `protobuf:"varint,2,opt,name=recordCount,proto3" json:"recordCount,omitempty"` // 表数据条数
-	RecordSize  int64         `protobuf:"varint,3,opt,name=recordSize,proto3" json:"recordSize,omitempty"`   // 表数据大小（字节）
-	Columns     []*ColumnItem `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`          // 表结构信息
+	return FileType_FILE_TYPE_UNKNOWN
 }
 
-func (x *TableInfoResponse) Reset() {
-	*x = TableInfoResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[33]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *StreamReadRequest) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
 	}
+	return ""
 }
 
-func (x *TableInfoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TableInfoResponse) ProtoMessage() {}
-
-func (x *TableInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[33]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *StreamReadRequest) GetFilterNames() []string {
+	if x != nil {
+		return x.FilterNames
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TableInfoResponse.ProtoReflect.Descriptor instead.
-func (*TableInfoResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{33}
+	return nil
 }
 
-func (x *TableInfoResponse) GetTableName() string {
+func (x *StreamReadRequest) GetFilterValues() []*FilterValue {
 	if x != nil {
-		return x.TableName
+		return x.FilterValues
 	}
-	return ""
+	return nil
 }
 
-func (x *TableInfoResponse) GetRecordCount() int32 {
+func (x *StreamReadRequest) GetSortRules() []*SortRule {
 	if x != nil {
-		return x.RecordCount
+		return x.SortRules
 	}
-	return 0
+	return nil
 }
 
-func (x *TableInfoResponse) GetRecordSize() int64 {
+func (x *StreamReadRequest) GetFilterOperators() []FilterOperator {
 	if x != nil {
-		return x.RecordSize
+		return x.FilterOperators
 	}
-	return 0
+	return nil
 }
 
-func (x *TableInfoResponse) GetColumns() []*ColumnItem {
+func (x *StreamReadRequest) GetAlias() string {
 	if x != nil {
-		return x.Columns
+		return x.Alias
 	}
-	return nil
+	return ""
 }
 
-type GroupCountRequest struct {
+type FilterValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableName       string           `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	FilterNames     []string         `protobuf:"bytes,2,rep,name=filterNames,proto3" json:"filterNames,omitempty"`   // 过滤字段
-	FilterValues    []*FilterValue   `protobuf:"bytes,3,rep,name=filterValues,proto3" json:"filterValues,omitempty"` // 过滤字段值
-	GroupByFields   []string         `protobuf:"bytes,4,rep,name=groupByFields,proto3" json:"groupByFields,omitempty"`
-	DbName          string           `protobuf:"bytes,5,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	FilterOperators []FilterOperator `protobuf:"varint,6,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"` // 过滤操作符
+	StrValue    string    `protobuf:"bytes,1,opt,name=str_value,json=strValue,proto3" json:"str_value,omitempty"`                   // 字符串类型（单个值）
+	IntValue    int32     `protobuf:"varint,2,opt,name=int_value,json=intValue,proto3" json:"int_value,omitempty"`                  // 整型类型（单个值）
+	FloatValue  float64   `protobuf:"fixed64,3,opt,name=float_value,json=floatValue,proto3" json:"float_value,omitempty"`           // 浮动类型（单个值）
+	BoolValue   bool      `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3" json:"bool_value,omitempty"`               // 布尔类型（单个值）
+	StrValues   []string  `protobuf:"bytes,5,rep,name=str_values,json=strValues,proto3" json:"str_values,omitempty"`                // 字符串数组（支持 IN 操作）
+	IntValues   []int32   `protobuf:"varint,6,rep,packed,name=int_values,json=intValues,proto3" json:"int_values,omitempty"`        // 整型数组（支持 IN 操作）
+	FloatValues []float64 `protobuf:"fixed64,7,rep,packed,name=float_values,json=floatValues,proto3" json:"float_values,omitempty"` // 浮动数组（支持 IN 操作）
+	BoolValues  []bool    `protobuf:"varint,8,rep,packed,name=bool_values,json=boolValues,proto3" json:"bool_values,omitempty"`     // 布尔数组（支持 IN 操作）
 }
 
-func (x *GroupCountRequest) Reset() {
-	*x = GroupCountRequest{}
+func (x *FilterValue) Reset() {
+	*x = FilterValue{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3402,13 +3592,13 @@ func (x *GroupCountRequest) Reset() {
 	}
 }
 
-func (x *GroupCountRequest) String() string {
+func (x *FilterValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupCountRequest) ProtoMessage() {}
+func (*FilterValue) ProtoMessage() {}
 
-func (x *GroupCountRequest) ProtoReflect() protoreflect.Message {
+func (x *FilterValue) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3420,64 +3610,78 @@ func (x *GroupCountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupCountRequest.ProtoReflect.Descriptor instead.
-func (*GroupCountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FilterValue.ProtoReflect.Descriptor instead.
+func (*FilterValue) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GroupCountRequest) GetTableName() string {
+func (x *FilterValue) GetStrValue() string {
 	if x != nil {
-		return x.TableName
+		return x.StrValue
 	}
 	return ""
 }
 
-func (x *GroupCountRequest) GetFilterNames() []string {
+func (x *FilterValue) GetIntValue() int32 {
 	if x != nil {
-		return x.FilterNames
+		return x.IntValue
 	}
-	return nil
+	return 0
 }
 
-func (x *GroupCountRequest) GetFilterValues() []*FilterValue {
+func (x *FilterValue) GetFloatValue() float64 {
 	if x != nil {
-		return x.FilterValues
+		return x.FloatValue
+	}
+	return 0
+}
+
+func (x *FilterValue) GetBoolValue() bool {
+	if x != nil {
+		return x.BoolValue
+	}
+	return false
+}
+
+func (x *FilterValue) GetStrValues() []string {
+	if x != nil {
+		return x.StrValues
 	}
 	return nil
 }
 
-func (x *GroupCountRequest) GetGroupByFields() []string {
+func (x *FilterValue) GetIntValues() []int32 {
 	if x != nil {
-		return x.GroupByFields
+		return x.IntValues
 	}
 	return nil
 }
 
-func (x *GroupCountRequest) GetDbName() string {
+func (x *FilterValue) GetFloatValues() []float64 {
 	if x != nil {
-		return x.DbName
+		return x.FloatValues
 	}
-	return ""
+	return nil
 }
 
-func (x *GroupCountRequest) GetFilterOperators() []FilterOperator {
+func (x *FilterValue) GetBoolValues() []bool {
 	if x != nil {
-		return x.FilterOperators
+		return x.BoolValues
 	}
 	return nil
 }
 
-type GroupCountResponse struct {
+type SortRule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableName   string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	RecordCount int64  `protobuf:"varint,2,opt,name=recordCount,proto3" json:"recordCount,omitempty"`
+	FieldName string    `protobuf:"bytes,1,opt,name=fieldName,proto3" json:"fieldName,omitempty"`                            // 排序字段名
+	SortOrder SortOrder `protobuf:"varint,2,opt,name=sortOrder,proto3,enum=datasource.SortOrder" json:"sortOrder,omitempty"` // 排序方向
 }
 
-func (x *GroupCountResponse) Reset() {
-	*x = GroupCountResponse{}
+func (x *SortRule) Reset() {
+	*x = SortRule{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3485,13 +3689,13 @@ func (x *GroupCountResponse) Reset() {
 	}
 }
 
-func (x *GroupCountResponse) String() string {
+func (x *SortRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupCountResponse) ProtoMessage() {}
+func (*SortRule) ProtoMessage() {}
 
-func (x *GroupCountResponse) ProtoReflect() protoreflect.Message {
+func (x *SortRule) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3503,35 +3707,43 @@ func (x *GroupCountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupCountResponse.ProtoReflect.Descriptor instead.
-func (*GroupCountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SortRule.ProtoReflect.Descriptor instead.
+func (*SortRule) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GroupCountResponse) GetTableName() string {
+func (x *SortRule) GetFieldName() string {
 	if x != nil {
-		return x.TableName
+		return x.FieldName
 	}
 	return ""
 }
 
-func (x *GroupCountResponse) GetRecordCount() int64 {
+func (x *SortRule) GetSortOrder() SortOrder {
 	if x != nil {
-		return x.RecordCount
+		return x.SortOrder
 	}
-	return 0
+	return SortOrder_ASC
 }
 
-type TruncateTableRequest struct {
+type ConnectionInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableName string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
-}
-
-func (x *TruncateTableRequest) Reset() {
-	*x = TruncateTableRequest{}
+	Dbtype    int32                `protobuf:"varint,1,opt,name=dbtype,proto3" json:"dbtype,omitempty"`      //数据库类型
+	Host      string               `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`           // 数据库地址
+	Port      int32                `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`          // 数据库端口
+	TableName string               `protobuf:"bytes,4,opt,name=tableName,proto3" json:"tableName,omitempty"` // 表名
+	TlsConfig *DatasourceTlsConfig `protobuf:"bytes,5,opt,name=tlsConfig,proto3" json:"tlsConfig,omitempty"` // TLS配置
+	User      string               `protobuf:"bytes,8,opt,name=user,proto3" json:"user,omitempty"`
+	Password  string               `protobuf:"bytes,9,opt,name=password,proto3" json:"password,omitempty"`
+	DbName    string               `protobuf:"bytes,10,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	Columns   []*ColumnItem        `protobuf:"bytes,11,rep,name=columns,proto3" json:"columns,omitempty"`
+}
+
+func (x *ConnectionInfo) Reset() {
+	*x = ConnectionInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3539,13 +3751,13 @@ func (x *TruncateTableRequest) Reset() {
 	}
 }
 
-func (x *TruncateTableRequest) String() string {
+func (x *ConnectionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TruncateTableRequest) ProtoMessage() {}
+func (*ConnectionInfo) ProtoMessage() {}
 
-func (x *TruncateTableRequest) ProtoReflect() protoreflect.Message {
+func (x *ConnectionInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3557,29 +3769,88 @@ func (x *TruncateTableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TruncateTableRequest.ProtoReflect.Descriptor instead.
-func (*TruncateTableRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConnectionInfo.ProtoReflect.Descriptor instead.
+func (*ConnectionInfo) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *TruncateTableRequest) GetTableName() string {
+func (x *ConnectionInfo) GetDbtype() int32 {
+	if x != nil {
+		return x.Dbtype
+	}
+	return 0
+}
+
+func (x *ConnectionInfo) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ConnectionInfo) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ConnectionInfo) GetTableName() string {
 	if x != nil {
 		return x.TableName
 	}
 	return ""
 }
 
-type TruncateTableResponse struct {
+func (x *ConnectionInfo) GetTlsConfig() *DatasourceTlsConfig {
+	if x != nil {
+		return x.TlsConfig
+	}
+	return nil
+}
+
+func (x *ConnectionInfo) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ConnectionInfo) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ConnectionInfo) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *ConnectionInfo) GetColumns() []*ColumnItem {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type ColumnItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                         // 字段名称
+	DataType  string `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"` // 字段类型
+	Nullable  bool   `protobuf:"varint,3,opt,name=nullable,proto3" json:"nullable,omitempty"`                // 是否可为空
+	Precision int32  `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`              // 数值类型的精度，非数值类型为 0
+	Scale     int32  `protobuf:"varint,5,opt,name=scale,proto3" json:"scale,omitempty"`                      // 数值类型的小数位数
 }
 
-func (x *TruncateTableResponse) Reset() {
-	*x = TruncateTableResponse{}
+func (x *ColumnItem) Reset() {
+	*x = ColumnItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3587,13 +3858,13 @@ func (x *TruncateTableResponse) Reset() {
 	}
 }
 
-func (x *TruncateTableResponse) String() string {
+func (x *ColumnItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TruncateTableResponse) ProtoMessage() {}
+func (*ColumnItem) ProtoMessage() {}
 
-func (x *TruncateTableResponse) ProtoReflect() protoreflect.Message {
+func (x *ColumnItem) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3605,41 +3876,58 @@ func (x *TruncateTableResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TruncateTableResponse.ProtoReflect.Descriptor instead.
-func (*TruncateTableResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ColumnItem.ProtoReflect.Descriptor instead.
+func (*ColumnItem) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *TruncateTableResponse) GetSuccess() bool {
+func (x *ColumnItem) GetName() string {
 	if x != nil {
-		return x.Success
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *TruncateTableResponse) GetMessage() string {
+func (x *ColumnItem) GetDataType() string {
 	if x != nil {
-		return x.Message
+		return x.DataType
 	}
 	return ""
 }
 
-// 推送任务结果请求
-type PushJobResultRequest struct {
+func (x *ColumnItem) GetNullable() bool {
+	if x != nil {
+		return x.Nullable
+	}
+	return false
+}
+
+func (x *ColumnItem) GetPrecision() int32 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+func (x *ColumnItem) GetScale() int32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+type ServerInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobInstanceId string `protobuf:"bytes,1,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"` // 作业实例ID
-	ChainInfoId   string `protobuf:"bytes,2,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
-	PartyId       string `protobuf:"bytes,3,opt,name=partyId,proto3" json:"partyId,omitempty"`
-	DataId        string `protobuf:"bytes,4,opt,name=dataId,proto3" json:"dataId,omitempty"`
-	IsEncrypted   bool   `protobuf:"varint,5,opt,name=isEncrypted,proto3" json:"isEncrypted,omitempty"`
-	PubKey        string `protobuf:"bytes,6,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Namespace   string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ServiceName string `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	ServicePort string `protobuf:"bytes,3,opt,name=servicePort,proto3" json:"servicePort,omitempty"`
 }
 
-func (x *PushJobResultRequest) Reset() {
-	*x = PushJobResultRequest{}
+func (x *ServerInfo) Reset() {
+	*x = ServerInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3647,13 +3935,13 @@ func (x *PushJobResultRequest) Reset() {
 	}
 }
 
-func (x *PushJobResultRequest) String() string {
+func (x *ServerInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushJobResultRequest) ProtoMessage() {}
+func (*ServerInfo) ProtoMessage() {}
 
-func (x *PushJobResultRequest) ProtoReflect() protoreflect.Message {
+func (x *ServerInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3665,64 +3953,44 @@ func (x *PushJobResultRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushJobResultRequest.ProtoReflect.Descriptor instead.
-func (*PushJobResultRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerInfo.ProtoReflect.Descriptor instead.
+func (*ServerInfo) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *PushJobResultRequest) GetJobInstanceId() string {
-	if x != nil {
-		return x.JobInstanceId
-	}
-	return ""
-}
-
-func (x *PushJobResultRequest) GetChainInfoId() string {
-	if x != nil {
-		return x.ChainInfoId
-	}
-	return ""
-}
-
-func (x *PushJobResultRequest) GetPartyId() string {
+func (x *ServerInfo) GetNamespace() string {
 	if x != nil {
-		return x.PartyId
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *PushJobResultRequest) GetDataId() string {
+func (x *ServerInfo) GetServiceName() string {
 	if x != nil {
-		return x.DataId
+		return x.ServiceName
 	}
 	return ""
 }
 
-func (x *PushJobResultRequest) GetIsEncrypted() bool {
-	if x != nil {
-		return x.IsEncrypted
-	}
-	return false
-}
-
-func (x *PushJobResultRequest) GetPubKey() string {
+func (x *ServerInfo) GetServicePort() string {
 	if x != nil {
-		return x.PubKey
+		return x.ServicePort
 	}
 	return ""
 }
 
-type PushJobResultResponse struct {
+type OSSWriteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	BucketName string `protobuf:"bytes,1,opt,name=bucketName,proto3" json:"bucketName,omitempty"` // 存储桶名称
+	ObjectName string `protobuf:"bytes,2,opt,name=objectName,proto3" json:"objectName,omitempty"` // 对象名称（文件名）
+	Chunk      []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`           // 文件内容块
 }
 
-func (x *PushJobResultResponse) Reset() {
-	*x = PushJobResultResponse{}
+func (x *OSSWriteRequest) Reset() {
+	*x = OSSWriteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3730,13 +3998,13 @@ func (x *PushJobResultResponse) Reset() {
 	}
 }
 
-func (x *PushJobResultResponse) String() string {
+func (x *OSSWriteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushJobResultResponse) ProtoMessage() {}
+func (*OSSWriteRequest) ProtoMessage() {}
 
-func (x *PushJobResultResponse) ProtoReflect() protoreflect.Message {
+func (x *OSSWriteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3748,40 +4016,43 @@ func (x *PushJobResultResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushJobResultResponse.ProtoReflect.Descriptor instead.
-func (*PushJobResultResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OSSWriteRequest.ProtoReflect.Descriptor instead.
+func (*OSSWriteRequest) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *PushJobResultResponse) GetSuccess() bool {
+func (x *OSSWriteRequest) GetBucketName() string {
 	if x != nil {
-		return x.Success
+		return x.BucketName
 	}
-	return false
+	return ""
 }
 
-func (x *PushJobResultResponse) GetMessage() string {
+func (x *OSSWriteRequest) GetObjectName() string {
 	if x != nil {
-		return x.Message
+		return x.ObjectName
 	}
 	return ""
 }
 
-type JobResultExternalDBInfo struct {
+func (x *OSSWriteRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type OSSReadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ResultStorageType int32  `protobuf:"varint,1,opt,name=resultStorageType,proto3" json:"resultStorageType,omitempty"`
-	Host              string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
-	Port              int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
-	DB                string `protobuf:"bytes,4,opt,name=DB,proto3" json:"DB,omitempty"`
-	User              string `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
-	Password          string `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	BucketName string `protobuf:"bytes,1,opt,name=bucketName,proto3" json:"bucketName,omitempty"`
+	ObjectName string `protobuf:"bytes,2,opt,name=objectName,proto3" json:"objectName,omitempty"`
 }
 
-func (x *JobResultExternalDBInfo) Reset() {
-	*x = JobResultExternalDBInfo{}
+func (x *OSSReadRequest) Reset() {
+	*x = OSSReadRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3789,13 +4060,13 @@ func (x *JobResultExternalDBInfo) Reset() {
 	}
 }
 
-func (x *JobResultExternalDBInfo) String() string {
+func (x *OSSReadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobResultExternalDBInfo) ProtoMessage() {}
+func (*OSSReadRequest) ProtoMessage() {}
 
-func (x *JobResultExternalDBInfo) ProtoReflect() protoreflect.Message {
+func (x *OSSReadRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3807,95 +4078,132 @@ func (x *JobResultExternalDBInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobResultExternalDBInfo.ProtoReflect.Descriptor instead.
-func (*JobResultExternalDBInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use OSSReadRequest.ProtoReflect.Descriptor instead.
+func (*OSSReadRequest) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *JobResultExternalDBInfo) GetResultStorageType() int32 {
+func (x *OSSReadRequest) GetBucketName() string {
 	if x != nil {
-		return x.ResultStorageType
+		return x.BucketName
 	}
-	return 0
+	return ""
 }
 
-func (x *JobResultExternalDBInfo) GetHost() string {
+func (x *OSSReadRequest) GetObjectName() string {
 	if x != nil {
-		return x.Host
+		return x.ObjectName
 	}
 	return ""
 }
 
-func (x *JobResultExternalDBInfo) GetPort() int32 {
-	if x != nil {
-		return x.Port
-	}
-	return 0
+type OSSReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Chunk   []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
 }
 
-func (x *JobResultExternalDBInfo) GetDB() string {
+func (x *OSSReadResponse) Reset() {
+	*x = OSSReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OSSReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OSSReadResponse) ProtoMessage() {}
+
+func (x *OSSReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OSSReadResponse.ProtoReflect.Descriptor instead.
+func (*OSSReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *OSSReadResponse) GetSuccess() bool {
 	if x != nil {
-		return x.DB
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *JobResultExternalDBInfo) GetUser() string {
+func (x *OSSReadResponse) GetMessage() string {
 	if x != nil {
-		return x.User
+		return x.Message
 	}
 	return ""
 }
 
-func (x *JobResultExternalDBInfo) GetPassword() string {
+func (x *OSSReadResponse) GetChunk() []byte {
 	if x != nil {
-		return x.Password
+		return x.Chunk
 	}
-	return ""
+	return nil
 }
 
-type DatasourceTlsConfig struct {
+type SparkDBConnInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 是否开启 tls，1-不开启，2-开启
-	UseTls int32 `protobuf:"varint,1,opt,name=useTls,proto3" json:"useTls,omitempty"`
-	// tls 模式，1-Require，2-Verify CA，3-Full Verification
-	Mode int32 `protobuf:"varint,2,opt,name=mode,proto3" json:"mode,omitempty"`
-	// tls CA 证书 base64 内容
-	CaCert string `protobuf:"bytes,3,opt,name=caCert,proto3" json:"caCert,omitempty"`
-	// tls CA 证书文件名
-	CaCertFilename string `protobuf:"bytes,4,opt,name=caCertFilename,proto3" json:"caCertFilename,omitempty"`
-	// 服务器主机名
-	ServerName string `protobuf:"bytes,5,opt,name=serverName,proto3" json:"serverName,omitempty"`
-	// tls 客户端证书 base64 内容
-	ClientCert string `protobuf:"bytes,6,opt,name=clientCert,proto3" json:"clientCert,omitempty"`
-	// tls 客户端证书文件名
-	ClientCertFilename string `protobuf:"bytes,7,opt,name=clientCertFilename,proto3" json:"clientCertFilename,omitempty"`
-	// tls 客户端私钥 base64 内容
-	ClientKey string `protobuf:"bytes,8,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
-	// tls 客户端私钥文件名
-	ClientKeyFilename string `protobuf:"bytes,9,opt,name=clientKeyFilename,proto3" json:"clientKeyFilename,omitempty"`
+	DbType        string        `protobuf:"bytes,1,opt,name=dbType,proto3" json:"dbType,omitempty"`
+	Host          string        `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Port          int32         `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Database      string        `protobuf:"bytes,4,opt,name=database,proto3" json:"database,omitempty"`
+	Username      string        `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string        `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	Query         string        `protobuf:"bytes,7,opt,name=query,proto3" json:"query,omitempty"`           // 执行的查询语句
+	DataObject    string        `protobuf:"bytes,8,opt,name=dataObject,proto3" json:"dataObject,omitempty"` // 存放的数据文件
+	InColumns     []string      `protobuf:"bytes,9,rep,name=inColumns,proto3" json:"inColumns,omitempty"`   // IN字段
+	InObjects     []string      `protobuf:"bytes,10,rep,name=inObjects,proto3" json:"inObjects,omitempty"`  // IN数据文件
+	BucketName    string        `protobuf:"bytes,11,opt,name=bucketName,proto3" json:"bucketName,omitempty"`
+	DbFields      []string      `protobuf:"bytes,12,rep,name=dbFields,proto3" json:"dbFields,omitempty"`                        // 数据库字段
+	JoinColumns   []string      `protobuf:"bytes,13,rep,name=joinColumns,proto3" json:"joinColumns,omitempty"`                  // join字段
+	Mode          OperationMode `protobuf:"varint,14,opt,name=mode,proto3,enum=datasource.OperationMode" json:"mode,omitempty"` // 执行的spark功能函数
+	JoinType      JoinType      `protobuf:"varint,15,opt,name=joinType,proto3,enum=datasource.JoinType" json:"joinType,omitempty"`
+	TempTable     string        `protobuf:"bytes,16,opt,name=tempTable,proto3" json:"tempTable,omitempty"`                                  // 存储的中间表
+	OrderByColumn string        `protobuf:"bytes,17,opt,name=orderByColumn,proto3" json:"orderByColumn,omitempty"`                          // 排序字段
+	TargetTable   string        `protobuf:"bytes,18,opt,name=targetTable,proto3" json:"targetTable,omitempty"`                              // 目标表
+	StorageType   StorageType   `protobuf:"varint,19,opt,name=storageType,proto3,enum=datasource.StorageType" json:"storageType,omitempty"` // 存储类型
+	SortRules     []*SortRule   `protobuf:"bytes,20,rep,name=sortRules,proto3" json:"sortRules,omitempty"`                                  // 排序规则
 }
 
-func (x *DatasourceTlsConfig) Reset() {
-	*x = DatasourceTlsConfig{}
+func (x *SparkDBConnInfo) Reset() {
+	*x = SparkDBConnInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[41]
+		mi := &file_proto_data_source_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DatasourceTlsConfig) String() string {
+func (x *SparkDBConnInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatasourceTlsConfig) ProtoMessage() {}
+func (*SparkDBConnInfo) ProtoMessage() {}
 
-func (x *DatasourceTlsConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[41]
+func (x *SparkDBConnInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3906,142 +4214,170 @@ func (x *DatasourceTlsConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatasourceTlsConfig.ProtoReflect.Descriptor instead.
-func (*DatasourceTlsConfig) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use SparkDBConnInfo.ProtoReflect.Descriptor instead.
+func (*SparkDBConnInfo) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *DatasourceTlsConfig) GetUseTls() int32 {
+func (x *SparkDBConnInfo) GetDbType() string {
 	if x != nil {
-		return x.UseTls
+		return x.DbType
 	}
-	return 0
+	return ""
 }
 
-func (x *DatasourceTlsConfig) GetMode() int32 {
+func (x *SparkDBConnInfo) GetHost() string {
 	if x != nil {
-		return x.Mode
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SparkDBConnInfo) GetPort() int32 {
+	if x != nil {
+		return x.Port
 	}
 	return 0
 }
 
-func (x *DatasourceTlsConfig) GetCaCert() string {
+func (x *SparkDBConnInfo) GetDatabase() string {
 	if x != nil {
-		return x.CaCert
+		return x.Database
 	}
 	return ""
 }
 
-func (x *DatasourceTlsConfig) GetCaCertFilename() string {
+func (x *SparkDBConnInfo) GetUsername() string {
 	if x != nil {
-		return x.CaCertFilename
+		return x.Username
 	}
 	return ""
 }
 
-func (x *DatasourceTlsConfig) GetServerName() string {
+func (x *SparkDBConnInfo) GetPassword() string {
 	if x != nil {
-		return x.ServerName
+		return x.Password
 	}
 	return ""
 }
 
-func (x *DatasourceTlsConfig) GetClientCert() string {
+func (x *SparkDBConnInfo) GetQuery() string {
 	if x != nil {
-		return x.ClientCert
+		return x.Query
 	}
 	return ""
 }
 
-func (x *DatasourceTlsConfig) GetClientCertFilename() string {
+func (x *SparkDBConnInfo) GetDataObject() string {
 	if x != nil {
-		return x.ClientCertFilename
+		return x.DataObject
 	}
 	return ""
 }
 
-func (x *DatasourceTlsConfig) GetClientKey() string {
+func (x *SparkDBConnInfo) GetInColumns() []string {
 	if x != nil {
-		return x.ClientKey
+		return x.InColumns
 	}
-	return ""
+	return nil
 }
 
-func (x *DatasourceTlsConfig) GetClientKeyFilename() string {
+func (x *SparkDBConnInfo) GetInObjects() []string {
 	if x != nil {
-		return x.ClientKeyFilename
+		return x.InObjects
 	}
-	return ""
+	return nil
 }
 
-type ExecuteDorisSQLRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SparkDBConnInfo) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
 
-	Sql  string   `protobuf:"bytes,4,opt,name=sql,proto3" json:"sql,omitempty"`   // 要执行的Doris SQL语句
-	Args []string `protobuf:"bytes,5,rep,name=args,proto3" json:"args,omitempty"` // SQL参数（全部转为字符串传递）
+func (x *SparkDBConnInfo) GetDbFields() []string {
+	if x != nil {
+		return x.DbFields
+	}
+	return nil
 }
 
-func (x *ExecuteDorisSQLRequest) Reset() {
-	*x = ExecuteDorisSQLRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_data_source_proto_msgTypes[42]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SparkDBConnInfo) GetJoinColumns() []string {
+	if x != nil {
+		return x.JoinColumns
 	}
+	return nil
 }
 
-func (x *ExecuteDorisSQLRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SparkDBConnInfo) GetMode() OperationMode {
+	if x != nil {
+		return x.Mode
+	}
+	return OperationMode_OPERATION_MODE_UNKNOWN
 }
 
-func (*ExecuteDorisSQLRequest) ProtoMessage() {}
+func (x *SparkDBConnInfo) GetJoinType() JoinType {
+	if x != nil {
+		return x.JoinType
+	}
+	return JoinType_JOIN_TYPE_UNKNOWN
+}
 
-func (x *ExecuteDorisSQLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_source_proto_msgTypes[42]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SparkDBConnInfo) GetTempTable() string {
+	if x != nil {
+		return x.TempTable
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ExecuteDorisSQLRequest.ProtoReflect.Descriptor instead.
-func (*ExecuteDorisSQLRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_source_proto_rawDescGZIP(), []int{42}
+func (x *SparkDBConnInfo) GetOrderByColumn() string {
+	if x != nil {
+		return x.OrderByColumn
+	}
+	return ""
 }
 
-func (x *ExecuteDorisSQLRequest) GetSql() string {
+func (x *SparkDBConnInfo) GetTargetTable() string {
 	if x != nil {
-		return x.Sql
+		return x.TargetTable
 	}
 	return ""
 }
 
-func (x *ExecuteDorisSQLRequest) GetArgs() []string {
+func (x *SparkDBConnInfo) GetStorageType() StorageType {
 	if x != nil {
-		return x.Args
+		return x.StorageType
+	}
+	return StorageType_STORAGE_TYPE_UNKNOWN
+}
+
+func (x *SparkDBConnInfo) GetSortRules() []*SortRule {
+	if x != nil {
+		return x.SortRules
 	}
 	return nil
 }
 
-type ExecuteDorisSQLResponse struct {
+type SparkConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// 如果是查询，返回结果集；如果是更新等，返回受影响行数
-	Rows []*DorisSQLRow `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"` // 查询结果（每行是一个map）
+	DynamicAllocationEnabled      bool              `protobuf:"varint,1,opt,name=dynamicAllocationEnabled,proto3" json:"dynamicAllocationEnabled,omitempty"`           // 启用动态分配
+	DynamicAllocationMinExecutors int32             `protobuf:"varint,2,opt,name=dynamicAllocationMinExecutors,proto3" json:"dynamicAllocationMinExecutors,omitempty"` // 执行器最小数量
+	DynamicAllocationMaxExecutors int32             `protobuf:"varint,3,opt,name=dynamicAllocationMaxExecutors,proto3" json:"dynamicAllocationMaxExecutors,omitempty"` // 执行器最大数量
+	ExecutorMemoryMB              int32             `protobuf:"varint,4,opt,name=executorMemoryMB,proto3" json:"executorMemoryMB,omitempty"`
+	ExecutorCores                 int32             `protobuf:"varint,5,opt,name=executorCores,proto3" json:"executorCores,omitempty"`
+	DriverMemoryMB                int32             `protobuf:"varint,6,opt,name=driverMemoryMB,proto3" json:"driverMemoryMB,omitempty"`
+	DriverCores                   int32             `protobuf:"varint,7,opt,name=driverCores,proto3" json:"driverCores,omitempty"`
+	Parallelism                   int32             `protobuf:"varint,8,opt,name=parallelism,proto3" json:"parallelism,omitempty"`     // 并行度
+	NumPartitions                 int32             `protobuf:"varint,9,opt,name=numPartitions,proto3" json:"numPartitions,omitempty"` // 分区数量
+	RetryPolicy                   *SparkRetryPolicy `protobuf:"bytes,10,opt,name=retryPolicy,proto3" json:"retryPolicy,omitempty"`     // 失败自动重试策略，为空不重试
 }
 
-func (x *ExecuteDorisSQLResponse) Reset() {
-	*x = ExecuteDorisSQLResponse{}
+func (x *SparkConfig) Reset() {
+	*x = SparkConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4049,13 +4385,13 @@ func (x *ExecuteDorisSQLResponse) Reset() {
 	}
 }
 
-func (x *ExecuteDorisSQLResponse) String() string {
+func (x *SparkConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteDorisSQLResponse) ProtoMessage() {}
+func (*SparkConfig) ProtoMessage() {}
 
-func (x *ExecuteDorisSQLResponse) ProtoReflect() protoreflect.Message {
+func (x *SparkConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4067,42 +4403,93 @@ func (x *ExecuteDorisSQLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteDorisSQLResponse.ProtoReflect.Descriptor instead.
-func (*ExecuteDorisSQLResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SparkConfig.ProtoReflect.Descriptor instead.
+func (*SparkConfig) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ExecuteDorisSQLResponse) GetSuccess() bool {
+func (x *SparkConfig) GetDynamicAllocationEnabled() bool {
 	if x != nil {
-		return x.Success
+		return x.DynamicAllocationEnabled
 	}
 	return false
 }
 
-func (x *ExecuteDorisSQLResponse) GetMessage() string {
+func (x *SparkConfig) GetDynamicAllocationMinExecutors() int32 {
 	if x != nil {
-		return x.Message
+		return x.DynamicAllocationMinExecutors
 	}
-	return ""
+	return 0
 }
 
-func (x *ExecuteDorisSQLResponse) GetRows() []*DorisSQLRow {
+func (x *SparkConfig) GetDynamicAllocationMaxExecutors() int32 {
 	if x != nil {
-		return x.Rows
+		return x.DynamicAllocationMaxExecutors
+	}
+	return 0
+}
+
+func (x *SparkConfig) GetExecutorMemoryMB() int32 {
+	if x != nil {
+		return x.ExecutorMemoryMB
+	}
+	return 0
+}
+
+func (x *SparkConfig) GetExecutorCores() int32 {
+	if x != nil {
+		return x.ExecutorCores
+	}
+	return 0
+}
+
+func (x *SparkConfig) GetDriverMemoryMB() int32 {
+	if x != nil {
+		return x.DriverMemoryMB
+	}
+	return 0
+}
+
+func (x *SparkConfig) GetDriverCores() int32 {
+	if x != nil {
+		return x.DriverCores
+	}
+	return 0
+}
+
+func (x *SparkConfig) GetParallelism() int32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+func (x *SparkConfig) GetNumPartitions() int32 {
+	if x != nil {
+		return x.NumPartitions
+	}
+	return 0
+}
+
+func (x *SparkConfig) GetRetryPolicy() *SparkRetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
 	}
 	return nil
 }
 
-type DorisSQLRow struct {
+type SparkRetryPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Columns map[string]string `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 列名到字符串值的映射
+	MaxRetries        int32 `protobuf:"varint,1,opt,name=maxRetries,proto3" json:"maxRetries,omitempty"`               // 失败后最大重试次数
+	BackoffSeconds    int32 `protobuf:"varint,2,opt,name=backoffSeconds,proto3" json:"backoffSeconds,omitempty"`       // 首次重试前等待秒数，之后按2倍递增
+	MaxBackoffSeconds int32 `protobuf:"varint,3,opt,name=maxBackoffSeconds,proto3" json:"maxBackoffSeconds,omitempty"` // 等待上限，0 不限制
 }
 
-func (x *DorisSQLRow) Reset() {
-	*x = DorisSQLRow{}
+func (x *SparkRetryPolicy) Reset() {
+	*x = SparkRetryPolicy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4110,13 +4497,13 @@ func (x *DorisSQLRow) Reset() {
 	}
 }
 
-func (x *DorisSQLRow) String() string {
+func (x *SparkRetryPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DorisSQLRow) ProtoMessage() {}
+func (*SparkRetryPolicy) ProtoMessage() {}
 
-func (x *DorisSQLRow) ProtoReflect() protoreflect.Message {
+func (x *SparkRetryPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4128,31 +4515,46 @@ func (x *DorisSQLRow) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DorisSQLRow.ProtoReflect.Descriptor instead.
-func (*DorisSQLRow) Descriptor() ([]byte, []int) {
+// Deprecated: Use SparkRetryPolicy.ProtoReflect.Descriptor instead.
+func (*SparkRetryPolicy) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *DorisSQLRow) GetColumns() map[string]string {
+func (x *SparkRetryPolicy) GetMaxRetries() int32 {
 	if x != nil {
-		return x.Columns
+		return x.MaxRetries
 	}
-	return nil
+	return 0
 }
 
-type CreateExternalAndInternalTableAndImportDataRequest struct {
+func (x *SparkRetryPolicy) GetBackoffSeconds() int32 {
+	if x != nil {
+		return x.BackoffSeconds
+	}
+	return 0
+}
+
+func (x *SparkRetryPolicy) GetMaxBackoffSeconds() int32 {
+	if x != nil {
+		return x.MaxBackoffSeconds
+	}
+	return 0
+}
+
+type TableInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssetName     string `protobuf:"bytes,1,opt,name=assetName,proto3" json:"assetName,omitempty"`
-	ChainInfoId   string `protobuf:"bytes,2,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
-	Alias         string `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
-	JobInstanceId string `protobuf:"bytes,4,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
+	AssetName    string `protobuf:"bytes,1,opt,name=assetName,proto3" json:"assetName,omitempty"`
+	ChainInfoId  string `protobuf:"bytes,2,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
+	RequestId    string `protobuf:"bytes,3,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	IsExactQuery bool   `protobuf:"varint,4,opt,name=isExactQuery,proto3" json:"isExactQuery,omitempty"`
+	Alias        string `protobuf:"bytes,5,opt,name=alias,proto3" json:"alias,omitempty"`
 }
 
-func (x *CreateExternalAndInternalTableAndImportDataRequest) Reset() {
-	*x = CreateExternalAndInternalTableAndImportDataRequest{}
+func (x *TableInfoRequest) Reset() {
+	*x = TableInfoRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4160,13 +4562,13 @@ func (x *CreateExternalAndInternalTableAndImportDataRequest) Reset() {
 	}
 }
 
-func (x *CreateExternalAndInternalTableAndImportDataRequest) String() string {
+func (x *TableInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExternalAndInternalTableAndImportDataRequest) ProtoMessage() {}
+func (*TableInfoRequest) ProtoMessage() {}
 
-func (x *CreateExternalAndInternalTableAndImportDataRequest) ProtoReflect() protoreflect.Message {
+func (x *TableInfoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4178,49 +4580,63 @@ func (x *CreateExternalAndInternalTableAndImportDataRequest) ProtoReflect() prot
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExternalAndInternalTableAndImportDataRequest.ProtoReflect.Descriptor instead.
-func (*CreateExternalAndInternalTableAndImportDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TableInfoRequest.ProtoReflect.Descriptor instead.
+func (*TableInfoRequest) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *CreateExternalAndInternalTableAndImportDataRequest) GetAssetName() string {
+func (x *TableInfoRequest) GetAssetName() string {
 	if x != nil {
 		return x.AssetName
 	}
 	return ""
 }
 
-func (x *CreateExternalAndInternalTableAndImportDataRequest) GetChainInfoId() string {
+func (x *TableInfoRequest) GetChainInfoId() string {
 	if x != nil {
 		return x.ChainInfoId
 	}
 	return ""
 }
 
-func (x *CreateExternalAndInternalTableAndImportDataRequest) GetAlias() string {
+func (x *TableInfoRequest) GetRequestId() string {
 	if x != nil {
-		return x.Alias
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *CreateExternalAndInternalTableAndImportDataRequest) GetJobInstanceId() string {
+func (x *TableInfoRequest) GetIsExactQuery() bool {
 	if x != nil {
-		return x.JobInstanceId
+		return x.IsExactQuery
+	}
+	return false
+}
+
+func (x *TableInfoRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
 	}
 	return ""
 }
 
-type CreateExternalAndInternalTableAndImportDataResponse struct {
+type TableInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableName string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"` // 存储在doris中的表名
+	TableName      string           `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	RecordCount    int32            `protobuf:"varint,2,opt,name=recordCount,proto3" json:"recordCount,omitempty"`                                  // 表数据条数，兼容旧版本保留，超过 int32 范围时为 2147483647，请使用 recordCount64
+	RecordSize     int64            `protobuf:"varint,3,opt,name=recordSize,proto3" json:"recordSize,omitempty"`                                    // 表数据大小（字节）
+	Columns        []*ColumnItem    `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`                                           // 表结构信息
+	RecordCount64  int64            `protobuf:"varint,5,opt,name=recordCount64,proto3" json:"recordCount64,omitempty"`                              // 表数据条数
+	IsExactCount   bool             `protobuf:"varint,6,opt,name=isExactCount,proto3" json:"isExactCount,omitempty"`                                // recordCount64 是否为 COUNT(*) 得到的精确值
+	StatsMethod    TableStatsMethod `protobuf:"varint,7,opt,name=statsMethod,proto3,enum=datasource.TableStatsMethod" json:"statsMethod,omitempty"` // 行数和大小的获取方式
+	LastAnalyzedAt int64            `protobuf:"varint,8,opt,name=lastAnalyzedAt,proto3" json:"lastAnalyzedAt,omitempty"`                            // 统计信息最近一次收集时间（Unix 秒），未知时为 0
 }
 
-func (x *CreateExternalAndInternalTableAndImportDataResponse) Reset() {
-	*x = CreateExternalAndInternalTableAndImportDataResponse{}
+func (x *TableInfoResponse) Reset() {
+	*x = TableInfoResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4228,13 +4644,13 @@ func (x *CreateExternalAndInternalTableAndImportDataResponse) Reset() {
 	}
 }
 
-func (x *CreateExternalAndInternalTableAndImportDataResponse) String() string {
+func (x *TableInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExternalAndInternalTableAndImportDataResponse) ProtoMessage() {}
+func (*TableInfoResponse) ProtoMessage() {}
 
-func (x *CreateExternalAndInternalTableAndImportDataResponse) ProtoReflect() protoreflect.Message {
+func (x *TableInfoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4246,33 +4662,82 @@ func (x *CreateExternalAndInternalTableAndImportDataResponse) ProtoReflect() pro
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExternalAndInternalTableAndImportDataResponse.ProtoReflect.Descriptor instead.
-func (*CreateExternalAndInternalTableAndImportDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TableInfoResponse.ProtoReflect.Descriptor instead.
+func (*TableInfoResponse) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *CreateExternalAndInternalTableAndImportDataResponse) GetTableName() string {
+func (x *TableInfoResponse) GetTableName() string {
 	if x != nil {
 		return x.TableName
 	}
 	return ""
 }
 
-type ImportCsvFileToDorisRequest struct {
+func (x *TableInfoResponse) GetRecordCount() int32 {
+	if x != nil {
+		return x.RecordCount
+	}
+	return 0
+}
+
+func (x *TableInfoResponse) GetRecordSize() int64 {
+	if x != nil {
+		return x.RecordSize
+	}
+	return 0
+}
+
+func (x *TableInfoResponse) GetColumns() []*ColumnItem {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *TableInfoResponse) GetRecordCount64() int64 {
+	if x != nil {
+		return x.RecordCount64
+	}
+	return 0
+}
+
+func (x *TableInfoResponse) GetIsExactCount() bool {
+	if x != nil {
+		return x.IsExactCount
+	}
+	return false
+}
+
+func (x *TableInfoResponse) GetStatsMethod() TableStatsMethod {
+	if x != nil {
+		return x.StatsMethod
+	}
+	return TableStatsMethod_TABLE_STATS_METHOD_UNKNOWN
+}
+
+func (x *TableInfoResponse) GetLastAnalyzedAt() int64 {
+	if x != nil {
+		return x.LastAnalyzedAt
+	}
+	return 0
+}
+
+type GroupCountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BucketName      string   `protobuf:"bytes,1,opt,name=bucketName,proto3" json:"bucketName,omitempty"`
-	ObjectName      string   `protobuf:"bytes,2,opt,name=objectName,proto3" json:"objectName,omitempty"`
-	TableName       string   `protobuf:"bytes,3,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	DbName          string   `protobuf:"bytes,4,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	Columns         []string `protobuf:"bytes,5,rep,name=columns,proto3" json:"columns,omitempty"`
-	ColumnSeparator string   `protobuf:"bytes,6,opt,name=columnSeparator,proto3" json:"columnSeparator,omitempty"`
+	TableName       string           `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	FilterNames     []string         `protobuf:"bytes,2,rep,name=filterNames,proto3" json:"filterNames,omitempty"`   // 过滤字段
+	FilterValues    []*FilterValue   `protobuf:"bytes,3,rep,name=filterValues,proto3" json:"filterValues,omitempty"` // 过滤字段值
+	GroupByFields   []string         `protobuf:"bytes,4,rep,name=groupByFields,proto3" json:"groupByFields,omitempty"`
+	DbName          string           `protobuf:"bytes,5,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	FilterOperators []FilterOperator `protobuf:"varint,6,rep,packed,name=filterOperators,proto3,enum=datasource.FilterOperator" json:"filterOperators,omitempty"` // 过滤操作符
 }
 
-func (x *ImportCsvFileToDorisRequest) Reset() {
-	*x = ImportCsvFileToDorisRequest{}
+func (x *GroupCountRequest) Reset() {
+	*x = GroupCountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4280,13 +4745,13 @@ func (x *ImportCsvFileToDorisRequest) Reset() {
 	}
 }
 
-func (x *ImportCsvFileToDorisRequest) String() string {
+func (x *GroupCountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportCsvFileToDorisRequest) ProtoMessage() {}
+func (*GroupCountRequest) ProtoMessage() {}
 
-func (x *ImportCsvFileToDorisRequest) ProtoReflect() protoreflect.Message {
+func (x *GroupCountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4298,70 +4763,64 @@ func (x *ImportCsvFileToDorisRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportCsvFileToDorisRequest.ProtoReflect.Descriptor instead.
-func (*ImportCsvFileToDorisRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupCountRequest.ProtoReflect.Descriptor instead.
+func (*GroupCountRequest) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ImportCsvFileToDorisRequest) GetBucketName() string {
+func (x *GroupCountRequest) GetTableName() string {
 	if x != nil {
-		return x.BucketName
+		return x.TableName
 	}
 	return ""
 }
 
-func (x *ImportCsvFileToDorisRequest) GetObjectName() string {
+func (x *GroupCountRequest) GetFilterNames() []string {
 	if x != nil {
-		return x.ObjectName
+		return x.FilterNames
 	}
-	return ""
+	return nil
 }
 
-func (x *ImportCsvFileToDorisRequest) GetTableName() string {
+func (x *GroupCountRequest) GetFilterValues() []*FilterValue {
 	if x != nil {
-		return x.TableName
+		return x.FilterValues
 	}
-	return ""
+	return nil
 }
 
-func (x *ImportCsvFileToDorisRequest) GetDbName() string {
+func (x *GroupCountRequest) GetGroupByFields() []string {
 	if x != nil {
-		return x.DbName
+		return x.GroupByFields
 	}
-	return ""
+	return nil
 }
 
-func (x *ImportCsvFileToDorisRequest) GetColumns() []string {
+func (x *GroupCountRequest) GetDbName() string {
 	if x != nil {
-		return x.Columns
+		return x.DbName
 	}
-	return nil
+	return ""
 }
 
-func (x *ImportCsvFileToDorisRequest) GetColumnSeparator() string {
+func (x *GroupCountRequest) GetFilterOperators() []FilterOperator {
 	if x != nil {
-		return x.ColumnSeparator
+		return x.FilterOperators
 	}
-	return ""
+	return nil
 }
 
-type ExportCsvFileFromDorisRequest struct {
+type GroupCountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobInstanceId    string             `protobuf:"bytes,1,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
-	TableName        string             `protobuf:"bytes,2,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	DbName           string             `protobuf:"bytes,3,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	Columns          []string           `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
-	ColumnSeparator  string             `protobuf:"bytes,5,opt,name=columnSeparator,proto3" json:"columnSeparator,omitempty"`
-	LineDelimiter    string             `protobuf:"bytes,6,opt,name=lineDelimiter,proto3" json:"lineDelimiter,omitempty"`
-	SortRules        []*SortRule        `protobuf:"bytes,7,rep,name=sortRules,proto3" json:"sortRules,omitempty"`               // 排序规则
-	FilterConditions []*FilterCondition `protobuf:"bytes,8,rep,name=filterConditions,proto3" json:"filterConditions,omitempty"` // 过滤条件
+	TableName   string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	RecordCount int64  `protobuf:"varint,2,opt,name=recordCount,proto3" json:"recordCount,omitempty"`
 }
 
-func (x *ExportCsvFileFromDorisRequest) Reset() {
-	*x = ExportCsvFileFromDorisRequest{}
+func (x *GroupCountResponse) Reset() {
+	*x = GroupCountResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4369,13 +4828,13 @@ func (x *ExportCsvFileFromDorisRequest) Reset() {
 	}
 }
 
-func (x *ExportCsvFileFromDorisRequest) String() string {
+func (x *GroupCountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportCsvFileFromDorisRequest) ProtoMessage() {}
+func (*GroupCountResponse) ProtoMessage() {}
 
-func (x *ExportCsvFileFromDorisRequest) ProtoReflect() protoreflect.Message {
+func (x *GroupCountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4387,78 +4846,35 @@ func (x *ExportCsvFileFromDorisRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportCsvFileFromDorisRequest.ProtoReflect.Descriptor instead.
-func (*ExportCsvFileFromDorisRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupCountResponse.ProtoReflect.Descriptor instead.
+func (*GroupCountResponse) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ExportCsvFileFromDorisRequest) GetJobInstanceId() string {
-	if x != nil {
-		return x.JobInstanceId
-	}
-	return ""
-}
-
-func (x *ExportCsvFileFromDorisRequest) GetTableName() string {
+func (x *GroupCountResponse) GetTableName() string {
 	if x != nil {
 		return x.TableName
 	}
 	return ""
 }
 
-func (x *ExportCsvFileFromDorisRequest) GetDbName() string {
-	if x != nil {
-		return x.DbName
-	}
-	return ""
-}
-
-func (x *ExportCsvFileFromDorisRequest) GetColumns() []string {
-	if x != nil {
-		return x.Columns
-	}
-	return nil
-}
-
-func (x *ExportCsvFileFromDorisRequest) GetColumnSeparator() string {
-	if x != nil {
-		return x.ColumnSeparator
-	}
-	return ""
-}
-
-func (x *ExportCsvFileFromDorisRequest) GetLineDelimiter() string {
-	if x != nil {
-		return x.LineDelimiter
-	}
-	return ""
-}
-
-func (x *ExportCsvFileFromDorisRequest) GetSortRules() []*SortRule {
-	if x != nil {
-		return x.SortRules
-	}
-	return nil
-}
-
-func (x *ExportCsvFileFromDorisRequest) GetFilterConditions() []*FilterCondition {
+func (x *GroupCountResponse) GetRecordCount() int64 {
 	if x != nil {
-		return x.FilterConditions
+		return x.RecordCount
 	}
-	return nil
+	return 0
 }
 
-type ExportCsvFileFromDorisResponse struct {
+type TruncateTableRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BucketName    string `protobuf:"bytes,1,opt,name=bucketName,proto3" json:"bucketName,omitempty"`
-	JobInstanceId string `protobuf:"bytes,2,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
+	TableName string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
 }
 
-func (x *ExportCsvFileFromDorisResponse) Reset() {
-	*x = ExportCsvFileFromDorisResponse{}
+func (x *TruncateTableRequest) Reset() {
+	*x = TruncateTableRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4466,13 +4882,13 @@ func (x *ExportCsvFileFromDorisResponse) Reset() {
 	}
 }
 
-func (x *ExportCsvFileFromDorisResponse) String() string {
+func (x *TruncateTableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportCsvFileFromDorisResponse) ProtoMessage() {}
+func (*TruncateTableRequest) ProtoMessage() {}
 
-func (x *ExportCsvFileFromDorisResponse) ProtoReflect() protoreflect.Message {
+func (x *TruncateTableRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4484,37 +4900,29 @@ func (x *ExportCsvFileFromDorisResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportCsvFileFromDorisResponse.ProtoReflect.Descriptor instead.
-func (*ExportCsvFileFromDorisResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TruncateTableRequest.ProtoReflect.Descriptor instead.
+func (*TruncateTableRequest) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ExportCsvFileFromDorisResponse) GetBucketName() string {
-	if x != nil {
-		return x.BucketName
-	}
-	return ""
-}
-
-func (x *ExportCsvFileFromDorisResponse) GetJobInstanceId() string {
+func (x *TruncateTableRequest) GetTableName() string {
 	if x != nil {
-		return x.JobInstanceId
+		return x.TableName
 	}
 	return ""
 }
 
-type ExportDorisDataToMiraDBRequest struct {
+type TruncateTableResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableName       string        `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`             // 存储到mira数据库表名
-	SourceTableName string        `protobuf:"bytes,2,opt,name=sourceTableName,proto3" json:"sourceTableName,omitempty"` // 从doris导出数据表
-	Columns         []*ColumnItem `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`                 // 导出字段
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *ExportDorisDataToMiraDBRequest) Reset() {
-	*x = ExportDorisDataToMiraDBRequest{}
+func (x *TruncateTableResponse) Reset() {
+	*x = TruncateTableResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4522,13 +4930,13 @@ func (x *ExportDorisDataToMiraDBRequest) Reset() {
 	}
 }
 
-func (x *ExportDorisDataToMiraDBRequest) String() string {
+func (x *TruncateTableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportDorisDataToMiraDBRequest) ProtoMessage() {}
+func (*TruncateTableResponse) ProtoMessage() {}
 
-func (x *ExportDorisDataToMiraDBRequest) ProtoReflect() protoreflect.Message {
+func (x *TruncateTableResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4540,43 +4948,42 @@ func (x *ExportDorisDataToMiraDBRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportDorisDataToMiraDBRequest.ProtoReflect.Descriptor instead.
-func (*ExportDorisDataToMiraDBRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TruncateTableResponse.ProtoReflect.Descriptor instead.
+func (*TruncateTableResponse) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ExportDorisDataToMiraDBRequest) GetTableName() string {
+func (x *TruncateTableResponse) GetSuccess() bool {
 	if x != nil {
-		return x.TableName
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *ExportDorisDataToMiraDBRequest) GetSourceTableName() string {
+func (x *TruncateTableResponse) GetMessage() string {
 	if x != nil {
-		return x.SourceTableName
+		return x.Message
 	}
 	return ""
 }
 
-func (x *ExportDorisDataToMiraDBRequest) GetColumns() []*ColumnItem {
-	if x != nil {
-		return x.Columns
-	}
-	return nil
-}
-
-type ImportMiraDBDataToDorisRequest struct {
+// 推送任务结果请求
+type PushJobResultRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MiraTableName string `protobuf:"bytes,1,opt,name=miraTableName,proto3" json:"miraTableName,omitempty"` // mira中间表
-	JobInstanceId string `protobuf:"bytes,2,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
+	JobInstanceId string      `protobuf:"bytes,1,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"` // 作业实例ID
+	ChainInfoId   string      `protobuf:"bytes,2,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
+	PartyId       string      `protobuf:"bytes,3,opt,name=partyId,proto3" json:"partyId,omitempty"`
+	DataId        string      `protobuf:"bytes,4,opt,name=dataId,proto3" json:"dataId,omitempty"`
+	IsEncrypted   bool        `protobuf:"varint,5,opt,name=isEncrypted,proto3" json:"isEncrypted,omitempty"` // 结果是否加密：JSON Lines 分块数字信封（每行 {"kek","cipherText"}，kek 可只在首行出现）或整体 SM2 密文
+	PubKey        string      `protobuf:"bytes,6,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Sink          *ResultSink `protobuf:"bytes,7,opt,name=sink,proto3" json:"sink,omitempty"` // 投递目标，为空时按 mira-gateway 的结果存储配置写入数据库
 }
 
-func (x *ImportMiraDBDataToDorisRequest) Reset() {
-	*x = ImportMiraDBDataToDorisRequest{}
+func (x *PushJobResultRequest) Reset() {
+	*x = PushJobResultRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4584,13 +4991,13 @@ func (x *ImportMiraDBDataToDorisRequest) Reset() {
 	}
 }
 
-func (x *ImportMiraDBDataToDorisRequest) String() string {
+func (x *PushJobResultRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportMiraDBDataToDorisRequest) ProtoMessage() {}
+func (*PushJobResultRequest) ProtoMessage() {}
 
-func (x *ImportMiraDBDataToDorisRequest) ProtoReflect() protoreflect.Message {
+func (x *PushJobResultRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4602,35 +5009,71 @@ func (x *ImportMiraDBDataToDorisRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportMiraDBDataToDorisRequest.ProtoReflect.Descriptor instead.
-func (*ImportMiraDBDataToDorisRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PushJobResultRequest.ProtoReflect.Descriptor instead.
+func (*PushJobResultRequest) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ImportMiraDBDataToDorisRequest) GetMiraTableName() string {
+func (x *PushJobResultRequest) GetJobInstanceId() string {
 	if x != nil {
-		return x.MiraTableName
+		return x.JobInstanceId
 	}
 	return ""
 }
 
-func (x *ImportMiraDBDataToDorisRequest) GetJobInstanceId() string {
+func (x *PushJobResultRequest) GetChainInfoId() string {
 	if x != nil {
-		return x.JobInstanceId
+		return x.ChainInfoId
 	}
 	return ""
 }
 
-type ImportMiraDBDataToDorisResponse struct {
+func (x *PushJobResultRequest) GetPartyId() string {
+	if x != nil {
+		return x.PartyId
+	}
+	return ""
+}
+
+func (x *PushJobResultRequest) GetDataId() string {
+	if x != nil {
+		return x.DataId
+	}
+	return ""
+}
+
+func (x *PushJobResultRequest) GetIsEncrypted() bool {
+	if x != nil {
+		return x.IsEncrypted
+	}
+	return false
+}
+
+func (x *PushJobResultRequest) GetPubKey() string {
+	if x != nil {
+		return x.PubKey
+	}
+	return ""
+}
+
+func (x *PushJobResultRequest) GetSink() *ResultSink {
+	if x != nil {
+		return x.Sink
+	}
+	return nil
+}
+
+type PushJobResultResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DorisTableName string `protobuf:"bytes,1,opt,name=dorisTableName,proto3" json:"dorisTableName,omitempty"` // 存储在doris中的表名
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *ImportMiraDBDataToDorisResponse) Reset() {
-	*x = ImportMiraDBDataToDorisResponse{}
+func (x *PushJobResultResponse) Reset() {
+	*x = PushJobResultResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4638,13 +5081,13 @@ func (x *ImportMiraDBDataToDorisResponse) Reset() {
 	}
 }
 
-func (x *ImportMiraDBDataToDorisResponse) String() string {
+func (x *PushJobResultResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportMiraDBDataToDorisResponse) ProtoMessage() {}
+func (*PushJobResultResponse) ProtoMessage() {}
 
-func (x *ImportMiraDBDataToDorisResponse) ProtoReflect() protoreflect.Message {
+func (x *PushJobResultResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4656,31 +5099,41 @@ func (x *ImportMiraDBDataToDorisResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportMiraDBDataToDorisResponse.ProtoReflect.Descriptor instead.
-func (*ImportMiraDBDataToDorisResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PushJobResultResponse.ProtoReflect.Descriptor instead.
+func (*PushJobResultResponse) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ImportMiraDBDataToDorisResponse) GetDorisTableName() string {
+func (x *PushJobResultResponse) GetSuccess() bool {
 	if x != nil {
-		return x.DorisTableName
+		return x.Success
+	}
+	return false
+}
+
+func (x *PushJobResultResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-type InternalTableInfoRequest struct {
+// 计算结果投递目标
+type ResultSink struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableName     string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	DbName        string `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	IsExactQuery  bool   `protobuf:"varint,3,opt,name=isExactQuery,proto3" json:"isExactQuery,omitempty"`
-	JobInstanceId string `protobuf:"bytes,4,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
+	Type          ResultSinkType `protobuf:"varint,1,opt,name=type,proto3,enum=datasource.ResultSinkType" json:"type,omitempty"`
+	Topic         string         `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`                 // KAFKA：topic 名称
+	BucketName    string         `protobuf:"bytes,3,opt,name=bucketName,proto3" json:"bucketName,omitempty"`       // S3：目标桶
+	ObjectPrefix  string         `protobuf:"bytes,4,opt,name=objectPrefix,proto3" json:"objectPrefix,omitempty"`   // S3：对象名前缀
+	CredentialRef string         `protobuf:"bytes,5,opt,name=credentialRef,proto3" json:"credentialRef,omitempty"` // S3：export_credentials 中的凭证名
+	Url           string         `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`                     // WEBHOOK：回调地址
 }
 
-func (x *InternalTableInfoRequest) Reset() {
-	*x = InternalTableInfoRequest{}
+func (x *ResultSink) Reset() {
+	*x = ResultSink{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4688,13 +5141,13 @@ func (x *InternalTableInfoRequest) Reset() {
 	}
 }
 
-func (x *InternalTableInfoRequest) String() string {
+func (x *ResultSink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalTableInfoRequest) ProtoMessage() {}
+func (*ResultSink) ProtoMessage() {}
 
-func (x *InternalTableInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *ResultSink) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4706,49 +5159,64 @@ func (x *InternalTableInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalTableInfoRequest.ProtoReflect.Descriptor instead.
-func (*InternalTableInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResultSink.ProtoReflect.Descriptor instead.
+func (*ResultSink) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *InternalTableInfoRequest) GetTableName() string {
+func (x *ResultSink) GetType() ResultSinkType {
 	if x != nil {
-		return x.TableName
+		return x.Type
 	}
-	return ""
+	return ResultSinkType_RESULT_SINK_TYPE_DB
 }
 
-func (x *InternalTableInfoRequest) GetDbName() string {
+func (x *ResultSink) GetTopic() string {
 	if x != nil {
-		return x.DbName
+		return x.Topic
 	}
 	return ""
 }
 
-func (x *InternalTableInfoRequest) GetIsExactQuery() bool {
+func (x *ResultSink) GetBucketName() string {
 	if x != nil {
-		return x.IsExactQuery
+		return x.BucketName
 	}
-	return false
+	return ""
 }
 
-func (x *InternalTableInfoRequest) GetJobInstanceId() string {
+func (x *ResultSink) GetObjectPrefix() string {
 	if x != nil {
-		return x.JobInstanceId
+		return x.ObjectPrefix
 	}
 	return ""
 }
 
-type CleanTmpDataRequest struct {
+func (x *ResultSink) GetCredentialRef() string {
+	if x != nil {
+		return x.CredentialRef
+	}
+	return ""
+}
+
+func (x *ResultSink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetResultDeliveryStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	JobInstanceId string `protobuf:"bytes,1,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
+	PartyId       string `protobuf:"bytes,2,opt,name=partyId,proto3" json:"partyId,omitempty"` // 为空时返回所有参与方
 }
 
-func (x *CleanTmpDataRequest) Reset() {
-	*x = CleanTmpDataRequest{}
+func (x *GetResultDeliveryStatusRequest) Reset() {
+	*x = GetResultDeliveryStatusRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4756,13 +5224,13 @@ func (x *CleanTmpDataRequest) Reset() {
 	}
 }
 
-func (x *CleanTmpDataRequest) String() string {
+func (x *GetResultDeliveryStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CleanTmpDataRequest) ProtoMessage() {}
+func (*GetResultDeliveryStatusRequest) ProtoMessage() {}
 
-func (x *CleanTmpDataRequest) ProtoReflect() protoreflect.Message {
+func (x *GetResultDeliveryStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4774,29 +5242,44 @@ func (x *CleanTmpDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CleanTmpDataRequest.ProtoReflect.Descriptor instead.
-func (*CleanTmpDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetResultDeliveryStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetResultDeliveryStatusRequest) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *CleanTmpDataRequest) GetJobInstanceId() string {
+func (x *GetResultDeliveryStatusRequest) GetJobInstanceId() string {
 	if x != nil {
 		return x.JobInstanceId
 	}
 	return ""
 }
 
-type GetRetryCleanupTasksRequest struct {
+func (x *GetResultDeliveryStatusRequest) GetPartyId() string {
+	if x != nil {
+		return x.PartyId
+	}
+	return ""
+}
+
+type ResultDeliveryRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page     int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	JobInstanceId  string               `protobuf:"bytes,1,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
+	PartyId        string               `protobuf:"bytes,2,opt,name=partyId,proto3" json:"partyId,omitempty"`
+	SinkType       ResultSinkType       `protobuf:"varint,3,opt,name=sinkType,proto3,enum=datasource.ResultSinkType" json:"sinkType,omitempty"`
+	Target         string               `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"` // 投递位置描述
+	IdempotencyKey string               `protobuf:"bytes,5,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+	Status         ResultDeliveryStatus `protobuf:"varint,6,opt,name=status,proto3,enum=datasource.ResultDeliveryStatus" json:"status,omitempty"`
+	Attempts       int32                `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"` // 累计尝试次数
+	Error          string               `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	UpdatedAt      int64                `protobuf:"varint,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`      // 更新时间（Unix毫秒）
+	DeliveredAt    int64                `protobuf:"varint,10,opt,name=deliveredAt,proto3" json:"deliveredAt,omitempty"` // 投递成功时间（Unix毫秒），未成功为0
 }
 
-func (x *GetRetryCleanupTasksRequest) Reset() {
-	*x = GetRetryCleanupTasksRequest{}
+func (x *ResultDeliveryRecord) Reset() {
+	*x = ResultDeliveryRecord{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4804,13 +5287,13 @@ func (x *GetRetryCleanupTasksRequest) Reset() {
 	}
 }
 
-func (x *GetRetryCleanupTasksRequest) String() string {
+func (x *ResultDeliveryRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRetryCleanupTasksRequest) ProtoMessage() {}
+func (*ResultDeliveryRecord) ProtoMessage() {}
 
-func (x *GetRetryCleanupTasksRequest) ProtoReflect() protoreflect.Message {
+func (x *ResultDeliveryRecord) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4822,38 +5305,91 @@ func (x *GetRetryCleanupTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRetryCleanupTasksRequest.ProtoReflect.Descriptor instead.
-func (*GetRetryCleanupTasksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResultDeliveryRecord.ProtoReflect.Descriptor instead.
+func (*ResultDeliveryRecord) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetRetryCleanupTasksRequest) GetPage() int32 {
+func (x *ResultDeliveryRecord) GetJobInstanceId() string {
 	if x != nil {
-		return x.Page
+		return x.JobInstanceId
+	}
+	return ""
+}
+
+func (x *ResultDeliveryRecord) GetPartyId() string {
+	if x != nil {
+		return x.PartyId
+	}
+	return ""
+}
+
+func (x *ResultDeliveryRecord) GetSinkType() ResultSinkType {
+	if x != nil {
+		return x.SinkType
+	}
+	return ResultSinkType_RESULT_SINK_TYPE_DB
+}
+
+func (x *ResultDeliveryRecord) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ResultDeliveryRecord) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *ResultDeliveryRecord) GetStatus() ResultDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ResultDeliveryStatus_RESULT_DELIVERY_STATUS_UNKNOWN
+}
+
+func (x *ResultDeliveryRecord) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
 	}
 	return 0
 }
 
-func (x *GetRetryCleanupTasksRequest) GetPageSize() int32 {
+func (x *ResultDeliveryRecord) GetError() string {
 	if x != nil {
-		return x.PageSize
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ResultDeliveryRecord) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
 	}
 	return 0
 }
 
-type GetRetryCleanupTasksResponse struct {
+func (x *ResultDeliveryRecord) GetDeliveredAt() int64 {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return 0
+}
+
+type GetResultDeliveryStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tasks      []*CleanupTaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	TotalCount int32              `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Page       int32              `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize   int32              `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Records []*ResultDeliveryRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
 }
 
-func (x *GetRetryCleanupTasksResponse) Reset() {
-	*x = GetRetryCleanupTasksResponse{}
+func (x *GetResultDeliveryStatusResponse) Reset() {
+	*x = GetResultDeliveryStatusResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4861,13 +5397,13 @@ func (x *GetRetryCleanupTasksResponse) Reset() {
 	}
 }
 
-func (x *GetRetryCleanupTasksResponse) String() string {
+func (x *GetResultDeliveryStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRetryCleanupTasksResponse) ProtoMessage() {}
+func (*GetResultDeliveryStatusResponse) ProtoMessage() {}
 
-func (x *GetRetryCleanupTasksResponse) ProtoReflect() protoreflect.Message {
+func (x *GetResultDeliveryStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4879,51 +5415,33 @@ func (x *GetRetryCleanupTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRetryCleanupTasksResponse.ProtoReflect.Descriptor instead.
-func (*GetRetryCleanupTasksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetResultDeliveryStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetResultDeliveryStatusResponse) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetRetryCleanupTasksResponse) GetTasks() []*CleanupTaskInfo {
+func (x *GetResultDeliveryStatusResponse) GetRecords() []*ResultDeliveryRecord {
 	if x != nil {
-		return x.Tasks
+		return x.Records
 	}
 	return nil
 }
 
-func (x *GetRetryCleanupTasksResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *GetRetryCleanupTasksResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetRetryCleanupTasksResponse) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-type CleanupTaskInfo struct {
+type JobResultExternalDBInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	JobInstanceId string `protobuf:"bytes,2,opt,name=job_instance_id,json=jobInstanceId,proto3" json:"job_instance_id,omitempty"`
-	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ResultStorageType int32  `protobuf:"varint,1,opt,name=resultStorageType,proto3" json:"resultStorageType,omitempty"`
+	Host              string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Port              int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	DB                string `protobuf:"bytes,4,opt,name=DB,proto3" json:"DB,omitempty"`
+	User              string `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	Password          string `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *CleanupTaskInfo) Reset() {
-	*x = CleanupTaskInfo{}
+func (x *JobResultExternalDBInfo) Reset() {
+	*x = JobResultExternalDBInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4931,13 +5449,13 @@ func (x *CleanupTaskInfo) Reset() {
 	}
 }
 
-func (x *CleanupTaskInfo) String() string {
+func (x *JobResultExternalDBInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CleanupTaskInfo) ProtoMessage() {}
+func (*JobResultExternalDBInfo) ProtoMessage() {}
 
-func (x *CleanupTaskInfo) ProtoReflect() protoreflect.Message {
+func (x *JobResultExternalDBInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4949,51 +5467,80 @@ func (x *CleanupTaskInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CleanupTaskInfo.ProtoReflect.Descriptor instead.
-func (*CleanupTaskInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use JobResultExternalDBInfo.ProtoReflect.Descriptor instead.
+func (*JobResultExternalDBInfo) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *CleanupTaskInfo) GetId() uint32 {
+func (x *JobResultExternalDBInfo) GetResultStorageType() int32 {
 	if x != nil {
-		return x.Id
+		return x.ResultStorageType
 	}
 	return 0
 }
 
-func (x *CleanupTaskInfo) GetJobInstanceId() string {
+func (x *JobResultExternalDBInfo) GetHost() string {
 	if x != nil {
-		return x.JobInstanceId
+		return x.Host
 	}
 	return ""
 }
 
-func (x *CleanupTaskInfo) GetStatus() string {
+func (x *JobResultExternalDBInfo) GetPort() int32 {
 	if x != nil {
-		return x.Status
+		return x.Port
+	}
+	return 0
+}
+
+func (x *JobResultExternalDBInfo) GetDB() string {
+	if x != nil {
+		return x.DB
 	}
 	return ""
 }
 
-type ReadDataSourceStreamingRequest struct {
+func (x *JobResultExternalDBInfo) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *JobResultExternalDBInfo) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type DatasourceTlsConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobInstanceId string `protobuf:"bytes,1,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
-	// Types that are assignable to DataSource:
-	//
-	//	*ReadDataSourceStreamingRequest_External
-	//	*ReadDataSourceStreamingRequest_Internal
-	//	*ReadDataSourceStreamingRequest_Doris
-	DataSource       isReadDataSourceStreamingRequest_DataSource `protobuf_oneof:"data_source"`
-	Columns          []string                                    `protobuf:"bytes,5,rep,name=columns,proto3" json:"columns,omitempty"`
-	SortRules        []*SortRule                                 `protobuf:"bytes,6,rep,name=sortRules,proto3" json:"sortRules,omitempty"`               // 排序规则
-	FilterConditions []*FilterCondition                          `protobuf:"bytes,7,rep,name=filterConditions,proto3" json:"filterConditions,omitempty"` // 过滤条件
+	// 是否开启 tls，1-不开启，2-开启
+	UseTls int32 `protobuf:"varint,1,opt,name=useTls,proto3" json:"useTls,omitempty"`
+	// tls 模式，1-Require，2-Verify CA，3-Full Verification
+	Mode int32 `protobuf:"varint,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	// tls CA 证书 base64 内容
+	CaCert string `protobuf:"bytes,3,opt,name=caCert,proto3" json:"caCert,omitempty"`
+	// tls CA 证书文件名
+	CaCertFilename string `protobuf:"bytes,4,opt,name=caCertFilename,proto3" json:"caCertFilename,omitempty"`
+	// 服务器主机名
+	ServerName string `protobuf:"bytes,5,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	// tls 客户端证书 base64 内容
+	ClientCert string `protobuf:"bytes,6,opt,name=clientCert,proto3" json:"clientCert,omitempty"`
+	// tls 客户端证书文件名
+	ClientCertFilename string `protobuf:"bytes,7,opt,name=clientCertFilename,proto3" json:"clientCertFilename,omitempty"`
+	// tls 客户端私钥 base64 内容
+	ClientKey string `protobuf:"bytes,8,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
+	// tls 客户端私钥文件名
+	ClientKeyFilename string `protobuf:"bytes,9,opt,name=clientKeyFilename,proto3" json:"clientKeyFilename,omitempty"`
 }
 
-func (x *ReadDataSourceStreamingRequest) Reset() {
-	*x = ReadDataSourceStreamingRequest{}
+func (x *DatasourceTlsConfig) Reset() {
+	*x = DatasourceTlsConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5001,13 +5548,13 @@ func (x *ReadDataSourceStreamingRequest) Reset() {
 	}
 }
 
-func (x *ReadDataSourceStreamingRequest) String() string {
+func (x *DatasourceTlsConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadDataSourceStreamingRequest) ProtoMessage() {}
+func (*DatasourceTlsConfig) ProtoMessage() {}
 
-func (x *ReadDataSourceStreamingRequest) ProtoReflect() protoreflect.Message {
+func (x *DatasourceTlsConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5019,101 +5566,85 @@ func (x *ReadDataSourceStreamingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadDataSourceStreamingRequest.ProtoReflect.Descriptor instead.
-func (*ReadDataSourceStreamingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DatasourceTlsConfig.ProtoReflect.Descriptor instead.
+func (*DatasourceTlsConfig) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ReadDataSourceStreamingRequest) GetJobInstanceId() string {
+func (x *DatasourceTlsConfig) GetUseTls() int32 {
 	if x != nil {
-		return x.JobInstanceId
+		return x.UseTls
 	}
-	return ""
+	return 0
 }
 
-func (m *ReadDataSourceStreamingRequest) GetDataSource() isReadDataSourceStreamingRequest_DataSource {
-	if m != nil {
-		return m.DataSource
+func (x *DatasourceTlsConfig) GetMode() int32 {
+	if x != nil {
+		return x.Mode
 	}
-	return nil
+	return 0
 }
 
-func (x *ReadDataSourceStreamingRequest) GetExternal() *ExternalDataSource {
-	if x, ok := x.GetDataSource().(*ReadDataSourceStreamingRequest_External); ok {
-		return x.External
+func (x *DatasourceTlsConfig) GetCaCert() string {
+	if x != nil {
+		return x.CaCert
 	}
-	return nil
+	return ""
 }
 
-func (x *ReadDataSourceStreamingRequest) GetInternal() *InternalDataSource {
-	if x, ok := x.GetDataSource().(*ReadDataSourceStreamingRequest_Internal); ok {
-		return x.Internal
+func (x *DatasourceTlsConfig) GetCaCertFilename() string {
+	if x != nil {
+		return x.CaCertFilename
 	}
-	return nil
+	return ""
 }
 
-func (x *ReadDataSourceStreamingRequest) GetDoris() *DorisDataSource {
-	if x, ok := x.GetDataSource().(*ReadDataSourceStreamingRequest_Doris); ok {
-		return x.Doris
+func (x *DatasourceTlsConfig) GetServerName() string {
+	if x != nil {
+		return x.ServerName
 	}
-	return nil
+	return ""
 }
 
-func (x *ReadDataSourceStreamingRequest) GetColumns() []string {
+func (x *DatasourceTlsConfig) GetClientCert() string {
 	if x != nil {
-		return x.Columns
+		return x.ClientCert
 	}
-	return nil
+	return ""
 }
 
-func (x *ReadDataSourceStreamingRequest) GetSortRules() []*SortRule {
+func (x *DatasourceTlsConfig) GetClientCertFilename() string {
 	if x != nil {
-		return x.SortRules
+		return x.ClientCertFilename
 	}
-	return nil
+	return ""
 }
 
-func (x *ReadDataSourceStreamingRequest) GetFilterConditions() []*FilterCondition {
+func (x *DatasourceTlsConfig) GetClientKey() string {
 	if x != nil {
-		return x.FilterConditions
+		return x.ClientKey
 	}
-	return nil
-}
-
-type isReadDataSourceStreamingRequest_DataSource interface {
-	isReadDataSourceStreamingRequest_DataSource()
-}
-
-type ReadDataSourceStreamingRequest_External struct {
-	External *ExternalDataSource `protobuf:"bytes,2,opt,name=external,proto3,oneof"` // 外部数据源
-}
-
-type ReadDataSourceStreamingRequest_Internal struct {
-	Internal *InternalDataSource `protobuf:"bytes,3,opt,name=internal,proto3,oneof"` // 内部数据源
+	return ""
 }
 
-type ReadDataSourceStreamingRequest_Doris struct {
-	Doris *DorisDataSource `protobuf:"bytes,4,opt,name=doris,proto3,oneof"` // doris数据源
+func (x *DatasourceTlsConfig) GetClientKeyFilename() string {
+	if x != nil {
+		return x.ClientKeyFilename
+	}
+	return ""
 }
 
-func (*ReadDataSourceStreamingRequest_External) isReadDataSourceStreamingRequest_DataSource() {}
-
-func (*ReadDataSourceStreamingRequest_Internal) isReadDataSourceStreamingRequest_DataSource() {}
-
-func (*ReadDataSourceStreamingRequest_Doris) isReadDataSourceStreamingRequest_DataSource() {}
-
-type ExecuteSqlRequest struct {
+type ExecuteDorisSQLRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sql             string `protobuf:"bytes,1,opt,name=sql,proto3" json:"sql,omitempty"`                         // 要执行的SQL语句，必填
-	DbName          string `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`                   // 数据库名（传入jobInstanceId），必填
-	TargetTableName string `protobuf:"bytes,3,opt,name=targetTableName,proto3" json:"targetTableName,omitempty"` // 查询结果写入的目标表名
+	Sql  string   `protobuf:"bytes,4,opt,name=sql,proto3" json:"sql,omitempty"`   // 要执行的Doris SQL语句
+	Args []string `protobuf:"bytes,5,rep,name=args,proto3" json:"args,omitempty"` // SQL参数（全部转为字符串传递）
 }
 
-func (x *ExecuteSqlRequest) Reset() {
-	*x = ExecuteSqlRequest{}
+func (x *ExecuteDorisSQLRequest) Reset() {
+	*x = ExecuteDorisSQLRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5121,13 +5652,13 @@ func (x *ExecuteSqlRequest) Reset() {
 	}
 }
 
-func (x *ExecuteSqlRequest) String() string {
+func (x *ExecuteDorisSQLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteSqlRequest) ProtoMessage() {}
+func (*ExecuteDorisSQLRequest) ProtoMessage() {}
 
-func (x *ExecuteSqlRequest) ProtoReflect() protoreflect.Message {
+func (x *ExecuteDorisSQLRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5139,51 +5670,38 @@ func (x *ExecuteSqlRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteSqlRequest.ProtoReflect.Descriptor instead.
-func (*ExecuteSqlRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecuteDorisSQLRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteDorisSQLRequest) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ExecuteSqlRequest) GetSql() string {
+func (x *ExecuteDorisSQLRequest) GetSql() string {
 	if x != nil {
 		return x.Sql
 	}
 	return ""
 }
 
-func (x *ExecuteSqlRequest) GetDbName() string {
-	if x != nil {
-		return x.DbName
-	}
-	return ""
-}
-
-func (x *ExecuteSqlRequest) GetTargetTableName() string {
+func (x *ExecuteDorisSQLRequest) GetArgs() []string {
 	if x != nil {
-		return x.TargetTableName
+		return x.Args
 	}
-	return ""
+	return nil
 }
 
-type ExecuteSqlResponse struct {
+type ExecuteDorisSQLResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 通用字段
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 执行是否成功
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 执行结果消息
-	// SELECT查询结果（流式数据）
-	//
-	// Types that are assignable to Result:
-	//
-	//	*ExecuteSqlResponse_ArrowBatch
-	//	*ExecuteSqlResponse_DmlResult
-	Result isExecuteSqlResponse_Result `protobuf_oneof:"result"`
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// 如果是查询，返回结果集；如果是更新等，返回受影响行数
+	Rows []*DorisSQLRow `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"` // 查询结果（每行是一个map）
 }
 
-func (x *ExecuteSqlResponse) Reset() {
-	*x = ExecuteSqlResponse{}
+func (x *ExecuteDorisSQLResponse) Reset() {
+	*x = ExecuteDorisSQLResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5191,13 +5709,13 @@ func (x *ExecuteSqlResponse) Reset() {
 	}
 }
 
-func (x *ExecuteSqlResponse) String() string {
+func (x *ExecuteDorisSQLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteSqlResponse) ProtoMessage() {}
+func (*ExecuteDorisSQLResponse) ProtoMessage() {}
 
-func (x *ExecuteSqlResponse) ProtoReflect() protoreflect.Message {
+func (x *ExecuteDorisSQLResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5209,73 +5727,42 @@ func (x *ExecuteSqlResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteSqlResponse.ProtoReflect.Descriptor instead.
-func (*ExecuteSqlResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecuteDorisSQLResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteDorisSQLResponse) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ExecuteSqlResponse) GetSuccess() bool {
+func (x *ExecuteDorisSQLResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ExecuteSqlResponse) GetMessage() string {
+func (x *ExecuteDorisSQLResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (m *ExecuteSqlResponse) GetResult() isExecuteSqlResponse_Result {
-	if m != nil {
-		return m.Result
-	}
-	return nil
-}
-
-func (x *ExecuteSqlResponse) GetArrowBatch() []byte {
-	if x, ok := x.GetResult().(*ExecuteSqlResponse_ArrowBatch); ok {
-		return x.ArrowBatch
-	}
-	return nil
-}
-
-func (x *ExecuteSqlResponse) GetDmlResult() *DmlResult {
-	if x, ok := x.GetResult().(*ExecuteSqlResponse_DmlResult); ok {
-		return x.DmlResult
+func (x *ExecuteDorisSQLResponse) GetRows() []*DorisSQLRow {
+	if x != nil {
+		return x.Rows
 	}
 	return nil
 }
 
-type isExecuteSqlResponse_Result interface {
-	isExecuteSqlResponse_Result()
-}
-
-type ExecuteSqlResponse_ArrowBatch struct {
-	ArrowBatch []byte `protobuf:"bytes,4,opt,name=arrow_batch,json=arrowBatch,proto3,oneof"` // Arrow格式的数据批次（SELECT时使用）
-}
-
-type ExecuteSqlResponse_DmlResult struct {
-	DmlResult *DmlResult `protobuf:"bytes,5,opt,name=dmlResult,proto3,oneof"` // DML操作结果（INSERT/UPDATE/DELETE时使用）
-}
-
-func (*ExecuteSqlResponse_ArrowBatch) isExecuteSqlResponse_Result() {}
-
-func (*ExecuteSqlResponse_DmlResult) isExecuteSqlResponse_Result() {}
-
-type DmlResult struct {
+type DorisSQLRow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AffectedRows  int64  `protobuf:"varint,1,opt,name=affectedRows,proto3" json:"affectedRows,omitempty"`  // 受影响的行数
-	ExecutionTime string `protobuf:"bytes,2,opt,name=executionTime,proto3" json:"executionTime,omitempty"` // 执行耗时
+	Columns map[string]string `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 列名到字符串值的映射
 }
 
-func (x *DmlResult) Reset() {
-	*x = DmlResult{}
+func (x *DorisSQLRow) Reset() {
+	*x = DorisSQLRow{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5283,13 +5770,13 @@ func (x *DmlResult) Reset() {
 	}
 }
 
-func (x *DmlResult) String() string {
+func (x *DorisSQLRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DmlResult) ProtoMessage() {}
+func (*DorisSQLRow) ProtoMessage() {}
 
-func (x *DmlResult) ProtoReflect() protoreflect.Message {
+func (x *DorisSQLRow) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5301,44 +5788,31 @@ func (x *DmlResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DmlResult.ProtoReflect.Descriptor instead.
-func (*DmlResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use DorisSQLRow.ProtoReflect.Descriptor instead.
+func (*DorisSQLRow) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *DmlResult) GetAffectedRows() int64 {
-	if x != nil {
-		return x.AffectedRows
-	}
-	return 0
-}
-
-func (x *DmlResult) GetExecutionTime() string {
+func (x *DorisSQLRow) GetColumns() map[string]string {
 	if x != nil {
-		return x.ExecutionTime
+		return x.Columns
 	}
-	return ""
+	return nil
 }
 
-type ReadRequest struct {
+type CreateExternalAndInternalTableAndImportDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to DataSource:
-	//
-	//	*ReadRequest_External
-	//	*ReadRequest_Internal
-	//	*ReadRequest_Doris
-	DataSource       isReadRequest_DataSource `protobuf_oneof:"data_source"`
-	Columns          []string                 `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
-	SortRules        []*SortRule              `protobuf:"bytes,5,rep,name=sortRules,proto3" json:"sortRules,omitempty"`               // 排序规则
-	FilterConditions []*FilterCondition       `protobuf:"bytes,6,rep,name=filterConditions,proto3" json:"filterConditions,omitempty"` // 过滤条件
-	Keys             []*TableKey              `protobuf:"bytes,7,rep,name=keys,proto3" json:"keys,omitempty"`                         // 表键信息
+	AssetName     string `protobuf:"bytes,1,opt,name=assetName,proto3" json:"assetName,omitempty"`
+	ChainInfoId   string `protobuf:"bytes,2,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
+	Alias         string `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
+	JobInstanceId string `protobuf:"bytes,4,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
 }
 
-func (x *ReadRequest) Reset() {
-	*x = ReadRequest{}
+func (x *CreateExternalAndInternalTableAndImportDataRequest) Reset() {
+	*x = CreateExternalAndInternalTableAndImportDataRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5346,13 +5820,13 @@ func (x *ReadRequest) Reset() {
 	}
 }
 
-func (x *ReadRequest) String() string {
+func (x *CreateExternalAndInternalTableAndImportDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadRequest) ProtoMessage() {}
+func (*CreateExternalAndInternalTableAndImportDataRequest) ProtoMessage() {}
 
-func (x *ReadRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateExternalAndInternalTableAndImportDataRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5364,102 +5838,49 @@ func (x *ReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadRequest.ProtoReflect.Descriptor instead.
-func (*ReadRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateExternalAndInternalTableAndImportDataRequest.ProtoReflect.Descriptor instead.
+func (*CreateExternalAndInternalTableAndImportDataRequest) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{62}
 }
 
-func (m *ReadRequest) GetDataSource() isReadRequest_DataSource {
-	if m != nil {
-		return m.DataSource
-	}
-	return nil
-}
-
-func (x *ReadRequest) GetExternal() *ExternalDataSource {
-	if x, ok := x.GetDataSource().(*ReadRequest_External); ok {
-		return x.External
-	}
-	return nil
-}
-
-func (x *ReadRequest) GetInternal() *InternalDataSource {
-	if x, ok := x.GetDataSource().(*ReadRequest_Internal); ok {
-		return x.Internal
+func (x *CreateExternalAndInternalTableAndImportDataRequest) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
 	}
-	return nil
+	return ""
 }
 
-func (x *ReadRequest) GetDoris() *DorisDataSource {
-	if x, ok := x.GetDataSource().(*ReadRequest_Doris); ok {
-		return x.Doris
+func (x *CreateExternalAndInternalTableAndImportDataRequest) GetChainInfoId() string {
+	if x != nil {
+		return x.ChainInfoId
 	}
-	return nil
+	return ""
 }
 
-func (x *ReadRequest) GetColumns() []string {
+func (x *CreateExternalAndInternalTableAndImportDataRequest) GetAlias() string {
 	if x != nil {
-		return x.Columns
+		return x.Alias
 	}
-	return nil
+	return ""
 }
 
-func (x *ReadRequest) GetSortRules() []*SortRule {
+func (x *CreateExternalAndInternalTableAndImportDataRequest) GetJobInstanceId() string {
 	if x != nil {
-		return x.SortRules
+		return x.JobInstanceId
 	}
-	return nil
+	return ""
 }
 
-func (x *ReadRequest) GetFilterConditions() []*FilterCondition {
-	if x != nil {
-		return x.FilterConditions
-	}
-	return nil
-}
-
-func (x *ReadRequest) GetKeys() []*TableKey {
-	if x != nil {
-		return x.Keys
-	}
-	return nil
-}
-
-type isReadRequest_DataSource interface {
-	isReadRequest_DataSource()
-}
-
-type ReadRequest_External struct {
-	External *ExternalDataSource `protobuf:"bytes,1,opt,name=external,proto3,oneof"` // 外部数据源
-}
-
-type ReadRequest_Internal struct {
-	Internal *InternalDataSource `protobuf:"bytes,2,opt,name=internal,proto3,oneof"` // 内部数据源
-}
-
-type ReadRequest_Doris struct {
-	Doris *DorisDataSource `protobuf:"bytes,3,opt,name=doris,proto3,oneof"` // doris数据源
-}
-
-func (*ReadRequest_External) isReadRequest_DataSource() {}
-
-func (*ReadRequest_Internal) isReadRequest_DataSource() {}
-
-func (*ReadRequest_Doris) isReadRequest_DataSource() {}
-
-// FilterCondition 过滤条件结构体
-type FilterCondition struct {
+type CreateExternalAndInternalTableAndImportDataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FieldName  string         `protobuf:"bytes,1,opt,name=fieldName,proto3" json:"fieldName,omitempty"`                               // 过滤字段名
-	FieldValue *FilterValue   `protobuf:"bytes,2,opt,name=fieldValue,proto3" json:"fieldValue,omitempty"`                             // 过滤字段值
-	Operator   FilterOperator `protobuf:"varint,3,opt,name=operator,proto3,enum=datasource.FilterOperator" json:"operator,omitempty"` // 过滤操作符
+	TableName string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"` // 存储在doris中的表名
 }
 
-func (x *FilterCondition) Reset() {
-	*x = FilterCondition{}
+func (x *CreateExternalAndInternalTableAndImportDataResponse) Reset() {
+	*x = CreateExternalAndInternalTableAndImportDataResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5467,13 +5888,13 @@ func (x *FilterCondition) Reset() {
 	}
 }
 
-func (x *FilterCondition) String() string {
+func (x *CreateExternalAndInternalTableAndImportDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilterCondition) ProtoMessage() {}
+func (*CreateExternalAndInternalTableAndImportDataResponse) ProtoMessage() {}
 
-func (x *FilterCondition) ProtoReflect() protoreflect.Message {
+func (x *CreateExternalAndInternalTableAndImportDataResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5485,44 +5906,35 @@ func (x *FilterCondition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilterCondition.ProtoReflect.Descriptor instead.
-func (*FilterCondition) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateExternalAndInternalTableAndImportDataResponse.ProtoReflect.Descriptor instead.
+func (*CreateExternalAndInternalTableAndImportDataResponse) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *FilterCondition) GetFieldName() string {
+func (x *CreateExternalAndInternalTableAndImportDataResponse) GetTableName() string {
 	if x != nil {
-		return x.FieldName
+		return x.TableName
 	}
 	return ""
 }
 
-func (x *FilterCondition) GetFieldValue() *FilterValue {
-	if x != nil {
-		return x.FieldValue
-	}
-	return nil
-}
-
-func (x *FilterCondition) GetOperator() FilterOperator {
-	if x != nil {
-		return x.Operator
-	}
-	return FilterOperator_EQUAL
-}
-
-type WriteRequest struct {
+type ImportCsvFileToDorisRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ArrowBatch []byte `protobuf:"bytes,1,opt,name=arrow_batch,json=arrowBatch,proto3" json:"arrow_batch,omitempty"`
-	DbName     string `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	TableName  string `protobuf:"bytes,3,opt,name=tableName,proto3" json:"tableName,omitempty"` // 写入的目标表名
+	BucketName      string   `protobuf:"bytes,1,opt,name=bucketName,proto3" json:"bucketName,omitempty"`
+	ObjectName      string   `protobuf:"bytes,2,opt,name=objectName,proto3" json:"objectName,omitempty"`
+	TableName       string   `protobuf:"bytes,3,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	DbName          string   `protobuf:"bytes,4,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	Columns         []string `protobuf:"bytes,5,rep,name=columns,proto3" json:"columns,omitempty"`
+	ColumnSeparator string   `protobuf:"bytes,6,opt,name=columnSeparator,proto3" json:"columnSeparator,omitempty"`
+	MaxErrorRatio   float64  `protobuf:"fixed64,7,opt,name=maxErrorRatio,proto3" json:"maxErrorRatio,omitempty"` // 允许被拒绝行占总行数的最大比例，与 maxErrorRows 均为 0 时任一行出错即失败
+	MaxErrorRows    int64    `protobuf:"varint,8,opt,name=maxErrorRows,proto3" json:"maxErrorRows,omitempty"`    // 允许被拒绝的最大行数，0 表示不按行数限制
 }
 
-func (x *WriteRequest) Reset() {
-	*x = WriteRequest{}
+func (x *ImportCsvFileToDorisRequest) Reset() {
+	*x = ImportCsvFileToDorisRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5530,13 +5942,13 @@ func (x *WriteRequest) Reset() {
 	}
 }
 
-func (x *WriteRequest) String() string {
+func (x *ImportCsvFileToDorisRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteRequest) ProtoMessage() {}
+func (*ImportCsvFileToDorisRequest) ProtoMessage() {}
 
-func (x *WriteRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportCsvFileToDorisRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5548,43 +5960,90 @@ func (x *WriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteRequest.ProtoReflect.Descriptor instead.
-func (*WriteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportCsvFileToDorisRequest.ProtoReflect.Descriptor instead.
+func (*ImportCsvFileToDorisRequest) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *WriteRequest) GetArrowBatch() []byte {
+func (x *ImportCsvFileToDorisRequest) GetBucketName() string {
 	if x != nil {
-		return x.ArrowBatch
+		return x.BucketName
 	}
-	return nil
+	return ""
 }
 
-func (x *WriteRequest) GetDbName() string {
+func (x *ImportCsvFileToDorisRequest) GetObjectName() string {
 	if x != nil {
-		return x.DbName
+		return x.ObjectName
 	}
 	return ""
 }
 
-func (x *WriteRequest) GetTableName() string {
+func (x *ImportCsvFileToDorisRequest) GetTableName() string {
 	if x != nil {
 		return x.TableName
 	}
 	return ""
 }
 
-type WriteResponse struct {
+func (x *ImportCsvFileToDorisRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *ImportCsvFileToDorisRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ImportCsvFileToDorisRequest) GetColumnSeparator() string {
+	if x != nil {
+		return x.ColumnSeparator
+	}
+	return ""
+}
+
+func (x *ImportCsvFileToDorisRequest) GetMaxErrorRatio() float64 {
+	if x != nil {
+		return x.MaxErrorRatio
+	}
+	return 0
+}
+
+func (x *ImportCsvFileToDorisRequest) GetMaxErrorRows() int64 {
+	if x != nil {
+		return x.MaxErrorRows
+	}
+	return 0
+}
+
+type ExportCsvFileFromDorisRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	JobInstanceId    string             `protobuf:"bytes,1,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
+	TableName        string             `protobuf:"bytes,2,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	DbName           string             `protobuf:"bytes,3,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	Columns          []string           `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+	ColumnSeparator  string             `protobuf:"bytes,5,opt,name=columnSeparator,proto3" json:"columnSeparator,omitempty"`
+	LineDelimiter    string             `protobuf:"bytes,6,opt,name=lineDelimiter,proto3" json:"lineDelimiter,omitempty"`
+	SortRules        []*SortRule        `protobuf:"bytes,7,rep,name=sortRules,proto3" json:"sortRules,omitempty"`               // 排序规则
+	FilterConditions []*FilterCondition `protobuf:"bytes,8,rep,name=filterConditions,proto3" json:"filterConditions,omitempty"` // 过滤条件
+	FileFormat       string             `protobuf:"bytes,9,opt,name=fileFormat,proto3" json:"fileFormat,omitempty"`             // 导出文件格式：parquet（默认）、orc、csv
+	CompressType     string             `protobuf:"bytes,10,opt,name=compressType,proto3" json:"compressType,omitempty"`        // CSV 压缩格式：gz、bz2、snappyblock、lz4block、zstd，为空不压缩
+	Target           *ExportTarget      `protobuf:"bytes,11,opt,name=target,proto3" json:"target,omitempty"`                    // 外部导出目标，为空时导出到内部桶
+	Limit            int64              `protobuf:"varint,12,opt,name=limit,proto3" json:"limit,omitempty"`                     // 最多导出的行数，0 表示不限制
+	Offset           int64              `protobuf:"varint,13,opt,name=offset,proto3" json:"offset,omitempty"`                   // 排序后跳过的行数
+	Sample           *ReadSample        `protobuf:"bytes,14,opt,name=sample,proto3" json:"sample,omitempty"`                    // 采样
 }
 
-func (x *WriteResponse) Reset() {
-	*x = WriteResponse{}
+func (x *ExportCsvFileFromDorisRequest) Reset() {
+	*x = ExportCsvFileFromDorisRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5592,13 +6051,13 @@ func (x *WriteResponse) Reset() {
 	}
 }
 
-func (x *WriteResponse) String() string {
+func (x *ExportCsvFileFromDorisRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteResponse) ProtoMessage() {}
+func (*ExportCsvFileFromDorisRequest) ProtoMessage() {}
 
-func (x *WriteResponse) ProtoReflect() protoreflect.Message {
+func (x *ExportCsvFileFromDorisRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5610,35 +6069,126 @@ func (x *WriteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteResponse.ProtoReflect.Descriptor instead.
-func (*WriteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportCsvFileFromDorisRequest.ProtoReflect.Descriptor instead.
+func (*ExportCsvFileFromDorisRequest) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *WriteResponse) GetSuccess() bool {
+func (x *ExportCsvFileFromDorisRequest) GetJobInstanceId() string {
 	if x != nil {
-		return x.Success
+		return x.JobInstanceId
 	}
-	return false
+	return ""
 }
 
-func (x *WriteResponse) GetMessage() string {
+func (x *ExportCsvFileFromDorisRequest) GetTableName() string {
 	if x != nil {
-		return x.Message
+		return x.TableName
 	}
 	return ""
 }
 
-type ImportDataRequest struct {
+func (x *ExportCsvFileFromDorisRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *ExportCsvFileFromDorisRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ExportCsvFileFromDorisRequest) GetColumnSeparator() string {
+	if x != nil {
+		return x.ColumnSeparator
+	}
+	return ""
+}
+
+func (x *ExportCsvFileFromDorisRequest) GetLineDelimiter() string {
+	if x != nil {
+		return x.LineDelimiter
+	}
+	return ""
+}
+
+func (x *ExportCsvFileFromDorisRequest) GetSortRules() []*SortRule {
+	if x != nil {
+		return x.SortRules
+	}
+	return nil
+}
+
+func (x *ExportCsvFileFromDorisRequest) GetFilterConditions() []*FilterCondition {
+	if x != nil {
+		return x.FilterConditions
+	}
+	return nil
+}
+
+func (x *ExportCsvFileFromDorisRequest) GetFileFormat() string {
+	if x != nil {
+		return x.FileFormat
+	}
+	return ""
+}
+
+func (x *ExportCsvFileFromDorisRequest) GetCompressType() string {
+	if x != nil {
+		return x.CompressType
+	}
+	return ""
+}
+
+func (x *ExportCsvFileFromDorisRequest) GetTarget() *ExportTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ExportCsvFileFromDorisRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ExportCsvFileFromDorisRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ExportCsvFileFromDorisRequest) GetSample() *ReadSample {
+	if x != nil {
+		return x.Sample
+	}
+	return nil
+}
+
+// 外部S3兼容对象存储导出目标
+type ExportTarget struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Targets []*ImportTarget `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"` // 导入目标列表
+	BucketName           string `protobuf:"bytes,1,opt,name=bucketName,proto3" json:"bucketName,omitempty"`                      // 目标桶
+	Prefix               string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`                              // 对象前缀，导出文件位于 prefix/jobInstanceId/ 下
+	CredentialRef        string `protobuf:"bytes,3,opt,name=credentialRef,proto3" json:"credentialRef,omitempty"`                // 凭证引用，对应配置 export_credentials 中的名称
+	MaxFileSize          string `protobuf:"bytes,4,opt,name=maxFileSize,proto3" json:"maxFileSize,omitempty"`                    // 单个文件大小上限，如 256MB，为空使用默认配置
+	WithManifest         bool   `protobuf:"varint,5,opt,name=withManifest,proto3" json:"withManifest,omitempty"`                 // 是否生成 manifest.json
+	Presign              bool   `protobuf:"varint,6,opt,name=presign,proto3" json:"presign,omitempty"`                           // 是否返回预签名下载地址
+	PresignExpirySeconds int64  `protobuf:"varint,7,opt,name=presignExpirySeconds,proto3" json:"presignExpirySeconds,omitempty"` // 预签名有效期（秒），默认24小时
 }
 
-func (x *ImportDataRequest) Reset() {
-	*x = ImportDataRequest{}
+func (x *ExportTarget) Reset() {
+	*x = ExportTarget{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5646,13 +6196,13 @@ func (x *ImportDataRequest) Reset() {
 	}
 }
 
-func (x *ImportDataRequest) String() string {
+func (x *ExportTarget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportDataRequest) ProtoMessage() {}
+func (*ExportTarget) ProtoMessage() {}
 
-func (x *ImportDataRequest) ProtoReflect() protoreflect.Message {
+func (x *ExportTarget) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5664,33 +6214,74 @@ func (x *ImportDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportDataRequest.ProtoReflect.Descriptor instead.
-func (*ImportDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportTarget.ProtoReflect.Descriptor instead.
+func (*ExportTarget) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ImportDataRequest) GetTargets() []*ImportTarget {
+func (x *ExportTarget) GetBucketName() string {
 	if x != nil {
-		return x.Targets
+		return x.BucketName
 	}
-	return nil
+	return ""
 }
 
-// 数据导入目标配置
-type ImportTarget struct {
+func (x *ExportTarget) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ExportTarget) GetCredentialRef() string {
+	if x != nil {
+		return x.CredentialRef
+	}
+	return ""
+}
+
+func (x *ExportTarget) GetMaxFileSize() string {
+	if x != nil {
+		return x.MaxFileSize
+	}
+	return ""
+}
+
+func (x *ExportTarget) GetWithManifest() bool {
+	if x != nil {
+		return x.WithManifest
+	}
+	return false
+}
+
+func (x *ExportTarget) GetPresign() bool {
+	if x != nil {
+		return x.Presign
+	}
+	return false
+}
+
+func (x *ExportTarget) GetPresignExpirySeconds() int64 {
+	if x != nil {
+		return x.PresignExpirySeconds
+	}
+	return 0
+}
+
+type ExportedFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	External        *ExternalDataSource `protobuf:"bytes,1,opt,name=external,proto3" json:"external,omitempty"`               // 外部数据源
-	TargetTableName string              `protobuf:"bytes,2,opt,name=targetTableName,proto3" json:"targetTableName,omitempty"` // 目标表名
-	DbName          string              `protobuf:"bytes,3,opt,name=dbName,proto3" json:"dbName,omitempty"`                   // 数据库名
-	Columns         []string            `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`                 // 导入字段
-	Keys            []*TableKey         `protobuf:"bytes,5,rep,name=keys,proto3" json:"keys,omitempty"`                       // 表键信息
+	ObjectName   string `protobuf:"bytes,1,opt,name=objectName,proto3" json:"objectName,omitempty"`
+	Size         int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`         // 文件大小（字节）
+	RowCount     int64  `protobuf:"varint,3,opt,name=rowCount,proto3" json:"rowCount,omitempty"` // 行数，生成 manifest 时统计
+	Checksum     string `protobuf:"bytes,4,opt,name=checksum,proto3" json:"checksum,omitempty"`  // 对象 ETag，生成 manifest 时从对象元数据读取；分片上传的文件不是内容的 MD5
+	PresignedUrl string `protobuf:"bytes,5,opt,name=presignedUrl,proto3" json:"presignedUrl,omitempty"`
 }
 
-func (x *ImportTarget) Reset() {
-	*x = ImportTarget{}
+func (x *ExportedFile) Reset() {
+	*x = ExportedFile{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5698,13 +6289,13 @@ func (x *ImportTarget) Reset() {
 	}
 }
 
-func (x *ImportTarget) String() string {
+func (x *ExportedFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportTarget) ProtoMessage() {}
+func (*ExportedFile) ProtoMessage() {}
 
-func (x *ImportTarget) ProtoReflect() protoreflect.Message {
+func (x *ExportedFile) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5716,60 +6307,61 @@ func (x *ImportTarget) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportTarget.ProtoReflect.Descriptor instead.
-func (*ImportTarget) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportedFile.ProtoReflect.Descriptor instead.
+func (*ExportedFile) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ImportTarget) GetExternal() *ExternalDataSource {
+func (x *ExportedFile) GetObjectName() string {
 	if x != nil {
-		return x.External
+		return x.ObjectName
 	}
-	return nil
+	return ""
 }
 
-func (x *ImportTarget) GetTargetTableName() string {
+func (x *ExportedFile) GetSize() int64 {
 	if x != nil {
-		return x.TargetTableName
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *ImportTarget) GetDbName() string {
+func (x *ExportedFile) GetRowCount() int64 {
 	if x != nil {
-		return x.DbName
+		return x.RowCount
 	}
-	return ""
+	return 0
 }
 
-func (x *ImportTarget) GetColumns() []string {
+func (x *ExportedFile) GetChecksum() string {
 	if x != nil {
-		return x.Columns
+		return x.Checksum
 	}
-	return nil
+	return ""
 }
 
-func (x *ImportTarget) GetKeys() []*TableKey {
+func (x *ExportedFile) GetPresignedUrl() string {
 	if x != nil {
-		return x.Keys
+		return x.PresignedUrl
 	}
-	return nil
+	return ""
 }
 
-// 表键信息
-type TableKey struct {
+type ExportCsvFileFromDorisResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KeyName     string            `protobuf:"bytes,1,opt,name=keyName,proto3" json:"keyName,omitempty"`                                                                                               // 键名称（可选）
-	KeyType     KeyType           `protobuf:"varint,2,opt,name=keyType,proto3,enum=datasource.KeyType" json:"keyType,omitempty"`                                                                      // 键类型
-	ColumnNames []string          `protobuf:"bytes,3,rep,name=columnNames,proto3" json:"columnNames,omitempty"`                                                                                       // 键包含的列名（支持组合键）
-	Attributes  map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 扩展属性（可选，可扩展）
+	BucketName     string          `protobuf:"bytes,1,opt,name=bucketName,proto3" json:"bucketName,omitempty"`
+	JobInstanceId  string          `protobuf:"bytes,2,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
+	FileFormat     string          `protobuf:"bytes,3,opt,name=fileFormat,proto3" json:"fileFormat,omitempty"`         // 实际导出的文件格式
+	Files          []*ExportedFile `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`                   // 导出的文件列表，内部导出仅返回对象名
+	ManifestObject string          `protobuf:"bytes,5,opt,name=manifestObject,proto3" json:"manifestObject,omitempty"` // manifest 对象名
+	ManifestUrl    string          `protobuf:"bytes,6,opt,name=manifestUrl,proto3" json:"manifestUrl,omitempty"`       // manifest 预签名下载地址
 }
 
-func (x *TableKey) Reset() {
-	*x = TableKey{}
+func (x *ExportCsvFileFromDorisResponse) Reset() {
+	*x = ExportCsvFileFromDorisResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5777,13 +6369,13 @@ func (x *TableKey) Reset() {
 	}
 }
 
-func (x *TableKey) String() string {
+func (x *ExportCsvFileFromDorisResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TableKey) ProtoMessage() {}
+func (*ExportCsvFileFromDorisResponse) ProtoMessage() {}
 
-func (x *TableKey) ProtoReflect() protoreflect.Message {
+func (x *ExportCsvFileFromDorisResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5795,51 +6387,66 @@ func (x *TableKey) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TableKey.ProtoReflect.Descriptor instead.
-func (*TableKey) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportCsvFileFromDorisResponse.ProtoReflect.Descriptor instead.
+func (*ExportCsvFileFromDorisResponse) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *TableKey) GetKeyName() string {
+func (x *ExportCsvFileFromDorisResponse) GetBucketName() string {
 	if x != nil {
-		return x.KeyName
+		return x.BucketName
 	}
 	return ""
 }
 
-func (x *TableKey) GetKeyType() KeyType {
+func (x *ExportCsvFileFromDorisResponse) GetJobInstanceId() string {
 	if x != nil {
-		return x.KeyType
+		return x.JobInstanceId
 	}
-	return KeyType_KEY_TYPE_UNKNOWN
+	return ""
 }
 
-func (x *TableKey) GetColumnNames() []string {
+func (x *ExportCsvFileFromDorisResponse) GetFileFormat() string {
 	if x != nil {
-		return x.ColumnNames
+		return x.FileFormat
 	}
-	return nil
+	return ""
 }
 
-func (x *TableKey) GetAttributes() map[string]string {
+func (x *ExportCsvFileFromDorisResponse) GetFiles() []*ExportedFile {
 	if x != nil {
-		return x.Attributes
+		return x.Files
 	}
 	return nil
 }
 
-type ImportDataResponse struct {
+func (x *ExportCsvFileFromDorisResponse) GetManifestObject() string {
+	if x != nil {
+		return x.ManifestObject
+	}
+	return ""
+}
+
+func (x *ExportCsvFileFromDorisResponse) GetManifestUrl() string {
+	if x != nil {
+		return x.ManifestUrl
+	}
+	return ""
+}
+
+type ExportDorisDataToMiraDBRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Results []*ImportResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"` // 多个导入结果
+	TableName       string        `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`             // 存储到mira数据库表名
+	SourceTableName string        `protobuf:"bytes,2,opt,name=sourceTableName,proto3" json:"sourceTableName,omitempty"` // 从doris导出数据表
+	Columns         []*ColumnItem `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`                 // 导出字段
+	OrderByColumns  []string      `protobuf:"bytes,4,rep,name=orderByColumns,proto3" json:"orderByColumns,omitempty"`   // 写入时按这些列升序排序，为空时不排序
 }
 
-func (x *ImportDataResponse) Reset() {
-	*x = ImportDataResponse{}
+func (x *ExportDorisDataToMiraDBRequest) Reset() {
+	*x = ExportDorisDataToMiraDBRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5847,13 +6454,13 @@ func (x *ImportDataResponse) Reset() {
 	}
 }
 
-func (x *ImportDataResponse) String() string {
+func (x *ExportDorisDataToMiraDBRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportDataResponse) ProtoMessage() {}
+func (*ExportDorisDataToMiraDBRequest) ProtoMessage() {}
 
-func (x *ImportDataResponse) ProtoReflect() protoreflect.Message {
+func (x *ExportDorisDataToMiraDBRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5865,49 +6472,50 @@ func (x *ImportDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportDataResponse.ProtoReflect.Descriptor instead.
-func (*ImportDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportDorisDataToMiraDBRequest.ProtoReflect.Descriptor instead.
+func (*ExportDorisDataToMiraDBRequest) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ImportDataResponse) GetSuccess() bool {
+func (x *ExportDorisDataToMiraDBRequest) GetTableName() string {
 	if x != nil {
-		return x.Success
+		return x.TableName
 	}
-	return false
+	return ""
 }
 
-func (x *ImportDataResponse) GetMessage() string {
+func (x *ExportDorisDataToMiraDBRequest) GetSourceTableName() string {
 	if x != nil {
-		return x.Message
+		return x.SourceTableName
 	}
 	return ""
 }
 
-func (x *ImportDataResponse) GetResults() []*ImportResult {
+func (x *ExportDorisDataToMiraDBRequest) GetColumns() []*ColumnItem {
 	if x != nil {
-		return x.Results
+		return x.Columns
 	}
 	return nil
 }
 
-// 单个导入结果
-type ImportResult struct {
+func (x *ExportDorisDataToMiraDBRequest) GetOrderByColumns() []string {
+	if x != nil {
+		return x.OrderByColumns
+	}
+	return nil
+}
+
+type ImportMiraDBDataToDorisRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SourceTableName string `protobuf:"bytes,1,opt,name=sourceTableName,proto3" json:"sourceTableName,omitempty"` // 源表名
-	TargetTableName string `protobuf:"bytes,2,opt,name=targetTableName,proto3" json:"targetTableName,omitempty"` // 目标表名（写入的表）
-	SourceDatabase  string `protobuf:"bytes,3,opt,name=sourceDatabase,proto3" json:"sourceDatabase,omitempty"`   // 源数据库名
-	TargetDatabase  string `protobuf:"bytes,4,opt,name=targetDatabase,proto3" json:"targetDatabase,omitempty"`   // 目标数据库名
-	AffectedRows    int64  `protobuf:"varint,5,opt,name=affectedRows,proto3" json:"affectedRows,omitempty"`      // 影响的行数
-	Success         bool   `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`                // 该目标是否导入成功
-	ErrorMessage    string `protobuf:"bytes,7,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`       // 如果失败，错误信息
+	MiraTableName string `protobuf:"bytes,1,opt,name=miraTableName,proto3" json:"miraTableName,omitempty"` // mira中间表
+	JobInstanceId string `protobuf:"bytes,2,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
 }
 
-func (x *ImportResult) Reset() {
-	*x = ImportResult{}
+func (x *ImportMiraDBDataToDorisRequest) Reset() {
+	*x = ImportMiraDBDataToDorisRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_data_source_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5915,13 +6523,13 @@ func (x *ImportResult) Reset() {
 	}
 }
 
-func (x *ImportResult) String() string {
+func (x *ImportMiraDBDataToDorisRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportResult) ProtoMessage() {}
+func (*ImportMiraDBDataToDorisRequest) ProtoMessage() {}
 
-func (x *ImportResult) ProtoReflect() protoreflect.Message {
+func (x *ImportMiraDBDataToDorisRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_source_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5933,1360 +6541,5484 @@ func (x *ImportResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportResult.ProtoReflect.Descriptor instead.
-func (*ImportResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportMiraDBDataToDorisRequest.ProtoReflect.Descriptor instead.
+func (*ImportMiraDBDataToDorisRequest) Descriptor() ([]byte, []int) {
 	return file_proto_data_source_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ImportResult) GetSourceTableName() string {
+func (x *ImportMiraDBDataToDorisRequest) GetMiraTableName() string {
 	if x != nil {
-		return x.SourceTableName
+		return x.MiraTableName
 	}
 	return ""
 }
 
-func (x *ImportResult) GetTargetTableName() string {
+func (x *ImportMiraDBDataToDorisRequest) GetJobInstanceId() string {
 	if x != nil {
-		return x.TargetTableName
+		return x.JobInstanceId
 	}
 	return ""
 }
 
-func (x *ImportResult) GetSourceDatabase() string {
-	if x != nil {
-		return x.SourceDatabase
-	}
-	return ""
+type ImportMiraDBDataToDorisResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DorisTableName string `protobuf:"bytes,1,opt,name=dorisTableName,proto3" json:"dorisTableName,omitempty"` // 存储在doris中的表名
 }
 
-func (x *ImportResult) GetTargetDatabase() string {
-	if x != nil {
-		return x.TargetDatabase
+func (x *ImportMiraDBDataToDorisResponse) Reset() {
+	*x = ImportMiraDBDataToDorisResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ImportResult) GetAffectedRows() int64 {
-	if x != nil {
-		return x.AffectedRows
-	}
-	return 0
+func (x *ImportMiraDBDataToDorisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ImportResult) GetSuccess() bool {
-	if x != nil {
-		return x.Success
+func (*ImportMiraDBDataToDorisResponse) ProtoMessage() {}
+
+func (x *ImportMiraDBDataToDorisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *ImportResult) GetErrorMessage() string {
+// Deprecated: Use ImportMiraDBDataToDorisResponse.ProtoReflect.Descriptor instead.
+func (*ImportMiraDBDataToDorisResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ImportMiraDBDataToDorisResponse) GetDorisTableName() string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.DorisTableName
 	}
 	return ""
 }
 
-var File_proto_data_source_proto protoreflect.FileDescriptor
+type InternalTableInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_proto_data_source_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x22, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3e, 0x0a, 0x08, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x0d, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f,
-	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x28,
-	0x0a, 0x10, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x0d, 0x41, 0x72, 0x72, 0x6f,
-	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x72,
-	0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
-	0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x74, 0x0a, 0x11, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64,
-	0x22, 0x71, 0x0a, 0x18, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x07,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x19, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0xce,
-	0x01, 0x0a, 0x19, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a,
-	0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69,
-	0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22,
-	0xe6, 0x02, 0x0a, 0x13, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74,
-	0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c,
-	0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
-	0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0xd4, 0x05, 0x0a, 0x10, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x73,
-	0x70, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x70,
-	0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x73, 0x70, 0x61, 0x72, 0x6b,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x12, 0x32, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x48, 0x01, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x6f,
-	0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x48, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x62, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x0c, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x62, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x6a, 0x6f, 0x69,
-	0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x48, 0x01, 0x52, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x12, 0x4c, 0x0a, 0x0f, 0x61, 0x64,
-	0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x41, 0x64, 0x64, 0x48, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x0d, 0x61, 0x64, 0x64, 0x48, 0x61,
-	0x73, 0x68, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x39, 0x0a, 0x08, 0x70, 0x73, 0x69, 0x5f,
-	0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x53, 0x49, 0x4a, 0x6f, 0x69, 0x6e, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x07, 0x70, 0x73, 0x69, 0x4a,
-	0x6f, 0x69, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x6a, 0x0a, 0x12, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x4a, 0x0a, 0x12, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x47, 0x0a, 0x0f, 0x44, 0x6f, 0x72, 0x69, 0x73,
-	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0xe9, 0x02, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
-	0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x44,
-	0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65,
-	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73,
-	0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a,
-	0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x0e,
-	0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
-	0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x22, 0x77, 0x0a, 0x0d, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42,
-	0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xd3, 0x01, 0x0a, 0x0e, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0f,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22,
-	0x80, 0x02, 0x0a, 0x15, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32,
-	0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x0d, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
-	0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x6d,
-	0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a,
-	0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x98, 0x01, 0x0a,
-	0x16, 0x41, 0x64, 0x64, 0x48, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6a, 0x6f, 0x69, 0x6e,
-	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x10, 0x50, 0x53, 0x49, 0x4a,
-	0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
-	0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x09, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6a, 0x6f,
-	0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24,
-	0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0xca, 0x03, 0x0a, 0x11, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
-	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
-	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09,
-	0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0f,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x89, 0x02, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1d,
-	0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x05, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x21, 0x0a,
-	0x0c, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20,
-	0x03, 0x28, 0x01, 0x52, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x08, 0x52, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x22, 0x5d, 0x0a, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x73,
-	0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x22, 0xa7, 0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x64, 0x62, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68,
-	0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
-	0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x3d, 0x0a, 0x09, 0x74, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x6c, 0x73, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x74, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x3d, 0x0a, 0x0a, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x22, 0x6e, 0x0a, 0x0a, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x67, 0x0a, 0x0f, 0x4f, 0x53, 0x53,
-	0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75,
-	0x6e, 0x6b, 0x22, 0x50, 0x0a, 0x0e, 0x4f, 0x53, 0x53, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x0f, 0x4f, 0x53, 0x53, 0x52, 0x65, 0x61, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x22, 0xab, 0x05, 0x0a, 0x0f, 0x53, 0x70, 0x61, 0x72, 0x6b, 0x44, 0x42, 0x43, 0x6f, 0x6e,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
-	0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x09, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64,
-	0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64,
-	0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6a, 0x6f,
-	0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
-	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f,
-	0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65,
-	0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
-	0x65, 0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x20,
-	0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x12, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x12, 0x39, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x73,
-	0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74,
-	0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22,
-	0xb9, 0x03, 0x0a, 0x0b, 0x53, 0x70, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x3a, 0x0a, 0x18, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x18, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x1d, 0x64,
-	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4d, 0x69, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x1d, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
-	0x73, 0x12, 0x44, 0x0a, 0x1d, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
-	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x1d, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69,
-	0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x6f, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x4d, 0x42, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x43,
-	0x6f, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0e, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
-	0x42, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x72, 0x65, 0x73,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x43, 0x6f,
-	0x72, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69,
-	0x73, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c,
-	0x65, 0x6c, 0x69, 0x73, 0x6d, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x75,
-	0x6d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x10,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64,
-	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x22,
-	0x0a, 0x0c, 0x69, 0x73, 0x45, 0x78, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x45, 0x78, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x11, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e,
-	0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x30,
-	0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
-	0x22, 0x94, 0x02, 0x0a, 0x11, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x42, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x54, 0x0a, 0x12, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x34, 0x0a,
-	0x14, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x22, 0x4b, 0x0a, 0x15, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0xca, 0x01, 0x0a, 0x14, 0x50, 0x75, 0x73, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
-	0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64,
-	0x61, 0x74, 0x61, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x61, 0x74,
-	0x61, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x45, 0x6e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x4b, 0x0a,
-	0x15, 0x50, 0x75, 0x73, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x17, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x44, 0x42, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x44, 0x42, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x44, 0x42, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xbd, 0x02, 0x0a,
-	0x13, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x6c, 0x73, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x54, 0x6c, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x54, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x61, 0x43, 0x65,
-	0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74,
-	0x12, 0x2e, 0x0a, 0x12, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2c,
-	0x0a, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x16,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x7a, 0x0a, 0x17,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x72,
-	0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52,
-	0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x0b, 0x44, 0x6f, 0x72,
-	0x69, 0x73, 0x53, 0x51, 0x4c, 0x52, 0x6f, 0x77, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52,
-	0x6f, 0x77, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0xb0, 0x01, 0x0a, 0x32, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61,
-	0x6c, 0x69, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61,
-	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x33, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd7, 0x01, 0x0a,
-	0x1b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x6f,
-	0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f,
-	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65, 0x70,
-	0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xe2, 0x02, 0x0a, 0x1d, 0x45, 0x78, 0x70, 0x6f, 0x72,
-	0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72, 0x69,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x28,
-	0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53,
-	0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x65,
-	0x44, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6c, 0x69, 0x6e, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x12, 0x32,
-	0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53,
-	0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x12, 0x47, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x66, 0x0a, 0x1e, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d,
-	0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a,
-	0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x49, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x1e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x6f,
-	0x72, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30,
-	0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
-	0x22, 0x6c, 0x0a, 0x1e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42,
-	0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x69, 0x72, 0x61, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x69, 0x72, 0x61, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x49,
-	0x0a, 0x1f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44, 0x61,
-	0x74, 0x61, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x6f, 0x72, 0x69, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x6f, 0x72, 0x69, 0x73,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x18, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c,
-	0x69, 0x73, 0x45, 0x78, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x45, 0x78, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x13, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x54,
-	0x6d, 0x70, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
-	0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43,
-	0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79,
-	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x61, 0x0a, 0x0f, 0x43, 0x6c, 0x65,
-	0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f,
-	0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x9d, 0x03, 0x0a,
-	0x1e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x12, 0x33, 0x0a, 0x05, 0x64, 0x6f, 0x72, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x44, 0x6f,
-	0x72, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52,
-	0x05, 0x64, 0x6f, 0x72, 0x69, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
-	0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52,
-	0x75, 0x6c, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0d, 0x0a,
-	0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x67, 0x0a, 0x11,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x73, 0x71, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x65, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x21, 0x0a, 0x0b, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x12, 0x35, 0x0a, 0x09, 0x64, 0x6d, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x44, 0x6d, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52,
-	0x09, 0x64, 0x6d, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x22, 0x55, 0x0a, 0x09, 0x44, 0x6d, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65,
-	0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8e, 0x03, 0x0a, 0x0b,
-	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x65,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52,
-	0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x08, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x05, 0x64, 0x6f, 0x72, 0x69, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x64, 0x6f, 0x72, 0x69, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75,
-	0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52,
-	0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x10, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x42, 0x0d, 0x0a,
-	0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xa0, 0x01, 0x0a,
-	0x0f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37,
-	0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22,
-	0x65, 0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x43, 0x0a, 0x0d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x47, 0x0a, 0x11, 0x49,
-	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x32, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49,
-	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64,
-	0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x28, 0x0a,
-	0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65,
-	0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0xfa, 0x01, 0x0a, 0x08, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d,
-	0x0a, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x13, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12,
-	0x44, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x7c, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32,
-	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70,
-	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x22, 0x94, 0x02, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a,
-	0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12,
-	0x26, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x66, 0x66, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61,
-	0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x1e, 0x0a, 0x09, 0x53, 0x6f, 0x72,
-	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x53, 0x43, 0x10, 0x00, 0x12,
-	0x08, 0x0a, 0x04, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x2a, 0xa2, 0x01, 0x0a, 0x0e, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x09, 0x0a, 0x05,
-	0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x45,
-	0x51, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x52, 0x45, 0x41, 0x54, 0x45,
-	0x52, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x45, 0x53, 0x53,
-	0x5f, 0x54, 0x48, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x52, 0x45, 0x41, 0x54,
-	0x45, 0x52, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f, 0x4f, 0x52, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c,
-	0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f,
-	0x4f, 0x52, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x49,
-	0x4b, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x06, 0x12, 0x0f, 0x0a,
-	0x0b, 0x49, 0x4e, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x07, 0x2a, 0x9a,
-	0x01, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x46,
-	0x49, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
-	0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x49, 0x4c,
-	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x51, 0x55, 0x45, 0x54, 0x10, 0x03,
-	0x12, 0x12, 0x0a, 0x0e, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x56,
-	0x52, 0x4f, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x41, 0x52, 0x52, 0x4f, 0x57, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x52,
-	0x45, 0x41, 0x4d, 0x5f, 0x41, 0x52, 0x52, 0x4f, 0x57, 0x10, 0x06, 0x2a, 0x92, 0x02, 0x0a, 0x0e,
-	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c,
-	0x0a, 0x18, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
-	0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x4d, 0x59, 0x53, 0x51, 0x4c, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x41, 0x54, 0x41,
-	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4b, 0x49, 0x4e,
-	0x47, 0x42, 0x41, 0x53, 0x45, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x41, 0x54, 0x41, 0x5f,
-	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x49, 0x56, 0x45,
-	0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
-	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x49, 0x44, 0x42, 0x10, 0x04, 0x12, 0x1a, 0x0a,
-	0x16, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x54, 0x44, 0x53, 0x51, 0x4c, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x41, 0x54,
-	0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x41,
-	0x53, 0x54, 0x42, 0x41, 0x53, 0x45, 0x10, 0x06, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x41,
-	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x42, 0x41,
-	0x53, 0x45, 0x10, 0x07, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55,
-	0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x4f, 0x52, 0x49, 0x53, 0x10, 0x08,
-	0x2a, 0x22, 0x0a, 0x0a, 0x44, 0x62, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x14,
-	0x0a, 0x10, 0x4d, 0x49, 0x52, 0x41, 0x5f, 0x45, 0x4e, 0x47, 0x49, 0x4e, 0x45, 0x5f, 0x54, 0x45,
-	0x4d, 0x50, 0x10, 0x00, 0x2a, 0x8e, 0x02, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
-	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
-	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x57,
-	0x52, 0x49, 0x54, 0x45, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x10, 0x03, 0x12,
-	0x18, 0x0a, 0x14, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44,
-	0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x50, 0x45,
-	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55,
-	0x50, 0x42, 0x59, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x4f,
-	0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4a, 0x4f,
-	0x49, 0x4e, 0x10, 0x06, 0x12, 0x22, 0x0a, 0x1e, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f,
-	0x43, 0x4f, 0x4c, 0x55, 0x4d, 0x4e, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x50, 0x45, 0x52,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x53, 0x49, 0x5f, 0x4a,
-	0x4f, 0x49, 0x4e, 0x10, 0x08, 0x2a, 0x74, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x15, 0x0a, 0x11, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4a, 0x4f, 0x49, 0x4e,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x12, 0x0a,
-	0x0e, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10,
-	0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52,
-	0x49, 0x47, 0x48, 0x54, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x04, 0x2a, 0x84, 0x01, 0x0a, 0x09,
-	0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f, 0x42,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
-	0x00, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f, 0x42,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10,
-	0x02, 0x12, 0x18, 0x0a, 0x14, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x4a,
-	0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
-	0x10, 0x04, 0x2a, 0x54, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53,
-	0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x42, 0x10, 0x01,
-	0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4f, 0x10, 0x02, 0x2a, 0x91, 0x01, 0x0a, 0x07, 0x4b, 0x65, 0x79,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x45,
-	0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x10, 0x01,
-	0x12, 0x14, 0x0a, 0x10, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x4f, 0x52,
-	0x45, 0x49, 0x47, 0x4e, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x4b,
-	0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x04, 0x12,
-	0x1b, 0x0a, 0x17, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x4f,
-	0x5f, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x05, 0x32, 0xfb, 0x13, 0x0a,
-	0x11, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x4a, 0x6f, 0x62, 0x12, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a,
-	0x11, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41,
-	0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4d,
-	0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x72, 0x6f, 0x77, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x72, 0x61,
-	0x70, 0x70, 0x65, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x43, 0x0a,
-	0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x53, 0x53, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4f, 0x53, 0x53, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x28, 0x01, 0x12, 0x47, 0x0a, 0x10, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x53, 0x53, 0x46, 0x69,
-	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x4f, 0x53, 0x53, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x48, 0x0a, 0x0b, 0x52,
-	0x65, 0x61, 0x64, 0x4f, 0x53, 0x53, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4f, 0x53, 0x53, 0x52, 0x65, 0x61, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x4f, 0x53, 0x53, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x52, 0x0a, 0x11, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x50, 0x0a, 0x10, 0x52, 0x65, 0x61,
-	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x72, 0x6f,
-	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x12, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x47, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x62,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x54, 0x72, 0x75, 0x6e,
-	0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
-	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60,
-	0x0a, 0x19, 0x50, 0x75, 0x73, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54,
-	0x6f, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x42, 0x12, 0x20, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4a, 0x6f, 0x62,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5a, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44, 0x6f, 0x72, 0x69, 0x73,
-	0x53, 0x51, 0x4c, 0x12, 0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44, 0x6f, 0x72, 0x69,
-	0x73, 0x53, 0x51, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xae, 0x01, 0x0a,
-	0x2b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41,
-	0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41,
-	0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3e, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a,
-	0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x6f,
-	0x44, 0x6f, 0x72, 0x69, 0x73, 0x12, 0x27, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65,
-	0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73,
-	0x76, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x12, 0x29,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f,
-	0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72,
-	0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76,
-	0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44,
-	0x6f, 0x72, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42,
-	0x12, 0x2a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78,
-	0x70, 0x6f, 0x72, 0x74, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x4d,
-	0x69, 0x72, 0x61, 0x44, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x72, 0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61,
-	0x44, 0x42, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x12, 0x2a, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x44, 0x6f, 0x72,
-	0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72,
-	0x61, 0x44, 0x42, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x2a,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64,
-	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x6d, 0x0a, 0x0c, 0x43, 0x6c, 0x65, 0x61, 0x6e,
-	0x54, 0x6d, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x54, 0x6d, 0x70, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x54,
-	0x6d, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x97, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x74, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x27,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x65,
-	0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x76,
-	0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x74,
-	0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b,
-	0x12, 0x4d, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x71, 0x6c, 0x12, 0x1d,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x65, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12,
-	0x3c, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x72,
-	0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x3e, 0x0a,
-	0x05, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x4b, 0x0a,
-	0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2f,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	TableName     string `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	DbName        string `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	IsExactQuery  bool   `protobuf:"varint,3,opt,name=isExactQuery,proto3" json:"isExactQuery,omitempty"`
+	JobInstanceId string `protobuf:"bytes,4,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
 }
 
-var (
-	file_proto_data_source_proto_rawDescOnce sync.Once
-	file_proto_data_source_proto_rawDescData = file_proto_data_source_proto_rawDesc
-)
+func (x *InternalTableInfoRequest) Reset() {
+	*x = InternalTableInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_proto_data_source_proto_rawDescGZIP() []byte {
-	file_proto_data_source_proto_rawDescOnce.Do(func() {
-		file_proto_data_source_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_data_source_proto_rawDescData)
-	})
-	return file_proto_data_source_proto_rawDescData
+func (x *InternalTableInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_proto_data_source_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
-var file_proto_data_source_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
-var file_proto_data_source_proto_goTypes = []any{
-	(SortOrder)(0),                    // 0: datasource.SortOrder
-	(FilterOperator)(0),               // 1: datasource.FilterOperator
-	(FileType)(0),                     // 2: datasource.FileType
-	(DataSourceType)(0),               // 3: datasource.DataSourceType
-	(DbConstant)(0),                   // 4: datasource.DbConstant
-	(OperationMode)(0),                // 5: datasource.OperationMode
-	(JoinType)(0),                     // 6: datasource.JoinType
-	(JobStatus)(0),                    // 7: datasource.JobStatus
-	(StorageType)(0),                  // 8: datasource.StorageType
-	(KeyType)(0),                      // 9: datasource.KeyType
-	(*Response)(nil),                  // 10: datasource.Response
-	(*BatchResponse)(nil),             // 11: datasource.BatchResponse
-	(*JobStatusRequest)(nil),          // 12: datasource.JobStatusRequest
-	(*ArrowResponse)(nil),             // 13: datasource.ArrowResponse
-	(*WriterDataRequest)(nil),         // 14: datasource.WriterDataRequest
-	(*WrappedWriterDataRequest)(nil),  // 15: datasource.WrappedWriterDataRequest
-	(*WriterInternalDataRequest)(nil), // 16: datasource.WriterInternalDataRequest
-	(*WriterExternalDataRequest)(nil), // 17: datasource.WriterExternalDataRequest
-	(*InternalReadRequest)(nil),       // 18: datasource.InternalReadRequest
-	(*BatchReadRequest)(nil),          // 19: datasource.BatchReadRequest
-	(*ExternalDataSource)(nil),        // 20: datasource.ExternalDataSource
-	(*InternalDataSource)(nil),        // 21: datasource.InternalDataSource
-	(*DorisDataSource)(nil),           // 22: datasource.DorisDataSource
-	(*QueryOperation)(nil),            // 23: datasource.QueryOperation
-	(*WriteOperation)(nil),            // 24: datasource.WriteOperation
-	(*SortOperation)(nil),             // 25: datasource.SortOperation
-	(*CountOperation)(nil),            // 26: datasource.CountOperation
-	(*GroupByCountOperation)(nil),     // 27: datasource.GroupByCountOperation
-	(*JoinOperation)(nil),             // 28: datasource.JoinOperation
-	(*AddHashColumnOperation)(nil),    // 29: datasource.AddHashColumnOperation
-	(*PSIJoinOperation)(nil),          // 30: datasource.PSIJoinOperation
-	(*StreamReadRequest)(nil),         // 31: datasource.StreamReadRequest
-	(*FilterValue)(nil),               // 32: datasource.FilterValue
-	(*SortRule)(nil),                  // 33: datasource.SortRule
-	(*ConnectionInfo)(nil),            // 34: datasource.ConnectionInfo
-	(*ColumnItem)(nil),                // 35: datasource.ColumnItem
-	(*ServerInfo)(nil),                // 36: datasource.ServerInfo
-	(*OSSWriteRequest)(nil),           // 37: datasource.OSSWriteRequest
-	(*OSSReadRequest)(nil),            // 38: datasource.OSSReadRequest
-	(*OSSReadResponse)(nil),           // 39: datasource.OSSReadResponse
-	(*SparkDBConnInfo)(nil),           // 40: datasource.SparkDBConnInfo
-	(*SparkConfig)(nil),               // 41: datasource.SparkConfig
-	(*TableInfoRequest)(nil),          // 42: datasource.TableInfoRequest
-	(*TableInfoResponse)(nil),         // 43: datasource.TableInfoResponse
-	(*GroupCountRequest)(nil),         // 44: datasource.GroupCountRequest
-	(*GroupCountResponse)(nil),        // 45: datasource.GroupCountResponse
-	(*TruncateTableRequest)(nil),      // 46: datasource.TruncateTableRequest
-	(*TruncateTableResponse)(nil),     // 47: datasource.TruncateTableResponse
-	(*PushJobResultRequest)(nil),      // 48: datasource.PushJobResultRequest
-	(*PushJobResultResponse)(nil),     // 49: datasource.PushJobResultResponse
-	(*JobResultExternalDBInfo)(nil),   // 50: datasource.JobResultExternalDBInfo
-	(*DatasourceTlsConfig)(nil),       // 51: datasource.DatasourceTlsConfig
-	(*ExecuteDorisSQLRequest)(nil),    // 52: datasource.ExecuteDorisSQLRequest
-	(*ExecuteDorisSQLResponse)(nil),   // 53: datasource.ExecuteDorisSQLResponse
-	(*DorisSQLRow)(nil),               // 54: datasource.DorisSQLRow
-	(*CreateExternalAndInternalTableAndImportDataRequest)(nil),  // 55: datasource.CreateExternalAndInternalTableAndImportDataRequest
-	(*CreateExternalAndInternalTableAndImportDataResponse)(nil), // 56: datasource.CreateExternalAndInternalTableAndImportDataResponse
-	(*ImportCsvFileToDorisRequest)(nil),                         // 57: datasource.ImportCsvFileToDorisRequest
-	(*ExportCsvFileFromDorisRequest)(nil),                       // 58: datasource.ExportCsvFileFromDorisRequest
-	(*ExportCsvFileFromDorisResponse)(nil),                      // 59: datasource.ExportCsvFileFromDorisResponse
-	(*ExportDorisDataToMiraDBRequest)(nil),                      // 60: datasource.ExportDorisDataToMiraDBRequest
-	(*ImportMiraDBDataToDorisRequest)(nil),                      // 61: datasource.ImportMiraDBDataToDorisRequest
-	(*ImportMiraDBDataToDorisResponse)(nil),                     // 62: datasource.ImportMiraDBDataToDorisResponse
-	(*InternalTableInfoRequest)(nil),                            // 63: datasource.InternalTableInfoRequest
-	(*CleanTmpDataRequest)(nil),                                 // 64: datasource.CleanTmpDataRequest
-	(*GetRetryCleanupTasksRequest)(nil),                         // 65: datasource.GetRetryCleanupTasksRequest
-	(*GetRetryCleanupTasksResponse)(nil),                        // 66: datasource.GetRetryCleanupTasksResponse
-	(*CleanupTaskInfo)(nil),                                     // 67: datasource.CleanupTaskInfo
-	(*ReadDataSourceStreamingRequest)(nil),                      // 68: datasource.ReadDataSourceStreamingRequest
-	(*ExecuteSqlRequest)(nil),                                   // 69: datasource.ExecuteSqlRequest
-	(*ExecuteSqlResponse)(nil),                                  // 70: datasource.ExecuteSqlResponse
-	(*DmlResult)(nil),                                           // 71: datasource.DmlResult
-	(*ReadRequest)(nil),                                         // 72: datasource.ReadRequest
-	(*FilterCondition)(nil),                                     // 73: datasource.FilterCondition
-	(*WriteRequest)(nil),                                        // 74: datasource.WriteRequest
-	(*WriteResponse)(nil),                                       // 75: datasource.WriteResponse
-	(*ImportDataRequest)(nil),                                   // 76: datasource.ImportDataRequest
-	(*ImportTarget)(nil),                                        // 77: datasource.ImportTarget
-	(*TableKey)(nil),                                            // 78: datasource.TableKey
-	(*ImportDataResponse)(nil),                                  // 79: datasource.ImportDataResponse
-	(*ImportResult)(nil),                                        // 80: datasource.ImportResult
-	nil,                                                         // 81: datasource.DorisSQLRow.ColumnsEntry
-	nil,                                                         // 82: datasource.TableKey.AttributesEntry
+func (*InternalTableInfoRequest) ProtoMessage() {}
+
+func (x *InternalTableInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_proto_data_source_proto_depIdxs = []int32{
-	7,  // 0: datasource.BatchResponse.status:type_name -> datasource.JobStatus
-	14, // 1: datasource.WrappedWriterDataRequest.request:type_name -> datasource.WriterDataRequest
-	32, // 2: datasource.InternalReadRequest.filterValues:type_name -> datasource.FilterValue
-	33, // 3: datasource.InternalReadRequest.sortRules:type_name -> datasource.SortRule
-	1,  // 4: datasource.InternalReadRequest.filterOperators:type_name -> datasource.FilterOperator
-	41, // 5: datasource.BatchReadRequest.sparkConfig:type_name -> datasource.SparkConfig
-	20, // 6: datasource.BatchReadRequest.external:type_name -> datasource.ExternalDataSource
-	21, // 7: datasource.BatchReadRequest.internal:type_name -> datasource.InternalDataSource
-	23, // 8: datasource.BatchReadRequest.query:type_name -> datasource.QueryOperation
-	24, // 9: datasource.BatchReadRequest.write:type_name -> datasource.WriteOperation
-	25, // 10: datasource.BatchReadRequest.sort:type_name -> datasource.SortOperation
-	26, // 11: datasource.BatchReadRequest.count:type_name -> datasource.CountOperation
-	27, // 12: datasource.BatchReadRequest.groupby_count:type_name -> datasource.GroupByCountOperation
-	28, // 13: datasource.BatchReadRequest.join:type_name -> datasource.JoinOperation
-	29, // 14: datasource.BatchReadRequest.add_hash_column:type_name -> datasource.AddHashColumnOperation
-	30, // 15: datasource.BatchReadRequest.psi_join:type_name -> datasource.PSIJoinOperation
-	32, // 16: datasource.QueryOperation.filterValues:type_name -> datasource.FilterValue
-	1,  // 17: datasource.QueryOperation.filterOperators:type_name -> datasource.FilterOperator
-	33, // 18: datasource.QueryOperation.sortRules:type_name -> datasource.SortRule
-	32, // 19: datasource.CountOperation.filterValues:type_name -> datasource.FilterValue
-	1,  // 20: datasource.CountOperation.filterOperators:type_name -> datasource.FilterOperator
-	32, // 21: datasource.GroupByCountOperation.filterValues:type_name -> datasource.FilterValue
-	1,  // 22: datasource.GroupByCountOperation.filterOperators:type_name -> datasource.FilterOperator
-	6,  // 23: datasource.JoinOperation.joinType:type_name -> datasource.JoinType
-	2,  // 24: datasource.StreamReadRequest.fileType:type_name -> datasource.FileType
-	32, // 25: datasource.StreamReadRequest.filterValues:type_name -> datasource.FilterValue
-	33, // 26: datasource.StreamReadRequest.sortRules:type_name -> datasource.SortRule
-	1,  // 27: datasource.StreamReadRequest.filterOperators:type_name -> datasource.FilterOperator
-	0,  // 28: datasource.SortRule.sortOrder:type_name -> datasource.SortOrder
-	51, // 29: datasource.ConnectionInfo.tlsConfig:type_name -> datasource.DatasourceTlsConfig
-	35, // 30: datasource.ConnectionInfo.columns:type_name -> datasource.ColumnItem
-	5,  // 31: datasource.SparkDBConnInfo.mode:type_name -> datasource.OperationMode
-	6,  // 32: datasource.SparkDBConnInfo.joinType:type_name -> datasource.JoinType
-	8,  // 33: datasource.SparkDBConnInfo.storageType:type_name -> datasource.StorageType
-	33, // 34: datasource.SparkDBConnInfo.sortRules:type_name -> datasource.SortRule
-	35, // 35: datasource.TableInfoResponse.columns:type_name -> datasource.ColumnItem
-	32, // 36: datasource.GroupCountRequest.filterValues:type_name -> datasource.FilterValue
-	1,  // 37: datasource.GroupCountRequest.filterOperators:type_name -> datasource.FilterOperator
-	54, // 38: datasource.ExecuteDorisSQLResponse.rows:type_name -> datasource.DorisSQLRow
-	81, // 39: datasource.DorisSQLRow.columns:type_name -> datasource.DorisSQLRow.ColumnsEntry
-	33, // 40: datasource.ExportCsvFileFromDorisRequest.sortRules:type_name -> datasource.SortRule
-	73, // 41: datasource.ExportCsvFileFromDorisRequest.filterConditions:type_name -> datasource.FilterCondition
-	35, // 42: datasource.ExportDorisDataToMiraDBRequest.columns:type_name -> datasource.ColumnItem
-	67, // 43: datasource.GetRetryCleanupTasksResponse.tasks:type_name -> datasource.CleanupTaskInfo
-	20, // 44: datasource.ReadDataSourceStreamingRequest.external:type_name -> datasource.ExternalDataSource
-	21, // 45: datasource.ReadDataSourceStreamingRequest.internal:type_name -> datasource.InternalDataSource
-	22, // 46: datasource.ReadDataSourceStreamingRequest.doris:type_name -> datasource.DorisDataSource
-	33, // 47: datasource.ReadDataSourceStreamingRequest.sortRules:type_name -> datasource.SortRule
-	73, // 48: datasource.ReadDataSourceStreamingRequest.filterConditions:type_name -> datasource.FilterCondition
-	71, // 49: datasource.ExecuteSqlResponse.dmlResult:type_name -> datasource.DmlResult
-	20, // 50: datasource.ReadRequest.external:type_name -> datasource.ExternalDataSource
-	21, // 51: datasource.ReadRequest.internal:type_name -> datasource.InternalDataSource
-	22, // 52: datasource.ReadRequest.doris:type_name -> datasource.DorisDataSource
-	33, // 53: datasource.ReadRequest.sortRules:type_name -> datasource.SortRule
-	73, // 54: datasource.ReadRequest.filterConditions:type_name -> datasource.FilterCondition
-	78, // 55: datasource.ReadRequest.keys:type_name -> datasource.TableKey
-	32, // 56: datasource.FilterCondition.fieldValue:type_name -> datasource.FilterValue
-	1,  // 57: datasource.FilterCondition.operator:type_name -> datasource.FilterOperator
-	77, // 58: datasource.ImportDataRequest.targets:type_name -> datasource.ImportTarget
-	20, // 59: datasource.ImportTarget.external:type_name -> datasource.ExternalDataSource
-	78, // 60: datasource.ImportTarget.keys:type_name -> datasource.TableKey
-	9,  // 61: datasource.TableKey.keyType:type_name -> datasource.KeyType
-	82, // 62: datasource.TableKey.attributes:type_name -> datasource.TableKey.AttributesEntry
-	80, // 63: datasource.ImportDataResponse.results:type_name -> datasource.ImportResult
-	19, // 64: datasource.DataSourceService.SubmitBatchJob:input_type -> datasource.BatchReadRequest
-	31, // 65: datasource.DataSourceService.ReadStreamingData:input_type -> datasource.StreamReadRequest
-	15, // 66: datasource.DataSourceService.SendArrowData:input_type -> datasource.WrappedWriterDataRequest
-	37, // 67: datasource.DataSourceService.WriteOSSData:input_type -> datasource.OSSWriteRequest
-	37, // 68: datasource.DataSourceService.WriteOSSFileData:input_type -> datasource.OSSWriteRequest
-	38, // 69: datasource.DataSourceService.ReadOSSData:input_type -> datasource.OSSReadRequest
-	16, // 70: datasource.DataSourceService.WriteInternalData:input_type -> datasource.WriterInternalDataRequest
-	18, // 71: datasource.DataSourceService.ReadInternalData:input_type -> datasource.InternalReadRequest
-	17, // 72: datasource.DataSourceService.WriterExternalData:input_type -> datasource.WriterExternalDataRequest
-	42, // 73: datasource.DataSourceService.GetTableInfo:input_type -> datasource.TableInfoRequest
-	44, // 74: datasource.DataSourceService.GetGroupCountInfo:input_type -> datasource.GroupCountRequest
-	12, // 75: datasource.DataSourceService.GetJobStatus:input_type -> datasource.JobStatusRequest
-	46, // 76: datasource.DataSourceService.TruncateTable:input_type -> datasource.TruncateTableRequest
-	48, // 77: datasource.DataSourceService.PushJobResultToExternalDB:input_type -> datasource.PushJobResultRequest
-	52, // 78: datasource.DataSourceService.ExecuteDorisSQL:input_type -> datasource.ExecuteDorisSQLRequest
-	55, // 79: datasource.DataSourceService.CreateExternalAndInternalTableAndImportData:input_type -> datasource.CreateExternalAndInternalTableAndImportDataRequest
-	57, // 80: datasource.DataSourceService.ImportCsvFileToDoris:input_type -> datasource.ImportCsvFileToDorisRequest
-	58, // 81: datasource.DataSourceService.ExportCsvFileFromDoris:input_type -> datasource.ExportCsvFileFromDorisRequest
-	60, // 82: datasource.DataSourceService.ExportDorisDataToMiraDB:input_type -> datasource.ExportDorisDataToMiraDBRequest
-	61, // 83: datasource.DataSourceService.ImportMiraDBDataToDoris:input_type -> datasource.ImportMiraDBDataToDorisRequest
-	63, // 84: datasource.DataSourceService.GetInternalTableInfo:input_type -> datasource.InternalTableInfoRequest
-	68, // 85: datasource.DataSourceService.ReadDataSourceStreaming:input_type -> datasource.ReadDataSourceStreamingRequest
-	64, // 86: datasource.DataSourceService.CleanTmpData:input_type -> datasource.CleanTmpDataRequest
-	65, // 87: datasource.DataSourceService.GetRetryCleanupTask:input_type -> datasource.GetRetryCleanupTasksRequest
-	69, // 88: datasource.DataSourceService.ExecuteSql:input_type -> datasource.ExecuteSqlRequest
-	72, // 89: datasource.DataSourceService.Read:input_type -> datasource.ReadRequest
-	74, // 90: datasource.DataSourceService.Write:input_type -> datasource.WriteRequest
-	76, // 91: datasource.DataSourceService.ImportData:input_type -> datasource.ImportDataRequest
-	11, // 92: datasource.DataSourceService.SubmitBatchJob:output_type -> datasource.BatchResponse
-	13, // 93: datasource.DataSourceService.ReadStreamingData:output_type -> datasource.ArrowResponse
-	10, // 94: datasource.DataSourceService.SendArrowData:output_type -> datasource.Response
-	10, // 95: datasource.DataSourceService.WriteOSSData:output_type -> datasource.Response
-	10, // 96: datasource.DataSourceService.WriteOSSFileData:output_type -> datasource.Response
-	39, // 97: datasource.DataSourceService.ReadOSSData:output_type -> datasource.OSSReadResponse
-	10, // 98: datasource.DataSourceService.WriteInternalData:output_type -> datasource.Response
-	13, // 99: datasource.DataSourceService.ReadInternalData:output_type -> datasource.ArrowResponse
-	10, // 100: datasource.DataSourceService.WriterExternalData:output_type -> datasource.Response
-	43, // 101: datasource.DataSourceService.GetTableInfo:output_type -> datasource.TableInfoResponse
-	45, // 102: datasource.DataSourceService.GetGroupCountInfo:output_type -> datasource.GroupCountResponse
-	11, // 103: datasource.DataSourceService.GetJobStatus:output_type -> datasource.BatchResponse
-	47, // 104: datasource.DataSourceService.TruncateTable:output_type -> datasource.TruncateTableResponse
-	49, // 105: datasource.DataSourceService.PushJobResultToExternalDB:output_type -> datasource.PushJobResultResponse
-	53, // 106: datasource.DataSourceService.ExecuteDorisSQL:output_type -> datasource.ExecuteDorisSQLResponse
-	56, // 107: datasource.DataSourceService.CreateExternalAndInternalTableAndImportData:output_type -> datasource.CreateExternalAndInternalTableAndImportDataResponse
-	10, // 108: datasource.DataSourceService.ImportCsvFileToDoris:output_type -> datasource.Response
-	59, // 109: datasource.DataSourceService.ExportCsvFileFromDoris:output_type -> datasource.ExportCsvFileFromDorisResponse
-	10, // 110: datasource.DataSourceService.ExportDorisDataToMiraDB:output_type -> datasource.Response
-	62, // 111: datasource.DataSourceService.ImportMiraDBDataToDoris:output_type -> datasource.ImportMiraDBDataToDorisResponse
-	43, // 112: datasource.DataSourceService.GetInternalTableInfo:output_type -> datasource.TableInfoResponse
-	13, // 113: datasource.DataSourceService.ReadDataSourceStreaming:output_type -> datasource.ArrowResponse
-	10, // 114: datasource.DataSourceService.CleanTmpData:output_type -> datasource.Response
-	66, // 115: datasource.DataSourceService.GetRetryCleanupTask:output_type -> datasource.GetRetryCleanupTasksResponse
-	70, // 116: datasource.DataSourceService.ExecuteSql:output_type -> datasource.ExecuteSqlResponse
-	13, // 117: datasource.DataSourceService.Read:output_type -> datasource.ArrowResponse
-	75, // 118: datasource.DataSourceService.Write:output_type -> datasource.WriteResponse
-	79, // 119: datasource.DataSourceService.ImportData:output_type -> datasource.ImportDataResponse
-	92, // [92:120] is the sub-list for method output_type
-	64, // [64:92] is the sub-list for method input_type
-	64, // [64:64] is the sub-list for extension type_name
-	64, // [64:64] is the sub-list for extension extendee
-	0,  // [0:64] is the sub-list for field type_name
+
+// Deprecated: Use InternalTableInfoRequest.ProtoReflect.Descriptor instead.
+func (*InternalTableInfoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{72}
 }
 
-func init() { file_proto_data_source_proto_init() }
-func file_proto_data_source_proto_init() {
-	if File_proto_data_source_proto != nil {
-		return
+func (x *InternalTableInfoRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_data_source_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*Response); i {
+	return ""
+}
+
+func (x *InternalTableInfoRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *InternalTableInfoRequest) GetIsExactQuery() bool {
+	if x != nil {
+		return x.IsExactQuery
+	}
+	return false
+}
+
+func (x *InternalTableInfoRequest) GetJobInstanceId() string {
+	if x != nil {
+		return x.JobInstanceId
+	}
+	return ""
+}
+
+type CleanTmpDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobInstanceId string `protobuf:"bytes,1,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
+}
+
+func (x *CleanTmpDataRequest) Reset() {
+	*x = CleanTmpDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CleanTmpDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanTmpDataRequest) ProtoMessage() {}
+
+func (x *CleanTmpDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanTmpDataRequest.ProtoReflect.Descriptor instead.
+func (*CleanTmpDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CleanTmpDataRequest) GetJobInstanceId() string {
+	if x != nil {
+		return x.JobInstanceId
+	}
+	return ""
+}
+
+type GetRetryCleanupTasksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page     int32  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Status   string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // 按状态查询，如 dead_letter；为空返回待执行任务
+}
+
+func (x *GetRetryCleanupTasksRequest) Reset() {
+	*x = GetRetryCleanupTasksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRetryCleanupTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRetryCleanupTasksRequest) ProtoMessage() {}
+
+func (x *GetRetryCleanupTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRetryCleanupTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetRetryCleanupTasksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetRetryCleanupTasksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetRetryCleanupTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetRetryCleanupTasksRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetRetryCleanupTasksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tasks      []*CleanupTaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	TotalCount int32              `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page       int32              `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize   int32              `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *GetRetryCleanupTasksResponse) Reset() {
+	*x = GetRetryCleanupTasksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRetryCleanupTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRetryCleanupTasksResponse) ProtoMessage() {}
+
+func (x *GetRetryCleanupTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRetryCleanupTasksResponse.ProtoReflect.Descriptor instead.
+func (*GetRetryCleanupTasksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetRetryCleanupTasksResponse) GetTasks() []*CleanupTaskInfo {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *GetRetryCleanupTasksResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetRetryCleanupTasksResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetRetryCleanupTasksResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CleanupTaskInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobInstanceId string `protobuf:"bytes,2,opt,name=job_instance_id,json=jobInstanceId,proto3" json:"job_instance_id,omitempty"`
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	RetryCount    int32  `protobuf:"varint,4,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	MaxRetries    int32  `protobuf:"varint,5,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	ErrorMessage  string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	NextRetryAt   int64  `protobuf:"varint,7,opt,name=next_retry_at,json=nextRetryAt,proto3" json:"next_retry_at,omitempty"` // 下次重试时间（Unix毫秒），为0表示未安排
+}
+
+func (x *CleanupTaskInfo) Reset() {
+	*x = CleanupTaskInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CleanupTaskInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanupTaskInfo) ProtoMessage() {}
+
+func (x *CleanupTaskInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanupTaskInfo.ProtoReflect.Descriptor instead.
+func (*CleanupTaskInfo) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CleanupTaskInfo) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CleanupTaskInfo) GetJobInstanceId() string {
+	if x != nil {
+		return x.JobInstanceId
+	}
+	return ""
+}
+
+func (x *CleanupTaskInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CleanupTaskInfo) GetRetryCount() int32 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *CleanupTaskInfo) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *CleanupTaskInfo) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *CleanupTaskInfo) GetNextRetryAt() int64 {
+	if x != nil {
+		return x.NextRetryAt
+	}
+	return 0
+}
+
+type RequeueCleanupTaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobInstanceIds []string `protobuf:"bytes,1,rep,name=job_instance_ids,json=jobInstanceIds,proto3" json:"job_instance_ids,omitempty"` // 为空时重新入队全部死信任务
+}
+
+func (x *RequeueCleanupTaskRequest) Reset() {
+	*x = RequeueCleanupTaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequeueCleanupTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueCleanupTaskRequest) ProtoMessage() {}
+
+func (x *RequeueCleanupTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueCleanupTaskRequest.ProtoReflect.Descriptor instead.
+func (*RequeueCleanupTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *RequeueCleanupTaskRequest) GetJobInstanceIds() []string {
+	if x != nil {
+		return x.JobInstanceIds
+	}
+	return nil
+}
+
+type RequeueCleanupTaskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequeuedCount int64 `protobuf:"varint,1,opt,name=requeued_count,json=requeuedCount,proto3" json:"requeued_count,omitempty"`
+}
+
+func (x *RequeueCleanupTaskResponse) Reset() {
+	*x = RequeueCleanupTaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequeueCleanupTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueCleanupTaskResponse) ProtoMessage() {}
+
+func (x *RequeueCleanupTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueCleanupTaskResponse.ProtoReflect.Descriptor instead.
+func (*RequeueCleanupTaskResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RequeueCleanupTaskResponse) GetRequeuedCount() int64 {
+	if x != nil {
+		return x.RequeuedCount
+	}
+	return 0
+}
+
+type GetOrphanReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GracePeriodSeconds int64    `protobuf:"varint,1,opt,name=grace_period_seconds,json=gracePeriodSeconds,proto3" json:"grace_period_seconds,omitempty"` // 覆盖配置的宽限期，为0使用配置
+	Kinds              []string `protobuf:"bytes,2,rep,name=kinds,proto3" json:"kinds,omitempty"`                                                        // 资源类型过滤：doris_database, doris_resource, doris_external_table, doris_tls_file, minio_export, minio_tls_object
+}
+
+func (x *GetOrphanReportRequest) Reset() {
+	*x = GetOrphanReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrphanReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrphanReportRequest) ProtoMessage() {}
+
+func (x *GetOrphanReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrphanReportRequest.ProtoReflect.Descriptor instead.
+func (*GetOrphanReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetOrphanReportRequest) GetGracePeriodSeconds() int64 {
+	if x != nil {
+		return x.GracePeriodSeconds
+	}
+	return 0
+}
+
+func (x *GetOrphanReportRequest) GetKinds() []string {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type OrphanResourceInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind          string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Location      string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`                                  // 所在 Doris 库或 MinIO 桶
+	JobInstanceId string `protobuf:"bytes,4,opt,name=job_instance_id,json=jobInstanceId,proto3" json:"job_instance_id,omitempty"` // 从资源名解析出的作业实例ID
+	FirstSeenAt   int64  `protobuf:"varint,5,opt,name=first_seen_at,json=firstSeenAt,proto3" json:"first_seen_at,omitempty"`      // 首次发现或创建时间（Unix毫秒）
+	AgeSeconds    int64  `protobuf:"varint,6,opt,name=age_seconds,json=ageSeconds,proto3" json:"age_seconds,omitempty"`
+}
+
+func (x *OrphanResourceInfo) Reset() {
+	*x = OrphanResourceInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrphanResourceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrphanResourceInfo) ProtoMessage() {}
+
+func (x *OrphanResourceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrphanResourceInfo.ProtoReflect.Descriptor instead.
+func (*OrphanResourceInfo) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *OrphanResourceInfo) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *OrphanResourceInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrphanResourceInfo) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *OrphanResourceInfo) GetJobInstanceId() string {
+	if x != nil {
+		return x.JobInstanceId
+	}
+	return ""
+}
+
+func (x *OrphanResourceInfo) GetFirstSeenAt() int64 {
+	if x != nil {
+		return x.FirstSeenAt
+	}
+	return 0
+}
+
+func (x *OrphanResourceInfo) GetAgeSeconds() int64 {
+	if x != nil {
+		return x.AgeSeconds
+	}
+	return 0
+}
+
+type GetOrphanReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resources          []*OrphanResourceInfo `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	GracePeriodSeconds int64                 `protobuf:"varint,2,opt,name=grace_period_seconds,json=gracePeriodSeconds,proto3" json:"grace_period_seconds,omitempty"`
+	DryRun             bool                  `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                // 后台巡检是否只记录不删除
+	GeneratedAt        int64                 `protobuf:"varint,4,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"` // Unix毫秒
+}
+
+func (x *GetOrphanReportResponse) Reset() {
+	*x = GetOrphanReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrphanReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrphanReportResponse) ProtoMessage() {}
+
+func (x *GetOrphanReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrphanReportResponse.ProtoReflect.Descriptor instead.
+func (*GetOrphanReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetOrphanReportResponse) GetResources() []*OrphanResourceInfo {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *GetOrphanReportResponse) GetGracePeriodSeconds() int64 {
+	if x != nil {
+		return x.GracePeriodSeconds
+	}
+	return 0
+}
+
+func (x *GetOrphanReportResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *GetOrphanReportResponse) GetGeneratedAt() int64 {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return 0
+}
+
+type UpdateTableTTLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DbName     string `protobuf:"bytes,1,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`     // 为空表示整个库
+	TtlSeconds int64  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // 从当前时间起的存活时长
+	ExpiresAt  int64  `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`    // 过期时间（Unix毫秒），ttl_seconds 为0时使用
+}
+
+func (x *UpdateTableTTLRequest) Reset() {
+	*x = UpdateTableTTLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTableTTLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTableTTLRequest) ProtoMessage() {}
+
+func (x *UpdateTableTTLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTableTTLRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTableTTLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UpdateTableTTLRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *UpdateTableTTLRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *UpdateTableTTLRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *UpdateTableTTLRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type UpdateTableTTLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpiresAt int64 `protobuf:"varint,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 生效的过期时间（Unix毫秒）
+}
+
+func (x *UpdateTableTTLResponse) Reset() {
+	*x = UpdateTableTTLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTableTTLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTableTTLResponse) ProtoMessage() {}
+
+func (x *UpdateTableTTLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTableTTLResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTableTTLResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateTableTTLResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ReadDataSourceStreamingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobInstanceId string `protobuf:"bytes,1,opt,name=jobInstanceId,proto3" json:"jobInstanceId,omitempty"`
+	// Types that are assignable to DataSource:
+	//
+	//	*ReadDataSourceStreamingRequest_External
+	//	*ReadDataSourceStreamingRequest_Internal
+	//	*ReadDataSourceStreamingRequest_Doris
+	DataSource       isReadDataSourceStreamingRequest_DataSource `protobuf_oneof:"data_source"`
+	Columns          []string                                    `protobuf:"bytes,5,rep,name=columns,proto3" json:"columns,omitempty"`
+	SortRules        []*SortRule                                 `protobuf:"bytes,6,rep,name=sortRules,proto3" json:"sortRules,omitempty"`               // 排序规则
+	FilterConditions []*FilterCondition                          `protobuf:"bytes,7,rep,name=filterConditions,proto3" json:"filterConditions,omitempty"` // 过滤条件
+	FileFormat       string                                      `protobuf:"bytes,8,opt,name=fileFormat,proto3" json:"fileFormat,omitempty"`             // 中间导出文件格式：parquet（默认）、orc、csv
+	Limit            int64                                       `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`                      // 最多读取的行数，0 表示不限制
+	Offset           int64                                       `protobuf:"varint,10,opt,name=offset,proto3" json:"offset,omitempty"`                   // 排序后跳过的行数
+	Sample           *ReadSample                                 `protobuf:"bytes,11,opt,name=sample,proto3" json:"sample,omitempty"`                    // 采样，在过滤之后、排序分页之前进行
+}
+
+func (x *ReadDataSourceStreamingRequest) Reset() {
+	*x = ReadDataSourceStreamingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDataSourceStreamingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDataSourceStreamingRequest) ProtoMessage() {}
+
+func (x *ReadDataSourceStreamingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDataSourceStreamingRequest.ProtoReflect.Descriptor instead.
+func (*ReadDataSourceStreamingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ReadDataSourceStreamingRequest) GetJobInstanceId() string {
+	if x != nil {
+		return x.JobInstanceId
+	}
+	return ""
+}
+
+func (m *ReadDataSourceStreamingRequest) GetDataSource() isReadDataSourceStreamingRequest_DataSource {
+	if m != nil {
+		return m.DataSource
+	}
+	return nil
+}
+
+func (x *ReadDataSourceStreamingRequest) GetExternal() *ExternalDataSource {
+	if x, ok := x.GetDataSource().(*ReadDataSourceStreamingRequest_External); ok {
+		return x.External
+	}
+	return nil
+}
+
+func (x *ReadDataSourceStreamingRequest) GetInternal() *InternalDataSource {
+	if x, ok := x.GetDataSource().(*ReadDataSourceStreamingRequest_Internal); ok {
+		return x.Internal
+	}
+	return nil
+}
+
+func (x *ReadDataSourceStreamingRequest) GetDoris() *DorisDataSource {
+	if x, ok := x.GetDataSource().(*ReadDataSourceStreamingRequest_Doris); ok {
+		return x.Doris
+	}
+	return nil
+}
+
+func (x *ReadDataSourceStreamingRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ReadDataSourceStreamingRequest) GetSortRules() []*SortRule {
+	if x != nil {
+		return x.SortRules
+	}
+	return nil
+}
+
+func (x *ReadDataSourceStreamingRequest) GetFilterConditions() []*FilterCondition {
+	if x != nil {
+		return x.FilterConditions
+	}
+	return nil
+}
+
+func (x *ReadDataSourceStreamingRequest) GetFileFormat() string {
+	if x != nil {
+		return x.FileFormat
+	}
+	return ""
+}
+
+func (x *ReadDataSourceStreamingRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReadDataSourceStreamingRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ReadDataSourceStreamingRequest) GetSample() *ReadSample {
+	if x != nil {
+		return x.Sample
+	}
+	return nil
+}
+
+type isReadDataSourceStreamingRequest_DataSource interface {
+	isReadDataSourceStreamingRequest_DataSource()
+}
+
+type ReadDataSourceStreamingRequest_External struct {
+	External *ExternalDataSource `protobuf:"bytes,2,opt,name=external,proto3,oneof"` // 外部数据源
+}
+
+type ReadDataSourceStreamingRequest_Internal struct {
+	Internal *InternalDataSource `protobuf:"bytes,3,opt,name=internal,proto3,oneof"` // 内部数据源
+}
+
+type ReadDataSourceStreamingRequest_Doris struct {
+	Doris *DorisDataSource `protobuf:"bytes,4,opt,name=doris,proto3,oneof"` // doris数据源
+}
+
+func (*ReadDataSourceStreamingRequest_External) isReadDataSourceStreamingRequest_DataSource() {}
+
+func (*ReadDataSourceStreamingRequest_Internal) isReadDataSourceStreamingRequest_DataSource() {}
+
+func (*ReadDataSourceStreamingRequest_Doris) isReadDataSourceStreamingRequest_DataSource() {}
+
+type ExecuteSqlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sql             string `protobuf:"bytes,1,opt,name=sql,proto3" json:"sql,omitempty"`                         // 要执行的SQL语句，必填
+	DbName          string `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`                   // 数据库名（传入jobInstanceId），必填
+	TargetTableName string `protobuf:"bytes,3,opt,name=targetTableName,proto3" json:"targetTableName,omitempty"` // 查询结果写入的目标表名
+	DryRun          bool   `protobuf:"varint,4,opt,name=dryRun,proto3" json:"dryRun,omitempty"`                  // 只返回执行计划和 EXPLAIN，不执行
+}
+
+func (x *ExecuteSqlRequest) Reset() {
+	*x = ExecuteSqlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecuteSqlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteSqlRequest) ProtoMessage() {}
+
+func (x *ExecuteSqlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteSqlRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteSqlRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ExecuteSqlRequest) GetSql() string {
+	if x != nil {
+		return x.Sql
+	}
+	return ""
+}
+
+func (x *ExecuteSqlRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *ExecuteSqlRequest) GetTargetTableName() string {
+	if x != nil {
+		return x.TargetTableName
+	}
+	return ""
+}
+
+func (x *ExecuteSqlRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ExecuteSqlResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 通用字段
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 执行是否成功
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 执行结果消息
+	// SELECT查询结果（流式数据）
+	//
+	// Types that are assignable to Result:
+	//
+	//	*ExecuteSqlResponse_ArrowBatch
+	//	*ExecuteSqlResponse_DmlResult
+	Result isExecuteSqlResponse_Result `protobuf_oneof:"result"`
+	Plan   *ExecutionPlan              `protobuf:"bytes,6,opt,name=plan,proto3" json:"plan,omitempty"` // 试运行时返回的执行计划
+}
+
+func (x *ExecuteSqlResponse) Reset() {
+	*x = ExecuteSqlResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecuteSqlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteSqlResponse) ProtoMessage() {}
+
+func (x *ExecuteSqlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteSqlResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteSqlResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ExecuteSqlResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ExecuteSqlResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (m *ExecuteSqlResponse) GetResult() isExecuteSqlResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *ExecuteSqlResponse) GetArrowBatch() []byte {
+	if x, ok := x.GetResult().(*ExecuteSqlResponse_ArrowBatch); ok {
+		return x.ArrowBatch
+	}
+	return nil
+}
+
+func (x *ExecuteSqlResponse) GetDmlResult() *DmlResult {
+	if x, ok := x.GetResult().(*ExecuteSqlResponse_DmlResult); ok {
+		return x.DmlResult
+	}
+	return nil
+}
+
+func (x *ExecuteSqlResponse) GetPlan() *ExecutionPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+type isExecuteSqlResponse_Result interface {
+	isExecuteSqlResponse_Result()
+}
+
+type ExecuteSqlResponse_ArrowBatch struct {
+	ArrowBatch []byte `protobuf:"bytes,4,opt,name=arrow_batch,json=arrowBatch,proto3,oneof"` // Arrow格式的数据批次（SELECT时使用）
+}
+
+type ExecuteSqlResponse_DmlResult struct {
+	DmlResult *DmlResult `protobuf:"bytes,5,opt,name=dmlResult,proto3,oneof"` // DML操作结果（INSERT/UPDATE/DELETE时使用）
+}
+
+func (*ExecuteSqlResponse_ArrowBatch) isExecuteSqlResponse_Result() {}
+
+func (*ExecuteSqlResponse_DmlResult) isExecuteSqlResponse_Result() {}
+
+type DmlResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AffectedRows  int64  `protobuf:"varint,1,opt,name=affectedRows,proto3" json:"affectedRows,omitempty"`  // 受影响的行数
+	ExecutionTime string `protobuf:"bytes,2,opt,name=executionTime,proto3" json:"executionTime,omitempty"` // 执行耗时
+}
+
+func (x *DmlResult) Reset() {
+	*x = DmlResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DmlResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DmlResult) ProtoMessage() {}
+
+func (x *DmlResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DmlResult.ProtoReflect.Descriptor instead.
+func (*DmlResult) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *DmlResult) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+func (x *DmlResult) GetExecutionTime() string {
+	if x != nil {
+		return x.ExecutionTime
+	}
+	return ""
+}
+
+type ReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to DataSource:
+	//
+	//	*ReadRequest_External
+	//	*ReadRequest_Internal
+	//	*ReadRequest_Doris
+	DataSource       isReadRequest_DataSource `protobuf_oneof:"data_source"`
+	Columns          []string                 `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+	SortRules        []*SortRule              `protobuf:"bytes,5,rep,name=sortRules,proto3" json:"sortRules,omitempty"`               // 排序规则
+	FilterConditions []*FilterCondition       `protobuf:"bytes,6,rep,name=filterConditions,proto3" json:"filterConditions,omitempty"` // 过滤条件
+	Keys             []*TableKey              `protobuf:"bytes,7,rep,name=keys,proto3" json:"keys,omitempty"`                         // 表键信息
+	FileFormat       string                   `protobuf:"bytes,8,opt,name=fileFormat,proto3" json:"fileFormat,omitempty"`             // 中间导出文件格式：parquet（默认）、orc、csv
+	DryRun           bool                     `protobuf:"varint,9,opt,name=dryRun,proto3" json:"dryRun,omitempty"`                    // 只返回执行计划，不导入、不导出
+	Limit            int64                    `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`                     // 最多读取的行数，0 表示不限制；外部和内部数据源在导入 Doris 时按窗口读取，窗口由 Doris 执行
+	Offset           int64                    `protobuf:"varint,11,opt,name=offset,proto3" json:"offset,omitempty"`                   // 排序后跳过的行数
+	Sample           *ReadSample              `protobuf:"bytes,12,opt,name=sample,proto3" json:"sample,omitempty"`                    // 采样，在过滤之后、排序分页之前进行
+}
+
+func (x *ReadRequest) Reset() {
+	*x = ReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadRequest) ProtoMessage() {}
+
+func (x *ReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadRequest.ProtoReflect.Descriptor instead.
+func (*ReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{88}
+}
+
+func (m *ReadRequest) GetDataSource() isReadRequest_DataSource {
+	if m != nil {
+		return m.DataSource
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetExternal() *ExternalDataSource {
+	if x, ok := x.GetDataSource().(*ReadRequest_External); ok {
+		return x.External
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetInternal() *InternalDataSource {
+	if x, ok := x.GetDataSource().(*ReadRequest_Internal); ok {
+		return x.Internal
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetDoris() *DorisDataSource {
+	if x, ok := x.GetDataSource().(*ReadRequest_Doris); ok {
+		return x.Doris
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetSortRules() []*SortRule {
+	if x != nil {
+		return x.SortRules
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetFilterConditions() []*FilterCondition {
+	if x != nil {
+		return x.FilterConditions
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetKeys() []*TableKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetFileFormat() string {
+	if x != nil {
+		return x.FileFormat
+	}
+	return ""
+}
+
+func (x *ReadRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ReadRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReadRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ReadRequest) GetSample() *ReadSample {
+	if x != nil {
+		return x.Sample
+	}
+	return nil
+}
+
+type isReadRequest_DataSource interface {
+	isReadRequest_DataSource()
+}
+
+type ReadRequest_External struct {
+	External *ExternalDataSource `protobuf:"bytes,1,opt,name=external,proto3,oneof"` // 外部数据源
+}
+
+type ReadRequest_Internal struct {
+	Internal *InternalDataSource `protobuf:"bytes,2,opt,name=internal,proto3,oneof"` // 内部数据源
+}
+
+type ReadRequest_Doris struct {
+	Doris *DorisDataSource `protobuf:"bytes,3,opt,name=doris,proto3,oneof"` // doris数据源
+}
+
+func (*ReadRequest_External) isReadRequest_DataSource() {}
+
+func (*ReadRequest_Internal) isReadRequest_DataSource() {}
+
+func (*ReadRequest_Doris) isReadRequest_DataSource() {}
+
+// FilterCondition 过滤条件结构体
+type FilterCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FieldName  string         `protobuf:"bytes,1,opt,name=fieldName,proto3" json:"fieldName,omitempty"`                               // 过滤字段名
+	FieldValue *FilterValue   `protobuf:"bytes,2,opt,name=fieldValue,proto3" json:"fieldValue,omitempty"`                             // 过滤字段值
+	Operator   FilterOperator `protobuf:"varint,3,opt,name=operator,proto3,enum=datasource.FilterOperator" json:"operator,omitempty"` // 过滤操作符
+}
+
+func (x *FilterCondition) Reset() {
+	*x = FilterCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilterCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterCondition) ProtoMessage() {}
+
+func (x *FilterCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterCondition.ProtoReflect.Descriptor instead.
+func (*FilterCondition) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *FilterCondition) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
+}
+
+func (x *FilterCondition) GetFieldValue() *FilterValue {
+	if x != nil {
+		return x.FieldValue
+	}
+	return nil
+}
+
+func (x *FilterCondition) GetOperator() FilterOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return FilterOperator_EQUAL
+}
+
+type WriteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArrowBatch    []byte  `protobuf:"bytes,1,opt,name=arrow_batch,json=arrowBatch,proto3" json:"arrow_batch,omitempty"`
+	DbName        string  `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	TableName     string  `protobuf:"bytes,3,opt,name=tableName,proto3" json:"tableName,omitempty"`           // 写入的目标表名
+	RequestId     string  `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`           // 写入请求标识，相同标识的重试按 Stream Load label 去重；为空时每次写入生成新标识
+	MaxErrorRatio float64 `protobuf:"fixed64,5,opt,name=maxErrorRatio,proto3" json:"maxErrorRatio,omitempty"` // 允许被拒绝行占总行数的最大比例，与 maxErrorRows 均为 0 时任一行出错即失败，仅首个请求生效
+	MaxErrorRows  int64   `protobuf:"varint,6,opt,name=maxErrorRows,proto3" json:"maxErrorRows,omitempty"`    // 允许被拒绝的最大行数，0 表示不按行数限制，仅首个请求生效
+}
+
+func (x *WriteRequest) Reset() {
+	*x = WriteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteRequest) ProtoMessage() {}
+
+func (x *WriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteRequest.ProtoReflect.Descriptor instead.
+func (*WriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *WriteRequest) GetArrowBatch() []byte {
+	if x != nil {
+		return x.ArrowBatch
+	}
+	return nil
+}
+
+func (x *WriteRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *WriteRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *WriteRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *WriteRequest) GetMaxErrorRatio() float64 {
+	if x != nil {
+		return x.MaxErrorRatio
+	}
+	return 0
+}
+
+func (x *WriteRequest) GetMaxErrorRows() int64 {
+	if x != nil {
+		return x.MaxErrorRows
+	}
+	return 0
+}
+
+type WriteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success        bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message        string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	LoadedRows     int64  `protobuf:"varint,3,opt,name=loadedRows,proto3" json:"loadedRows,omitempty"`         // Doris 导入成功的行数
+	FilteredRows   int64  `protobuf:"varint,4,opt,name=filteredRows,proto3" json:"filteredRows,omitempty"`     // 数据质量不合格被过滤的行数
+	UnselectedRows int64  `protobuf:"varint,5,opt,name=unselectedRows,proto3" json:"unselectedRows,omitempty"` // 被 where 条件过滤的行数
+	Label          string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`                    // Stream Load label 前缀，多批写入的 label 为 <label>_<序号>
+	RejectedRows   int64  `protobuf:"varint,7,opt,name=rejectedRows,proto3" json:"rejectedRows,omitempty"`     // 被拒绝的行数
+	RejectsObject  string `protobuf:"bytes,8,opt,name=rejectsObject,proto3" json:"rejectsObject,omitempty"`    // 被拒绝行及原因所在的对象（BATCH_DATA_BUCKET_NAME 桶），无拒绝行时为空
+}
+
+func (x *WriteResponse) Reset() {
+	*x = WriteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteResponse) ProtoMessage() {}
+
+func (x *WriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteResponse.ProtoReflect.Descriptor instead.
+func (*WriteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *WriteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *WriteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WriteResponse) GetLoadedRows() int64 {
+	if x != nil {
+		return x.LoadedRows
+	}
+	return 0
+}
+
+func (x *WriteResponse) GetFilteredRows() int64 {
+	if x != nil {
+		return x.FilteredRows
+	}
+	return 0
+}
+
+func (x *WriteResponse) GetUnselectedRows() int64 {
+	if x != nil {
+		return x.UnselectedRows
+	}
+	return 0
+}
+
+func (x *WriteResponse) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *WriteResponse) GetRejectedRows() int64 {
+	if x != nil {
+		return x.RejectedRows
+	}
+	return 0
+}
+
+func (x *WriteResponse) GetRejectsObject() string {
+	if x != nil {
+		return x.RejectsObject
+	}
+	return ""
+}
+
+type ImportDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Targets        []*ImportTarget `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`                // 导入目标列表
+	Atomic         bool            `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`                 // 先导入临时表，全部成功后再替换目标表，任一失败时目标表保持不变
+	MaxConcurrency int32           `protobuf:"varint,3,opt,name=maxConcurrency,proto3" json:"maxConcurrency,omitempty"` // 并发导入的目标数，0 使用配置
+	DryRun         bool            `protobuf:"varint,4,opt,name=dryRun,proto3" json:"dryRun,omitempty"`                 // 只返回每个目标的执行计划，不建表、不导入
+}
+
+func (x *ImportDataRequest) Reset() {
+	*x = ImportDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDataRequest) ProtoMessage() {}
+
+func (x *ImportDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDataRequest.ProtoReflect.Descriptor instead.
+func (*ImportDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ImportDataRequest) GetTargets() []*ImportTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *ImportDataRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *ImportDataRequest) GetMaxConcurrency() int32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *ImportDataRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// 导入进度
+type ImportDataProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetIndex     int32               `protobuf:"varint,1,opt,name=targetIndex,proto3" json:"targetIndex,omitempty"` // 目标在请求中的下标
+	TargetDatabase  string              `protobuf:"bytes,2,opt,name=targetDatabase,proto3" json:"targetDatabase,omitempty"`
+	TargetTableName string              `protobuf:"bytes,3,opt,name=targetTableName,proto3" json:"targetTableName,omitempty"`
+	Stage           ImportStage         `protobuf:"varint,4,opt,name=stage,proto3,enum=datasource.ImportStage" json:"stage,omitempty"`
+	Result          *ImportResult       `protobuf:"bytes,5,opt,name=result,proto3" json:"result,omitempty"`   // 目标结束时的结果
+	Summary         *ImportDataResponse `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"` // 仅最后一条消息设置
+}
+
+func (x *ImportDataProgress) Reset() {
+	*x = ImportDataProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportDataProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDataProgress) ProtoMessage() {}
+
+func (x *ImportDataProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDataProgress.ProtoReflect.Descriptor instead.
+func (*ImportDataProgress) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ImportDataProgress) GetTargetIndex() int32 {
+	if x != nil {
+		return x.TargetIndex
+	}
+	return 0
+}
+
+func (x *ImportDataProgress) GetTargetDatabase() string {
+	if x != nil {
+		return x.TargetDatabase
+	}
+	return ""
+}
+
+func (x *ImportDataProgress) GetTargetTableName() string {
+	if x != nil {
+		return x.TargetTableName
+	}
+	return ""
+}
+
+func (x *ImportDataProgress) GetStage() ImportStage {
+	if x != nil {
+		return x.Stage
+	}
+	return ImportStage_IMPORT_STAGE_UNKNOWN
+}
+
+func (x *ImportDataProgress) GetResult() *ImportResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ImportDataProgress) GetSummary() *ImportDataResponse {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+// 数据导入目标配置
+type ImportTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	External        *ExternalDataSource `protobuf:"bytes,1,opt,name=external,proto3" json:"external,omitempty"`               // 外部数据源
+	TargetTableName string              `protobuf:"bytes,2,opt,name=targetTableName,proto3" json:"targetTableName,omitempty"` // 目标表名
+	DbName          string              `protobuf:"bytes,3,opt,name=dbName,proto3" json:"dbName,omitempty"`                   // 数据库名
+	Columns         []string            `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`                 // 导入字段
+	Keys            []*TableKey         `protobuf:"bytes,5,rep,name=keys,proto3" json:"keys,omitempty"`                       // 表键信息
+	MaxErrorRatio   float64             `protobuf:"fixed64,6,opt,name=maxErrorRatio,proto3" json:"maxErrorRatio,omitempty"`   // 允许被拒绝行占总行数的最大比例，与 maxErrorRows 均为 0 时任一行出错即失败
+	MaxErrorRows    int64               `protobuf:"varint,7,opt,name=maxErrorRows,proto3" json:"maxErrorRows,omitempty"`      // 允许被拒绝的最大行数，0 表示不按行数限制
+	Window          *ReadWindow         `protobuf:"bytes,8,opt,name=window,proto3" json:"window,omitempty"`                   // 只导入源表的一部分，为空时导入全部
+}
+
+func (x *ImportTarget) Reset() {
+	*x = ImportTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTarget) ProtoMessage() {}
+
+func (x *ImportTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTarget.ProtoReflect.Descriptor instead.
+func (*ImportTarget) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ImportTarget) GetExternal() *ExternalDataSource {
+	if x != nil {
+		return x.External
+	}
+	return nil
+}
+
+func (x *ImportTarget) GetTargetTableName() string {
+	if x != nil {
+		return x.TargetTableName
+	}
+	return ""
+}
+
+func (x *ImportTarget) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *ImportTarget) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ImportTarget) GetKeys() []*TableKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *ImportTarget) GetMaxErrorRatio() float64 {
+	if x != nil {
+		return x.MaxErrorRatio
+	}
+	return 0
+}
+
+func (x *ImportTarget) GetMaxErrorRows() int64 {
+	if x != nil {
+		return x.MaxErrorRows
+	}
+	return 0
+}
+
+func (x *ImportTarget) GetWindow() *ReadWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+// 表键信息
+type TableKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyName     string            `protobuf:"bytes,1,opt,name=keyName,proto3" json:"keyName,omitempty"`                                                                                               // 键名称（可选）
+	KeyType     KeyType           `protobuf:"varint,2,opt,name=keyType,proto3,enum=datasource.KeyType" json:"keyType,omitempty"`                                                                      // 键类型
+	ColumnNames []string          `protobuf:"bytes,3,rep,name=columnNames,proto3" json:"columnNames,omitempty"`                                                                                       // 键包含的列名（支持组合键）
+	Attributes  map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 扩展属性（可选，可扩展）
+}
+
+func (x *TableKey) Reset() {
+	*x = TableKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TableKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableKey) ProtoMessage() {}
+
+func (x *TableKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableKey.ProtoReflect.Descriptor instead.
+func (*TableKey) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *TableKey) GetKeyName() string {
+	if x != nil {
+		return x.KeyName
+	}
+	return ""
+}
+
+func (x *TableKey) GetKeyType() KeyType {
+	if x != nil {
+		return x.KeyType
+	}
+	return KeyType_KEY_TYPE_UNKNOWN
+}
+
+func (x *TableKey) GetColumnNames() []string {
+	if x != nil {
+		return x.ColumnNames
+	}
+	return nil
+}
+
+func (x *TableKey) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type ImportDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Results []*ImportResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"` // 多个导入结果
+}
+
+func (x *ImportDataResponse) Reset() {
+	*x = ImportDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDataResponse) ProtoMessage() {}
+
+func (x *ImportDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDataResponse.ProtoReflect.Descriptor instead.
+func (*ImportDataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ImportDataResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ImportDataResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportDataResponse) GetResults() []*ImportResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// 单个导入结果
+type ImportResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceTableName string         `protobuf:"bytes,1,opt,name=sourceTableName,proto3" json:"sourceTableName,omitempty"` // 源表名
+	TargetTableName string         `protobuf:"bytes,2,opt,name=targetTableName,proto3" json:"targetTableName,omitempty"` // 目标表名（写入的表）
+	SourceDatabase  string         `protobuf:"bytes,3,opt,name=sourceDatabase,proto3" json:"sourceDatabase,omitempty"`   // 源数据库名
+	TargetDatabase  string         `protobuf:"bytes,4,opt,name=targetDatabase,proto3" json:"targetDatabase,omitempty"`   // 目标数据库名
+	AffectedRows    int64          `protobuf:"varint,5,opt,name=affectedRows,proto3" json:"affectedRows,omitempty"`      // 影响的行数
+	Success         bool           `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`                // 该目标是否导入成功
+	ErrorMessage    string         `protobuf:"bytes,7,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`       // 如果失败，错误信息
+	RejectedRows    int64          `protobuf:"varint,8,opt,name=rejectedRows,proto3" json:"rejectedRows,omitempty"`      // 被拒绝的行数
+	RejectsObject   string         `protobuf:"bytes,9,opt,name=rejectsObject,proto3" json:"rejectsObject,omitempty"`     // 被拒绝行及原因所在的对象（BATCH_DATA_BUCKET_NAME 桶），无拒绝行时为空
+	Plan            *ExecutionPlan `protobuf:"bytes,10,opt,name=plan,proto3" json:"plan,omitempty"`                      // 试运行时该目标的执行计划
+}
+
+func (x *ImportResult) Reset() {
+	*x = ImportResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResult) ProtoMessage() {}
+
+func (x *ImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResult.ProtoReflect.Descriptor instead.
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ImportResult) GetSourceTableName() string {
+	if x != nil {
+		return x.SourceTableName
+	}
+	return ""
+}
+
+func (x *ImportResult) GetTargetTableName() string {
+	if x != nil {
+		return x.TargetTableName
+	}
+	return ""
+}
+
+func (x *ImportResult) GetSourceDatabase() string {
+	if x != nil {
+		return x.SourceDatabase
+	}
+	return ""
+}
+
+func (x *ImportResult) GetTargetDatabase() string {
+	if x != nil {
+		return x.TargetDatabase
+	}
+	return ""
+}
+
+func (x *ImportResult) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+func (x *ImportResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ImportResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ImportResult) GetRejectedRows() int64 {
+	if x != nil {
+		return x.RejectedRows
+	}
+	return 0
+}
+
+func (x *ImportResult) GetRejectsObject() string {
+	if x != nil {
+		return x.RejectsObject
+	}
+	return ""
+}
+
+func (x *ImportResult) GetPlan() *ExecutionPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+type QueryAuditLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime int64  `protobuf:"varint,1,opt,name=startTime,proto3" json:"startTime,omitempty"` // 起始时间（Unix毫秒），为0不限制
+	EndTime   int64  `protobuf:"varint,2,opt,name=endTime,proto3" json:"endTime,omitempty"`     // 结束时间（Unix毫秒），为0不限制
+	Caller    string `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`        // 调用方标识
+	Rpc       string `protobuf:"bytes,4,opt,name=rpc,proto3" json:"rpc,omitempty"`              // RPC 名称，如 Read、TruncateTable
+	Asset     string `protobuf:"bytes,5,opt,name=asset,proto3" json:"asset,omitempty"`          // 资产名或表名
+	Page      int32  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize  int32  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *QueryAuditLogRequest) Reset() {
+	*x = QueryAuditLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogRequest) ProtoMessage() {}
+
+func (x *QueryAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *QueryAuditLogRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *QueryAuditLogRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *QueryAuditLogRequest) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetRpc() string {
+	if x != nil {
+		return x.Rpc
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *QueryAuditLogRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type AuditLogRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp      int64    `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // 调用时间（Unix毫秒）
+	Caller         string   `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`        // 调用方标识
+	Peer           string   `protobuf:"bytes,4,opt,name=peer,proto3" json:"peer,omitempty"`            // 调用方地址
+	Rpc            string   `protobuf:"bytes,5,opt,name=rpc,proto3" json:"rpc,omitempty"`
+	Asset          string   `protobuf:"bytes,6,opt,name=asset,proto3" json:"asset,omitempty"` // 资产名或表名
+	Columns        []string `protobuf:"bytes,7,rep,name=columns,proto3" json:"columns,omitempty"`
+	Filters        string   `protobuf:"bytes,8,opt,name=filters,proto3" json:"filters,omitempty"`             // 过滤条件
+	Statement      string   `protobuf:"bytes,9,opt,name=statement,proto3" json:"statement,omitempty"`         // 执行的SQL（截断）
+	RowsReturned   int64    `protobuf:"varint,10,opt,name=rowsReturned,proto3" json:"rowsReturned,omitempty"` // 返回行数
+	Success        bool     `protobuf:"varint,11,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage   string   `protobuf:"bytes,12,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	DurationMs     int64    `protobuf:"varint,13,opt,name=durationMs,proto3" json:"durationMs,omitempty"`
+	CallerVerified bool     `protobuf:"varint,14,opt,name=callerVerified,proto3" json:"callerVerified,omitempty"` // 调用方标识来自已验证的客户端证书；为 false 时是客户端 metadata 自称的标识
+}
+
+func (x *AuditLogRecord) Reset() {
+	*x = AuditLogRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogRecord) ProtoMessage() {}
+
+func (x *AuditLogRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogRecord.ProtoReflect.Descriptor instead.
+func (*AuditLogRecord) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *AuditLogRecord) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditLogRecord) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *AuditLogRecord) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *AuditLogRecord) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *AuditLogRecord) GetRpc() string {
+	if x != nil {
+		return x.Rpc
+	}
+	return ""
+}
+
+func (x *AuditLogRecord) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *AuditLogRecord) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *AuditLogRecord) GetFilters() string {
+	if x != nil {
+		return x.Filters
+	}
+	return ""
+}
+
+func (x *AuditLogRecord) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *AuditLogRecord) GetRowsReturned() int64 {
+	if x != nil {
+		return x.RowsReturned
+	}
+	return 0
+}
+
+func (x *AuditLogRecord) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AuditLogRecord) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *AuditLogRecord) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *AuditLogRecord) GetCallerVerified() bool {
+	if x != nil {
+		return x.CallerVerified
+	}
+	return false
+}
+
+type QueryAuditLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records    []*AuditLogRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	TotalCount int64             `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page       int32             `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize   int32             `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *QueryAuditLogResponse) Reset() {
+	*x = QueryAuditLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogResponse) ProtoMessage() {}
+
+func (x *QueryAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *QueryAuditLogResponse) GetRecords() []*AuditLogRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *QueryAuditLogResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *QueryAuditLogResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *QueryAuditLogResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ProfileTableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	// 外部数据资产，通过数据源下推统计
+	AssetName   string `protobuf:"bytes,2,opt,name=assetName,proto3" json:"assetName,omitempty"`
+	ChainInfoId string `protobuf:"bytes,3,opt,name=chainInfoId,proto3" json:"chainInfoId,omitempty"`
+	Alias       string `protobuf:"bytes,4,opt,name=alias,proto3" json:"alias,omitempty"`
+	// Doris 内部表，assetName 为空时使用
+	DbName              string   `protobuf:"bytes,5,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	TableName           string   `protobuf:"bytes,6,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	Columns             []string `protobuf:"bytes,7,rep,name=columns,proto3" json:"columns,omitempty"`                          // 需要统计的列，为空统计全部列
+	SamplePercent       float64  `protobuf:"fixed64,8,opt,name=samplePercent,proto3" json:"samplePercent,omitempty"`            // 采样比例(0,100)，为0或100全量统计
+	ApproximateDistinct bool     `protobuf:"varint,9,opt,name=approximateDistinct,proto3" json:"approximateDistinct,omitempty"` // 使用 HyperLogLog 估算去重数，数据源不支持时退化为精确统计
+	TopK                int32    `protobuf:"varint,10,opt,name=topK,proto3" json:"topK,omitempty"`                              // 高频值个数，为0使用默认值
+	AssetVersion        string   `protobuf:"bytes,11,opt,name=assetVersion,proto3" json:"assetVersion,omitempty"`               // 资产版本，为空时根据表结构、行数和大小生成
+	ForceRefresh        bool     `protobuf:"varint,12,opt,name=forceRefresh,proto3" json:"forceRefresh,omitempty"`              // 忽略缓存重新统计
+}
+
+func (x *ProfileTableRequest) Reset() {
+	*x = ProfileTableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProfileTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileTableRequest) ProtoMessage() {}
+
+func (x *ProfileTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileTableRequest.ProtoReflect.Descriptor instead.
+func (*ProfileTableRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ProfileTableRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ProfileTableRequest) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
+}
+
+func (x *ProfileTableRequest) GetChainInfoId() string {
+	if x != nil {
+		return x.ChainInfoId
+	}
+	return ""
+}
+
+func (x *ProfileTableRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *ProfileTableRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *ProfileTableRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *ProfileTableRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ProfileTableRequest) GetSamplePercent() float64 {
+	if x != nil {
+		return x.SamplePercent
+	}
+	return 0
+}
+
+func (x *ProfileTableRequest) GetApproximateDistinct() bool {
+	if x != nil {
+		return x.ApproximateDistinct
+	}
+	return false
+}
+
+func (x *ProfileTableRequest) GetTopK() int32 {
+	if x != nil {
+		return x.TopK
+	}
+	return 0
+}
+
+func (x *ProfileTableRequest) GetAssetVersion() string {
+	if x != nil {
+		return x.AssetVersion
+	}
+	return ""
+}
+
+func (x *ProfileTableRequest) GetForceRefresh() bool {
+	if x != nil {
+		return x.ForceRefresh
+	}
+	return false
+}
+
+type ValueFrequency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ValueFrequency) Reset() {
+	*x = ValueFrequency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValueFrequency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValueFrequency) ProtoMessage() {}
+
+func (x *ValueFrequency) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValueFrequency.ProtoReflect.Descriptor instead.
+func (*ValueFrequency) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ValueFrequency) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ValueFrequency) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type LengthBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LowerBound int64 `protobuf:"varint,1,opt,name=lowerBound,proto3" json:"lowerBound,omitempty"` // 长度下界（含）
+	UpperBound int64 `protobuf:"varint,2,opt,name=upperBound,proto3" json:"upperBound,omitempty"` // 长度上界（含）
+	Count      int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *LengthBucket) Reset() {
+	*x = LengthBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LengthBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LengthBucket) ProtoMessage() {}
+
+func (x *LengthBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LengthBucket.ProtoReflect.Descriptor instead.
+func (*LengthBucket) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *LengthBucket) GetLowerBound() int64 {
+	if x != nil {
+		return x.LowerBound
+	}
+	return 0
+}
+
+func (x *LengthBucket) GetUpperBound() int64 {
+	if x != nil {
+		return x.UpperBound
+	}
+	return 0
+}
+
+func (x *LengthBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type StringLengthStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinLength int64           `protobuf:"varint,1,opt,name=minLength,proto3" json:"minLength,omitempty"`
+	MaxLength int64           `protobuf:"varint,2,opt,name=maxLength,proto3" json:"maxLength,omitempty"`
+	AvgLength float64         `protobuf:"fixed64,3,opt,name=avgLength,proto3" json:"avgLength,omitempty"`
+	Buckets   []*LengthBucket `protobuf:"bytes,4,rep,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (x *StringLengthStats) Reset() {
+	*x = StringLengthStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringLengthStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringLengthStats) ProtoMessage() {}
+
+func (x *StringLengthStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringLengthStats.ProtoReflect.Descriptor instead.
+func (*StringLengthStats) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *StringLengthStats) GetMinLength() int64 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *StringLengthStats) GetMaxLength() int64 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *StringLengthStats) GetAvgLength() float64 {
+	if x != nil {
+		return x.AvgLength
+	}
+	return 0
+}
+
+func (x *StringLengthStats) GetBuckets() []*LengthBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type ColumnProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DataType            string             `protobuf:"bytes,2,opt,name=dataType,proto3" json:"dataType,omitempty"`
+	NullCount           int64              `protobuf:"varint,3,opt,name=nullCount,proto3" json:"nullCount,omitempty"`
+	NullRatio           float64            `protobuf:"fixed64,4,opt,name=nullRatio,proto3" json:"nullRatio,omitempty"`
+	DistinctCount       int64              `protobuf:"varint,5,opt,name=distinctCount,proto3" json:"distinctCount,omitempty"`
+	DistinctApproximate bool               `protobuf:"varint,6,opt,name=distinctApproximate,proto3" json:"distinctApproximate,omitempty"` // 去重数是否为 HyperLogLog 估算值
+	MinValue            string             `protobuf:"bytes,7,opt,name=minValue,proto3" json:"minValue,omitempty"`
+	MaxValue            string             `protobuf:"bytes,8,opt,name=maxValue,proto3" json:"maxValue,omitempty"`
+	TopValues           []*ValueFrequency  `protobuf:"bytes,9,rep,name=topValues,proto3" json:"topValues,omitempty"`
+	LengthStats         *StringLengthStats `protobuf:"bytes,10,opt,name=lengthStats,proto3" json:"lengthStats,omitempty"` // 仅字符串列
+}
+
+func (x *ColumnProfile) Reset() {
+	*x = ColumnProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColumnProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColumnProfile) ProtoMessage() {}
+
+func (x *ColumnProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColumnProfile.ProtoReflect.Descriptor instead.
+func (*ColumnProfile) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ColumnProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ColumnProfile) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *ColumnProfile) GetNullCount() int64 {
+	if x != nil {
+		return x.NullCount
+	}
+	return 0
+}
+
+func (x *ColumnProfile) GetNullRatio() float64 {
+	if x != nil {
+		return x.NullRatio
+	}
+	return 0
+}
+
+func (x *ColumnProfile) GetDistinctCount() int64 {
+	if x != nil {
+		return x.DistinctCount
+	}
+	return 0
+}
+
+func (x *ColumnProfile) GetDistinctApproximate() bool {
+	if x != nil {
+		return x.DistinctApproximate
+	}
+	return false
+}
+
+func (x *ColumnProfile) GetMinValue() string {
+	if x != nil {
+		return x.MinValue
+	}
+	return ""
+}
+
+func (x *ColumnProfile) GetMaxValue() string {
+	if x != nil {
+		return x.MaxValue
+	}
+	return ""
+}
+
+func (x *ColumnProfile) GetTopValues() []*ValueFrequency {
+	if x != nil {
+		return x.TopValues
+	}
+	return nil
+}
+
+func (x *ColumnProfile) GetLengthStats() *StringLengthStats {
+	if x != nil {
+		return x.LengthStats
+	}
+	return nil
+}
+
+type ProfileTableResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableName     string           `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	RowCount      int64            `protobuf:"varint,2,opt,name=rowCount,proto3" json:"rowCount,omitempty"` // 参与统计的行数，采样时为样本行数
+	SamplePercent float64          `protobuf:"fixed64,3,opt,name=samplePercent,proto3" json:"samplePercent,omitempty"`
+	Columns       []*ColumnProfile `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+	AssetVersion  string           `protobuf:"bytes,5,opt,name=assetVersion,proto3" json:"assetVersion,omitempty"`
+	ProfiledAt    int64            `protobuf:"varint,6,opt,name=profiledAt,proto3" json:"profiledAt,omitempty"` // 统计时间（Unix毫秒）
+	Cached        bool             `protobuf:"varint,7,opt,name=cached,proto3" json:"cached,omitempty"`         // 是否命中缓存
+}
+
+func (x *ProfileTableResponse) Reset() {
+	*x = ProfileTableResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_data_source_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProfileTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileTableResponse) ProtoMessage() {}
+
+func (x *ProfileTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_source_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileTableResponse.ProtoReflect.Descriptor instead.
+func (*ProfileTableResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_source_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ProfileTableResponse) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *ProfileTableResponse) GetRowCount() int64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *ProfileTableResponse) GetSamplePercent() float64 {
+	if x != nil {
+		return x.SamplePercent
+	}
+	return 0
+}
+
+func (x *ProfileTableResponse) GetColumns() []*ColumnProfile {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ProfileTableResponse) GetAssetVersion() string {
+	if x != nil {
+		return x.AssetVersion
+	}
+	return ""
+}
+
+func (x *ProfileTableResponse) GetProfiledAt() int64 {
+	if x != nil {
+		return x.ProfiledAt
+	}
+	return 0
+}
+
+func (x *ProfileTableResponse) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+var File_proto_data_source_proto protoreflect.FileDescriptor
+
+var file_proto_data_source_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x22, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x01, 0x0a, 0x08, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x22, 0xe1, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x08,
+	0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x9c, 0x01, 0x0a, 0x0b, 0x4a, 0x6f, 0x62,
+	0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x2d,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x22, 0x28, 0x0a, 0x10, 0x4a, 0x6f, 0x62, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6a,
+	0x6f, 0x62, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49,
+	0x64, 0x22, 0x45, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x7e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61,
+	0x69, 0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x61, 0x69, 0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb0, 0x01, 0x0a, 0x1a, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xb1, 0x03,
+	0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12,
+	0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x62,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x64, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x73, 0x70, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x0b, 0x73, 0x70, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x0a,
+	0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x22, 0xac, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a,
+	0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x5f, 0x0a, 0x0d, 0x41, 0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x2d, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x04, 0x70, 0x6c, 0x61,
+	0x6e, 0x22, 0x70, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70,
+	0x6c, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x70, 0x6c,
+	0x61, 0x69, 0x6e, 0x22, 0x34, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x0d, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x2a, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x6e,
+	0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x52,
+	0x6f, 0x77, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x74, 0x0a, 0x11, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x22, 0x71, 0x0a,
+	0x18, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x22, 0x98, 0x01, 0x0a, 0x19, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f,
+	0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0xce, 0x01, 0x0a, 0x19,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x72,
+	0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0xc4, 0x03, 0x0a,
+	0x13, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09,
+	0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x22, 0x4e, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x65, 0x65, 0x64, 0x22, 0xe7, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x47, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x73,
+	0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x2e, 0x0a,
+	0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x22, 0xd4, 0x05,
+	0x0a, 0x10, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x39, 0x0a, 0x0b, 0x73, 0x70, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b,
+	0x73, 0x70, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x08, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52,
+	0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x01, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12,
+	0x2f, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74,
+	0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x62, 0x79, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01,
+	0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x62, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f,
+	0x0a, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x12,
+	0x4c, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x48, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x0d,
+	0x61, 0x64, 0x64, 0x48, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x39, 0x0a,
+	0x08, 0x70, 0x73, 0x69, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x53, 0x49,
+	0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52,
+	0x07, 0x70, 0x73, 0x69, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x12, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
+	0x22, 0x4a, 0x0a, 0x12, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x47, 0x0a, 0x0f,
+	0x44, 0x6f, 0x72, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xe9, 0x02, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x62, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a,
+	0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x22, 0x52, 0x0a, 0x0e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x77, 0x0a, 0x0d, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xd3,
+	0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x44,
+	0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x22, 0x80, 0x02, 0x0a, 0x15, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b,
+	0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x0d, 0x4a, 0x6f, 0x69, 0x6e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6a, 0x6f, 0x69,
+	0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x6a,
+	0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
+	0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a,
+	0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x22, 0xd2, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x48, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x65, 0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6a, 0x6f,
+	0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x68, 0x61, 0x73, 0x68, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x68, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x22, 0xfe, 0x01, 0x0a, 0x10, 0x50, 0x53, 0x49, 0x4a, 0x6f,
+	0x69, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69,
+	0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6a, 0x6f, 0x69,
+	0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x0a,
+	0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0xca, 0x03, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73,
+	0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x22, 0x89, 0x02, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x08, 0x52, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x22, 0x5d, 0x0a, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x73, 0x6f,
+	0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22,
+	0xa7, 0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x64, 0x62, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x3d, 0x0a, 0x09, 0x74, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x6c, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x74, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x0a, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6c,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6e, 0x75, 0x6c,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x6e, 0x0a, 0x0a, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x67, 0x0a, 0x0f, 0x4f, 0x53, 0x53,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x22, 0x50, 0x0a, 0x0e, 0x4f, 0x53, 0x53, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x0f, 0x4f, 0x53, 0x53, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x22, 0xab, 0x05, 0x0a, 0x0f, 0x53, 0x70, 0x61, 0x72, 0x6b, 0x44, 0x42, 0x43, 0x6f, 0x6e,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x62, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6a, 0x6f,
+	0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65,
+	0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x65, 0x6d, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x39, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x73,
+	0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22,
+	0xf9, 0x03, 0x0a, 0x0b, 0x53, 0x70, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x3a, 0x0a, 0x18, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x18, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x1d, 0x64,
+	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x69, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x1d, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
+	0x73, 0x12, 0x44, 0x0a, 0x1d, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x1d, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69,
+	0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x6f, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x4d, 0x42, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x43,
+	0x6f, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
+	0x42, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x72, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x43, 0x6f,
+	0x72, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69,
+	0x73, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c,
+	0x65, 0x6c, 0x69, 0x73, 0x6d, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x75,
+	0x6d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x70,
+	0x61, 0x72, 0x6b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x88, 0x01, 0x0a, 0x10,
+	0x53, 0x70, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x26, 0x0a, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66,
+	0x66, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x42,
+	0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x10, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73, 0x45,
+	0x78, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x69, 0x73, 0x45, 0x78, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x22, 0xd7, 0x02, 0x0a, 0x11, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x36,
+	0x34, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73, 0x45, 0x78, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x45, 0x78, 0x61, 0x63, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x73, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x73, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c,
+	0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x64, 0x41, 0x74, 0x22, 0x94, 0x02,
+	0x0a, 0x11, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x44,
+	0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x22, 0x54, 0x0a, 0x12, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x34, 0x0a, 0x14, 0x54, 0x72,
+	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x4b, 0x0a, 0x15, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xf6, 0x01,
+	0x0a, 0x14, 0x50, 0x75, 0x73, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a,
+	0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x61, 0x74, 0x61,
+	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x61, 0x74, 0x61, 0x49, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x04, 0x73, 0x69,
+	0x6e, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x6e, 0x6b,
+	0x52, 0x04, 0x73, 0x69, 0x6e, 0x6b, 0x22, 0x4b, 0x0a, 0x15, 0x50, 0x75, 0x73, 0x68, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0xce, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x69,
+	0x6e, 0x6b, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x24, 0x0a, 0x0d,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52,
+	0x65, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x22, 0x60, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a,
+	0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x22, 0xfa, 0x02, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12,
+	0x36, 0x0a, 0x08, 0x73, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x73,
+	0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+	0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x5d, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x17, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x42, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c,
+	0x0a, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x44, 0x42, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x44, 0x42, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x22, 0xbd, 0x02, 0x0a, 0x13, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x54, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x54, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x43, 0x65,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74,
+	0x12, 0x26, 0x0a, 0x0e, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x16, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44,
+	0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x72, 0x67, 0x73, 0x22, 0x7a, 0x0a, 0x17, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44,
+	0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x44,
+	0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x22, 0x89, 0x01, 0x0a, 0x0b, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52, 0x6f, 0x77,
+	0x12, 0x3e, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x44,
+	0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52, 0x6f, 0x77, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb0, 0x01, 0x0a,
+	0x32, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41,
+	0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41,
+	0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22,
+	0x53, 0x0a, 0x33, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0xa1, 0x02, 0x0a, 0x1b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65,
+	0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x24,
+	0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x6f, 0x77, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x6f, 0x77, 0x73, 0x22, 0xb6, 0x04, 0x0a, 0x1d, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f,
+	0x72, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f,
+	0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x69,
+	0x6e, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6c, 0x69, 0x6e, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72,
+	0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x66, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x22, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x30, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x22, 0x80, 0x02, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x66,
+	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e,
+	0x12, 0x32, 0x0a, 0x14, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14,
+	0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x77,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x6f, 0x77,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
+	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
+	0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x55, 0x72, 0x6c, 0x22, 0x80, 0x02, 0x0a, 0x1e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72, 0x69, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x2e,
+	0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x0e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x55, 0x72, 0x6c, 0x22, 0xc2, 0x01, 0x0a, 0x1e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x4d, 0x69,
+	0x72, 0x61, 0x44, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x6c, 0x0a,
+	0x1e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44, 0x61, 0x74,
+	0x61, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x24, 0x0a, 0x0d, 0x6d, 0x69, 0x72, 0x61, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x69, 0x72, 0x61, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f,
+	0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x1f, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44, 0x61, 0x74, 0x61, 0x54,
+	0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x64, 0x6f, 0x72, 0x69, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x6f, 0x72, 0x69, 0x73, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x18, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73, 0x45,
+	0x78, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x69, 0x73, 0x45, 0x78, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x24, 0x0a,
+	0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x13, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x54, 0x6d, 0x70, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f,
+	0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x22, 0x66, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61,
+	0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x74, 0x61, 0x73,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73,
+	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xec,
+	0x01, 0x0a, 0x0f, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x74, 0x22, 0x45, 0x0a,
+	0x19, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6a, 0x6f,
+	0x62, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x73, 0x22, 0x43, 0x0a, 0x1a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x43,
+	0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x60, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x12, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x12,
+	0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x0d, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e,
+	0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x70, 0x68, 0x61,
+	0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x67, 0x72, 0x61,
+	0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x15,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x54, 0x4c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x37, 0x0a,
+	0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x54, 0x4c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x9b, 0x04, 0x0a, 0x1e, 0x52, 0x65, 0x61, 0x64, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x62,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x3c, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x3c, 0x0a,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48,
+	0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x05, 0x64,
+	0x6f, 0x72, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x64, 0x6f, 0x72, 0x69, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f,
+	0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x47,
+	0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c,
+	0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x22, 0x7f, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53,
+	0x71, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xdb, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x21, 0x0a, 0x0b, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x35, 0x0a, 0x09, 0x64, 0x6d, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x44, 0x6d, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52,
+	0x09, 0x64, 0x6d, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x70, 0x6c,
+	0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6c, 0x61, 0x6e, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x55, 0x0a, 0x09, 0x44, 0x6d, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x22, 0x0a, 0x0c, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x52, 0x6f, 0x77, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xa4, 0x04, 0x0a, 0x0b, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x05, 0x64, 0x6f, 0x72, 0x69, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x64, 0x6f, 0x72, 0x69, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09,
+	0x73, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x10, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x66, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x22, 0xa0, 0x01, 0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x36, 0x0a, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x22, 0xcd, 0x01, 0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x6f,
+	0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x61,
+	0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6f,
+	0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x6f, 0x77, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x6f, 0x77, 0x73, 0x22, 0x8f, 0x02, 0x0a, 0x0d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f,
+	0x61, 0x64, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x26,
+	0x0a, 0x0e, 0x75, 0x6e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x75, 0x6e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x0c,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x43,
+	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xa3, 0x02, 0x0a, 0x12, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0xca,
+	0x02, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+	0x3a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74,
+	0x69, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d,
+	0x61, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0xfa, 0x01, 0x0a, 0x08,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x2e, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7c, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x8d, 0x03, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0xbf, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x72, 0x70, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x70,
+	0x63, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x8e, 0x03, 0x0a, 0x0e, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c,
+	0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x70, 0x63, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x72, 0x70, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x9f, 0x01, 0x0a, 0x15, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x8d, 0x03, 0x0a, 0x13,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a,
+	0x13, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x63, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x6f, 0x70, 0x4b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74,
+	0x6f, 0x70, 0x4b, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x3c, 0x0a, 0x0e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x64, 0x0a, 0x0c, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x70,
+	0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75,
+	0x70, 0x70, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xa1, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x67, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x61, 0x76, 0x67, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12,
+	0x32, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x22, 0x86, 0x03, 0x0a, 0x0d, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74,
+	0x61, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74,
+	0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x52, 0x61, 0x74, 0x69, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x52, 0x61, 0x74, 0x69,
+	0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x63, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x52, 0x09, 0x74, 0x6f, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x0b, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x87, 0x02, 0x0a,
+	0x14, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x24, 0x0a, 0x0d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x2a, 0x1e, 0x0a, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x53, 0x43, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x2a, 0xa2, 0x01, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x51, 0x55,
+	0x41, 0x4c, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x51, 0x55, 0x41,
+	0x4c, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x52, 0x45, 0x41, 0x54, 0x45, 0x52, 0x5f, 0x54,
+	0x48, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x48,
+	0x41, 0x4e, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x52, 0x45, 0x41, 0x54, 0x45, 0x52, 0x5f,
+	0x54, 0x48, 0x41, 0x4e, 0x5f, 0x4f, 0x52, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x04, 0x12,
+	0x16, 0x0a, 0x12, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f, 0x4f, 0x52, 0x5f,
+	0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x49, 0x4b, 0x45, 0x5f,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e,
+	0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x07, 0x2a, 0x9a, 0x01, 0x0a, 0x08,
+	0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x49, 0x4c, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x11, 0x0a, 0x0d, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x53, 0x56,
+	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x51, 0x55, 0x45, 0x54, 0x10, 0x03, 0x12, 0x12, 0x0a,
+	0x0e, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x56, 0x52, 0x4f, 0x10,
+	0x04, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41,
+	0x52, 0x52, 0x4f, 0x57, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
+	0x5f, 0x41, 0x52, 0x52, 0x4f, 0x57, 0x10, 0x06, 0x2a, 0x92, 0x02, 0x0a, 0x0e, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x44,
+	0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54,
+	0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x59,
+	0x53, 0x51, 0x4c, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x47, 0x42, 0x41,
+	0x53, 0x45, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x49, 0x56, 0x45, 0x10, 0x03, 0x12,
+	0x19, 0x0a, 0x15, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x54, 0x49, 0x44, 0x42, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41,
+	0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54,
+	0x44, 0x53, 0x51, 0x4c, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x41, 0x53, 0x54, 0x42,
+	0x41, 0x53, 0x45, 0x10, 0x06, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x42, 0x41, 0x53, 0x45, 0x10,
+	0x07, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x4f, 0x52, 0x49, 0x53, 0x10, 0x08, 0x2a, 0xbd, 0x01,
+	0x0a, 0x10, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x53, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x53, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01,
+	0x12, 0x29, 0x0a, 0x25, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f,
+	0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x54,
+	0x41, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f,
+	0x44, 0x5f, 0x50, 0x47, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b,
+	0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x4d, 0x45, 0x54, 0x48,
+	0x4f, 0x44, 0x5f, 0x53, 0x41, 0x4d, 0x50, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x2a, 0x7c, 0x0a,
+	0x0e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x17, 0x0a, 0x13, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x49, 0x4e, 0x4b, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x44, 0x42, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x55,
+	0x4c, 0x54, 0x5f, 0x53, 0x49, 0x4e, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4b, 0x41, 0x46,
+	0x4b, 0x41, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x53,
+	0x49, 0x4e, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x33, 0x10, 0x02, 0x12, 0x1c, 0x0a,
+	0x18, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x49, 0x4e, 0x4b, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x10, 0x03, 0x2a, 0xaa, 0x01, 0x0a, 0x14,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x1e, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x44,
+	0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x52, 0x45, 0x53, 0x55,
+	0x4c, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x24, 0x0a, 0x20, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45,
+	0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45,
+	0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f,
+	0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xaf, 0x01, 0x0a, 0x0b, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4d, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x47, 0x45, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x17,
+	0x0a, 0x13, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x47, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4d, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x47, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18,
+	0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x4f, 0x4c,
+	0x4c, 0x45, 0x44, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x05, 0x2a, 0x22, 0x0a, 0x0a, 0x44, 0x62,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x49, 0x52, 0x41,
+	0x5f, 0x45, 0x4e, 0x47, 0x49, 0x4e, 0x45, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x10, 0x00, 0x2a, 0x8e,
+	0x02, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x51,
+	0x55, 0x45, 0x52, 0x59, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x02,
+	0x12, 0x17, 0x0a, 0x13, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e,
+	0x54, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x42, 0x59, 0x5f, 0x43, 0x4f,
+	0x55, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x06, 0x12, 0x22,
+	0x0a, 0x1e, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x41, 0x44, 0x44, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x43, 0x4f, 0x4c, 0x55, 0x4d, 0x4e,
+	0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x53, 0x49, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x08, 0x2a,
+	0x74, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x4a,
+	0x4f, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4a, 0x4f, 0x49, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4a,
+	0x4f, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x49, 0x47, 0x48, 0x54, 0x10, 0x03,
+	0x12, 0x13, 0x0a, 0x0f, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x55,
+	0x54, 0x45, 0x52, 0x10, 0x04, 0x2a, 0x9e, 0x01, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4a,
+	0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x4a,
+	0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45,
+	0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14,
+	0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45,
+	0x4c, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x54, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x13, 0x0a, 0x0f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x44, 0x42, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4f, 0x10, 0x02, 0x2a, 0x91, 0x01, 0x0a,
+	0x07, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x45, 0x59, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x14,
+	0x0a, 0x10, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x41,
+	0x52, 0x59, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x46, 0x4f, 0x52, 0x45, 0x49, 0x47, 0x4e, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4b, 0x45,
+	0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0x03, 0x12,
+	0x12, 0x0a, 0x0e, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45,
+	0x58, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x41, 0x55, 0x54, 0x4f, 0x5f, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x05,
+	0x32, 0x96, 0x1b, 0x0a, 0x11, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x12, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4f, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x41, 0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x12, 0x4d, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x72, 0x6f, 0x77, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
+	0x01, 0x12, 0x43, 0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x53, 0x53, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4f,
+	0x53, 0x53, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x47, 0x0a, 0x10, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f,
+	0x53, 0x53, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4f, 0x53, 0x53, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12,
+	0x48, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x53, 0x53, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4f, 0x53, 0x53, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4f, 0x53, 0x53, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x52, 0x0a, 0x11, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x50, 0x0a,
+	0x10, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x41, 0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12,
+	0x51, 0x0a, 0x12, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x12, 0x21,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x73, 0x12,
+	0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x4c, 0x6f, 0x67, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x30, 0x01, 0x12, 0x66, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x26, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x54,
+	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61,
+	0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x6e,
+	0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x60, 0x0a, 0x19, 0x50, 0x75, 0x73, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x54, 0x6f, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x42, 0x12, 0x20,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x75, 0x73, 0x68,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x75,
+	0x73, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x12, 0x22, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44,
+	0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x53, 0x51, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0xae, 0x01, 0x0a, 0x2b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x3e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41,
+	0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41,
+	0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41,
+	0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41,
+	0x6e, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73,
+	0x76, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x12, 0x27, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x16, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x44, 0x6f, 0x72, 0x69, 0x73, 0x12, 0x29, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x44,
+	0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x17,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x54,
+	0x6f, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x12, 0x2a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x6f, 0x72, 0x69, 0x73,
+	0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x17, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x44,
+	0x6f, 0x72, 0x69, 0x73, 0x12, 0x2a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44, 0x61,
+	0x74, 0x61, 0x54, 0x6f, 0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x72, 0x61, 0x44, 0x42, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f,
+	0x44, 0x6f, 0x72, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x17, 0x52, 0x65,
+	0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41,
+	0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x6d,
+	0x0a, 0x0c, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x54, 0x6d, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61,
+	0x6e, 0x54, 0x6d, 0x70, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a,
+	0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x54, 0x6d, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x97, 0x01,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75,
+	0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x27, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x6e,
+	0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
+	0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61,
+	0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x63, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x25, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x54, 0x4c, 0x12, 0x21, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x54, 0x54, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x54, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x71, 0x6c, 0x12,
+	0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01,
+	0x12, 0x3c, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41,
+	0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x3e,
+	0x0a, 0x05, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x4b,
+	0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x30, 0x01,
+	0x12, 0x54, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f,
+	0x67, 0x12, 0x20, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2f, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_proto_data_source_proto_rawDescOnce sync.Once
+	file_proto_data_source_proto_rawDescData = file_proto_data_source_proto_rawDesc
+)
+
+func file_proto_data_source_proto_rawDescGZIP() []byte {
+	file_proto_data_source_proto_rawDescOnce.Do(func() {
+		file_proto_data_source_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_data_source_proto_rawDescData)
+	})
+	return file_proto_data_source_proto_rawDescData
+}
+
+var file_proto_data_source_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
+var file_proto_data_source_proto_msgTypes = make([]protoimpl.MessageInfo, 109)
+var file_proto_data_source_proto_goTypes = []any{
+	(SortOrder)(0),                          // 0: datasource.SortOrder
+	(FilterOperator)(0),                     // 1: datasource.FilterOperator
+	(FileType)(0),                           // 2: datasource.FileType
+	(DataSourceType)(0),                     // 3: datasource.DataSourceType
+	(TableStatsMethod)(0),                   // 4: datasource.TableStatsMethod
+	(ResultSinkType)(0),                     // 5: datasource.ResultSinkType
+	(ResultDeliveryStatus)(0),               // 6: datasource.ResultDeliveryStatus
+	(ImportStage)(0),                        // 7: datasource.ImportStage
+	(DbConstant)(0),                         // 8: datasource.DbConstant
+	(OperationMode)(0),                      // 9: datasource.OperationMode
+	(JoinType)(0),                           // 10: datasource.JoinType
+	(JobStatus)(0),                          // 11: datasource.JobStatus
+	(StorageType)(0),                        // 12: datasource.StorageType
+	(KeyType)(0),                            // 13: datasource.KeyType
+	(*Response)(nil),                        // 14: datasource.Response
+	(*BatchResponse)(nil),                   // 15: datasource.BatchResponse
+	(*JobCallback)(nil),                     // 16: datasource.JobCallback
+	(*JobStatusRequest)(nil),                // 17: datasource.JobStatusRequest
+	(*CancelBatchJobRequest)(nil),           // 18: datasource.CancelBatchJobRequest
+	(*GetBatchJobLogsRequest)(nil),          // 19: datasource.GetBatchJobLogsRequest
+	(*BatchJobLogChunk)(nil),                // 20: datasource.BatchJobLogChunk
+	(*ListBatchJobHistoryRequest)(nil),      // 21: datasource.ListBatchJobHistoryRequest
+	(*BatchJobHistoryRecord)(nil),           // 22: datasource.BatchJobHistoryRecord
+	(*ListBatchJobHistoryResponse)(nil),     // 23: datasource.ListBatchJobHistoryResponse
+	(*ArrowResponse)(nil),                   // 24: datasource.ArrowResponse
+	(*PlanStep)(nil),                        // 25: datasource.PlanStep
+	(*PlanObject)(nil),                      // 26: datasource.PlanObject
+	(*ExecutionPlan)(nil),                   // 27: datasource.ExecutionPlan
+	(*WriterDataRequest)(nil),               // 28: datasource.WriterDataRequest
+	(*WrappedWriterDataRequest)(nil),        // 29: datasource.WrappedWriterDataRequest
+	(*WriterInternalDataRequest)(nil),       // 30: datasource.WriterInternalDataRequest
+	(*WriterExternalDataRequest)(nil),       // 31: datasource.WriterExternalDataRequest
+	(*InternalReadRequest)(nil),             // 32: datasource.InternalReadRequest
+	(*ReadSample)(nil),                      // 33: datasource.ReadSample
+	(*ReadWindow)(nil),                      // 34: datasource.ReadWindow
+	(*BatchReadRequest)(nil),                // 35: datasource.BatchReadRequest
+	(*ExternalDataSource)(nil),              // 36: datasource.ExternalDataSource
+	(*InternalDataSource)(nil),              // 37: datasource.InternalDataSource
+	(*DorisDataSource)(nil),                 // 38: datasource.DorisDataSource
+	(*QueryOperation)(nil),                  // 39: datasource.QueryOperation
+	(*WriteOperation)(nil),                  // 40: datasource.WriteOperation
+	(*SortOperation)(nil),                   // 41: datasource.SortOperation
+	(*CountOperation)(nil),                  // 42: datasource.CountOperation
+	(*GroupByCountOperation)(nil),           // 43: datasource.GroupByCountOperation
+	(*JoinOperation)(nil),                   // 44: datasource.JoinOperation
+	(*AddHashColumnOperation)(nil),          // 45: datasource.AddHashColumnOperation
+	(*PSIJoinOperation)(nil),                // 46: datasource.PSIJoinOperation
+	(*StreamReadRequest)(nil),               // 47: datasource.StreamReadRequest
+	(*FilterValue)(nil),                     // 48: datasource.FilterValue
+	(*SortRule)(nil),                        // 49: datasource.SortRule
+	(*ConnectionInfo)(nil),                  // 50: datasource.ConnectionInfo
+	(*ColumnItem)(nil),                      // 51: datasource.ColumnItem
+	(*ServerInfo)(nil),                      // 52: datasource.ServerInfo
+	(*OSSWriteRequest)(nil),                 // 53: datasource.OSSWriteRequest
+	(*OSSReadRequest)(nil),                  // 54: datasource.OSSReadRequest
+	(*OSSReadResponse)(nil),                 // 55: datasource.OSSReadResponse
+	(*SparkDBConnInfo)(nil),                 // 56: datasource.SparkDBConnInfo
+	(*SparkConfig)(nil),                     // 57: datasource.SparkConfig
+	(*SparkRetryPolicy)(nil),                // 58: datasource.SparkRetryPolicy
+	(*TableInfoRequest)(nil),                // 59: datasource.TableInfoRequest
+	(*TableInfoResponse)(nil),               // 60: datasource.TableInfoResponse
+	(*GroupCountRequest)(nil),               // 61: datasource.GroupCountRequest
+	(*GroupCountResponse)(nil),              // 62: datasource.GroupCountResponse
+	(*TruncateTableRequest)(nil),            // 63: datasource.TruncateTableRequest
+	(*TruncateTableResponse)(nil),           // 64: datasource.TruncateTableResponse
+	(*PushJobResultRequest)(nil),            // 65: datasource.PushJobResultRequest
+	(*PushJobResultResponse)(nil),           // 66: datasource.PushJobResultResponse
+	(*ResultSink)(nil),                      // 67: datasource.ResultSink
+	(*GetResultDeliveryStatusRequest)(nil),  // 68: datasource.GetResultDeliveryStatusRequest
+	(*ResultDeliveryRecord)(nil),            // 69: datasource.ResultDeliveryRecord
+	(*GetResultDeliveryStatusResponse)(nil), // 70: datasource.GetResultDeliveryStatusResponse
+	(*JobResultExternalDBInfo)(nil),         // 71: datasource.JobResultExternalDBInfo
+	(*DatasourceTlsConfig)(nil),             // 72: datasource.DatasourceTlsConfig
+	(*ExecuteDorisSQLRequest)(nil),          // 73: datasource.ExecuteDorisSQLRequest
+	(*ExecuteDorisSQLResponse)(nil),         // 74: datasource.ExecuteDorisSQLResponse
+	(*DorisSQLRow)(nil),                     // 75: datasource.DorisSQLRow
+	(*CreateExternalAndInternalTableAndImportDataRequest)(nil),  // 76: datasource.CreateExternalAndInternalTableAndImportDataRequest
+	(*CreateExternalAndInternalTableAndImportDataResponse)(nil), // 77: datasource.CreateExternalAndInternalTableAndImportDataResponse
+	(*ImportCsvFileToDorisRequest)(nil),                         // 78: datasource.ImportCsvFileToDorisRequest
+	(*ExportCsvFileFromDorisRequest)(nil),                       // 79: datasource.ExportCsvFileFromDorisRequest
+	(*ExportTarget)(nil),                                        // 80: datasource.ExportTarget
+	(*ExportedFile)(nil),                                        // 81: datasource.ExportedFile
+	(*ExportCsvFileFromDorisResponse)(nil),                      // 82: datasource.ExportCsvFileFromDorisResponse
+	(*ExportDorisDataToMiraDBRequest)(nil),                      // 83: datasource.ExportDorisDataToMiraDBRequest
+	(*ImportMiraDBDataToDorisRequest)(nil),                      // 84: datasource.ImportMiraDBDataToDorisRequest
+	(*ImportMiraDBDataToDorisResponse)(nil),                     // 85: datasource.ImportMiraDBDataToDorisResponse
+	(*InternalTableInfoRequest)(nil),                            // 86: datasource.InternalTableInfoRequest
+	(*CleanTmpDataRequest)(nil),                                 // 87: datasource.CleanTmpDataRequest
+	(*GetRetryCleanupTasksRequest)(nil),                         // 88: datasource.GetRetryCleanupTasksRequest
+	(*GetRetryCleanupTasksResponse)(nil),                        // 89: datasource.GetRetryCleanupTasksResponse
+	(*CleanupTaskInfo)(nil),                                     // 90: datasource.CleanupTaskInfo
+	(*RequeueCleanupTaskRequest)(nil),                           // 91: datasource.RequeueCleanupTaskRequest
+	(*RequeueCleanupTaskResponse)(nil),                          // 92: datasource.RequeueCleanupTaskResponse
+	(*GetOrphanReportRequest)(nil),                              // 93: datasource.GetOrphanReportRequest
+	(*OrphanResourceInfo)(nil),                                  // 94: datasource.OrphanResourceInfo
+	(*GetOrphanReportResponse)(nil),                             // 95: datasource.GetOrphanReportResponse
+	(*UpdateTableTTLRequest)(nil),                               // 96: datasource.UpdateTableTTLRequest
+	(*UpdateTableTTLResponse)(nil),                              // 97: datasource.UpdateTableTTLResponse
+	(*ReadDataSourceStreamingRequest)(nil),                      // 98: datasource.ReadDataSourceStreamingRequest
+	(*ExecuteSqlRequest)(nil),                                   // 99: datasource.ExecuteSqlRequest
+	(*ExecuteSqlResponse)(nil),                                  // 100: datasource.ExecuteSqlResponse
+	(*DmlResult)(nil),                                           // 101: datasource.DmlResult
+	(*ReadRequest)(nil),                                         // 102: datasource.ReadRequest
+	(*FilterCondition)(nil),                                     // 103: datasource.FilterCondition
+	(*WriteRequest)(nil),                                        // 104: datasource.WriteRequest
+	(*WriteResponse)(nil),                                       // 105: datasource.WriteResponse
+	(*ImportDataRequest)(nil),                                   // 106: datasource.ImportDataRequest
+	(*ImportDataProgress)(nil),                                  // 107: datasource.ImportDataProgress
+	(*ImportTarget)(nil),                                        // 108: datasource.ImportTarget
+	(*TableKey)(nil),                                            // 109: datasource.TableKey
+	(*ImportDataResponse)(nil),                                  // 110: datasource.ImportDataResponse
+	(*ImportResult)(nil),                                        // 111: datasource.ImportResult
+	(*QueryAuditLogRequest)(nil),                                // 112: datasource.QueryAuditLogRequest
+	(*AuditLogRecord)(nil),                                      // 113: datasource.AuditLogRecord
+	(*QueryAuditLogResponse)(nil),                               // 114: datasource.QueryAuditLogResponse
+	(*ProfileTableRequest)(nil),                                 // 115: datasource.ProfileTableRequest
+	(*ValueFrequency)(nil),                                      // 116: datasource.ValueFrequency
+	(*LengthBucket)(nil),                                        // 117: datasource.LengthBucket
+	(*StringLengthStats)(nil),                                   // 118: datasource.StringLengthStats
+	(*ColumnProfile)(nil),                                       // 119: datasource.ColumnProfile
+	(*ProfileTableResponse)(nil),                                // 120: datasource.ProfileTableResponse
+	nil,                                                         // 121: datasource.DorisSQLRow.ColumnsEntry
+	nil,                                                         // 122: datasource.TableKey.AttributesEntry
+}
+var file_proto_data_source_proto_depIdxs = []int32{
+	11,  // 0: datasource.BatchResponse.status:type_name -> datasource.JobStatus
+	16,  // 1: datasource.BatchResponse.callback:type_name -> datasource.JobCallback
+	11,  // 2: datasource.JobCallback.status:type_name -> datasource.JobStatus
+	11,  // 3: datasource.ListBatchJobHistoryRequest.status:type_name -> datasource.JobStatus
+	11,  // 4: datasource.BatchJobHistoryRecord.status:type_name -> datasource.JobStatus
+	57,  // 5: datasource.BatchJobHistoryRecord.sparkConfig:type_name -> datasource.SparkConfig
+	22,  // 6: datasource.ListBatchJobHistoryResponse.records:type_name -> datasource.BatchJobHistoryRecord
+	27,  // 7: datasource.ArrowResponse.plan:type_name -> datasource.ExecutionPlan
+	25,  // 8: datasource.ExecutionPlan.steps:type_name -> datasource.PlanStep
+	26,  // 9: datasource.ExecutionPlan.objects:type_name -> datasource.PlanObject
+	28,  // 10: datasource.WrappedWriterDataRequest.request:type_name -> datasource.WriterDataRequest
+	48,  // 11: datasource.InternalReadRequest.filterValues:type_name -> datasource.FilterValue
+	49,  // 12: datasource.InternalReadRequest.sortRules:type_name -> datasource.SortRule
+	1,   // 13: datasource.InternalReadRequest.filterOperators:type_name -> datasource.FilterOperator
+	33,  // 14: datasource.InternalReadRequest.sample:type_name -> datasource.ReadSample
+	103, // 15: datasource.ReadWindow.filterConditions:type_name -> datasource.FilterCondition
+	49,  // 16: datasource.ReadWindow.sortRules:type_name -> datasource.SortRule
+	33,  // 17: datasource.ReadWindow.sample:type_name -> datasource.ReadSample
+	57,  // 18: datasource.BatchReadRequest.sparkConfig:type_name -> datasource.SparkConfig
+	36,  // 19: datasource.BatchReadRequest.external:type_name -> datasource.ExternalDataSource
+	37,  // 20: datasource.BatchReadRequest.internal:type_name -> datasource.InternalDataSource
+	39,  // 21: datasource.BatchReadRequest.query:type_name -> datasource.QueryOperation
+	40,  // 22: datasource.BatchReadRequest.write:type_name -> datasource.WriteOperation
+	41,  // 23: datasource.BatchReadRequest.sort:type_name -> datasource.SortOperation
+	42,  // 24: datasource.BatchReadRequest.count:type_name -> datasource.CountOperation
+	43,  // 25: datasource.BatchReadRequest.groupby_count:type_name -> datasource.GroupByCountOperation
+	44,  // 26: datasource.BatchReadRequest.join:type_name -> datasource.JoinOperation
+	45,  // 27: datasource.BatchReadRequest.add_hash_column:type_name -> datasource.AddHashColumnOperation
+	46,  // 28: datasource.BatchReadRequest.psi_join:type_name -> datasource.PSIJoinOperation
+	48,  // 29: datasource.QueryOperation.filterValues:type_name -> datasource.FilterValue
+	1,   // 30: datasource.QueryOperation.filterOperators:type_name -> datasource.FilterOperator
+	49,  // 31: datasource.QueryOperation.sortRules:type_name -> datasource.SortRule
+	48,  // 32: datasource.CountOperation.filterValues:type_name -> datasource.FilterValue
+	1,   // 33: datasource.CountOperation.filterOperators:type_name -> datasource.FilterOperator
+	48,  // 34: datasource.GroupByCountOperation.filterValues:type_name -> datasource.FilterValue
+	1,   // 35: datasource.GroupByCountOperation.filterOperators:type_name -> datasource.FilterOperator
+	10,  // 36: datasource.JoinOperation.joinType:type_name -> datasource.JoinType
+	2,   // 37: datasource.StreamReadRequest.fileType:type_name -> datasource.FileType
+	48,  // 38: datasource.StreamReadRequest.filterValues:type_name -> datasource.FilterValue
+	49,  // 39: datasource.StreamReadRequest.sortRules:type_name -> datasource.SortRule
+	1,   // 40: datasource.StreamReadRequest.filterOperators:type_name -> datasource.FilterOperator
+	0,   // 41: datasource.SortRule.sortOrder:type_name -> datasource.SortOrder
+	72,  // 42: datasource.ConnectionInfo.tlsConfig:type_name -> datasource.DatasourceTlsConfig
+	51,  // 43: datasource.ConnectionInfo.columns:type_name -> datasource.ColumnItem
+	9,   // 44: datasource.SparkDBConnInfo.mode:type_name -> datasource.OperationMode
+	10,  // 45: datasource.SparkDBConnInfo.joinType:type_name -> datasource.JoinType
+	12,  // 46: datasource.SparkDBConnInfo.storageType:type_name -> datasource.StorageType
+	49,  // 47: datasource.SparkDBConnInfo.sortRules:type_name -> datasource.SortRule
+	58,  // 48: datasource.SparkConfig.retryPolicy:type_name -> datasource.SparkRetryPolicy
+	51,  // 49: datasource.TableInfoResponse.columns:type_name -> datasource.ColumnItem
+	4,   // 50: datasource.TableInfoResponse.statsMethod:type_name -> datasource.TableStatsMethod
+	48,  // 51: datasource.GroupCountRequest.filterValues:type_name -> datasource.FilterValue
+	1,   // 52: datasource.GroupCountRequest.filterOperators:type_name -> datasource.FilterOperator
+	67,  // 53: datasource.PushJobResultRequest.sink:type_name -> datasource.ResultSink
+	5,   // 54: datasource.ResultSink.type:type_name -> datasource.ResultSinkType
+	5,   // 55: datasource.ResultDeliveryRecord.sinkType:type_name -> datasource.ResultSinkType
+	6,   // 56: datasource.ResultDeliveryRecord.status:type_name -> datasource.ResultDeliveryStatus
+	69,  // 57: datasource.GetResultDeliveryStatusResponse.records:type_name -> datasource.ResultDeliveryRecord
+	75,  // 58: datasource.ExecuteDorisSQLResponse.rows:type_name -> datasource.DorisSQLRow
+	121, // 59: datasource.DorisSQLRow.columns:type_name -> datasource.DorisSQLRow.ColumnsEntry
+	49,  // 60: datasource.ExportCsvFileFromDorisRequest.sortRules:type_name -> datasource.SortRule
+	103, // 61: datasource.ExportCsvFileFromDorisRequest.filterConditions:type_name -> datasource.FilterCondition
+	80,  // 62: datasource.ExportCsvFileFromDorisRequest.target:type_name -> datasource.ExportTarget
+	33,  // 63: datasource.ExportCsvFileFromDorisRequest.sample:type_name -> datasource.ReadSample
+	81,  // 64: datasource.ExportCsvFileFromDorisResponse.files:type_name -> datasource.ExportedFile
+	51,  // 65: datasource.ExportDorisDataToMiraDBRequest.columns:type_name -> datasource.ColumnItem
+	90,  // 66: datasource.GetRetryCleanupTasksResponse.tasks:type_name -> datasource.CleanupTaskInfo
+	94,  // 67: datasource.GetOrphanReportResponse.resources:type_name -> datasource.OrphanResourceInfo
+	36,  // 68: datasource.ReadDataSourceStreamingRequest.external:type_name -> datasource.ExternalDataSource
+	37,  // 69: datasource.ReadDataSourceStreamingRequest.internal:type_name -> datasource.InternalDataSource
+	38,  // 70: datasource.ReadDataSourceStreamingRequest.doris:type_name -> datasource.DorisDataSource
+	49,  // 71: datasource.ReadDataSourceStreamingRequest.sortRules:type_name -> datasource.SortRule
+	103, // 72: datasource.ReadDataSourceStreamingRequest.filterConditions:type_name -> datasource.FilterCondition
+	33,  // 73: datasource.ReadDataSourceStreamingRequest.sample:type_name -> datasource.ReadSample
+	101, // 74: datasource.ExecuteSqlResponse.dmlResult:type_name -> datasource.DmlResult
+	27,  // 75: datasource.ExecuteSqlResponse.plan:type_name -> datasource.ExecutionPlan
+	36,  // 76: datasource.ReadRequest.external:type_name -> datasource.ExternalDataSource
+	37,  // 77: datasource.ReadRequest.internal:type_name -> datasource.InternalDataSource
+	38,  // 78: datasource.ReadRequest.doris:type_name -> datasource.DorisDataSource
+	49,  // 79: datasource.ReadRequest.sortRules:type_name -> datasource.SortRule
+	103, // 80: datasource.ReadRequest.filterConditions:type_name -> datasource.FilterCondition
+	109, // 81: datasource.ReadRequest.keys:type_name -> datasource.TableKey
+	33,  // 82: datasource.ReadRequest.sample:type_name -> datasource.ReadSample
+	48,  // 83: datasource.FilterCondition.fieldValue:type_name -> datasource.FilterValue
+	1,   // 84: datasource.FilterCondition.operator:type_name -> datasource.FilterOperator
+	108, // 85: datasource.ImportDataRequest.targets:type_name -> datasource.ImportTarget
+	7,   // 86: datasource.ImportDataProgress.stage:type_name -> datasource.ImportStage
+	111, // 87: datasource.ImportDataProgress.result:type_name -> datasource.ImportResult
+	110, // 88: datasource.ImportDataProgress.summary:type_name -> datasource.ImportDataResponse
+	36,  // 89: datasource.ImportTarget.external:type_name -> datasource.ExternalDataSource
+	109, // 90: datasource.ImportTarget.keys:type_name -> datasource.TableKey
+	34,  // 91: datasource.ImportTarget.window:type_name -> datasource.ReadWindow
+	13,  // 92: datasource.TableKey.keyType:type_name -> datasource.KeyType
+	122, // 93: datasource.TableKey.attributes:type_name -> datasource.TableKey.AttributesEntry
+	111, // 94: datasource.ImportDataResponse.results:type_name -> datasource.ImportResult
+	27,  // 95: datasource.ImportResult.plan:type_name -> datasource.ExecutionPlan
+	113, // 96: datasource.QueryAuditLogResponse.records:type_name -> datasource.AuditLogRecord
+	117, // 97: datasource.StringLengthStats.buckets:type_name -> datasource.LengthBucket
+	116, // 98: datasource.ColumnProfile.topValues:type_name -> datasource.ValueFrequency
+	118, // 99: datasource.ColumnProfile.lengthStats:type_name -> datasource.StringLengthStats
+	119, // 100: datasource.ProfileTableResponse.columns:type_name -> datasource.ColumnProfile
+	35,  // 101: datasource.DataSourceService.SubmitBatchJob:input_type -> datasource.BatchReadRequest
+	47,  // 102: datasource.DataSourceService.ReadStreamingData:input_type -> datasource.StreamReadRequest
+	29,  // 103: datasource.DataSourceService.SendArrowData:input_type -> datasource.WrappedWriterDataRequest
+	53,  // 104: datasource.DataSourceService.WriteOSSData:input_type -> datasource.OSSWriteRequest
+	53,  // 105: datasource.DataSourceService.WriteOSSFileData:input_type -> datasource.OSSWriteRequest
+	54,  // 106: datasource.DataSourceService.ReadOSSData:input_type -> datasource.OSSReadRequest
+	30,  // 107: datasource.DataSourceService.WriteInternalData:input_type -> datasource.WriterInternalDataRequest
+	32,  // 108: datasource.DataSourceService.ReadInternalData:input_type -> datasource.InternalReadRequest
+	31,  // 109: datasource.DataSourceService.WriterExternalData:input_type -> datasource.WriterExternalDataRequest
+	59,  // 110: datasource.DataSourceService.GetTableInfo:input_type -> datasource.TableInfoRequest
+	61,  // 111: datasource.DataSourceService.GetGroupCountInfo:input_type -> datasource.GroupCountRequest
+	17,  // 112: datasource.DataSourceService.GetJobStatus:input_type -> datasource.JobStatusRequest
+	18,  // 113: datasource.DataSourceService.CancelBatchJob:input_type -> datasource.CancelBatchJobRequest
+	19,  // 114: datasource.DataSourceService.GetBatchJobLogs:input_type -> datasource.GetBatchJobLogsRequest
+	21,  // 115: datasource.DataSourceService.ListBatchJobHistory:input_type -> datasource.ListBatchJobHistoryRequest
+	63,  // 116: datasource.DataSourceService.TruncateTable:input_type -> datasource.TruncateTableRequest
+	65,  // 117: datasource.DataSourceService.PushJobResultToExternalDB:input_type -> datasource.PushJobResultRequest
+	68,  // 118: datasource.DataSourceService.GetResultDeliveryStatus:input_type -> datasource.GetResultDeliveryStatusRequest
+	73,  // 119: datasource.DataSourceService.ExecuteDorisSQL:input_type -> datasource.ExecuteDorisSQLRequest
+	76,  // 120: datasource.DataSourceService.CreateExternalAndInternalTableAndImportData:input_type -> datasource.CreateExternalAndInternalTableAndImportDataRequest
+	78,  // 121: datasource.DataSourceService.ImportCsvFileToDoris:input_type -> datasource.ImportCsvFileToDorisRequest
+	79,  // 122: datasource.DataSourceService.ExportCsvFileFromDoris:input_type -> datasource.ExportCsvFileFromDorisRequest
+	83,  // 123: datasource.DataSourceService.ExportDorisDataToMiraDB:input_type -> datasource.ExportDorisDataToMiraDBRequest
+	84,  // 124: datasource.DataSourceService.ImportMiraDBDataToDoris:input_type -> datasource.ImportMiraDBDataToDorisRequest
+	86,  // 125: datasource.DataSourceService.GetInternalTableInfo:input_type -> datasource.InternalTableInfoRequest
+	98,  // 126: datasource.DataSourceService.ReadDataSourceStreaming:input_type -> datasource.ReadDataSourceStreamingRequest
+	87,  // 127: datasource.DataSourceService.CleanTmpData:input_type -> datasource.CleanTmpDataRequest
+	88,  // 128: datasource.DataSourceService.GetRetryCleanupTask:input_type -> datasource.GetRetryCleanupTasksRequest
+	91,  // 129: datasource.DataSourceService.RequeueCleanupTask:input_type -> datasource.RequeueCleanupTaskRequest
+	93,  // 130: datasource.DataSourceService.GetOrphanReport:input_type -> datasource.GetOrphanReportRequest
+	96,  // 131: datasource.DataSourceService.UpdateTableTTL:input_type -> datasource.UpdateTableTTLRequest
+	99,  // 132: datasource.DataSourceService.ExecuteSql:input_type -> datasource.ExecuteSqlRequest
+	102, // 133: datasource.DataSourceService.Read:input_type -> datasource.ReadRequest
+	104, // 134: datasource.DataSourceService.Write:input_type -> datasource.WriteRequest
+	106, // 135: datasource.DataSourceService.ImportData:input_type -> datasource.ImportDataRequest
+	106, // 136: datasource.DataSourceService.ImportDataStream:input_type -> datasource.ImportDataRequest
+	112, // 137: datasource.DataSourceService.QueryAuditLog:input_type -> datasource.QueryAuditLogRequest
+	115, // 138: datasource.DataSourceService.ProfileTable:input_type -> datasource.ProfileTableRequest
+	15,  // 139: datasource.DataSourceService.SubmitBatchJob:output_type -> datasource.BatchResponse
+	24,  // 140: datasource.DataSourceService.ReadStreamingData:output_type -> datasource.ArrowResponse
+	14,  // 141: datasource.DataSourceService.SendArrowData:output_type -> datasource.Response
+	14,  // 142: datasource.DataSourceService.WriteOSSData:output_type -> datasource.Response
+	14,  // 143: datasource.DataSourceService.WriteOSSFileData:output_type -> datasource.Response
+	55,  // 144: datasource.DataSourceService.ReadOSSData:output_type -> datasource.OSSReadResponse
+	14,  // 145: datasource.DataSourceService.WriteInternalData:output_type -> datasource.Response
+	24,  // 146: datasource.DataSourceService.ReadInternalData:output_type -> datasource.ArrowResponse
+	14,  // 147: datasource.DataSourceService.WriterExternalData:output_type -> datasource.Response
+	60,  // 148: datasource.DataSourceService.GetTableInfo:output_type -> datasource.TableInfoResponse
+	62,  // 149: datasource.DataSourceService.GetGroupCountInfo:output_type -> datasource.GroupCountResponse
+	15,  // 150: datasource.DataSourceService.GetJobStatus:output_type -> datasource.BatchResponse
+	15,  // 151: datasource.DataSourceService.CancelBatchJob:output_type -> datasource.BatchResponse
+	20,  // 152: datasource.DataSourceService.GetBatchJobLogs:output_type -> datasource.BatchJobLogChunk
+	23,  // 153: datasource.DataSourceService.ListBatchJobHistory:output_type -> datasource.ListBatchJobHistoryResponse
+	64,  // 154: datasource.DataSourceService.TruncateTable:output_type -> datasource.TruncateTableResponse
+	66,  // 155: datasource.DataSourceService.PushJobResultToExternalDB:output_type -> datasource.PushJobResultResponse
+	70,  // 156: datasource.DataSourceService.GetResultDeliveryStatus:output_type -> datasource.GetResultDeliveryStatusResponse
+	74,  // 157: datasource.DataSourceService.ExecuteDorisSQL:output_type -> datasource.ExecuteDorisSQLResponse
+	77,  // 158: datasource.DataSourceService.CreateExternalAndInternalTableAndImportData:output_type -> datasource.CreateExternalAndInternalTableAndImportDataResponse
+	14,  // 159: datasource.DataSourceService.ImportCsvFileToDoris:output_type -> datasource.Response
+	82,  // 160: datasource.DataSourceService.ExportCsvFileFromDoris:output_type -> datasource.ExportCsvFileFromDorisResponse
+	14,  // 161: datasource.DataSourceService.ExportDorisDataToMiraDB:output_type -> datasource.Response
+	85,  // 162: datasource.DataSourceService.ImportMiraDBDataToDoris:output_type -> datasource.ImportMiraDBDataToDorisResponse
+	60,  // 163: datasource.DataSourceService.GetInternalTableInfo:output_type -> datasource.TableInfoResponse
+	24,  // 164: datasource.DataSourceService.ReadDataSourceStreaming:output_type -> datasource.ArrowResponse
+	14,  // 165: datasource.DataSourceService.CleanTmpData:output_type -> datasource.Response
+	89,  // 166: datasource.DataSourceService.GetRetryCleanupTask:output_type -> datasource.GetRetryCleanupTasksResponse
+	92,  // 167: datasource.DataSourceService.RequeueCleanupTask:output_type -> datasource.RequeueCleanupTaskResponse
+	95,  // 168: datasource.DataSourceService.GetOrphanReport:output_type -> datasource.GetOrphanReportResponse
+	97,  // 169: datasource.DataSourceService.UpdateTableTTL:output_type -> datasource.UpdateTableTTLResponse
+	100, // 170: datasource.DataSourceService.ExecuteSql:output_type -> datasource.ExecuteSqlResponse
+	24,  // 171: datasource.DataSourceService.Read:output_type -> datasource.ArrowResponse
+	105, // 172: datasource.DataSourceService.Write:output_type -> datasource.WriteResponse
+	110, // 173: datasource.DataSourceService.ImportData:output_type -> datasource.ImportDataResponse
+	107, // 174: datasource.DataSourceService.ImportDataStream:output_type -> datasource.ImportDataProgress
+	114, // 175: datasource.DataSourceService.QueryAuditLog:output_type -> datasource.QueryAuditLogResponse
+	120, // 176: datasource.DataSourceService.ProfileTable:output_type -> datasource.ProfileTableResponse
+	139, // [139:177] is the sub-list for method output_type
+	101, // [101:139] is the sub-list for method input_type
+	101, // [101:101] is the sub-list for extension type_name
+	101, // [101:101] is the sub-list for extension extendee
+	0,   // [0:101] is the sub-list for field type_name
+}
+
+func init() { file_proto_data_source_proto_init() }
+func file_proto_data_source_proto_init() {
+	if File_proto_data_source_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_data_source_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*JobCallback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*JobStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelBatchJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*GetBatchJobLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchJobLogChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*ListBatchJobHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchJobHistoryRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ListBatchJobHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ArrowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*PlanStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*PlanObject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecutionPlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*WriterDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*WrappedWriterDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*WriterInternalDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*WriterExternalDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*InternalReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*ReadSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*ReadWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*ExternalDataSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*InternalDataSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*DorisDataSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*WriteOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*SortOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*CountOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*GroupByCountOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*JoinOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*AddHashColumnOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*PSIJoinOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*StreamReadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7297,8 +12029,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*BatchResponse); i {
+		file_proto_data_source_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*FilterValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7309,8 +12041,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*JobStatusRequest); i {
+		file_proto_data_source_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*SortRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7321,8 +12053,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*ArrowResponse); i {
+		file_proto_data_source_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ConnectionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7333,8 +12065,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*WriterDataRequest); i {
+		file_proto_data_source_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*ColumnItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7345,8 +12077,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*WrappedWriterDataRequest); i {
+		file_proto_data_source_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*ServerInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7357,8 +12089,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*WriterInternalDataRequest); i {
+		file_proto_data_source_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*OSSWriteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7369,8 +12101,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*WriterExternalDataRequest); i {
+		file_proto_data_source_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*OSSReadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7381,8 +12113,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*InternalReadRequest); i {
+		file_proto_data_source_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*OSSReadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7393,8 +12125,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*BatchReadRequest); i {
+		file_proto_data_source_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*SparkDBConnInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7405,8 +12137,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*ExternalDataSource); i {
+		file_proto_data_source_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*SparkConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7417,8 +12149,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*InternalDataSource); i {
+		file_proto_data_source_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*SparkRetryPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7429,8 +12161,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*DorisDataSource); i {
+		file_proto_data_source_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*TableInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7441,8 +12173,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*QueryOperation); i {
+		file_proto_data_source_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*TableInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7453,8 +12185,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*WriteOperation); i {
+		file_proto_data_source_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*GroupCountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7465,8 +12197,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*SortOperation); i {
+		file_proto_data_source_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*GroupCountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7477,8 +12209,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*CountOperation); i {
+		file_proto_data_source_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*TruncateTableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7489,8 +12221,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*GroupByCountOperation); i {
+		file_proto_data_source_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*TruncateTableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7501,8 +12233,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*JoinOperation); i {
+		file_proto_data_source_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*PushJobResultRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7513,8 +12245,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*AddHashColumnOperation); i {
+		file_proto_data_source_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*PushJobResultResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7525,8 +12257,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*PSIJoinOperation); i {
+		file_proto_data_source_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*ResultSink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7537,8 +12269,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*StreamReadRequest); i {
+		file_proto_data_source_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*GetResultDeliveryStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7549,8 +12281,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*FilterValue); i {
+		file_proto_data_source_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*ResultDeliveryRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7561,8 +12293,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*SortRule); i {
+		file_proto_data_source_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*GetResultDeliveryStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7573,8 +12305,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*ConnectionInfo); i {
+		file_proto_data_source_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*JobResultExternalDBInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7585,8 +12317,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*ColumnItem); i {
+		file_proto_data_source_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*DatasourceTlsConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7597,8 +12329,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*ServerInfo); i {
+		file_proto_data_source_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecuteDorisSQLRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7609,8 +12341,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*OSSWriteRequest); i {
+		file_proto_data_source_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecuteDorisSQLResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7621,8 +12353,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*OSSReadRequest); i {
+		file_proto_data_source_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*DorisSQLRow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7633,8 +12365,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*OSSReadResponse); i {
+		file_proto_data_source_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateExternalAndInternalTableAndImportDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7645,8 +12377,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*SparkDBConnInfo); i {
+		file_proto_data_source_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateExternalAndInternalTableAndImportDataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7657,8 +12389,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*SparkConfig); i {
+		file_proto_data_source_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportCsvFileToDorisRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7669,8 +12401,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*TableInfoRequest); i {
+		file_proto_data_source_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportCsvFileFromDorisRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7681,8 +12413,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*TableInfoResponse); i {
+		file_proto_data_source_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportTarget); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7693,8 +12425,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[34].Exporter = func(v any, i int) any {
-			switch v := v.(*GroupCountRequest); i {
+		file_proto_data_source_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportedFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7705,8 +12437,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[35].Exporter = func(v any, i int) any {
-			switch v := v.(*GroupCountResponse); i {
+		file_proto_data_source_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportCsvFileFromDorisResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7717,8 +12449,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[36].Exporter = func(v any, i int) any {
-			switch v := v.(*TruncateTableRequest); i {
+		file_proto_data_source_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportDorisDataToMiraDBRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7729,8 +12461,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[37].Exporter = func(v any, i int) any {
-			switch v := v.(*TruncateTableResponse); i {
+		file_proto_data_source_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportMiraDBDataToDorisRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7741,8 +12473,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[38].Exporter = func(v any, i int) any {
-			switch v := v.(*PushJobResultRequest); i {
+		file_proto_data_source_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportMiraDBDataToDorisResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7753,8 +12485,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[39].Exporter = func(v any, i int) any {
-			switch v := v.(*PushJobResultResponse); i {
+		file_proto_data_source_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*InternalTableInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7765,8 +12497,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[40].Exporter = func(v any, i int) any {
-			switch v := v.(*JobResultExternalDBInfo); i {
+		file_proto_data_source_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*CleanTmpDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7777,8 +12509,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[41].Exporter = func(v any, i int) any {
-			switch v := v.(*DatasourceTlsConfig); i {
+		file_proto_data_source_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRetryCleanupTasksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7789,8 +12521,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[42].Exporter = func(v any, i int) any {
-			switch v := v.(*ExecuteDorisSQLRequest); i {
+		file_proto_data_source_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRetryCleanupTasksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7801,8 +12533,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[43].Exporter = func(v any, i int) any {
-			switch v := v.(*ExecuteDorisSQLResponse); i {
+		file_proto_data_source_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*CleanupTaskInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7813,8 +12545,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[44].Exporter = func(v any, i int) any {
-			switch v := v.(*DorisSQLRow); i {
+		file_proto_data_source_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*RequeueCleanupTaskRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7825,8 +12557,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[45].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateExternalAndInternalTableAndImportDataRequest); i {
+		file_proto_data_source_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*RequeueCleanupTaskResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7837,8 +12569,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[46].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateExternalAndInternalTableAndImportDataResponse); i {
+		file_proto_data_source_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*GetOrphanReportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7849,8 +12581,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[47].Exporter = func(v any, i int) any {
-			switch v := v.(*ImportCsvFileToDorisRequest); i {
+		file_proto_data_source_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*OrphanResourceInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7861,8 +12593,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[48].Exporter = func(v any, i int) any {
-			switch v := v.(*ExportCsvFileFromDorisRequest); i {
+		file_proto_data_source_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*GetOrphanReportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7873,8 +12605,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[49].Exporter = func(v any, i int) any {
-			switch v := v.(*ExportCsvFileFromDorisResponse); i {
+		file_proto_data_source_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateTableTTLRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7885,8 +12617,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[50].Exporter = func(v any, i int) any {
-			switch v := v.(*ExportDorisDataToMiraDBRequest); i {
+		file_proto_data_source_proto_msgTypes[83].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateTableTTLResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7897,8 +12629,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[51].Exporter = func(v any, i int) any {
-			switch v := v.(*ImportMiraDBDataToDorisRequest); i {
+		file_proto_data_source_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*ReadDataSourceStreamingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7909,8 +12641,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[52].Exporter = func(v any, i int) any {
-			switch v := v.(*ImportMiraDBDataToDorisResponse); i {
+		file_proto_data_source_proto_msgTypes[85].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecuteSqlRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7921,8 +12653,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[53].Exporter = func(v any, i int) any {
-			switch v := v.(*InternalTableInfoRequest); i {
+		file_proto_data_source_proto_msgTypes[86].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecuteSqlResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7933,8 +12665,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[54].Exporter = func(v any, i int) any {
-			switch v := v.(*CleanTmpDataRequest); i {
+		file_proto_data_source_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*DmlResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7945,8 +12677,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[55].Exporter = func(v any, i int) any {
-			switch v := v.(*GetRetryCleanupTasksRequest); i {
+		file_proto_data_source_proto_msgTypes[88].Exporter = func(v any, i int) any {
+			switch v := v.(*ReadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7957,8 +12689,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[56].Exporter = func(v any, i int) any {
-			switch v := v.(*GetRetryCleanupTasksResponse); i {
+		file_proto_data_source_proto_msgTypes[89].Exporter = func(v any, i int) any {
+			switch v := v.(*FilterCondition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7969,8 +12701,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[57].Exporter = func(v any, i int) any {
-			switch v := v.(*CleanupTaskInfo); i {
+		file_proto_data_source_proto_msgTypes[90].Exporter = func(v any, i int) any {
+			switch v := v.(*WriteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7981,8 +12713,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[58].Exporter = func(v any, i int) any {
-			switch v := v.(*ReadDataSourceStreamingRequest); i {
+		file_proto_data_source_proto_msgTypes[91].Exporter = func(v any, i int) any {
+			switch v := v.(*WriteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7993,8 +12725,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[59].Exporter = func(v any, i int) any {
-			switch v := v.(*ExecuteSqlRequest); i {
+		file_proto_data_source_proto_msgTypes[92].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8005,8 +12737,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[60].Exporter = func(v any, i int) any {
-			switch v := v.(*ExecuteSqlResponse); i {
+		file_proto_data_source_proto_msgTypes[93].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportDataProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8017,8 +12749,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[61].Exporter = func(v any, i int) any {
-			switch v := v.(*DmlResult); i {
+		file_proto_data_source_proto_msgTypes[94].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportTarget); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8029,8 +12761,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[62].Exporter = func(v any, i int) any {
-			switch v := v.(*ReadRequest); i {
+		file_proto_data_source_proto_msgTypes[95].Exporter = func(v any, i int) any {
+			switch v := v.(*TableKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8041,8 +12773,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[63].Exporter = func(v any, i int) any {
-			switch v := v.(*FilterCondition); i {
+		file_proto_data_source_proto_msgTypes[96].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportDataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8053,8 +12785,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[64].Exporter = func(v any, i int) any {
-			switch v := v.(*WriteRequest); i {
+		file_proto_data_source_proto_msgTypes[97].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8065,8 +12797,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[65].Exporter = func(v any, i int) any {
-			switch v := v.(*WriteResponse); i {
+		file_proto_data_source_proto_msgTypes[98].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryAuditLogRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8077,8 +12809,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[66].Exporter = func(v any, i int) any {
-			switch v := v.(*ImportDataRequest); i {
+		file_proto_data_source_proto_msgTypes[99].Exporter = func(v any, i int) any {
+			switch v := v.(*AuditLogRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8089,8 +12821,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[67].Exporter = func(v any, i int) any {
-			switch v := v.(*ImportTarget); i {
+		file_proto_data_source_proto_msgTypes[100].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryAuditLogResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8101,8 +12833,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[68].Exporter = func(v any, i int) any {
-			switch v := v.(*TableKey); i {
+		file_proto_data_source_proto_msgTypes[101].Exporter = func(v any, i int) any {
+			switch v := v.(*ProfileTableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8113,8 +12845,8 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[69].Exporter = func(v any, i int) any {
-			switch v := v.(*ImportDataResponse); i {
+		file_proto_data_source_proto_msgTypes[102].Exporter = func(v any, i int) any {
+			switch v := v.(*ValueFrequency); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8125,8 +12857,44 @@ func file_proto_data_source_proto_init() {
 				return nil
 			}
 		}
-		file_proto_data_source_proto_msgTypes[70].Exporter = func(v any, i int) any {
-			switch v := v.(*ImportResult); i {
+		file_proto_data_source_proto_msgTypes[103].Exporter = func(v any, i int) any {
+			switch v := v.(*LengthBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[104].Exporter = func(v any, i int) any {
+			switch v := v.(*StringLengthStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[105].Exporter = func(v any, i int) any {
+			switch v := v.(*ColumnProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_data_source_proto_msgTypes[106].Exporter = func(v any, i int) any {
+			switch v := v.(*ProfileTableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8138,7 +12906,7 @@ func file_proto_data_source_proto_init() {
 			}
 		}
 	}
-	file_proto_data_source_proto_msgTypes[9].OneofWrappers = []any{
+	file_proto_data_source_proto_msgTypes[21].OneofWrappers = []any{
 		(*BatchReadRequest_External)(nil),
 		(*BatchReadRequest_Internal)(nil),
 		(*BatchReadRequest_Query)(nil),
@@ -8150,16 +12918,16 @@ func file_proto_data_source_proto_init() {
 		(*BatchReadRequest_AddHashColumn)(nil),
 		(*BatchReadRequest_PsiJoin)(nil),
 	}
-	file_proto_data_source_proto_msgTypes[58].OneofWrappers = []any{
+	file_proto_data_source_proto_msgTypes[84].OneofWrappers = []any{
 		(*ReadDataSourceStreamingRequest_External)(nil),
 		(*ReadDataSourceStreamingRequest_Internal)(nil),
 		(*ReadDataSourceStreamingRequest_Doris)(nil),
 	}
-	file_proto_data_source_proto_msgTypes[60].OneofWrappers = []any{
+	file_proto_data_source_proto_msgTypes[86].OneofWrappers = []any{
 		(*ExecuteSqlResponse_ArrowBatch)(nil),
 		(*ExecuteSqlResponse_DmlResult)(nil),
 	}
-	file_proto_data_source_proto_msgTypes[62].OneofWrappers = []any{
+	file_proto_data_source_proto_msgTypes[88].OneofWrappers = []any{
 		(*ReadRequest_External)(nil),
 		(*ReadRequest_Internal)(nil),
 		(*ReadRequest_Doris)(nil),
@@ -8169,8 +12937,8 @@ func file_proto_data_source_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_data_source_proto_rawDesc,
-			NumEnums:      10,
-			NumMessages:   73,
+			NumEnums:      14,
+			NumMessages:   109,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
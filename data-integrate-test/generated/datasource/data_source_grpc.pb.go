@@ -31,8 +31,12 @@ const (
 	DataSourceService_GetTableInfo_FullMethodName                                = "/datasource.DataSourceService/GetTableInfo"
 	DataSourceService_GetGroupCountInfo_FullMethodName                           = "/datasource.DataSourceService/GetGroupCountInfo"
 	DataSourceService_GetJobStatus_FullMethodName                                = "/datasource.DataSourceService/GetJobStatus"
+	DataSourceService_CancelBatchJob_FullMethodName                              = "/datasource.DataSourceService/CancelBatchJob"
+	DataSourceService_GetBatchJobLogs_FullMethodName                             = "/datasource.DataSourceService/GetBatchJobLogs"
+	DataSourceService_ListBatchJobHistory_FullMethodName                         = "/datasource.DataSourceService/ListBatchJobHistory"
 	DataSourceService_TruncateTable_FullMethodName                               = "/datasource.DataSourceService/TruncateTable"
 	DataSourceService_PushJobResultToExternalDB_FullMethodName                   = "/datasource.DataSourceService/PushJobResultToExternalDB"
+	DataSourceService_GetResultDeliveryStatus_FullMethodName                     = "/datasource.DataSourceService/GetResultDeliveryStatus"
 	DataSourceService_ExecuteDorisSQL_FullMethodName                             = "/datasource.DataSourceService/ExecuteDorisSQL"
 	DataSourceService_CreateExternalAndInternalTableAndImportData_FullMethodName = "/datasource.DataSourceService/CreateExternalAndInternalTableAndImportData"
 	DataSourceService_ImportCsvFileToDoris_FullMethodName                        = "/datasource.DataSourceService/ImportCsvFileToDoris"
@@ -43,10 +47,16 @@ const (
 	DataSourceService_ReadDataSourceStreaming_FullMethodName                     = "/datasource.DataSourceService/ReadDataSourceStreaming"
 	DataSourceService_CleanTmpData_FullMethodName                                = "/datasource.DataSourceService/CleanTmpData"
 	DataSourceService_GetRetryCleanupTask_FullMethodName                         = "/datasource.DataSourceService/GetRetryCleanupTask"
+	DataSourceService_RequeueCleanupTask_FullMethodName                          = "/datasource.DataSourceService/RequeueCleanupTask"
+	DataSourceService_GetOrphanReport_FullMethodName                             = "/datasource.DataSourceService/GetOrphanReport"
+	DataSourceService_UpdateTableTTL_FullMethodName                              = "/datasource.DataSourceService/UpdateTableTTL"
 	DataSourceService_ExecuteSql_FullMethodName                                  = "/datasource.DataSourceService/ExecuteSql"
 	DataSourceService_Read_FullMethodName                                        = "/datasource.DataSourceService/Read"
 	DataSourceService_Write_FullMethodName                                       = "/datasource.DataSourceService/Write"
 	DataSourceService_ImportData_FullMethodName                                  = "/datasource.DataSourceService/ImportData"
+	DataSourceService_ImportDataStream_FullMethodName                            = "/datasource.DataSourceService/ImportDataStream"
+	DataSourceService_QueryAuditLog_FullMethodName                               = "/datasource.DataSourceService/QueryAuditLog"
+	DataSourceService_ProfileTable_FullMethodName                                = "/datasource.DataSourceService/ProfileTable"
 )
 
 // DataSourceServiceClient is the client API for DataSourceService service.
@@ -79,10 +89,18 @@ type DataSourceServiceClient interface {
 	GetGroupCountInfo(ctx context.Context, in *GroupCountRequest, opts ...grpc.CallOption) (*GroupCountResponse, error)
 	// 查询作业状态
 	GetJobStatus(ctx context.Context, in *JobStatusRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	// 取消批处理作业，删除作业的所有 Spark Pod
+	CancelBatchJob(ctx context.Context, in *CancelBatchJobRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	// 流式读取批处理作业的 driver 日志
+	GetBatchJobLogs(ctx context.Context, in *GetBatchJobLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BatchJobLogChunk], error)
+	// 查询批处理作业历史
+	ListBatchJobHistory(ctx context.Context, in *ListBatchJobHistoryRequest, opts ...grpc.CallOption) (*ListBatchJobHistoryResponse, error)
 	// 清空表数据
 	TruncateTable(ctx context.Context, in *TruncateTableRequest, opts ...grpc.CallOption) (*TruncateTableResponse, error)
 	// 推送任务结果到外部数据库
 	PushJobResultToExternalDB(ctx context.Context, in *PushJobResultRequest, opts ...grpc.CallOption) (*PushJobResultResponse, error)
+	// 查询任务结果投递状态
+	GetResultDeliveryStatus(ctx context.Context, in *GetResultDeliveryStatusRequest, opts ...grpc.CallOption) (*GetResultDeliveryStatusResponse, error)
 	// 执行doris sql语句
 	ExecuteDorisSQL(ctx context.Context, in *ExecuteDorisSQLRequest, opts ...grpc.CallOption) (*ExecuteDorisSQLResponse, error)
 	// 执行创建外部资源、外部表、内部表并导入数据
@@ -103,6 +121,12 @@ type DataSourceServiceClient interface {
 	CleanTmpData(ctx context.Context, in *CleanTmpDataRequest, opts ...grpc.CallOption) (*Response, error)
 	// 获取待重试清理任务列表
 	GetRetryCleanupTask(ctx context.Context, in *GetRetryCleanupTasksRequest, opts ...grpc.CallOption) (*GetRetryCleanupTasksResponse, error)
+	// 将重试耗尽的死信清理任务重新入队
+	RequeueCleanupTask(ctx context.Context, in *RequeueCleanupTaskRequest, opts ...grpc.CallOption) (*RequeueCleanupTaskResponse, error)
+	// 列出当前可被孤儿巡检删除的临时资源（只读，不执行删除）
+	GetOrphanReport(ctx context.Context, in *GetOrphanReportRequest, opts ...grpc.CallOption) (*GetOrphanReportResponse, error)
+	// 延长或缩短服务创建的表或库的生命周期，到期后由后台调度删除
+	UpdateTableTTL(ctx context.Context, in *UpdateTableTTLRequest, opts ...grpc.CallOption) (*UpdateTableTTLResponse, error)
 	// 执行SQL
 	ExecuteSql(ctx context.Context, in *ExecuteSqlRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExecuteSqlResponse], error)
 	// 读接口（返回流式数据）
@@ -111,6 +135,12 @@ type DataSourceServiceClient interface {
 	Write(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[WriteRequest, WriteResponse], error)
 	// 导入数据
 	ImportData(ctx context.Context, in *ImportDataRequest, opts ...grpc.CallOption) (*ImportDataResponse, error)
+	// 导入数据，逐个目标返回导入进度，最后一条消息包含汇总结果
+	ImportDataStream(ctx context.Context, in *ImportDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ImportDataProgress], error)
+	// 查询审计日志
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+	// 数据质量画像：统计每列空值率、去重数、最值、高频值和字符串长度分布
+	ProfileTable(ctx context.Context, in *ProfileTableRequest, opts ...grpc.CallOption) (*ProfileTableResponse, error)
 }
 
 type dataSourceServiceClient struct {
@@ -280,6 +310,45 @@ func (c *dataSourceServiceClient) GetJobStatus(ctx context.Context, in *JobStatu
 	return out, nil
 }
 
+func (c *dataSourceServiceClient) CancelBatchJob(ctx context.Context, in *CancelBatchJobRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, DataSourceService_CancelBatchJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSourceServiceClient) GetBatchJobLogs(ctx context.Context, in *GetBatchJobLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BatchJobLogChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[7], DataSourceService_GetBatchJobLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetBatchJobLogsRequest, BatchJobLogChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataSourceService_GetBatchJobLogsClient = grpc.ServerStreamingClient[BatchJobLogChunk]
+
+func (c *dataSourceServiceClient) ListBatchJobHistory(ctx context.Context, in *ListBatchJobHistoryRequest, opts ...grpc.CallOption) (*ListBatchJobHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBatchJobHistoryResponse)
+	err := c.cc.Invoke(ctx, DataSourceService_ListBatchJobHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataSourceServiceClient) TruncateTable(ctx context.Context, in *TruncateTableRequest, opts ...grpc.CallOption) (*TruncateTableResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TruncateTableResponse)
@@ -300,6 +369,16 @@ func (c *dataSourceServiceClient) PushJobResultToExternalDB(ctx context.Context,
 	return out, nil
 }
 
+func (c *dataSourceServiceClient) GetResultDeliveryStatus(ctx context.Context, in *GetResultDeliveryStatusRequest, opts ...grpc.CallOption) (*GetResultDeliveryStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResultDeliveryStatusResponse)
+	err := c.cc.Invoke(ctx, DataSourceService_GetResultDeliveryStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataSourceServiceClient) ExecuteDorisSQL(ctx context.Context, in *ExecuteDorisSQLRequest, opts ...grpc.CallOption) (*ExecuteDorisSQLResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExecuteDorisSQLResponse)
@@ -372,7 +451,7 @@ func (c *dataSourceServiceClient) GetInternalTableInfo(ctx context.Context, in *
 
 func (c *dataSourceServiceClient) ReadDataSourceStreaming(ctx context.Context, in *ReadDataSourceStreamingRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArrowResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[7], DataSourceService_ReadDataSourceStreaming_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[8], DataSourceService_ReadDataSourceStreaming_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -409,9 +488,39 @@ func (c *dataSourceServiceClient) GetRetryCleanupTask(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *dataSourceServiceClient) RequeueCleanupTask(ctx context.Context, in *RequeueCleanupTaskRequest, opts ...grpc.CallOption) (*RequeueCleanupTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequeueCleanupTaskResponse)
+	err := c.cc.Invoke(ctx, DataSourceService_RequeueCleanupTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSourceServiceClient) GetOrphanReport(ctx context.Context, in *GetOrphanReportRequest, opts ...grpc.CallOption) (*GetOrphanReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrphanReportResponse)
+	err := c.cc.Invoke(ctx, DataSourceService_GetOrphanReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSourceServiceClient) UpdateTableTTL(ctx context.Context, in *UpdateTableTTLRequest, opts ...grpc.CallOption) (*UpdateTableTTLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTableTTLResponse)
+	err := c.cc.Invoke(ctx, DataSourceService_UpdateTableTTL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataSourceServiceClient) ExecuteSql(ctx context.Context, in *ExecuteSqlRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExecuteSqlResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[8], DataSourceService_ExecuteSql_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[9], DataSourceService_ExecuteSql_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -430,7 +539,7 @@ type DataSourceService_ExecuteSqlClient = grpc.ServerStreamingClient[ExecuteSqlR
 
 func (c *dataSourceServiceClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArrowResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[9], DataSourceService_Read_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[10], DataSourceService_Read_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -449,7 +558,7 @@ type DataSourceService_ReadClient = grpc.ServerStreamingClient[ArrowResponse]
 
 func (c *dataSourceServiceClient) Write(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[WriteRequest, WriteResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[10], DataSourceService_Write_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[11], DataSourceService_Write_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -470,6 +579,45 @@ func (c *dataSourceServiceClient) ImportData(ctx context.Context, in *ImportData
 	return out, nil
 }
 
+func (c *dataSourceServiceClient) ImportDataStream(ctx context.Context, in *ImportDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ImportDataProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DataSourceService_ServiceDesc.Streams[12], DataSourceService_ImportDataStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportDataRequest, ImportDataProgress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataSourceService_ImportDataStreamClient = grpc.ServerStreamingClient[ImportDataProgress]
+
+func (c *dataSourceServiceClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, DataSourceService_QueryAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSourceServiceClient) ProfileTable(ctx context.Context, in *ProfileTableRequest, opts ...grpc.CallOption) (*ProfileTableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileTableResponse)
+	err := c.cc.Invoke(ctx, DataSourceService_ProfileTable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataSourceServiceServer is the server API for DataSourceService service.
 // All implementations must embed UnimplementedDataSourceServiceServer
 // for forward compatibility.
@@ -500,10 +648,18 @@ type DataSourceServiceServer interface {
 	GetGroupCountInfo(context.Context, *GroupCountRequest) (*GroupCountResponse, error)
 	// 查询作业状态
 	GetJobStatus(context.Context, *JobStatusRequest) (*BatchResponse, error)
+	// 取消批处理作业，删除作业的所有 Spark Pod
+	CancelBatchJob(context.Context, *CancelBatchJobRequest) (*BatchResponse, error)
+	// 流式读取批处理作业的 driver 日志
+	GetBatchJobLogs(*GetBatchJobLogsRequest, grpc.ServerStreamingServer[BatchJobLogChunk]) error
+	// 查询批处理作业历史
+	ListBatchJobHistory(context.Context, *ListBatchJobHistoryRequest) (*ListBatchJobHistoryResponse, error)
 	// 清空表数据
 	TruncateTable(context.Context, *TruncateTableRequest) (*TruncateTableResponse, error)
 	// 推送任务结果到外部数据库
 	PushJobResultToExternalDB(context.Context, *PushJobResultRequest) (*PushJobResultResponse, error)
+	// 查询任务结果投递状态
+	GetResultDeliveryStatus(context.Context, *GetResultDeliveryStatusRequest) (*GetResultDeliveryStatusResponse, error)
 	// 执行doris sql语句
 	ExecuteDorisSQL(context.Context, *ExecuteDorisSQLRequest) (*ExecuteDorisSQLResponse, error)
 	// 执行创建外部资源、外部表、内部表并导入数据
@@ -524,6 +680,12 @@ type DataSourceServiceServer interface {
 	CleanTmpData(context.Context, *CleanTmpDataRequest) (*Response, error)
 	// 获取待重试清理任务列表
 	GetRetryCleanupTask(context.Context, *GetRetryCleanupTasksRequest) (*GetRetryCleanupTasksResponse, error)
+	// 将重试耗尽的死信清理任务重新入队
+	RequeueCleanupTask(context.Context, *RequeueCleanupTaskRequest) (*RequeueCleanupTaskResponse, error)
+	// 列出当前可被孤儿巡检删除的临时资源（只读，不执行删除）
+	GetOrphanReport(context.Context, *GetOrphanReportRequest) (*GetOrphanReportResponse, error)
+	// 延长或缩短服务创建的表或库的生命周期，到期后由后台调度删除
+	UpdateTableTTL(context.Context, *UpdateTableTTLRequest) (*UpdateTableTTLResponse, error)
 	// 执行SQL
 	ExecuteSql(*ExecuteSqlRequest, grpc.ServerStreamingServer[ExecuteSqlResponse]) error
 	// 读接口（返回流式数据）
@@ -532,6 +694,12 @@ type DataSourceServiceServer interface {
 	Write(grpc.ClientStreamingServer[WriteRequest, WriteResponse]) error
 	// 导入数据
 	ImportData(context.Context, *ImportDataRequest) (*ImportDataResponse, error)
+	// 导入数据，逐个目标返回导入进度，最后一条消息包含汇总结果
+	ImportDataStream(*ImportDataRequest, grpc.ServerStreamingServer[ImportDataProgress]) error
+	// 查询审计日志
+	QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	// 数据质量画像：统计每列空值率、去重数、最值、高频值和字符串长度分布
+	ProfileTable(context.Context, *ProfileTableRequest) (*ProfileTableResponse, error)
 	mustEmbedUnimplementedDataSourceServiceServer()
 }
 
@@ -578,12 +746,24 @@ func (UnimplementedDataSourceServiceServer) GetGroupCountInfo(context.Context, *
 func (UnimplementedDataSourceServiceServer) GetJobStatus(context.Context, *JobStatusRequest) (*BatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJobStatus not implemented")
 }
+func (UnimplementedDataSourceServiceServer) CancelBatchJob(context.Context, *CancelBatchJobRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBatchJob not implemented")
+}
+func (UnimplementedDataSourceServiceServer) GetBatchJobLogs(*GetBatchJobLogsRequest, grpc.ServerStreamingServer[BatchJobLogChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method GetBatchJobLogs not implemented")
+}
+func (UnimplementedDataSourceServiceServer) ListBatchJobHistory(context.Context, *ListBatchJobHistoryRequest) (*ListBatchJobHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBatchJobHistory not implemented")
+}
 func (UnimplementedDataSourceServiceServer) TruncateTable(context.Context, *TruncateTableRequest) (*TruncateTableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TruncateTable not implemented")
 }
 func (UnimplementedDataSourceServiceServer) PushJobResultToExternalDB(context.Context, *PushJobResultRequest) (*PushJobResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PushJobResultToExternalDB not implemented")
 }
+func (UnimplementedDataSourceServiceServer) GetResultDeliveryStatus(context.Context, *GetResultDeliveryStatusRequest) (*GetResultDeliveryStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResultDeliveryStatus not implemented")
+}
 func (UnimplementedDataSourceServiceServer) ExecuteDorisSQL(context.Context, *ExecuteDorisSQLRequest) (*ExecuteDorisSQLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteDorisSQL not implemented")
 }
@@ -614,6 +794,15 @@ func (UnimplementedDataSourceServiceServer) CleanTmpData(context.Context, *Clean
 func (UnimplementedDataSourceServiceServer) GetRetryCleanupTask(context.Context, *GetRetryCleanupTasksRequest) (*GetRetryCleanupTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRetryCleanupTask not implemented")
 }
+func (UnimplementedDataSourceServiceServer) RequeueCleanupTask(context.Context, *RequeueCleanupTaskRequest) (*RequeueCleanupTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequeueCleanupTask not implemented")
+}
+func (UnimplementedDataSourceServiceServer) GetOrphanReport(context.Context, *GetOrphanReportRequest) (*GetOrphanReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrphanReport not implemented")
+}
+func (UnimplementedDataSourceServiceServer) UpdateTableTTL(context.Context, *UpdateTableTTLRequest) (*UpdateTableTTLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTableTTL not implemented")
+}
 func (UnimplementedDataSourceServiceServer) ExecuteSql(*ExecuteSqlRequest, grpc.ServerStreamingServer[ExecuteSqlResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method ExecuteSql not implemented")
 }
@@ -626,6 +815,15 @@ func (UnimplementedDataSourceServiceServer) Write(grpc.ClientStreamingServer[Wri
 func (UnimplementedDataSourceServiceServer) ImportData(context.Context, *ImportDataRequest) (*ImportDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportData not implemented")
 }
+func (UnimplementedDataSourceServiceServer) ImportDataStream(*ImportDataRequest, grpc.ServerStreamingServer[ImportDataProgress]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportDataStream not implemented")
+}
+func (UnimplementedDataSourceServiceServer) QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
+func (UnimplementedDataSourceServiceServer) ProfileTable(context.Context, *ProfileTableRequest) (*ProfileTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProfileTable not implemented")
+}
 func (UnimplementedDataSourceServiceServer) mustEmbedUnimplementedDataSourceServiceServer() {}
 func (UnimplementedDataSourceServiceServer) testEmbeddedByValue()                           {}
 
@@ -798,6 +996,53 @@ func _DataSourceService_GetJobStatus_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataSourceService_CancelBatchJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBatchJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).CancelBatchJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_CancelBatchJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).CancelBatchJob(ctx, req.(*CancelBatchJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSourceService_GetBatchJobLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetBatchJobLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataSourceServiceServer).GetBatchJobLogs(m, &grpc.GenericServerStream[GetBatchJobLogsRequest, BatchJobLogChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataSourceService_GetBatchJobLogsServer = grpc.ServerStreamingServer[BatchJobLogChunk]
+
+func _DataSourceService_ListBatchJobHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBatchJobHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).ListBatchJobHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_ListBatchJobHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).ListBatchJobHistory(ctx, req.(*ListBatchJobHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataSourceService_TruncateTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TruncateTableRequest)
 	if err := dec(in); err != nil {
@@ -834,6 +1079,24 @@ func _DataSourceService_PushJobResultToExternalDB_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataSourceService_GetResultDeliveryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResultDeliveryStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).GetResultDeliveryStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_GetResultDeliveryStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).GetResultDeliveryStatus(ctx, req.(*GetResultDeliveryStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataSourceService_ExecuteDorisSQL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExecuteDorisSQLRequest)
 	if err := dec(in); err != nil {
@@ -1007,6 +1270,60 @@ func _DataSourceService_GetRetryCleanupTask_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataSourceService_RequeueCleanupTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequeueCleanupTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).RequeueCleanupTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_RequeueCleanupTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).RequeueCleanupTask(ctx, req.(*RequeueCleanupTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSourceService_GetOrphanReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrphanReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).GetOrphanReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_GetOrphanReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).GetOrphanReport(ctx, req.(*GetOrphanReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSourceService_UpdateTableTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTableTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).UpdateTableTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_UpdateTableTTL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).UpdateTableTTL(ctx, req.(*UpdateTableTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataSourceService_ExecuteSql_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ExecuteSqlRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1054,6 +1371,53 @@ func _DataSourceService_ImportData_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataSourceService_ImportDataStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImportDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataSourceServiceServer).ImportDataStream(m, &grpc.GenericServerStream[ImportDataRequest, ImportDataProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataSourceService_ImportDataStreamServer = grpc.ServerStreamingServer[ImportDataProgress]
+
+func _DataSourceService_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_QueryAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).QueryAuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSourceService_ProfileTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).ProfileTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_ProfileTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).ProfileTable(ctx, req.(*ProfileTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DataSourceService_ServiceDesc is the grpc.ServiceDesc for DataSourceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1081,6 +1445,14 @@ var DataSourceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetJobStatus",
 			Handler:    _DataSourceService_GetJobStatus_Handler,
 		},
+		{
+			MethodName: "CancelBatchJob",
+			Handler:    _DataSourceService_CancelBatchJob_Handler,
+		},
+		{
+			MethodName: "ListBatchJobHistory",
+			Handler:    _DataSourceService_ListBatchJobHistory_Handler,
+		},
 		{
 			MethodName: "TruncateTable",
 			Handler:    _DataSourceService_TruncateTable_Handler,
@@ -1089,6 +1461,10 @@ var DataSourceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PushJobResultToExternalDB",
 			Handler:    _DataSourceService_PushJobResultToExternalDB_Handler,
 		},
+		{
+			MethodName: "GetResultDeliveryStatus",
+			Handler:    _DataSourceService_GetResultDeliveryStatus_Handler,
+		},
 		{
 			MethodName: "ExecuteDorisSQL",
 			Handler:    _DataSourceService_ExecuteDorisSQL_Handler,
@@ -1125,10 +1501,30 @@ var DataSourceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRetryCleanupTask",
 			Handler:    _DataSourceService_GetRetryCleanupTask_Handler,
 		},
+		{
+			MethodName: "RequeueCleanupTask",
+			Handler:    _DataSourceService_RequeueCleanupTask_Handler,
+		},
+		{
+			MethodName: "GetOrphanReport",
+			Handler:    _DataSourceService_GetOrphanReport_Handler,
+		},
+		{
+			MethodName: "UpdateTableTTL",
+			Handler:    _DataSourceService_UpdateTableTTL_Handler,
+		},
 		{
 			MethodName: "ImportData",
 			Handler:    _DataSourceService_ImportData_Handler,
 		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _DataSourceService_QueryAuditLog_Handler,
+		},
+		{
+			MethodName: "ProfileTable",
+			Handler:    _DataSourceService_ProfileTable_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1166,6 +1562,11 @@ var DataSourceService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _DataSourceService_ReadInternalData_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetBatchJobLogs",
+			Handler:       _DataSourceService_GetBatchJobLogs_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ReadDataSourceStreaming",
 			Handler:       _DataSourceService_ReadDataSourceStreaming_Handler,
@@ -1186,6 +1587,11 @@ var DataSourceService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _DataSourceService_Write_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ImportDataStream",
+			Handler:       _DataSourceService_ImportDataStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "proto/data_source.proto",
 }
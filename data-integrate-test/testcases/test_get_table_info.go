@@ -62,7 +62,7 @@ func (te *TestExecutor) testGetTableInfo(
 	}
 
 	// 验证行数
-	actualCount := int64(resp.RecordCount)
+	actualCount := resp.RecordCount64
 	validationResult, err := validator.ValidateReadResult(ctx, actualCount)
 	if err != nil {
 		result.Passed = false
@@ -77,7 +77,7 @@ func (te *TestExecutor) testGetTableInfo(
 	result.Diff = validationResult.Diff
 	result.DiffPercent = validationResult.DiffPercent
 	result.Message = fmt.Sprintf("表名: %s, 行数: %d, 大小: %d 字节, 列数: %d (调用耗时: %v)",
-		resp.TableName, resp.RecordCount, resp.RecordSize, len(resp.Columns), callDuration)
+		resp.TableName, resp.RecordCount64, resp.RecordSize, len(resp.Columns), callDuration)
 	result.EndTime = time.Now()
 	result.Duration = result.EndTime.Sub(result.StartTime)
 
@@ -0,0 +1,64 @@
+package common
+
+import "fmt"
+
+// ErrorTolerance 写入和导入的容错阈值，均为 0 时任一行出错即失败
+type ErrorTolerance struct {
+	MaxErrorRatio float64 // 被拒绝行占总行数的最大比例
+	MaxErrorRows  int64   // 被拒绝的最大行数，0 表示不按行数限制
+}
+
+// NewErrorTolerance 校验并创建容错阈值
+func NewErrorTolerance(maxErrorRatio float64, maxErrorRows int64) (ErrorTolerance, error) {
+	if maxErrorRatio < 0 || maxErrorRatio > 1 {
+		return ErrorTolerance{}, fmt.Errorf("invalid max error ratio %v, must be between 0 and 1", maxErrorRatio)
+	}
+	if maxErrorRows < 0 {
+		return ErrorTolerance{}, fmt.Errorf("invalid max error rows %d, must not be negative", maxErrorRows)
+	}
+	return ErrorTolerance{MaxErrorRatio: maxErrorRatio, MaxErrorRows: maxErrorRows}, nil
+}
+
+// Enabled 是否允许部分行被拒绝
+func (t ErrorTolerance) Enabled() bool {
+	return t.MaxErrorRatio > 0 || t.MaxErrorRows > 0
+}
+
+// FilterRatio 传给 Doris 的 max_filter_ratio；只限制行数时由 Doris 放行，导入后按行数校验
+func (t ErrorTolerance) FilterRatio() float64 {
+	switch {
+	case t.MaxErrorRatio > 0:
+		return t.MaxErrorRatio
+	case t.MaxErrorRows > 0:
+		return 1
+	}
+	return 0
+}
+
+// ExceedsRows 被拒绝行数是否超过行数阈值
+func (t ErrorTolerance) ExceedsRows(rejected int64) bool {
+	return t.MaxErrorRows > 0 && rejected > t.MaxErrorRows
+}
+
+// Check 校验被拒绝行数是否超出阈值，total 为参与导入的总行数
+func (t ErrorTolerance) Check(rejected, total int64) error {
+	if rejected == 0 {
+		return nil
+	}
+	if !t.Enabled() {
+		return fmt.Errorf("%d rows rejected, no error tolerance set", rejected)
+	}
+	if t.ExceedsRows(rejected) {
+		return fmt.Errorf("%d rows rejected, exceeds max error rows %d", rejected, t.MaxErrorRows)
+	}
+	if t.MaxErrorRatio > 0 && total > 0 && float64(rejected)/float64(total) > t.MaxErrorRatio {
+		return fmt.Errorf("%d of %d rows rejected, exceeds max error ratio %v", rejected, total, t.MaxErrorRatio)
+	}
+	return nil
+}
+
+// RejectSummary 一次写入或导入被拒绝的行
+type RejectSummary struct {
+	Rows   int64  // 被拒绝的行数
+	Object string // 被拒绝行及原因所在的对象，无拒绝行时为空
+}
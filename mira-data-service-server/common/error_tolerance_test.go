@@ -0,0 +1,63 @@
+package common
+
+import (
+	"testing"
+)
+
+func TestNewErrorTolerance(t *testing.T) {
+	if _, err := NewErrorTolerance(1.5, 0); err == nil {
+		t.Errorf("NewErrorTolerance() expected error for ratio > 1")
+	}
+	if _, err := NewErrorTolerance(-0.1, 0); err == nil {
+		t.Errorf("NewErrorTolerance() expected error for negative ratio")
+	}
+	if _, err := NewErrorTolerance(0, -1); err == nil {
+		t.Errorf("NewErrorTolerance() expected error for negative rows")
+	}
+	if tolerance, err := NewErrorTolerance(0, 0); err != nil || tolerance.Enabled() {
+		t.Errorf("NewErrorTolerance(0, 0) = %+v, %v, want disabled", tolerance, err)
+	}
+}
+
+func TestErrorToleranceFilterRatio(t *testing.T) {
+	tests := []struct {
+		tolerance ErrorTolerance
+		want      float64
+	}{
+		{ErrorTolerance{}, 0},
+		{ErrorTolerance{MaxErrorRatio: 0.1}, 0.1},
+		{ErrorTolerance{MaxErrorRows: 10}, 1},
+		{ErrorTolerance{MaxErrorRatio: 0.2, MaxErrorRows: 10}, 0.2},
+	}
+	for _, tt := range tests {
+		if got := tt.tolerance.FilterRatio(); got != tt.want {
+			t.Errorf("%+v.FilterRatio() = %v, want %v", tt.tolerance, got, tt.want)
+		}
+	}
+}
+
+func TestErrorToleranceCheck(t *testing.T) {
+	tests := []struct {
+		name      string
+		tolerance ErrorTolerance
+		rejected  int64
+		total     int64
+		wantErr   bool
+	}{
+		{"no rejects", ErrorTolerance{}, 0, 100, false},
+		{"rejects without tolerance", ErrorTolerance{}, 1, 100, true},
+		{"within rows", ErrorTolerance{MaxErrorRows: 5}, 5, 10, false},
+		{"exceeds rows", ErrorTolerance{MaxErrorRows: 5}, 6, 1000, true},
+		{"within ratio", ErrorTolerance{MaxErrorRatio: 0.1}, 10, 100, false},
+		{"exceeds ratio", ErrorTolerance{MaxErrorRatio: 0.1}, 11, 100, true},
+		{"ratio ok rows exceeded", ErrorTolerance{MaxErrorRatio: 0.5, MaxErrorRows: 2}, 3, 100, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tolerance.Check(tt.rejected, tt.total)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Check(%d, %d) error = %v, wantErr %v", tt.rejected, tt.total, err, tt.wantErr)
+			}
+		})
+	}
+}
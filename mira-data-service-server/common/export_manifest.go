@@ -0,0 +1,26 @@
+package common
+
+// JobExportManifest 单次导出的分片清单。每次导出写入 <jobInstanceId>/<label>/ 子目录，
+// 读取时只读取清单中的对象，同一作业并发读取同一张表时互不干扰
+type JobExportManifest struct {
+	JobInstanceId string
+	Label         string
+	Bucket        string
+	Format        FileFormat
+	Parts         []string // 按导出顺序排列的对象 key
+}
+
+// ExportJobPath 返回单次导出所在目录（不含桶名）
+func ExportJobPath(jobInstanceId, label string) string {
+	return jobInstanceId + "/" + label
+}
+
+// Dir 本次导出所在目录
+func (m *JobExportManifest) Dir() string {
+	return ExportJobPath(m.JobInstanceId, m.Label)
+}
+
+// ExportId 作业与导出标识，用于日志和本地临时文件命名
+func (m *JobExportManifest) ExportId() string {
+	return m.JobInstanceId + "_" + m.Label
+}
@@ -0,0 +1,15 @@
+package common
+
+import (
+	"testing"
+)
+
+func TestJobExportManifestPaths(t *testing.T) {
+	m := &JobExportManifest{JobInstanceId: "orders_3fa9c2e1", Label: "0a1b2c3d"}
+	if got := m.Dir(); got != "orders_3fa9c2e1/0a1b2c3d" {
+		t.Errorf("Dir() = %s", got)
+	}
+	if got := m.ExportId(); got != "orders_3fa9c2e1_0a1b2c3d" {
+		t.Errorf("ExportId() = %s", got)
+	}
+}
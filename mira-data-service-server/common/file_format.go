@@ -9,6 +9,13 @@
 */
 package common
 
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
 // FileFormat 文件格式枚举
 type FileFormat string
 
@@ -17,6 +24,7 @@ const (
 	FILE_FORMAT_ARROW   FileFormat = "arrow"   // Apache Arrow 格式
 	FILE_FORMAT_CSV     FileFormat = "csv"     // CSV 格式
 	FILE_FORMAT_JSON    FileFormat = "json"    // JSON 格式
+	FILE_FORMAT_JSONL   FileFormat = "jsonl"   // JSON Lines 格式
 	FILE_FORMAT_PARQUET FileFormat = "parquet" // Parquet 格式
 	FILE_FORMAT_ORC     FileFormat = "orc"     // ORC 格式
 	FILE_FORMAT_AVRO    FileFormat = "avro"    // Avro 格式
@@ -48,7 +56,7 @@ const (
 // IsValidFileFormat 检查文件格式是否有效
 func (ff FileFormat) IsValidFileFormat() bool {
 	switch ff {
-	case FILE_FORMAT_ARROW, FILE_FORMAT_CSV, FILE_FORMAT_JSON, FILE_FORMAT_PARQUET,
+	case FILE_FORMAT_ARROW, FILE_FORMAT_CSV, FILE_FORMAT_JSON, FILE_FORMAT_JSONL, FILE_FORMAT_PARQUET,
 		FILE_FORMAT_ORC, FILE_FORMAT_AVRO, FILE_FORMAT_EXCEL, FILE_FORMAT_XLS, FILE_FORMAT_XLSX,
 		FILE_FORMAT_XML, FILE_FORMAT_YAML, FILE_FORMAT_YML, FILE_FORMAT_TXT, FILE_FORMAT_TSV,
 		FILE_FORMAT_GZIP, FILE_FORMAT_ZIP, FILE_FORMAT_TAR, FILE_FORMAT_BINARY:
@@ -57,3 +65,128 @@ func (ff FileFormat) IsValidFileFormat() bool {
 		return false
 	}
 }
+
+// ParseExportFileFormat 解析 Doris 导出文件格式，为空时默认 parquet
+func ParseExportFileFormat(format string) (FileFormat, error) {
+	ff := FileFormat(strings.ToLower(strings.TrimSpace(format)))
+	if ff == "" {
+		return FILE_FORMAT_PARQUET, nil
+	}
+	if !ff.IsDorisExportFormat() {
+		return FILE_FORMAT_UNKNOWN, fmt.Errorf("unsupported export file format: %s", format)
+	}
+	return ff, nil
+}
+
+// exportCompressTypes Doris 导出 CSV 支持的压缩格式
+var exportCompressTypes = map[string]bool{
+	"plain":       true,
+	"gz":          true,
+	"bz2":         true,
+	"snappyblock": true,
+	"lz4block":    true,
+	"zstd":        true,
+}
+
+// ValidateExportCompressType 检查导出压缩格式，只有 CSV 支持压缩，为空表示不压缩
+func ValidateExportCompressType(compressType string, format FileFormat) error {
+	if compressType == "" {
+		return nil
+	}
+	if format != FILE_FORMAT_CSV {
+		return fmt.Errorf("compressType is only supported for csv, got %s", format)
+	}
+	if !exportCompressTypes[strings.ToLower(compressType)] {
+		return fmt.Errorf("unsupported compressType: %q", compressType)
+	}
+	return nil
+}
+
+// IsDorisExportFormat 检查是否为 Doris OUTFILE 支持写出的文件格式。
+// Doris 不能写出 arrow 文件；读取时导出分片总是转换为 Arrow 流返回，无需导出为 arrow
+func (ff FileFormat) IsDorisExportFormat() bool {
+	switch ff {
+	case FILE_FORMAT_PARQUET, FILE_FORMAT_ORC, FILE_FORMAT_CSV:
+		return true
+	default:
+		return false
+	}
+}
+
+// DorisFormatName 返回 Doris 导出语句中使用的格式名，CSV 导出时带表头
+func (ff FileFormat) DorisFormatName() string {
+	if ff == FILE_FORMAT_CSV {
+		return "csv_with_names"
+	}
+	return string(ff)
+}
+
+// FileExtension 返回导出分片文件的扩展名
+func (ff FileFormat) FileExtension() string {
+	return "." + string(ff)
+}
+
+// 结果接口各输出格式的 Content-Type
+var resultContentTypes = map[FileFormat]string{
+	FILE_FORMAT_CSV:     "text/csv",
+	FILE_FORMAT_JSONL:   "application/x-ndjson",
+	FILE_FORMAT_PARQUET: "application/vnd.apache.parquet",
+	FILE_FORMAT_ARROW:   "application/vnd.apache.arrow.stream",
+}
+
+// 结果接口可识别的 Accept 媒体类型
+var resultMediaTypes = map[string]FileFormat{
+	"text/csv":                            FILE_FORMAT_CSV,
+	"application/x-ndjson":                FILE_FORMAT_JSONL,
+	"application/jsonl":                   FILE_FORMAT_JSONL,
+	"application/json-lines":              FILE_FORMAT_JSONL,
+	"application/vnd.apache.parquet":      FILE_FORMAT_PARQUET,
+	"application/x-parquet":               FILE_FORMAT_PARQUET,
+	"application/vnd.apache.arrow.stream": FILE_FORMAT_ARROW,
+}
+
+// ResultContentType 返回结果接口输出格式的 Content-Type
+func (ff FileFormat) ResultContentType() string {
+	return resultContentTypes[ff]
+}
+
+// NegotiateResultFormat 按 Accept 头选择结果输出格式，按 q 值从高到低取第一个支持的类型，
+// 为空或只接受 */* 时默认 CSV
+func NegotiateResultFormat(accept string) (FileFormat, error) {
+	if strings.TrimSpace(accept) == "" {
+		return FILE_FORMAT_CSV, nil
+	}
+
+	type candidate struct {
+		mediaType string
+		q         float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(accept, ",") {
+		params := strings.Split(part, ";")
+		c := candidate{mediaType: strings.ToLower(strings.TrimSpace(params[0])), q: 1}
+		for _, param := range params[1:] {
+			if v, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				if q, err := strconv.ParseFloat(v, 64); err == nil {
+					c.q = q
+				}
+			}
+		}
+		if c.q > 0 {
+			candidates = append(candidates, c)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+
+	for _, c := range candidates {
+		if ff, ok := resultMediaTypes[c.mediaType]; ok {
+			return ff, nil
+		}
+		if c.mediaType == "*/*" || c.mediaType == "text/*" {
+			return FILE_FORMAT_CSV, nil
+		}
+	}
+	return FILE_FORMAT_UNKNOWN, fmt.Errorf("unsupported result media type: %s", accept)
+}
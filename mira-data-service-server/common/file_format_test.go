@@ -0,0 +1,103 @@
+package common
+
+import (
+	"testing"
+)
+
+func TestParseExportFileFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    FileFormat
+		wantErr bool
+	}{
+		{name: "Empty defaults to parquet", input: "", want: FILE_FORMAT_PARQUET},
+		{name: "Parquet", input: "parquet", want: FILE_FORMAT_PARQUET},
+		{name: "ORC upper case", input: "ORC", want: FILE_FORMAT_ORC},
+		{name: "CSV with spaces", input: " csv ", want: FILE_FORMAT_CSV},
+		{name: "Arrow not writable by Doris export", input: "arrow", want: FILE_FORMAT_UNKNOWN, wantErr: true},
+		{name: "JSON not supported by Doris export", input: "json", want: FILE_FORMAT_UNKNOWN, wantErr: true},
+		{name: "Unknown format", input: "foo", want: FILE_FORMAT_UNKNOWN, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseExportFileFormat(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseExportFileFormat(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseExportFileFormat(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateExportCompressType(t *testing.T) {
+	tests := []struct {
+		name         string
+		compressType string
+		format       FileFormat
+		wantErr      bool
+	}{
+		{name: "Empty", compressType: "", format: FILE_FORMAT_PARQUET},
+		{name: "Gzip csv", compressType: "gz", format: FILE_FORMAT_CSV},
+		{name: "Upper case", compressType: "ZSTD", format: FILE_FORMAT_CSV},
+		{name: "Parquet not compressible", compressType: "gz", format: FILE_FORMAT_PARQUET, wantErr: true},
+		{name: "Unknown", compressType: "rar", format: FILE_FORMAT_CSV, wantErr: true},
+		{name: "Quote injection", compressType: `gz", "s3.endpoint" = "evil`, format: FILE_FORMAT_CSV, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateExportCompressType(tt.compressType, tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateExportCompressType(%q, %v) error = %v, wantErr %v", tt.compressType, tt.format, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFileFormatDorisFormatName(t *testing.T) {
+	if got := FILE_FORMAT_CSV.DorisFormatName(); got != "csv_with_names" {
+		t.Errorf("DorisFormatName() = %v, want csv_with_names", got)
+	}
+	if got := FILE_FORMAT_ORC.DorisFormatName(); got != "orc" {
+		t.Errorf("DorisFormatName() = %v, want orc", got)
+	}
+	if got := FILE_FORMAT_ARROW.FileExtension(); got != ".arrow" {
+		t.Errorf("FileExtension() = %v, want .arrow", got)
+	}
+}
+
+func TestNegotiateResultFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		accept  string
+		want    FileFormat
+		wantErr bool
+	}{
+		{name: "Empty defaults to csv", accept: "", want: FILE_FORMAT_CSV},
+		{name: "Wildcard", accept: "*/*", want: FILE_FORMAT_CSV},
+		{name: "JSON Lines", accept: "application/x-ndjson", want: FILE_FORMAT_JSONL},
+		{name: "Parquet with params", accept: "application/vnd.apache.parquet; charset=binary", want: FILE_FORMAT_PARQUET},
+		{name: "Highest q wins", accept: "text/csv;q=0.5, application/vnd.apache.arrow.stream;q=0.9", want: FILE_FORMAT_ARROW},
+		{name: "Unsupported skipped", accept: "application/xml, application/jsonl;q=0.1", want: FILE_FORMAT_JSONL},
+		{name: "q=0 excluded", accept: "text/csv;q=0", want: FILE_FORMAT_UNKNOWN, wantErr: true},
+		{name: "Unsupported", accept: "application/xml", want: FILE_FORMAT_UNKNOWN, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NegotiateResultFormat(tt.accept)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NegotiateResultFormat(%q) error = %v, wantErr %v", tt.accept, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NegotiateResultFormat(%q) = %v, want %v", tt.accept, got, tt.want)
+			}
+		})
+	}
+}
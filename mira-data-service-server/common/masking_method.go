@@ -0,0 +1,22 @@
+package common
+
+// MaskingMethod 列脱敏方式
+type MaskingMethod string
+
+const (
+	MaskingMethodRedact   MaskingMethod = "redact"   // 整体替换为固定掩码
+	MaskingMethodPartial  MaskingMethod = "partial"  // 保留首尾部分字符，其余掩码
+	MaskingMethodHash     MaskingMethod = "hash"     // 加盐哈希（sha256/sm3）
+	MaskingMethodTokenize MaskingMethod = "tokenize" // 格式保留令牌化
+	MaskingMethodNull     MaskingMethod = "null"     // 置空
+)
+
+// IsValid 检查脱敏方式是否有效
+func (m MaskingMethod) IsValid() bool {
+	switch m {
+	case MaskingMethodRedact, MaskingMethodPartial, MaskingMethodHash, MaskingMethodTokenize, MaskingMethodNull:
+		return true
+	default:
+		return false
+	}
+}
@@ -4,10 +4,11 @@ package common
 type TaskStatus string
 
 const (
-	TaskStatusPending   TaskStatus = "pending"   // 待执行
-	TaskStatusRunning   TaskStatus = "running"   // 执行中
-	TaskStatusCompleted TaskStatus = "completed" // 已完成
-	TaskStatusFailed    TaskStatus = "failed"    // 失败
+	TaskStatusPending    TaskStatus = "pending"     // 待执行
+	TaskStatusRunning    TaskStatus = "running"     // 执行中
+	TaskStatusCompleted  TaskStatus = "completed"   // 已完成
+	TaskStatusFailed     TaskStatus = "failed"      // 失败
+	TaskStatusDeadLetter TaskStatus = "dead_letter" // 重试耗尽，等待人工重新入队
 )
 
 // String 实现Stringer接口
@@ -18,7 +19,7 @@ func (s TaskStatus) String() string {
 // IsValid 检查状态是否有效
 func (s TaskStatus) IsValid() bool {
 	switch s {
-	case TaskStatusPending, TaskStatusRunning, TaskStatusCompleted, TaskStatusFailed:
+	case TaskStatusPending, TaskStatusRunning, TaskStatusCompleted, TaskStatusFailed, TaskStatusDeadLetter:
 		return true
 	default:
 		return false
@@ -32,5 +33,6 @@ func GetTaskStatusList() []TaskStatus {
 		TaskStatusRunning,
 		TaskStatusCompleted,
 		TaskStatusFailed,
+		TaskStatusDeadLetter,
 	}
 }
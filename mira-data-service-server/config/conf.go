@@ -102,6 +102,21 @@ type DataServiceConf struct {
 	RedisConfig       RedisConfig       `yaml:"redis"`
 	DorisConfig       DorisConfig       `yaml:"doris"`
 	StreamConfig      StreamConfig      `yaml:"stream"`
+	// 外部导出目标凭证，key 为导出请求中的 credentialRef
+	ExportCredentials map[string]ExportCredentialConfig `yaml:"export_credentials"`
+	MaskingConfig     MaskingConfig                     `yaml:"masking"`
+	AuditConfig       AuditConfig                       `yaml:"audit"`
+	ProfileConfig     ProfileConfig                     `yaml:"profile"`
+	CleanupWorker     CleanupWorkerConfig               `yaml:"cleanup_worker"`
+	OrphanReconciler  OrphanReconcilerConfig            `yaml:"orphan_reconciler"`
+	BatchExecutor     BatchExecutorConfig               `yaml:"batch_executor"`
+	ResultDelivery    ResultDeliveryConfig              `yaml:"result_delivery"`
+	JobCallback       JobCallbackConfig                 `yaml:"job_callback"`
+	DistributedLock   DistributedLockConfig             `yaml:"distributed_lock"`
+	AdaptiveBatch     AdaptiveBatchConfig               `yaml:"adaptive_batch"`
+	BulkLoad          BulkLoadConfig                    `yaml:"bulk_load"`
+	StreamLoad        StreamLoadConfig                  `yaml:"stream_load"`
+	TableTTL          TableTTLConfig                    `yaml:"table_ttl"`
 }
 
 type DbmsConfig struct {
@@ -144,8 +159,9 @@ type DorisConfig struct {
 	MaxIdleTime  int `yaml:"max_idle_time"`  // 空闲连接最大空闲时间（分钟）
 	QueryTimeout int `yaml:"query_timeout"`  // 查询超时时间（秒）
 	// 导入相关配置
-	ImportBatchSize int `yaml:"import_batch_size"` // 分批导入每批的条数
-	ImportMaxRetry  int `yaml:"import_max_retry"`  // 导入失败重试次数
+	ImportBatchSize   int `yaml:"import_batch_size"`  // 分批导入每批的条数
+	ImportMaxRetry    int `yaml:"import_max_retry"`   // 导入失败重试次数
+	ImportConcurrency int `yaml:"import_concurrency"` // ImportData 并发导入的目标数
 	// s3导出配置
 	S3ExportMaxFileSize       string `yaml:"s3_export_max_file_size"`      // 单个导出文件大小
 	S3ExportRequestTimeout    int    `yaml:"s3_export_request_timeout"`    // 请求超时时间（秒）
@@ -159,6 +175,51 @@ type OSSConfig struct {
 	Port      int32  `yaml:"port"`
 	AccessKey string `yaml:"access_key"`
 	SecretKey string `yaml:"secret_key"`
+	Region    string `yaml:"region"` // S3 区域，Doris 导出时使用，默认 us-east-1
+}
+
+// ExportCredentialConfig 外部S3兼容对象存储的连接信息
+type ExportCredentialConfig struct {
+	Endpoint  string `yaml:"endpoint"` // host:port
+	AccessKey string `yaml:"access_key"`
+	SecretKey string `yaml:"secret_key"`
+	Region    string `yaml:"region"`
+	UseSSL    bool   `yaml:"use_ssl"`
+}
+
+// MaskingConfig 读取数据时的列脱敏配置
+type MaskingConfig struct {
+	Enabled         bool                  `yaml:"enabled"`
+	TokenKey        string                `yaml:"token_key"`        // 格式保留令牌化密钥
+	Salt            string                `yaml:"salt"`             // 哈希默认盐值
+	RefreshInterval int                   `yaml:"refresh_interval"` // 策略刷新间隔（秒）
+	Policies        []MaskingPolicyConfig `yaml:"policies"`
+}
+
+// MaskingPolicyConfig 单条脱敏策略，按资产和列匹配
+type MaskingPolicyConfig struct {
+	Asset      string `yaml:"asset"`  // 资产名或表名，* 表示所有资产
+	Column     string `yaml:"column"` // 列名
+	Method     string `yaml:"method"` // redact、partial、hash、tokenize、null
+	KeepPrefix int    `yaml:"keep_prefix"`
+	KeepSuffix int    `yaml:"keep_suffix"`
+	MaskChar   string `yaml:"mask_char"`
+	Algorithm  string `yaml:"algorithm"` // 哈希算法：sha256（默认）、sm3
+	Salt       string `yaml:"salt"`
+}
+
+// AuditConfig 数据访问审计配置
+type AuditConfig struct {
+	Enabled      bool   `yaml:"enabled"`
+	FilePath     string `yaml:"file_path"`     // JSONL 文件路径，为空不写文件
+	CallerHeader string `yaml:"caller_header"` // 调用方标识所在的 gRPC metadata 键，默认 x-caller-id
+}
+
+// ProfileConfig 数据质量画像配置
+type ProfileConfig struct {
+	CacheTTLSeconds int `yaml:"cache_ttl_seconds"` // 画像缓存有效期，默认一天
+	DefaultTopK     int `yaml:"default_top_k"`     // 默认高频值个数
+	LengthBuckets   int `yaml:"length_buckets"`    // 字符串长度分布的分桶数
 }
 
 type HttpServiceConfig struct {
@@ -215,6 +276,92 @@ type CleanupConfig struct {
 	Interval      int  `yaml:"interval"`
 }
 
+// CleanupWorkerConfig 清理任务后台重试配置
+type CleanupWorkerConfig struct {
+	Enabled             bool `yaml:"enabled"`
+	Workers             int  `yaml:"workers"`               // 并发执行的任务数
+	PollIntervalSeconds int  `yaml:"poll_interval_seconds"` // 拉取任务间隔
+	BatchSize           int  `yaml:"batch_size"`            // 每轮最多领取的任务数
+	LeaseSeconds        int  `yaml:"lease_seconds"`         // 任务租约时长，超时后可被其他副本领取
+	BaseBackoffSeconds  int  `yaml:"base_backoff_seconds"`  // 首次重试等待时间，之后指数增长
+	MaxBackoffSeconds   int  `yaml:"max_backoff_seconds"`   // 重试等待时间上限
+}
+
+// OrphanReconcilerConfig 孤儿资源巡检配置
+type OrphanReconcilerConfig struct {
+	Enabled            bool     `yaml:"enabled"`
+	DryRun             bool     `yaml:"dry_run"`             // 只记录不删除
+	IntervalMinutes    int      `yaml:"interval_minutes"`    // 巡检间隔
+	GracePeriodHours   int      `yaml:"grace_period_hours"`  // 孤儿资源超过该时长才会被删除
+	ProtectedDatabases []string `yaml:"protected_databases"` // 永不清理的库
+}
+
+// BatchExecutorConfig 批处理作业执行后端选择
+type BatchExecutorConfig struct {
+	Backend              string `yaml:"backend"`                  // spark/doris/auto，默认 spark
+	DorisMaxRecordCount  int64  `yaml:"doris_max_record_count"`   // auto 时不超过该行数的表在 Doris 中执行，0 表示不使用 Doris
+	DorisMaxRecordSizeMB int64  `yaml:"doris_max_record_size_mb"` // auto 时不超过该大小的表在 Doris 中执行，0 表示不限制
+}
+
+// ResultDeliveryConfig 计算结果投递到 Kafka/S3/webhook 的配置
+type ResultDeliveryConfig struct {
+	MaxRetries        int    `yaml:"max_retries"`         // 单次推送的最大重试次数
+	RetryDelaySeconds int    `yaml:"retry_delay_seconds"` // 重试基础间隔
+	TimeoutSeconds    int    `yaml:"timeout_seconds"`     // Kafka/webhook 单次请求超时
+	KafkaRestProxy    string `yaml:"kafka_rest_proxy"`    // Kafka REST Proxy 地址，如 http://kafka-rest:8082
+	WebhookSecret     string `yaml:"webhook_secret"`      // webhook 请求体 HMAC-SHA256 签名密钥，为空时不签名
+	// webhook 允许投递的主机：主机名、主机名:端口或 *.example.com，为空时不允许 webhook 投递
+	WebhookAllowedHosts []string `yaml:"webhook_allowed_hosts"`
+}
+
+// JobCallbackConfig Spark 作业完成回调配置
+type JobCallbackConfig struct {
+	WaitTimeoutSeconds int `yaml:"wait_timeout_seconds"` // Pod 成功结束后等待回调的时长，0 表示不等待
+}
+
+// DistributedLockConfig 多副本部署时导入和表操作使用的分布式锁配置
+type DistributedLockConfig struct {
+	Backend            string `yaml:"backend"`              // redis/db/local，为空时使用进程内锁
+	TTLSeconds         int    `yaml:"ttl_seconds"`          // 租约时长，持有期间自动续租
+	RetryIntervalMs    int    `yaml:"retry_interval_ms"`    // 锁被占用时的重试间隔
+	WaitTimeoutSeconds int    `yaml:"wait_timeout_seconds"` // 获取锁的最长等待时间，0 表示不限制
+}
+
+// AdaptiveBatchConfig Arrow 数据写入数据库时按内存预算、目标库包大小和写入耗时自适应调整批次行数
+type AdaptiveBatchConfig struct {
+	Enabled         bool `yaml:"enabled"`           // 关闭时按 dbms.batch_data_size 固定批次写入
+	MemoryBudgetMB  int  `yaml:"memory_budget_mb"`  // 单批数据按 Arrow 缓冲区大小计算的内存上限
+	MaxPacketMB     int  `yaml:"max_packet_mb"`     // 无法查询目标库 max_allowed_packet 时单条语句的上限，0 不限制
+	TargetLatencyMs int  `yaml:"target_latency_ms"` // 单批写入的目标耗时，超过时减小批次，远低于时增大批次
+	MinRows         int  `yaml:"min_rows"`          // 批次行数下限
+	MaxRows         int  `yaml:"max_rows"`          // 批次行数上限
+	HeapLimitMB     int  `yaml:"heap_limit_mb"`     // 进程堆内存超过该值时批次减半，0 不检查
+}
+
+// BulkLoadConfig 外部库写入使用原生批量导入（LOAD DATA、COPY 等），不支持时回退到 INSERT
+type BulkLoadConfig struct {
+	Enabled            bool `yaml:"enabled"`              // 关闭时始终使用多行 INSERT
+	StageExpireSeconds int  `yaml:"stage_expire_seconds"` // GBase 8a 从对象存储拉取中转文件的链接有效期
+	GBaseStageEnabled  bool `yaml:"gbase_stage_enabled"`  // GBase 8a 经对象存储预签名链接中转导入，链接会出现在 SQL 日志中，默认关闭
+}
+
+// StreamLoadConfig 数据通过 Doris Stream Load HTTP 分块传输直接写入，不落本地文件
+type StreamLoadConfig struct {
+	Enabled        bool `yaml:"enabled"`         // 关闭时 Write 先落本地 parquet 文件再导入
+	CsvEnabled     bool `yaml:"csv_enabled"`     // ImportCsvFileToDoris 走 Stream Load，关闭时使用 doris-streamloader
+	HTTPPort       int  `yaml:"http_port"`       // FE HTTP 端口
+	BatchMB        int  `yaml:"batch_mb"`        // 单次 Stream Load 的数据量，超过时拆成多批，两阶段提交后统一生效
+	TimeoutSeconds int  `yaml:"timeout_seconds"` // 单批导入超时
+}
+
+// TableTTLConfig 服务创建的临时表和库的生命周期配置，过期后由调度器删除
+type TableTTLConfig struct {
+	Enabled         bool `yaml:"enabled"`
+	DefaultTTLHours int  `yaml:"default_ttl_hours"` // 新建表的默认存活时长，0 时使用 clean_table.retention_days
+	IntervalMinutes int  `yaml:"interval_minutes"`  // 过期检查间隔
+	BatchSize       int  `yaml:"batch_size"`        // 每轮最多删除的表数
+}
+
 type StreamConfig struct {
 	BatchLines       int `yaml:"batch_lines"`
 	ParquetBatchSize int `yaml:"parquet_batch_size"`
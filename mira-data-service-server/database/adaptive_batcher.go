@@ -0,0 +1,230 @@
+package database
+
+import (
+	"database/sql"
+	"runtime/metrics"
+	"sync"
+	"time"
+
+	"data-service/common"
+	"data-service/config"
+	pb "data-service/generated/datasource"
+	log "data-service/log"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/util"
+)
+
+// 批次行数的调整原因
+const (
+	BatchReasonInitial = "initial" // 初始值
+	BatchReasonMemory  = "memory"  // 受内存预算限制
+	BatchReasonPacket  = "packet"  // 受目标库单条语句大小限制
+	BatchReasonLatency = "latency" // 按写入耗时调整
+	BatchReasonHeap    = "heap"    // 进程堆内存超限
+	BatchReasonMax     = "max"     // 达到配置上限
+)
+
+// packetHeadroom 单批 Arrow 数据最多占目标库包大小的比例，余量留给 SQL 文本和转义
+const packetHeadroom = 0.5
+
+// rowBytesWeight 平均行大小的滑动平均权重
+const rowBytesWeight = 0.3
+
+// BatchMetrics 自适应批次指标
+type BatchMetrics interface {
+	// SetBatchRows 记录批次行数的调整及原因
+	SetBatchRows(dbType, reason string, rows int)
+	// ObserveBatch 记录一批数据的行数、Arrow 字节数和写入耗时
+	ObserveBatch(dbType string, rows, bytes int64, elapsed time.Duration)
+}
+
+var (
+	batchMetricsMu sync.RWMutex
+	batchMetrics   BatchMetrics
+)
+
+// SetBatchMetrics 设置自适应批次指标
+func SetBatchMetrics(m BatchMetrics) {
+	batchMetricsMu.Lock()
+	defer batchMetricsMu.Unlock()
+	batchMetrics = m
+}
+
+func getBatchMetrics() BatchMetrics {
+	batchMetricsMu.RLock()
+	defer batchMetricsMu.RUnlock()
+	return batchMetrics
+}
+
+// AdaptiveBatcher 决定 Arrow 数据写入时每批的行数
+// 行大小取自实际 Arrow 缓冲区，批次同时受内存预算、目标库包大小限制，并按每批写入耗时和进程堆内存增减
+type AdaptiveBatcher struct {
+	conf        config.AdaptiveBatchConfig
+	dbType      string
+	maxPacket   int64   // 单条语句最大字节数，0 不限制
+	rowBytes    float64 // 平均行大小
+	latencyRows int     // 按写入耗时和堆内存调整出的行数
+	latencyWhy  string  // latencyRows 的调整原因
+	batchRows   int
+	reason      string
+	heapBytes   func() uint64
+}
+
+// NewAdaptiveBatcher 创建批次决策器，初始批次为 initialRows；未启用时始终返回 initialRows
+func NewAdaptiveBatcher(conf config.AdaptiveBatchConfig, dbType pb.DataSourceType, maxPacket int64, initialRows int) *AdaptiveBatcher {
+	if conf.MinRows <= 0 {
+		conf.MinRows = 1
+	}
+	if conf.MaxRows > 0 && conf.MaxRows < conf.MinRows {
+		conf.MaxRows = conf.MinRows
+	}
+	if initialRows <= 0 {
+		initialRows = conf.MinRows
+	}
+	b := &AdaptiveBatcher{
+		conf:        conf,
+		dbType:      dbType.String(),
+		maxPacket:   maxPacket,
+		latencyRows: initialRows,
+		latencyWhy:  BatchReasonInitial,
+		batchRows:   initialRows,
+		reason:      BatchReasonInitial,
+		heapBytes:   heapObjectBytes,
+	}
+	if conf.Enabled {
+		b.recompute()
+	}
+	return b
+}
+
+// BatchRows 当前每批行数
+func (b *AdaptiveBatcher) BatchRows() int {
+	return b.batchRows
+}
+
+// Reason 当前批次行数的决定原因
+func (b *AdaptiveBatcher) Reason() string {
+	return b.reason
+}
+
+// ObserveRecord 按 Arrow 记录的缓冲区大小更新平均行大小，需在切片前传入完整记录
+func (b *AdaptiveBatcher) ObserveRecord(record arrow.Record) {
+	if !b.conf.Enabled || record.NumRows() == 0 {
+		return
+	}
+	perRow := float64(util.TotalRecordSize(record)) / float64(record.NumRows())
+	if b.rowBytes == 0 {
+		b.rowBytes = perRow
+	} else {
+		b.rowBytes = rowBytesWeight*perRow + (1-rowBytesWeight)*b.rowBytes
+	}
+	b.recompute()
+}
+
+// RecordBytes 按平均行大小估算 rows 行的字节数
+func (b *AdaptiveBatcher) RecordBytes(rows int64) int64 {
+	return int64(b.rowBytes * float64(rows))
+}
+
+// ObserveBatch 记录一批写入的耗时：超过目标耗时减半，低于目标一半时增加四分之一；堆内存超限时减半
+func (b *AdaptiveBatcher) ObserveBatch(rows int64, elapsed time.Duration) {
+	if m := getBatchMetrics(); m != nil {
+		m.ObserveBatch(b.dbType, rows, b.RecordBytes(rows), elapsed)
+	}
+	if !b.conf.Enabled || rows <= 0 {
+		return
+	}
+
+	target := time.Duration(b.conf.TargetLatencyMs) * time.Millisecond
+	reason := ""
+	if b.conf.HeapLimitMB > 0 && b.heapBytes() > uint64(b.conf.HeapLimitMB)<<20 {
+		b.latencyRows = b.batchRows / 2
+		reason = BatchReasonHeap
+	} else if target > 0 && elapsed > target {
+		b.latencyRows = b.batchRows / 2
+		reason = BatchReasonLatency
+	} else if target > 0 && elapsed < target/2 && int(rows) >= b.batchRows {
+		// 只有整批写入才能说明还有余量，最后一批不足时不增大
+		b.latencyRows = b.batchRows + b.batchRows/4 + 1
+		reason = BatchReasonLatency
+	}
+	if reason == "" {
+		return
+	}
+	if b.latencyRows < b.conf.MinRows {
+		b.latencyRows = b.conf.MinRows
+	}
+	b.latencyWhy = reason
+	b.recompute()
+}
+
+// recompute 取各项限制中最小的行数作为批次行数
+func (b *AdaptiveBatcher) recompute() {
+	rows, reason := b.latencyRows, b.latencyWhy
+	if b.rowBytes > 0 {
+		if b.conf.MemoryBudgetMB > 0 {
+			if limit := int(float64(int64(b.conf.MemoryBudgetMB)<<20) / b.rowBytes); limit < rows {
+				rows, reason = limit, BatchReasonMemory
+			}
+		}
+		if b.maxPacket > 0 {
+			if limit := int(float64(b.maxPacket) * packetHeadroom / b.rowBytes); limit < rows {
+				rows, reason = limit, BatchReasonPacket
+			}
+		}
+	}
+	if b.conf.MaxRows > 0 && rows > b.conf.MaxRows {
+		rows, reason = b.conf.MaxRows, BatchReasonMax
+	}
+	if rows < b.conf.MinRows {
+		rows = b.conf.MinRows
+	}
+	if rows == b.batchRows && reason == b.reason {
+		return
+	}
+	log.Logger.Debugf("Adjust %s batch rows %d -> %d (%s), avg row bytes %.0f", b.dbType, b.batchRows, rows, reason, b.rowBytes)
+	b.batchRows, b.reason = rows, reason
+	if m := getBatchMetrics(); m != nil {
+		m.SetBatchRows(b.dbType, reason, rows)
+	}
+}
+
+// heapObjectBytes 当前堆上存活对象占用的字节数，不触发 STW
+func heapObjectBytes() uint64 {
+	sample := []metrics.Sample{{Name: "/memory/classes/heap/objects:bytes"}}
+	metrics.Read(sample)
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return sample[0].Value.Uint64()
+}
+
+// queryMaxPacketBytes 查询目标库单条语句的大小上限，MySQL 协议的库取 max_allowed_packet，
+// 其他库或查询失败时使用配置值
+func queryMaxPacketBytes(db *sql.DB, dbType pb.DataSourceType, conf config.AdaptiveBatchConfig) int64 {
+	fallback := int64(conf.MaxPacketMB) << 20
+	switch dbType {
+	case pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL, pb.DataSourceType_DATA_SOURCE_TYPE_TIDB,
+		pb.DataSourceType_DATA_SOURCE_TYPE_TDSQL, pb.DataSourceType_DATA_SOURCE_TYPE_GBASE,
+		pb.DataSourceType_DATA_SOURCE_TYPE_DORIS:
+	default:
+		return fallback
+	}
+	var maxPacket int64
+	if err := db.QueryRow("SELECT @@max_allowed_packet").Scan(&maxPacket); err != nil || maxPacket <= 0 {
+		log.Logger.Warnf("Failed to query max_allowed_packet, use %d bytes: %v", fallback, err)
+		return fallback
+	}
+	return maxPacket
+}
+
+// newInsertBatcher 按配置为一次 INSERT 写入创建批次决策器，初始批次为 dbms.batch_data_size
+func newInsertBatcher(db *sql.DB, dbType pb.DataSourceType) *AdaptiveBatcher {
+	conf := config.GetConfigMap().AdaptiveBatch
+	var maxPacket int64
+	if conf.Enabled {
+		maxPacket = queryMaxPacketBytes(db, dbType, conf)
+	}
+	return NewAdaptiveBatcher(conf, dbType, maxPacket, common.BATCH_DATA_SIZE)
+}
@@ -0,0 +1,165 @@
+package database
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"data-service/config"
+	ds "data-service/generated/datasource"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/apache/arrow/go/v15/arrow/util"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingBatchMetrics struct {
+	reasons []string
+	rows    []int
+	batches int
+}
+
+func (m *recordingBatchMetrics) SetBatchRows(dbType, reason string, rows int) {
+	m.reasons = append(m.reasons, reason)
+	m.rows = append(m.rows, rows)
+}
+
+func (m *recordingBatchMetrics) ObserveBatch(dbType string, rows, bytes int64, elapsed time.Duration) {
+	m.batches++
+}
+
+// newWideRecord 创建 rows 行、每行一个 width 字节字符串的记录
+func newWideRecord(t *testing.T, rows, width int) arrow.Record {
+	schema := arrow.NewSchema([]arrow.Field{{Name: "payload", Type: arrow.BinaryTypes.String}}, nil)
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+	value := strings.Repeat("x", width)
+	for i := 0; i < rows; i++ {
+		builder.Field(0).(*array.StringBuilder).Append(value)
+	}
+	record := builder.NewRecord()
+	t.Cleanup(record.Release)
+	return record
+}
+
+func testBatchConfig() config.AdaptiveBatchConfig {
+	return config.AdaptiveBatchConfig{
+		Enabled:         true,
+		MemoryBudgetMB:  1,
+		TargetLatencyMs: 1000,
+		MinRows:         10,
+		MaxRows:         100000,
+	}
+}
+
+func TestAdaptiveBatcher_MemoryBudget(t *testing.T) {
+	metrics := &recordingBatchMetrics{}
+	SetBatchMetrics(metrics)
+	defer SetBatchMetrics(nil)
+
+	b := NewAdaptiveBatcher(testBatchConfig(), ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE, 0, 20000)
+	assert.Equal(t, 20000, b.BatchRows())
+
+	record := newWideRecord(t, 100, 4096)
+	b.ObserveRecord(record)
+	rowBytes := float64(util.TotalRecordSize(record)) / 100
+	assert.Equal(t, int(float64(1<<20)/rowBytes), b.BatchRows())
+	assert.Equal(t, BatchReasonMemory, b.Reason())
+	assert.Equal(t, []string{BatchReasonMemory}, metrics.reasons)
+}
+
+func TestAdaptiveBatcher_PacketLimit(t *testing.T) {
+	conf := testBatchConfig()
+	conf.MemoryBudgetMB = 0
+	b := NewAdaptiveBatcher(conf, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, 1<<20, 20000)
+
+	record := newWideRecord(t, 100, 1024)
+	b.ObserveRecord(record)
+	rowBytes := float64(util.TotalRecordSize(record)) / 100
+	assert.Equal(t, int(float64(1<<20)*packetHeadroom/rowBytes), b.BatchRows())
+	assert.Equal(t, BatchReasonPacket, b.Reason())
+}
+
+func TestAdaptiveBatcher_NarrowRowsUseMaxRows(t *testing.T) {
+	conf := testBatchConfig()
+	conf.MemoryBudgetMB = 256
+	conf.MaxRows = 50000
+	b := NewAdaptiveBatcher(conf, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, 64<<20, 100000)
+
+	b.ObserveRecord(newWideRecord(t, 100, 8))
+	assert.Equal(t, 50000, b.BatchRows())
+	assert.Equal(t, BatchReasonMax, b.Reason())
+}
+
+func TestAdaptiveBatcher_Latency(t *testing.T) {
+	metrics := &recordingBatchMetrics{}
+	SetBatchMetrics(metrics)
+	defer SetBatchMetrics(nil)
+
+	b := NewAdaptiveBatcher(testBatchConfig(), ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, 0, 1000)
+
+	// 慢批次减半
+	b.ObserveBatch(1000, 3*time.Second)
+	assert.Equal(t, 500, b.BatchRows())
+	assert.Equal(t, BatchReasonLatency, b.Reason())
+
+	// 快速写完整批后增加四分之一
+	b.ObserveBatch(500, 100*time.Millisecond)
+	assert.Equal(t, 626, b.BatchRows())
+
+	// 不足一批的最后一批不增大
+	b.ObserveBatch(100, 100*time.Millisecond)
+	assert.Equal(t, 626, b.BatchRows())
+
+	// 不低于下限
+	for i := 0; i < 20; i++ {
+		b.ObserveBatch(int64(b.BatchRows()), 5*time.Second)
+	}
+	assert.Equal(t, 10, b.BatchRows())
+	assert.Equal(t, 23, metrics.batches)
+}
+
+func TestAdaptiveBatcher_HeapLimit(t *testing.T) {
+	conf := testBatchConfig()
+	conf.HeapLimitMB = 100
+	b := NewAdaptiveBatcher(conf, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, 0, 1000)
+	b.heapBytes = func() uint64 { return 200 << 20 }
+
+	b.ObserveBatch(1000, 10*time.Millisecond)
+	assert.Equal(t, 500, b.BatchRows())
+	assert.Equal(t, BatchReasonHeap, b.Reason())
+}
+
+func TestAdaptiveBatcher_Disabled(t *testing.T) {
+	conf := testBatchConfig()
+	conf.Enabled = false
+	b := NewAdaptiveBatcher(conf, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, 1024, 20000)
+
+	b.ObserveRecord(newWideRecord(t, 10, 4096))
+	b.ObserveBatch(20000, time.Minute)
+	assert.Equal(t, 20000, b.BatchRows())
+	assert.Equal(t, BatchReasonInitial, b.Reason())
+}
+
+func TestQueryMaxPacketBytes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock database: %v", err)
+	}
+	defer db.Close()
+	conf := config.AdaptiveBatchConfig{MaxPacketMB: 16}
+
+	mock.ExpectQuery(`SELECT @@max_allowed_packet`).WillReturnRows(sqlmock.NewRows([]string{"@@max_allowed_packet"}).AddRow(67108864))
+	assert.Equal(t, int64(67108864), queryMaxPacketBytes(db, ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL, conf))
+
+	mock.ExpectQuery(`SELECT @@max_allowed_packet`).WillReturnError(errors.New("denied"))
+	assert.Equal(t, int64(16<<20), queryMaxPacketBytes(db, ds.DataSourceType_DATA_SOURCE_TYPE_DORIS, conf))
+
+	// PostgreSQL 协议的库不查询
+	assert.Equal(t, int64(16<<20), queryMaxPacketBytes(db, ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE, conf))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,508 @@
+package database
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"data-service/common"
+	"data-service/config"
+	pb "data-service/generated/datasource"
+	log "data-service/log"
+	"data-service/oss"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+)
+
+// ErrBulkLoadUnavailable 目标库当前不支持批量导入（如未开启 local_infile），调用方应改用 INSERT
+var ErrBulkLoadUnavailable = errors.New("bulk load unavailable")
+
+// arrowChunkRows 从 Arrow 记录逐行读取时每次展开的行数
+const arrowChunkRows = 1024
+
+// bulkLoadStagePrefix GBase 8a 中转文件在对象存储中的前缀
+const bulkLoadStagePrefix = "bulk_load"
+
+// RowSource 按行提供批量导入的数据，值为 nil 表示 NULL，读完返回 io.EOF
+type RowSource interface {
+	Next() ([]interface{}, error)
+}
+
+// AsBulkLoader 返回策略的批量导入能力，策略未实现或配置关闭时 ok 为 false
+func AsBulkLoader(strategy DatabaseStrategy) (BulkLoader, bool) {
+	if !config.GetConfigMap().BulkLoad.Enabled {
+		return nil, false
+	}
+	loader, ok := strategy.(BulkLoader)
+	return loader, ok
+}
+
+// bulkLoaderForDB 按数据库类型取已有连接对应策略的批量导入能力
+func bulkLoaderForDB(db *sql.DB, dbType pb.DataSourceType) (BulkLoader, bool) {
+	var strategy DatabaseStrategy
+	switch dbType {
+	case pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL, pb.DataSourceType_DATA_SOURCE_TYPE_TIDB,
+		pb.DataSourceType_DATA_SOURCE_TYPE_TDSQL:
+		strategy = &MySQLStrategy{DB: db}
+	case pb.DataSourceType_DATA_SOURCE_TYPE_KINGBASE:
+		strategy = &KingbaseStrategy{DB: db}
+	case pb.DataSourceType_DATA_SOURCE_TYPE_VASTBASE:
+		strategy = &VastbaseStrategy{DB: db}
+	case pb.DataSourceType_DATA_SOURCE_TYPE_GBASE:
+		strategy = &GBaseStrategy{DB: db}
+	default:
+		return nil, false
+	}
+	return AsBulkLoader(strategy)
+}
+
+// bulkLoadArrowData 以批量导入方式写入 Arrow 数据，不支持时返回 ErrBulkLoadUnavailable 且未读取数据
+func bulkLoadArrowData(db *sql.DB, tableName string, schema *arrow.Schema, ipcReader *ipc.Reader, dbType pb.DataSourceType) error {
+	loader, ok := bulkLoaderForDB(db, dbType)
+	if !ok {
+		return ErrBulkLoadUnavailable
+	}
+	columns := make([]string, schema.NumFields())
+	for i, field := range schema.Fields() {
+		columns[i] = field.Name
+	}
+	count, err := loader.BulkLoad(context.Background(), tableName, columns, newArrowRowSource(ipcReader, schema))
+	if err != nil {
+		return err
+	}
+	log.Logger.Infof("Data bulk loaded into %s, total row count: %d", tableName, count)
+	return nil
+}
+
+// arrowRowSource 逐行读取 Arrow IPC 数据，值的转换与 INSERT 写入一致
+type arrowRowSource struct {
+	reader    *ipc.Reader
+	numCols   int
+	extractor ArrowRowExtractor
+	record    arrow.Record
+	offset    int64
+	values    []interface{}
+}
+
+func newArrowRowSource(reader *ipc.Reader, schema *arrow.Schema) *arrowRowSource {
+	return &arrowRowSource{reader: reader, numCols: schema.NumFields()}
+}
+
+func (s *arrowRowSource) Next() ([]interface{}, error) {
+	for len(s.values) == 0 {
+		if s.record == nil || s.offset >= s.record.NumRows() {
+			if !s.reader.Next() {
+				if err := s.reader.Err(); err != nil {
+					return nil, fmt.Errorf("failed to read arrow record: %v", err)
+				}
+				return nil, io.EOF
+			}
+			s.record, s.offset = s.reader.Record(), 0
+			continue
+		}
+		// 每次只展开一小段，避免大记录一次性转换占用过多内存
+		end := s.offset + arrowChunkRows
+		if end > s.record.NumRows() {
+			end = s.record.NumRows()
+		}
+		slice := s.record.NewSlice(s.offset, end)
+		values, err := s.extractor.ExtractRowData(slice)
+		slice.Release()
+		if err != nil {
+			return nil, err
+		}
+		s.values, s.offset = values, end
+	}
+	row := s.values[:s.numCols:s.numCols]
+	s.values = s.values[s.numCols:]
+	return row, nil
+}
+
+// BulkLoadPipe 在后台执行批量导入，调用方按批推送数据，适用于回调式的数据来源
+type BulkLoadPipe struct {
+	batches chan [][]interface{}
+	done    chan struct{}
+	current [][]interface{}
+	cause   error
+	count   int64
+	err     error
+}
+
+// StartBulkLoad 在后台以 loader 向表 tableName 的 columns 列导入，数据通过 Write 推送，最后调用一次 Close
+func StartBulkLoad(ctx context.Context, loader BulkLoader, tableName string, columns []string) *BulkLoadPipe {
+	p := &BulkLoadPipe{batches: make(chan [][]interface{}), done: make(chan struct{})}
+	go func() {
+		defer close(p.done)
+		p.count, p.err = loader.BulkLoad(ctx, tableName, columns, p)
+	}()
+	return p
+}
+
+// Next 供后台导入逐行读取推送的数据
+func (p *BulkLoadPipe) Next() ([]interface{}, error) {
+	for len(p.current) == 0 {
+		batch, ok := <-p.batches
+		if !ok {
+			if p.cause != nil {
+				return nil, p.cause
+			}
+			return nil, io.EOF
+		}
+		p.current = batch
+	}
+	row := p.current[0]
+	p.current = p.current[1:]
+	return row, nil
+}
+
+// Write 推送一批数据；返回 ErrBulkLoadUnavailable 时导入未读取任何数据，该批及后续数据可改用 INSERT 写入
+func (p *BulkLoadPipe) Write(rows [][]interface{}) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	select {
+	case p.batches <- rows:
+		return nil
+	case <-p.done:
+		if p.err == nil {
+			return errors.New("bulk load finished before all rows were written")
+		}
+		return p.err
+	}
+}
+
+// Close 结束推送并等待导入完成，返回写入行数；cause 不为空时中止导入，已导入的数据随事务回滚
+func (p *BulkLoadPipe) Close(cause error) (int64, error) {
+	p.cause = cause
+	close(p.batches)
+	<-p.done
+	return p.count, p.err
+}
+
+// textWriteResult 后台编码文本数据的结果
+type textWriteResult struct {
+	rows int64
+	err  error
+}
+
+// writeTextRows 把 rows 编码为制表符分隔、换行结尾的文本写入 w，转义规则与 LOAD DATA 默认一致
+func writeTextRows(w io.Writer, rows RowSource, numCols int) (int64, error) {
+	bw := bufio.NewWriterSize(w, 64*1024)
+	var buf []byte
+	var count int64
+	for {
+		values, err := rows.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return count, err
+		}
+		if len(values) != numCols {
+			return count, fmt.Errorf("row %d has %d values, expected %d columns", count+1, len(values), numCols)
+		}
+		buf = buf[:0]
+		for i, v := range values {
+			if i > 0 {
+				buf = append(buf, '\t')
+			}
+			buf = appendTextValue(buf, v)
+		}
+		buf = append(buf, '\n')
+		if _, err := bw.Write(buf); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, bw.Flush()
+}
+
+// appendTextValue 编码单个值：NULL 写作 \N，字符串中的反斜杠、制表符、换行、回车和 NUL 转义
+func appendTextValue(buf []byte, v interface{}) []byte {
+	switch val := v.(type) {
+	case nil:
+		return append(buf, `\N`...)
+	case string:
+		return appendEscapedText(buf, val)
+	case []byte:
+		return appendEscapedText(buf, string(val))
+	case bool:
+		if val {
+			return append(buf, '1')
+		}
+		return append(buf, '0')
+	case time.Time:
+		return val.AppendFormat(buf, "2006-01-02 15:04:05.999999")
+	case float32:
+		return strconv.AppendFloat(buf, float64(val), 'f', -1, 32)
+	case float64:
+		return strconv.AppendFloat(buf, val, 'f', -1, 64)
+	default:
+		return appendEscapedText(buf, fmt.Sprint(val))
+	}
+}
+
+func appendEscapedText(buf []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			buf = append(buf, '\\', '\\')
+		case '\t':
+			buf = append(buf, '\\', 't')
+		case '\n':
+			buf = append(buf, '\\', 'n')
+		case '\r':
+			buf = append(buf, '\\', 'r')
+		case 0:
+			buf = append(buf, '\\', '0')
+		default:
+			buf = append(buf, c)
+		}
+	}
+	return buf
+}
+
+// quoteIdent 用 quote 包裹标识符，已带引号或带库名前缀的名称保持原样
+func quoteIdent(name string, quote byte) string {
+	if strings.Contains(name, ".") || (len(name) >= 2 && name[0] == quote && name[len(name)-1] == quote) {
+		return name
+	}
+	q := string(quote)
+	return q + strings.ReplaceAll(name, q, q+q) + q
+}
+
+func quoteIdents(names []string, quote byte) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = quoteIdent(name, quote)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// BulkLoad 通过 LOAD DATA LOCAL INFILE 从内存管道流式导入，服务端未开启 local_infile 时返回 ErrBulkLoadUnavailable
+// 导入在事务内执行，数据读取失败时整体回滚。LOCAL 导入把重复键和类型转换错误降为警告，
+// 提交前检查 SHOW WARNINGS 和导入行数，有警告或行数不一致时回滚并返回错误
+func (m *MySQLStrategy) BulkLoad(ctx context.Context, tableName string, columns []string, rows RowSource) (int64, error) {
+	var localInfile int
+	if err := m.DB.QueryRowContext(ctx, "SELECT @@local_infile").Scan(&localInfile); err != nil {
+		log.Logger.Warnf("Failed to query local_infile, bulk load unavailable: %v", err)
+		return 0, ErrBulkLoadUnavailable
+	}
+	if localInfile == 0 {
+		log.Logger.Infof("MySQL local_infile is disabled, bulk load unavailable")
+		return 0, ErrBulkLoadUnavailable
+	}
+
+	tx, err := m.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	pr, pw := io.Pipe()
+	handler := "bulk_load_" + uuid.NewString()
+	mysql.RegisterReaderHandler(handler, func() io.Reader { return pr })
+	defer mysql.DeregisterReaderHandler(handler)
+
+	written := make(chan textWriteResult, 1)
+	go func() {
+		n, err := writeTextRows(pw, rows, len(columns))
+		pw.CloseWithError(err)
+		written <- textWriteResult{rows: n, err: err}
+	}()
+
+	query := fmt.Sprintf("LOAD DATA LOCAL INFILE 'Reader::%s' INTO TABLE %s CHARACTER SET utf8mb4 "+
+		"FIELDS TERMINATED BY '\\t' ESCAPED BY '\\\\' LINES TERMINATED BY '\\n' (%s)",
+		handler, quoteIdent(tableName, '`'), quoteIdents(columns, '`'))
+	result, execErr := tx.ExecContext(ctx, query)
+	// 服务端未读完数据时关闭管道，让后台编码退出
+	pr.CloseWithError(execErr)
+	w := <-written
+	if execErr != nil {
+		return 0, fmt.Errorf("failed to execute LOAD DATA into %s: %v", tableName, execErr)
+	}
+	if w.err != nil {
+		return 0, fmt.Errorf("failed to read rows for LOAD DATA: %v", w.err)
+	}
+	warnings, err := loadDataWarnings(ctx, tx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check LOAD DATA warnings: %v", err)
+	}
+	if len(warnings) > 0 {
+		return 0, fmt.Errorf("LOAD DATA into %s produced warnings: %s", tableName, strings.Join(warnings, "; "))
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get LOAD DATA row count: %v", err)
+	}
+	if affected != w.rows {
+		return 0, fmt.Errorf("LOAD DATA into %s loaded %d of %d rows", tableName, affected, w.rows)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit LOAD DATA: %v", err)
+	}
+	return w.rows, nil
+}
+
+// loadDataMaxWarnings 导入失败时错误信息中最多带上的警告数
+const loadDataMaxWarnings = 5
+
+// loadDataWarnings 读取本事务连接上一条语句产生的警告，格式为 <Level> <Code>: <Message>
+func loadDataWarnings(ctx context.Context, tx *sql.Tx) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("SHOW WARNINGS LIMIT %d", loadDataMaxWarnings))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var warnings []string
+	for rows.Next() {
+		var level, message string
+		var code int
+		if err := rows.Scan(&level, &code, &message); err != nil {
+			return nil, err
+		}
+		warnings = append(warnings, fmt.Sprintf("%s %d: %s", level, code, message))
+	}
+	return warnings, rows.Err()
+}
+
+// BulkLoad 通过 COPY FROM STDIN 导入
+func (k *KingbaseStrategy) BulkLoad(ctx context.Context, tableName string, columns []string, rows RowSource) (int64, error) {
+	return copyFromStdin(ctx, k.DB, tableName, columns, rows)
+}
+
+// BulkLoad 通过 COPY FROM STDIN 导入
+func (v *VastbaseStrategy) BulkLoad(ctx context.Context, tableName string, columns []string, rows RowSource) (int64, error) {
+	return copyFromStdin(ctx, v.DB, tableName, columns, rows)
+}
+
+// copyFromStdin PostgreSQL 系驱动在事务内预编译 COPY 语句后，每次 Exec 追加一行，无参数的 Exec 把缓冲数据发送完毕
+func copyFromStdin(ctx context.Context, db *sql.DB, tableName string, columns []string, rows RowSource) (int64, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, fmt.Sprintf("COPY %s (%s) FROM STDIN", quoteIdent(tableName, '"'), quoteIdents(columns, '"')))
+	if err != nil {
+		return 0, fmt.Errorf("failed to prepare COPY into %s: %v", tableName, err)
+	}
+	defer stmt.Close()
+
+	var count int64
+	for {
+		values, err := rows.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		if len(values) != len(columns) {
+			return 0, fmt.Errorf("row %d has %d values, expected %d columns", count+1, len(values), len(columns))
+		}
+		if _, err := stmt.ExecContext(ctx, values...); err != nil {
+			return 0, fmt.Errorf("failed to copy row %d into %s: %v", count+1, tableName, err)
+		}
+		count++
+	}
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		return 0, fmt.Errorf("failed to finish COPY into %s: %v", tableName, err)
+	}
+	if err := stmt.Close(); err != nil {
+		return 0, fmt.Errorf("failed to close COPY statement: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit COPY: %v", err)
+	}
+	return count, nil
+}
+
+// bulkLoadStage GBase 8a 批量导入的中转存储，导入节点需能访问对象存储
+type bulkLoadStage struct {
+	client oss.ClientInterface
+	expiry time.Duration
+}
+
+var newBulkLoadStage = func() (*bulkLoadStage, error) {
+	conf := config.GetConfigMap()
+	if !conf.BulkLoad.GBaseStageEnabled {
+		return nil, errors.New("gbase bulk load stage is disabled")
+	}
+	client, err := oss.NewOSSFactory(conf).NewOSSClient()
+	if err != nil {
+		return nil, err
+	}
+	expiry := time.Duration(conf.BulkLoad.StageExpireSeconds) * time.Second
+	if expiry <= 0 {
+		expiry = time.Hour
+	}
+	return &bulkLoadStage{client: client, expiry: expiry}, nil
+}
+
+// BulkLoad 把数据编码为文本上传到对象存储中转，再由 GBase 8a 通过 LOAD DATA INFILE 从预签名链接拉取，
+// 未开启 bulk_load.gbase_stage_enabled 或无法创建对象存储客户端时返回 ErrBulkLoadUnavailable。
+// 预签名链接以明文写在 SQL 中，会出现在 GBase 的 processlist 和审计日志里，有效期内可被任何拿到链接的人下载中转数据
+func (g *GBaseStrategy) BulkLoad(ctx context.Context, tableName string, columns []string, rows RowSource) (int64, error) {
+	stage, err := newBulkLoadStage()
+	if err != nil {
+		log.Logger.Warnf("Failed to create bulk load stage for GBase, bulk load unavailable: %v", err)
+		return 0, ErrBulkLoadUnavailable
+	}
+
+	objectName := fmt.Sprintf("%s/%s.tsv", bulkLoadStagePrefix, uuid.NewString())
+	defer func() {
+		if err := stage.client.DeleteObject(context.Background(), common.BATCH_DATA_BUCKET_NAME, objectName); err != nil {
+			log.Logger.Warnf("Failed to delete bulk load stage object %s: %v", objectName, err)
+		}
+	}()
+
+	pr, pw := io.Pipe()
+	written := make(chan textWriteResult, 1)
+	go func() {
+		n, err := writeTextRows(pw, rows, len(columns))
+		pw.CloseWithError(err)
+		written <- textWriteResult{rows: n, err: err}
+	}()
+	_, putErr := stage.client.PutObject(ctx, common.BATCH_DATA_BUCKET_NAME, objectName, pr, -1,
+		&oss.PutOptions{ContentType: "text/tab-separated-values"})
+	pr.CloseWithError(putErr)
+	w := <-written
+	if putErr != nil {
+		return 0, fmt.Errorf("failed to upload bulk load stage object: %v", putErr)
+	}
+	if w.err != nil {
+		return 0, fmt.Errorf("failed to read rows for bulk load: %v", w.err)
+	}
+
+	url, err := stage.client.PresignedGetObject(common.BATCH_DATA_BUCKET_NAME, objectName, stage.expiry)
+	if err != nil {
+		return 0, fmt.Errorf("failed to presign bulk load stage object: %v", err)
+	}
+	fields := make([]string, len(columns))
+	for i, column := range columns {
+		fields[i] = strings.Trim(column, "`\"")
+	}
+	query := fmt.Sprintf("LOAD DATA INFILE '%s' INTO TABLE %s DATA_FORMAT 3 "+
+		"FIELDS TERMINATED BY '\\t' ESCAPED BY '\\\\' NULL_VALUE '\\\\N' LINES TERMINATED BY '\\n' TABLE_FIELDS '%s'",
+		url, quoteIdent(tableName, '`'), strings.Join(fields, ","))
+	result, err := g.DB.ExecContext(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute GBase LOAD DATA into %s: %v", tableName, err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected != w.rows {
+		log.Logger.Warnf("GBase LOAD DATA into %s loaded %d of %d rows", tableName, affected, w.rows)
+		return affected, nil
+	}
+	return w.rows, nil
+}
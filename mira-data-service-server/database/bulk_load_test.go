@@ -0,0 +1,213 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"data-service/common"
+	"data-service/mocks"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// sliceRows 测试用的内存数据源
+type sliceRows struct {
+	rows [][]interface{}
+	read int
+}
+
+func (s *sliceRows) Next() ([]interface{}, error) {
+	if s.read >= len(s.rows) {
+		return nil, io.EOF
+	}
+	s.read++
+	return s.rows[s.read-1], nil
+}
+
+func TestWriteTextRows(t *testing.T) {
+	rows := &sliceRows{rows: [][]interface{}{
+		{int64(1), "a\tb\nc\\d", nil, true},
+		{int32(-2), []byte("x\ry"), 1.5, false},
+		{uint8(3), "", float32(0.25), time.Date(2024, 1, 2, 3, 4, 5, 600000000, time.UTC)},
+	}}
+	var buf strings.Builder
+	n, err := writeTextRows(&buf, rows, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+	assert.Equal(t, "1\ta\\tb\\nc\\\\d\t\\N\t1\n"+
+		"-2\tx\\ry\t1.5\t0\n"+
+		"3\t\t0.25\t2024-01-02 03:04:05.6\n", buf.String())
+
+	_, err = writeTextRows(&buf, &sliceRows{rows: [][]interface{}{{1}}}, 2)
+	assert.Error(t, err)
+}
+
+func TestQuoteIdent(t *testing.T) {
+	assert.Equal(t, "`t`", quoteIdent("t", '`'))
+	assert.Equal(t, "`a``b`", quoteIdent("a`b", '`'))
+	assert.Equal(t, "`t`", quoteIdent("`t`", '`'))
+	assert.Equal(t, "db.t", quoteIdent("db.t", '`'))
+	assert.Equal(t, `"t"`, quoteIdent("t", '"'))
+}
+
+func TestCopyFromStdin(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	prep := mock.ExpectPrepare(`COPY "result" \("id", "name"\) FROM STDIN`)
+	prep.ExpectExec().WithArgs(int64(1), "a").WillReturnResult(sqlmock.NewResult(0, 0))
+	prep.ExpectExec().WithArgs(int64(2), nil).WillReturnResult(sqlmock.NewResult(0, 0))
+	prep.ExpectExec().WithoutArgs().WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	strategy := &KingbaseStrategy{DB: db}
+	rows := &sliceRows{rows: [][]interface{}{{int64(1), "a"}, {int64(2), nil}}}
+	n, err := strategy.BulkLoad(context.Background(), "result", []string{"id", "name"}, rows)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCopyFromStdin_RowErrorRollsBack(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	prep := mock.ExpectPrepare(`COPY "result" \("id"\) FROM STDIN`)
+	prep.ExpectExec().WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	strategy := &VastbaseStrategy{DB: db}
+	rows := &sliceRows{rows: [][]interface{}{{int64(1)}, {int64(2), "extra"}}}
+	_, err = strategy.BulkLoad(context.Background(), "result", []string{"id"}, rows)
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLBulkLoad_WarningsRollBack(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT @@local_infile`).WillReturnRows(sqlmock.NewRows([]string{"@@local_infile"}).AddRow(1))
+	mock.ExpectBegin()
+	mock.ExpectExec(`LOAD DATA LOCAL INFILE 'Reader::bulk_load_.*' INTO TABLE ` + "`result`").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SHOW WARNINGS LIMIT 5`).WillReturnRows(sqlmock.NewRows([]string{"Level", "Code", "Message"}).
+		AddRow("Warning", 1062, "Duplicate entry '1' for key 'PRIMARY'"))
+	mock.ExpectRollback()
+
+	strategy := &MySQLStrategy{DB: db}
+	_, err = strategy.BulkLoad(context.Background(), "result", []string{"id"}, &sliceRows{})
+	assert.ErrorContains(t, err, "Duplicate entry")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLBulkLoad_LocalInfileDisabled(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT @@local_infile`).WillReturnRows(sqlmock.NewRows([]string{"@@local_infile"}).AddRow(0))
+
+	strategy := &MySQLStrategy{DB: db}
+	rows := &sliceRows{rows: [][]interface{}{{int64(1)}}}
+	_, err = strategy.BulkLoad(context.Background(), "result", []string{"id"}, rows)
+	assert.ErrorIs(t, err, ErrBulkLoadUnavailable)
+	assert.Equal(t, 0, rows.read)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGBaseBulkLoad(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockClientInterface(ctrl)
+
+	origStage := newBulkLoadStage
+	newBulkLoadStage = func() (*bulkLoadStage, error) {
+		return &bulkLoadStage{client: client, expiry: time.Minute}, nil
+	}
+	defer func() { newBulkLoadStage = origStage }()
+
+	var uploaded []byte
+	client.EXPECT().PutObject(gomock.Any(), common.BATCH_DATA_BUCKET_NAME, gomock.Any(), gomock.Any(), int64(-1), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, reader io.Reader, _ int64, _ interface{}) (interface{}, error) {
+			uploaded, err = io.ReadAll(reader)
+			return nil, err
+		})
+	client.EXPECT().PresignedGetObject(common.BATCH_DATA_BUCKET_NAME, gomock.Any(), time.Minute).
+		Return("http://minio:9000/data-service/bulk_load/x.tsv?sig=1", nil)
+	client.EXPECT().DeleteObject(gomock.Any(), common.BATCH_DATA_BUCKET_NAME, gomock.Any()).Return(nil)
+	mock.ExpectExec(`LOAD DATA INFILE 'http://minio:9000/data-service/bulk_load/x.tsv\?sig=1' INTO TABLE ` + "`result`" +
+		` DATA_FORMAT 3 .* TABLE_FIELDS 'id,name'`).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	strategy := &GBaseStrategy{DB: db}
+	rows := &sliceRows{rows: [][]interface{}{{int64(1), "a"}, {int64(2), nil}}}
+	n, err := strategy.BulkLoad(context.Background(), "result", []string{"id", "`name`"}, rows)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+	assert.Equal(t, "1\ta\n2\t\\N\n", string(uploaded))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// stubLoader 按设定结果返回的批量导入
+type stubLoader struct {
+	unavailable bool
+	got         [][]interface{}
+}
+
+func (l *stubLoader) BulkLoad(_ context.Context, _ string, _ []string, rows RowSource) (int64, error) {
+	if l.unavailable {
+		return 0, ErrBulkLoadUnavailable
+	}
+	for {
+		row, err := rows.Next()
+		if err == io.EOF {
+			return int64(len(l.got)), nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		l.got = append(l.got, row)
+	}
+}
+
+func TestBulkLoadPipe(t *testing.T) {
+	loader := &stubLoader{}
+	pipe := StartBulkLoad(context.Background(), loader, "t", []string{"id"})
+	assert.NoError(t, pipe.Write([][]interface{}{{1}, {2}}))
+	assert.NoError(t, pipe.Write([][]interface{}{{3}}))
+	n, err := pipe.Close(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+	assert.Equal(t, [][]interface{}{{1}, {2}, {3}}, loader.got)
+}
+
+func TestBulkLoadPipe_Unavailable(t *testing.T) {
+	pipe := StartBulkLoad(context.Background(), &stubLoader{unavailable: true}, "t", []string{"id"})
+	assert.ErrorIs(t, pipe.Write([][]interface{}{{1}}), ErrBulkLoadUnavailable)
+	_, err := pipe.Close(nil)
+	assert.ErrorIs(t, err, ErrBulkLoadUnavailable)
+}
+
+func TestBulkLoadPipe_Abort(t *testing.T) {
+	pipe := StartBulkLoad(context.Background(), &stubLoader{}, "t", []string{"id"})
+	assert.NoError(t, pipe.Write([][]interface{}{{1}}))
+	cause := errors.New("read failed")
+	_, err := pipe.Close(cause)
+	assert.ErrorIs(t, err, cause)
+}
@@ -33,6 +33,15 @@ import (
 )
 
 func InsertArrowDataInBatches(db *sql.DB, tableName string, schema *arrow.Schema, ipcReader *ipc.Reader, dbType pb.DataSourceType) error {
+	// 目标库支持原生批量导入时优先使用，不支持时回退到 INSERT
+	err := bulkLoadArrowData(db, tableName, schema, ipcReader, dbType)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, ErrBulkLoadUnavailable) {
+		return fmt.Errorf("bulk load failed: %v", err)
+	}
+
 	for i := 0; i < common.MAX_RETRY_COUNT; i++ {
 		err := performBatchInsert(db, tableName, schema, ipcReader, dbType)
 		if err == nil {
@@ -109,6 +118,8 @@ func processBatch(tx *sql.Tx, args []interface{}, currentRowCount int64, schema
 
 // 执行批量插入的逻辑
 func performBatchInsert(db *sql.DB, tableName string, schema *arrow.Schema, ipcReader *ipc.Reader, dbType pb.DataSourceType) error {
+	// 在开启事务前查询目标库包大小，避免连接池只有一个连接时阻塞
+	batcher := newInsertBatcher(db, dbType)
 	tx, err := db.Begin()
 	if err != nil {
 		log.Logger.Errorf("Failed to begin transaction: %v", err)
@@ -122,6 +133,19 @@ func performBatchInsert(db *sql.DB, tableName string, schema *arrow.Schema, ipcR
 	log.Logger.Infof("Start Inserting........")
 	rowExtractor := &ArrowRowExtractor{}
 
+	// 写入当前批次并按耗时调整批次大小
+	flush := func() error {
+		start := time.Now()
+		var processedCount int64
+		argsBatch, rowCount, processedCount, err = processBatch(tx, argsBatch, rowCount, schema, tableName, dbType)
+		if err != nil {
+			return err
+		}
+		batcher.ObserveBatch(processedCount, time.Since(start))
+		totalRowCount += processedCount
+		return nil
+	}
+
 	// 主处理循环
 	for ipcReader.Next() {
 		record := ipcReader.Record()
@@ -129,35 +153,43 @@ func performBatchInsert(db *sql.DB, tableName string, schema *arrow.Schema, ipcR
 			log.Logger.Infof("Skipping empty record")
 			continue
 		}
+		batcher.ObserveRecord(record)
 
-		args, err := rowExtractor.ExtractRowData(record)
-		if err != nil {
-			log.Logger.Errorf("Failed to extract row data: %v", err)
-			return err
-		}
-		log.Logger.Debugf("Extracted row data: %v", args)
-		argsBatch = append(argsBatch, args...)
-		rowCount += record.NumRows()
-
-		// 当达到批次大小时处理数据
-		if rowCount >= int64(common.BATCH_DATA_SIZE) {
-			var processedCount int64
-			argsBatch, rowCount, processedCount, err = processBatch(tx, argsBatch, rowCount, schema, tableName, dbType)
+		// 按批次行数切分记录，避免宽表的大记录一次性展开占用过多内存
+		for offset := int64(0); offset < record.NumRows(); {
+			take := int64(batcher.BatchRows()) - rowCount
+			if take < 1 {
+				take = 1
+			}
+			end := offset + take
+			if end > record.NumRows() {
+				end = record.NumRows()
+			}
+			slice := record.NewSlice(offset, end)
+			args, err := rowExtractor.ExtractRowData(slice)
+			slice.Release()
 			if err != nil {
+				log.Logger.Errorf("Failed to extract row data: %v", err)
 				return err
 			}
-			totalRowCount += processedCount
+			argsBatch = append(argsBatch, args...)
+			rowCount += end - offset
+			offset = end
+
+			// 当达到批次大小时处理数据
+			if rowCount >= int64(batcher.BatchRows()) {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
 		}
 	}
 
 	// 处理最后一批数据
 	if rowCount > 0 || len(argsBatch) > 0 {
-		var processedCount int64
-		_, _, processedCount, err = processBatch(tx, argsBatch, rowCount, schema, tableName, dbType)
-		if err != nil {
+		if err := flush(); err != nil {
 			return err
 		}
-		totalRowCount += processedCount
 	}
 
 	// 提交事务
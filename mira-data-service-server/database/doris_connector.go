@@ -173,17 +173,17 @@ func (d *DorisStrategy) GetTableInfo(database string, tableName string, isExactQ
 
 	if isExactQuery {
 		// 精确查询，只返回记录数
-		if err := d.DB.QueryRow(sqlQuery).Scan(&result.TableRows); err != nil {
+		var rowCount int64
+		if err := d.DB.QueryRow(sqlQuery).Scan(&rowCount); err != nil {
 			return nil, err
 		}
-		result.TableSchema = ""      // 没有表模式
-		result.TableName = tableName // 返回表名
-		result.TableSize = 0         // 精确查询不关心表的大小
+		result.setExactCount(tableName, rowCount)
 	} else {
 		// 普通查询，返回更多的表信息
 		if err := d.DB.QueryRow(sqlQuery).Scan(&result.TableSchema, &result.TableName, &result.TableRows, &result.TableSize); err != nil {
 			return nil, err
 		}
+		result.StatsMethod = ds.TableStatsMethod_TABLE_STATS_METHOD_INFORMATION_SCHEMA
 	}
 
 	// 获取表结构信息
@@ -194,17 +194,12 @@ func (d *DorisStrategy) GetTableInfo(database string, tableName string, isExactQ
 	}
 
 	// 返回查询结果
-	return &ds.TableInfoResponse{
-		TableName:   result.TableName,
-		RecordCount: result.TableRows,
-		RecordSize:  result.TableSize,
-		Columns:     columns,
-	}, nil
+	return result.ToTableInfoResponse(columns), nil
 }
 
 // 获取表结构信息
 func (d *DorisStrategy) getTableSchema(database, tableName string) ([]*ds.ColumnItem, error) {
-	query := `SELECT COLUMN_NAME, DATA_TYPE, CHARACTER_MAXIMUM_LENGTH, IS_NULLABLE, COLUMN_DEFAULT, COLUMN_COMMENT
+	query := `SELECT COLUMN_NAME, DATA_TYPE, CHARACTER_MAXIMUM_LENGTH, IS_NULLABLE, COLUMN_DEFAULT, COLUMN_COMMENT, NUMERIC_PRECISION, NUMERIC_SCALE
               FROM INFORMATION_SCHEMA.COLUMNS 
               WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?
               ORDER BY ORDINAL_POSITION`
@@ -217,16 +212,16 @@ func (d *DorisStrategy) getTableSchema(database, tableName string) ([]*ds.Column
 
 	var columns []*ds.ColumnItem
 	for rows.Next() {
-		var col ds.ColumnItem
-		var maxLength sql.NullInt64
+		var name, dataType string
+		var maxLength, precision, scale sql.NullInt64
 		var isNullable, columnDefault, comment sql.NullString
 
-		err := rows.Scan(&col.Name, &col.DataType, &maxLength, &isNullable, &columnDefault, &comment)
+		err := rows.Scan(&name, &dataType, &maxLength, &isNullable, &columnDefault, &comment, &precision, &scale)
 		if err != nil {
 			return nil, err
 		}
 
-		columns = append(columns, &col)
+		columns = append(columns, newColumnItem(name, dataType, isNullable, precision, scale))
 	}
 
 	return columns, nil
@@ -240,6 +235,7 @@ func (d *DorisStrategy) BuildWithConditionQuery(
 	filterOperators []ds.FilterOperator,
 	filterValues []*ds.FilterValue,
 	sortRules []*ds.SortRule,
+	window *ReadWindow,
 ) (string, []interface{}, error) {
 	// 实现条件查询构建逻辑
 	// 这里可以根据需要实现具体的查询构建
@@ -484,12 +484,11 @@ func (g *GBaseStrategy) GetTableInfo(database string, tableName string, isExactQ
 
 	if isExactQuery {
 		// 精确查询，只返回记录数
-		if err := g.DB.QueryRow(sqlQuery).Scan(&result.TableRows); err != nil {
+		var rowCount int64
+		if err := g.DB.QueryRow(sqlQuery).Scan(&rowCount); err != nil {
 			return nil, err
 		}
-		result.TableSchema = ""      // 没有表模式
-		result.TableName = tableName // 返回表名
-		result.TableSize = 0         // 精确查询不关心表的大小
+		result.setExactCount(tableName, rowCount)
 	} else {
 		// 检查是否启用估算模式
 		if ShouldUseEstimationOnly() {
@@ -532,8 +531,12 @@ func (g *GBaseStrategy) GetTableInfo(database string, tableName string, isExactQ
 						result.TableRows = 0
 					} else {
 						result.TableRows = rowCount
+						result.ExactCount = true
+						result.StatsMethod = ds.TableStatsMethod_TABLE_STATS_METHOD_COUNT
 					}
 				}
+			} else {
+				result.StatsMethod = ds.TableStatsMethod_TABLE_STATS_METHOD_INFORMATION_SCHEMA
 			}
 		}
 	}
@@ -548,17 +551,12 @@ func (g *GBaseStrategy) GetTableInfo(database string, tableName string, isExactQ
 	log.Logger.Infof("Table info: %+v", result)
 
 	// 返回查询结果
-	return &ds.TableInfoResponse{
-		TableName:   result.TableName,
-		RecordCount: result.TableRows,
-		RecordSize:  result.TableSize,
-		Columns:     columns,
-	}, nil
+	return result.ToTableInfoResponse(columns), nil
 }
 
 // getTableSchema retrieves table structure information
 func (g *GBaseStrategy) getTableSchema(database, tableName string) ([]*ds.ColumnItem, error) {
-	query := `SELECT COLUMN_NAME, DATA_TYPE, CHARACTER_MAXIMUM_LENGTH, IS_NULLABLE, COLUMN_DEFAULT, COLUMN_COMMENT
+	query := `SELECT COLUMN_NAME, DATA_TYPE, CHARACTER_MAXIMUM_LENGTH, IS_NULLABLE, COLUMN_DEFAULT, COLUMN_COMMENT, NUMERIC_PRECISION, NUMERIC_SCALE
               FROM INFORMATION_SCHEMA.COLUMNS 
               WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?
               ORDER BY ORDINAL_POSITION`
@@ -571,16 +569,16 @@ func (g *GBaseStrategy) getTableSchema(database, tableName string) ([]*ds.Column
 
 	var columns []*ds.ColumnItem
 	for rows.Next() {
-		var col ds.ColumnItem
-		var maxLength sql.NullInt64
+		var name, dataType string
+		var maxLength, precision, scale sql.NullInt64
 		var isNullable, columnDefault, comment sql.NullString
 
-		err := rows.Scan(&col.Name, &col.DataType, &maxLength, &isNullable, &columnDefault, &comment)
+		err := rows.Scan(&name, &dataType, &maxLength, &isNullable, &columnDefault, &comment, &precision, &scale)
 		if err != nil {
 			return nil, err
 		}
 
-		columns = append(columns, &col)
+		columns = append(columns, newColumnItem(name, dataType, isNullable, precision, scale))
 	}
 
 	return columns, nil
@@ -593,6 +591,7 @@ func (g *GBaseStrategy) BuildWithConditionQuery(
 	filterOperators []ds.FilterOperator,
 	filterValues []*ds.FilterValue,
 	sortRules []*ds.SortRule,
+	window *ReadWindow,
 ) (string, []interface{}, error) {
 	var queryBuilder strings.Builder
 	args := []interface{}{}
@@ -615,7 +614,7 @@ func (g *GBaseStrategy) BuildWithConditionQuery(
 		queryBuilder.WriteString("*")
 	}
 	queryBuilder.WriteString(" FROM ")
-	queryBuilder.WriteString(tableName)
+	queryBuilder.WriteString(window.sampleTable(tableName, mysqlWindowDialect))
 
 	// Build WHERE clause
 	if len(filterNames) > 0 && len(filterOperators) > 0 && len(filterValues) > 0 {
@@ -664,10 +663,19 @@ func (g *GBaseStrategy) BuildWithConditionQuery(
 		queryBuilder.WriteString(strings.Join(conditions, " AND "))
 	}
 
+	// 按比例采样
+	if predicate := window.samplePredicate(mysqlWindowDialect); predicate != "" {
+		if len(filterNames) > 0 && len(filterOperators) > 0 && len(filterValues) > 0 {
+			queryBuilder.WriteString(" AND ")
+		} else {
+			queryBuilder.WriteString(" WHERE ")
+		}
+		queryBuilder.WriteString(predicate)
+	}
+
 	// Build ORDER BY clause
+	orderBy := ""
 	if len(sortRules) > 0 {
-		queryBuilder.WriteString(" ORDER BY ")
-
 		orders := make([]string, len(sortRules))
 		for i, rule := range sortRules {
 			order := "ASC"
@@ -676,10 +684,11 @@ func (g *GBaseStrategy) BuildWithConditionQuery(
 			}
 			orders[i] = fmt.Sprintf("%s %s", rule.FieldName, order)
 		}
-		queryBuilder.WriteString(strings.Join(orders, ", "))
+		orderBy = strings.Join(orders, ", ")
 	}
 
-	return queryBuilder.String(), args, nil
+	// 采样、分页
+	return window.finish(queryBuilder.String(), orderBy, mysqlWindowDialect), args, nil
 }
 
 func (g *GBaseStrategy) EnsureDatabaseExists(dbName string) error {
@@ -765,9 +774,9 @@ func (g *GBaseStrategy) GetGroupCountInfo(tableName string, groupBy []string, fi
 }
 
 // getTableRowCount 获取表的总行数
-func (g *GBaseStrategy) getTableRowCount(database string, tableName string) (int32, error) {
+func (g *GBaseStrategy) getTableRowCount(database string, tableName string) (int64, error) {
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s.%s", database, tableName)
-	var rowCount int32
+	var rowCount int64
 	if err := g.DB.QueryRow(countQuery).Scan(&rowCount); err != nil {
 		return 0, err
 	}
@@ -775,7 +784,7 @@ func (g *GBaseStrategy) getTableRowCount(database string, tableName string) (int
 }
 
 // estimateTableSize 通过采样数据估算表的大小
-func (g *GBaseStrategy) estimateTableSize(database string, tableName string, totalRows int32) (int64, error) {
+func (g *GBaseStrategy) estimateTableSize(database string, tableName string, totalRows int64) (int64, error) {
 	// 获取100条数据估算平均行大小
 	sampleQuery := fmt.Sprintf("SELECT * FROM %s.%s LIMIT 100", database, tableName)
 	rows, err := g.DB.Query(sampleQuery)
@@ -838,11 +847,11 @@ func gbase8aCipherSuites() []uint16 {
 }
 
 // GetTableRowCount 实现 TableInfoEstimator 接口
-func (g *GBaseStrategy) GetTableRowCount(database string, tableName string) (int32, error) {
+func (g *GBaseStrategy) GetTableRowCount(database string, tableName string) (int64, error) {
 	return g.getTableRowCount(database, tableName)
 }
 
 // EstimateTableSize 实现 TableInfoEstimator 接口
-func (g *GBaseStrategy) EstimateTableSize(database string, tableName string, totalRows int32) (int64, error) {
+func (g *GBaseStrategy) EstimateTableSize(database string, tableName string, totalRows int64) (int64, error) {
 	return g.estimateTableSize(database, tableName, totalRows)
 }
@@ -65,6 +65,17 @@ func InitGormConnection() error {
 	// 自动迁移表结构
 	err = db.AutoMigrate(
 		&models.CleanupTask{},
+		&models.MaskingPolicy{},
+		&models.MaskingAuditRecord{},
+		&models.AuditLog{},
+		&models.TableProfileCache{},
+		&models.OrphanResource{},
+		&models.BatchJobHistory{},
+		&models.ResultDelivery{},
+		&models.JobCallback{},
+		&models.DistributedLock{},
+		&models.TableTTL{},
+		&models.ActiveJob{},
 	)
 	if err != nil {
 		return fmt.Errorf("failed to auto migrate: %v", err)
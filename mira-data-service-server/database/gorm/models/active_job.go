@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+)
+
+// ActiveJob 各副本上运行中的作业，副本定期续期，停止续期后登记过期
+type ActiveJob struct {
+	ID            uint      `gorm:"primarykey"`
+	JobInstanceID string    `gorm:"uniqueIndex:idx_active_job_owner;size:255;not null;comment:作业实例ID"`
+	Owner         string    `gorm:"uniqueIndex:idx_active_job_owner;size:255;not null;comment:登记作业的副本"`
+	ExpiresAt     time.Time `gorm:"index;not null;comment:登记过期时间"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (ActiveJob) TableName() string {
+	return "t_data_service_active_job"
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+)
+
+// AuditLog 数据访问与变更审计记录，只追加不修改
+type AuditLog struct {
+	ID             uint      `gorm:"primarykey"`
+	Caller         string    `gorm:"index;size:255;comment:调用方标识"`
+	CallerVerified bool      `gorm:"comment:调用方标识是否来自已验证的客户端证书"`
+	Peer           string    `gorm:"size:255;comment:调用方地址"`
+	Rpc            string    `gorm:"index;size:64;not null;comment:RPC名称"`
+	Asset          string    `gorm:"index;size:255;comment:资产名或表名"`
+	Columns        string    `gorm:"type:text;comment:访问的列，逗号分隔"`
+	Filters        string    `gorm:"type:text;comment:过滤条件"`
+	Statement      string    `gorm:"type:text;comment:执行的SQL"`
+	RowsReturned   int64     `gorm:"default:0;comment:返回行数"`
+	Success        bool      `gorm:"comment:是否成功"`
+	ErrorMessage   string    `gorm:"type:text;comment:错误信息"`
+	DurationMs     int64     `gorm:"comment:耗时(毫秒)"`
+	CreatedAt      time.Time `gorm:"index"`
+}
+
+func (AuditLog) TableName() string {
+	return "t_data_service_audit_logs"
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+)
+
+// BatchJobHistory 批处理作业历史，Pod 清理后仍可查询作业结果和实际使用的 Spark 配置
+type BatchJobHistory struct {
+	ID           uint       `gorm:"primarykey"`
+	JobID        string     `gorm:"uniqueIndex;size:255;not null;comment:作业ID(首个Pod名称)"`
+	RequestID    string     `gorm:"index;size:255;comment:请求ID"`
+	Mode         string     `gorm:"size:64;comment:操作模式"`
+	Backend      string     `gorm:"size:16;comment:执行后端(spark/doris)"`
+	Status       string     `gorm:"index;size:32;not null;comment:作业状态(JOB_STATUS_*)"`
+	Attempt      int        `gorm:"default:1;comment:当前尝试次数"`
+	MaxRetries   int        `gorm:"default:0;comment:最大重试次数"`
+	PodName      string     `gorm:"size:255;comment:最近一次尝试的Pod名称"`
+	SparkConfig  string     `gorm:"type:text;comment:调整后的Spark配置(JSON)"`
+	RecordCount  int64      `gorm:"default:0;comment:源表记录数"`
+	ErrorMessage string     `gorm:"type:text;comment:错误信息"`
+	SubmittedAt  time.Time  `gorm:"index;not null;comment:提交时间"`
+	FinishedAt   *time.Time `gorm:"comment:结束时间"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (BatchJobHistory) TableName() string {
+	return "t_data_service_batch_job_history"
+}
@@ -10,7 +10,7 @@ type CleanupTask struct {
 	ID            uint              `gorm:"primarykey"`
 	JobInstanceID string            `gorm:"uniqueIndex;size:255;not null;comment:作业实例ID"`
 	TaskType      string            `gorm:"not null;comment:任务类型(doris_table, mira_table, etc)"`
-	Status        common.TaskStatus `gorm:"not null;default:'pending';comment:任务状态(pending, running, completed, failed)"`
+	Status        common.TaskStatus `gorm:"not null;default:'pending';comment:任务状态(pending, running, completed, failed, dead_letter)"`
 	TablesFound   int               `gorm:"default:0;comment:找到的表数量"`
 	TablesDropped int               `gorm:"default:0;comment:成功删除的表数量"`
 	ErrorMessage  string            `gorm:"type:text;comment:错误信息"`
@@ -18,6 +18,10 @@ type CleanupTask struct {
 	CompletedAt   *time.Time        `gorm:"comment:完成时间"`
 	RetryCount    int               `gorm:"default:0;comment:重试次数"`
 	MaxRetries    int               `gorm:"default:3;comment:最大重试次数"`
+	NextRetryAt   *time.Time        `gorm:"index;comment:下次重试时间"`
+	LeaseOwner    string            `gorm:"size:255;comment:租约持有者"`
+	LeaseExpires  *time.Time        `gorm:"comment:租约过期时间"`
+	ExecuteAfter  *time.Time        `gorm:"index;comment:最早执行时间，作业方触发清理时设置，为空时后台不领取"`
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+)
+
+// DistributedLock 数据库行锁实现的分布式锁，每个锁一行，Token 为单调递增的 fencing token
+type DistributedLock struct {
+	LockKey   string    `gorm:"primaryKey;size:255;comment:锁名称"`
+	Owner     string    `gorm:"size:128;not null;default:'';comment:持有者，为空表示未持有"`
+	Token     int64     `gorm:"not null;default:0;comment:fencing token，每次获取加一"`
+	ExpiresAt time.Time `gorm:"not null;comment:租约到期时间"`
+	UpdatedAt time.Time
+}
+
+func (DistributedLock) TableName() string {
+	return "t_data_service_distributed_lock"
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+)
+
+// JobCallback Spark 作业完成回调，服务重启后仍可查询
+type JobCallback struct {
+	ID           uint      `gorm:"primarykey"`
+	JobID        string    `gorm:"index;size:255;not null;comment:作业ID(首个Pod名称)"`
+	PodName      string    `gorm:"size:255;comment:上报回调的Pod名称"`
+	Status       string    `gorm:"size:32;not null;comment:回调状态(JOB_STATUS_*)"`
+	Payload      string    `gorm:"type:text;comment:回调内容(JSON)"`
+	ErrorMessage string    `gorm:"type:text;comment:错误信息"`
+	ReceivedAt   time.Time `gorm:"index;not null;comment:接收时间"`
+	CreatedAt    time.Time
+}
+
+func (JobCallback) TableName() string {
+	return "t_data_service_job_callback"
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+)
+
+// MaskingPolicy 列脱敏策略模型
+type MaskingPolicy struct {
+	ID         uint   `gorm:"primarykey"`
+	Asset      string `gorm:"index:idx_masking_asset_column;size:255;not null;comment:资产名或表名，*表示所有资产"`
+	ColumnName string `gorm:"index:idx_masking_asset_column;size:255;not null;comment:列名"`
+	Method     string `gorm:"size:32;not null;comment:脱敏方式(redact, partial, hash, tokenize, null)"`
+	KeepPrefix int    `gorm:"default:0;comment:部分掩码保留前缀长度"`
+	KeepSuffix int    `gorm:"default:0;comment:部分掩码保留后缀长度"`
+	MaskChar   string `gorm:"size:8;comment:掩码字符"`
+	Algorithm  string `gorm:"size:32;comment:哈希算法(sha256, sm3)"`
+	Salt       string `gorm:"size:255;comment:哈希盐值"`
+	Enabled    bool   `gorm:"default:true;comment:是否启用"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (MaskingPolicy) TableName() string {
+	return "t_data_service_masking_policies"
+}
+
+// MaskingAuditRecord 脱敏策略应用记录
+type MaskingAuditRecord struct {
+	ID         uint   `gorm:"primarykey"`
+	Rpc        string `gorm:"size:64;not null;comment:调用的RPC"`
+	Asset      string `gorm:"index;size:255;comment:资产名或表名"`
+	ColumnName string `gorm:"size:255;not null;comment:列名"`
+	PolicyID   uint   `gorm:"comment:策略ID，配置文件策略为0"`
+	Source     string `gorm:"size:16;comment:策略来源(config, db)"`
+	Method     string `gorm:"size:32;not null;comment:脱敏方式"`
+	RowsMasked int64  `gorm:"default:0;comment:脱敏行数"`
+	CreatedAt  time.Time
+}
+
+func (MaskingAuditRecord) TableName() string {
+	return "t_data_service_masking_audit"
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+)
+
+// OrphanResource 孤儿资源巡检记录，用于没有创建时间的资源（JDBC 资源、Doris FILE 等）计算存活时长
+type OrphanResource struct {
+	ID          uint      `gorm:"primarykey"`
+	Kind        string    `gorm:"uniqueIndex:idx_orphan_kind_name;size:32;not null;comment:资源类型"`
+	Name        string    `gorm:"uniqueIndex:idx_orphan_kind_name;size:255;not null;comment:资源名，库内对象为 db.name"`
+	FirstSeenAt time.Time `gorm:"not null;comment:首次发现时间"`
+	LastSeenAt  time.Time `gorm:"index;not null;comment:最近一次发现时间"`
+}
+
+func (OrphanResource) TableName() string {
+	return "t_data_service_orphan_resources"
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+)
+
+// ResultDelivery 计算结果投递记录，同一作业参与方和投递目标使用同一个幂等键
+type ResultDelivery struct {
+	ID             uint       `gorm:"primarykey"`
+	IdempotencyKey string     `gorm:"uniqueIndex;size:255;not null;comment:幂等键"`
+	JobInstanceID  string     `gorm:"index:idx_result_delivery_job;size:255;not null;comment:作业实例ID"`
+	PartyID        string     `gorm:"index:idx_result_delivery_job;size:255;comment:参与方ID"`
+	SinkType       string     `gorm:"size:32;not null;comment:投递类型(RESULT_SINK_TYPE_*)"`
+	Target         string     `gorm:"size:1024;comment:投递位置"`
+	Status         string     `gorm:"index;size:32;not null;comment:投递状态(RESULT_DELIVERY_STATUS_*)"`
+	Attempts       int        `gorm:"default:0;comment:累计尝试次数"`
+	ErrorMessage   string     `gorm:"type:text;comment:错误信息"`
+	DeliveredAt    *time.Time `gorm:"comment:投递成功时间"`
+	LeaseOwner     string     `gorm:"size:255;comment:正在投递的实例"`
+	LeaseExpires   *time.Time `gorm:"comment:投递租约到期时间，过期后其他实例可重新领取"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (ResultDelivery) TableName() string {
+	return "t_data_service_result_delivery"
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+)
+
+// TableProfileCache 数据质量画像缓存，按资产版本失效
+type TableProfileCache struct {
+	ID           uint      `gorm:"primarykey"`
+	CacheKey     string    `gorm:"uniqueIndex;size:64;not null;comment:资产与统计参数的摘要"`
+	Asset        string    `gorm:"index;size:255;not null;comment:资产名或Doris表名"`
+	AssetVersion string    `gorm:"size:128;not null;comment:资产版本"`
+	Result       string    `gorm:"size:16777215;comment:画像结果JSON"`
+	ExpiresAt    time.Time `gorm:"index;comment:过期时间"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (TableProfileCache) TableName() string {
+	return "t_data_service_table_profiles"
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+)
+
+// TableTTL 服务创建的 Doris 表或库的生命周期记录，过期后由调度器删除
+type TableTTL struct {
+	ID            uint      `gorm:"primarykey"`
+	DbName        string    `gorm:"uniqueIndex:idx_table_ttl_db_table;size:255;not null;comment:库名"`
+	Table         string    `gorm:"column:table_name;uniqueIndex:idx_table_ttl_db_table;size:255;not null;default:'';comment:表名，为空表示整个库"`
+	JobInstanceID string    `gorm:"index;size:255;comment:创建该表的作业"`
+	CreatedAt     time.Time `gorm:"not null;comment:创建时间"`
+	ExpiresAt     time.Time `gorm:"index;not null;comment:过期时间"`
+}
+
+func (TableTTL) TableName() string {
+	return "t_data_service_table_ttls"
+}
@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"data-service/database/gorm/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ActiveJobRepository struct {
+	db *gorm.DB
+}
+
+func NewActiveJobRepository(db *gorm.DB) *ActiveJobRepository {
+	return &ActiveJobRepository{db: db}
+}
+
+// Register 登记 owner 上运行中的作业，已登记时只更新过期时间
+func (r *ActiveJobRepository) Register(jobInstanceID, owner string, expiresAt time.Time) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "job_instance_id"}, {Name: "owner"}},
+		DoUpdates: clause.AssignmentColumns([]string{"expires_at", "updated_at"}),
+	}).Create(&models.ActiveJob{
+		JobInstanceID: jobInstanceID,
+		Owner:         owner,
+		ExpiresAt:     expiresAt,
+	}).Error
+}
+
+// Unregister 删除 owner 上作业的登记
+func (r *ActiveJobRepository) Unregister(jobInstanceID, owner string) error {
+	return r.db.Where("job_instance_id = ? AND owner = ?", jobInstanceID, owner).
+		Delete(&models.ActiveJob{}).Error
+}
+
+// FindActiveJobInstanceIDs 查找所有副本上登记未过期的作业实例ID
+func (r *ActiveJobRepository) FindActiveJobInstanceIDs(now time.Time) ([]string, error) {
+	var ids []string
+	err := r.db.Model(&models.ActiveJob{}).Where("expires_at > ?", now).
+		Distinct().Pluck("job_instance_id", &ids).Error
+	return ids, err
+}
+
+// DeleteExpired 删除已过期的登记，返回删除数量
+func (r *ActiveJobRepository) DeleteExpired(now time.Time) (int64, error) {
+	result := r.db.Where("expires_at <= ?", now).Delete(&models.ActiveJob{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"data-service/database/gorm/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// AuditLogFilter 审计日志查询条件
+type AuditLogFilter struct {
+	StartTime *time.Time
+	EndTime   *time.Time
+	Caller    string
+	Rpc       string
+	Asset     string
+}
+
+type AuditLogRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditLogRepository(db *gorm.DB) *AuditLogRepository {
+	return &AuditLogRepository{db: db}
+}
+
+// Create 追加审计记录
+func (r *AuditLogRepository) Create(entry *models.AuditLog) error {
+	return r.db.Create(entry).Error
+}
+
+// FindWithPagination 按条件分页查询审计记录，按时间倒序
+func (r *AuditLogRepository) FindWithPagination(filter AuditLogFilter, offset, limit int) ([]models.AuditLog, int64, error) {
+	query := r.db.Model(&models.AuditLog{})
+	if filter.StartTime != nil {
+		query = query.Where("created_at >= ?", *filter.StartTime)
+	}
+	if filter.EndTime != nil {
+		query = query.Where("created_at <= ?", *filter.EndTime)
+	}
+	if filter.Caller != "" {
+		query = query.Where("caller = ?", filter.Caller)
+	}
+	if filter.Rpc != "" {
+		query = query.Where("rpc = ?", filter.Rpc)
+	}
+	if filter.Asset != "" {
+		query = query.Where("asset = ?", filter.Asset)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var logs []models.AuditLog
+	err := query.Order("created_at DESC").Order("id DESC").Offset(offset).Limit(limit).Find(&logs).Error
+	return logs, total, err
+}
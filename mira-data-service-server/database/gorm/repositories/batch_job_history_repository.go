@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"data-service/database/gorm/models"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// BatchJobHistoryFilter 批处理作业历史查询条件
+type BatchJobHistoryFilter struct {
+	JobID     string
+	RequestID string
+	Status    string
+}
+
+type BatchJobHistoryRepository struct {
+	db *gorm.DB
+}
+
+func NewBatchJobHistoryRepository(db *gorm.DB) *BatchJobHistoryRepository {
+	return &BatchJobHistoryRepository{db: db}
+}
+
+// Create 新增作业历史
+func (r *BatchJobHistoryRepository) Create(record *models.BatchJobHistory) error {
+	return r.db.Create(record).Error
+}
+
+// FindByJobID 根据作业ID查询，不存在时返回 nil
+func (r *BatchJobHistoryRepository) FindByJobID(jobID string) (*models.BatchJobHistory, error) {
+	var record models.BatchJobHistory
+	err := r.db.Where("job_id = ?", jobID).First(&record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// UpdateByJobID 更新未结束作业的历史字段，已结束的作业（包括已取消）不会被后续状态覆盖
+func (r *BatchJobHistoryRepository) UpdateByJobID(jobID string, updates map[string]interface{}) error {
+	return r.db.Model(&models.BatchJobHistory{}).Where("job_id = ? AND finished_at IS NULL", jobID).Updates(updates).Error
+}
+
+// FindWithPagination 按条件分页查询作业历史，按提交时间倒序
+func (r *BatchJobHistoryRepository) FindWithPagination(filter BatchJobHistoryFilter, offset, limit int) ([]models.BatchJobHistory, int64, error) {
+	query := r.db.Model(&models.BatchJobHistory{})
+	if filter.JobID != "" {
+		query = query.Where("job_id = ?", filter.JobID)
+	}
+	if filter.RequestID != "" {
+		query = query.Where("request_id = ?", filter.RequestID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var records []models.BatchJobHistory
+	err := query.Order("submitted_at DESC").Order("id DESC").Offset(offset).Limit(limit).Find(&records).Error
+	return records, total, err
+}
@@ -122,3 +122,154 @@ func (r *CleanupTaskRepository) CountPendingTasks() (int64, error) {
 		Count(&totalCount).Error
 	return totalCount, err
 }
+
+// FindClaimableTasks 查找可领取的任务：作业方已触发（execute_after 已到）的待执行或失败且到达重试时间的任务，
+// 或租约已过期的运行中任务；作业方未触发的任务（如刚导入的库）不会被后台执行
+func (r *CleanupTaskRepository) FindClaimableTasks(limit int) ([]models.CleanupTask, error) {
+	now := time.Now()
+	var tasks []models.CleanupTask
+	err := r.db.Where("((status IN ? AND retry_count < max_retries AND execute_after IS NOT NULL AND execute_after <= ? "+
+		"AND (next_retry_at IS NULL OR next_retry_at <= ?)) "+
+		"OR (status = ? AND lease_expires IS NOT NULL AND lease_expires < ? AND retry_count < max_retries)) "+
+		"AND (lease_expires IS NULL OR lease_expires < ?)",
+		[]common.TaskStatus{common.TaskStatusPending, common.TaskStatusFailed}, now, now,
+		common.TaskStatusRunning, now, now).
+		Order("updated_at ASC").
+		Limit(limit).
+		Find(&tasks).Error
+	return tasks, err
+}
+
+// ClaimTask 以条件更新领取处于 statuses 状态的任务租约，返回是否领取成功，多副本并发领取时只有一个成功。
+// 领取即视为作业方已触发清理，未设置 execute_after 时记为当前时间，失败后可由后台重试
+func (r *CleanupTaskRepository) ClaimTask(taskID uint, owner string, lease time.Duration, statuses []common.TaskStatus) (bool, error) {
+	now := time.Now()
+	expires := now.Add(lease)
+	result := r.db.Model(&models.CleanupTask{}).
+		Where("id = ? AND status IN ? AND (lease_expires IS NULL OR lease_expires < ? OR lease_owner = ?)",
+			taskID, statuses, now, owner).
+		Updates(map[string]interface{}{
+			"status":        common.TaskStatusRunning,
+			"lease_owner":   owner,
+			"lease_expires": &expires,
+			"execute_after": gorm.Expr("COALESCE(execute_after, ?)", now),
+			"started_at":    &now,
+			"updated_at":    now,
+		})
+	return result.RowsAffected == 1, result.Error
+}
+
+// ReclaimExpiredTask 领取租约已过期的运行中任务，上一次执行视为失败，重试次数加一
+func (r *CleanupTaskRepository) ReclaimExpiredTask(taskID uint, owner string, lease time.Duration) (bool, error) {
+	now := time.Now()
+	expires := now.Add(lease)
+	result := r.db.Model(&models.CleanupTask{}).
+		Where("id = ? AND status = ? AND lease_expires IS NOT NULL AND lease_expires < ? AND retry_count < max_retries",
+			taskID, common.TaskStatusRunning, now).
+		Updates(map[string]interface{}{
+			"retry_count":   gorm.Expr("retry_count + 1"),
+			"lease_owner":   owner,
+			"lease_expires": &expires,
+			"started_at":    &now,
+			"updated_at":    now,
+		})
+	return result.RowsAffected == 1, result.Error
+}
+
+// RenewLease 延长 owner 持有的运行中任务的租约，返回是否仍持有租约
+func (r *CleanupTaskRepository) RenewLease(taskID uint, owner string, lease time.Duration) (bool, error) {
+	now := time.Now()
+	expires := now.Add(lease)
+	result := r.db.Model(&models.CleanupTask{}).
+		Where("id = ? AND status = ? AND lease_owner = ?", taskID, common.TaskStatusRunning, owner).
+		Updates(map[string]interface{}{
+			"lease_expires": &expires,
+			"updated_at":    now,
+		})
+	return result.RowsAffected == 1, result.Error
+}
+
+// CompleteTask 标记任务完成并释放租约
+func (r *CleanupTaskRepository) CompleteTask(taskID uint, owner string) error {
+	now := time.Now()
+	return r.db.Model(&models.CleanupTask{}).Where("id = ? AND lease_owner = ?", taskID, owner).
+		Updates(map[string]interface{}{
+			"status":        common.TaskStatusCompleted,
+			"completed_at":  &now,
+			"error_message": "",
+			"next_retry_at": nil,
+			"lease_owner":   "",
+			"lease_expires": nil,
+			"updated_at":    now,
+		}).Error
+}
+
+// FailTask 记录失败并释放租约，nextRetryAt 为空时进入死信状态
+func (r *CleanupTaskRepository) FailTask(taskID uint, owner string, errorMsg string, nextRetryAt *time.Time) error {
+	status := common.TaskStatusFailed
+	if nextRetryAt == nil {
+		status = common.TaskStatusDeadLetter
+	}
+	return r.db.Model(&models.CleanupTask{}).Where("id = ? AND lease_owner = ?", taskID, owner).
+		Updates(map[string]interface{}{
+			"status":        status,
+			"retry_count":   gorm.Expr("retry_count + 1"),
+			"error_message": errorMsg,
+			"next_retry_at": nextRetryAt,
+			"lease_owner":   "",
+			"lease_expires": nil,
+			"updated_at":    time.Now(),
+		}).Error
+}
+
+// MoveExhaustedToDeadLetter 将重试次数耗尽的失败任务及租约过期的运行中任务转为死信状态
+func (r *CleanupTaskRepository) MoveExhaustedToDeadLetter() (int64, error) {
+	now := time.Now()
+	result := r.db.Model(&models.CleanupTask{}).
+		Where("retry_count >= max_retries AND (status IN ? OR (status = ? AND lease_expires IS NOT NULL AND lease_expires < ?))",
+			[]common.TaskStatus{common.TaskStatusPending, common.TaskStatusFailed}, common.TaskStatusRunning, now).
+		Updates(map[string]interface{}{
+			"status":        common.TaskStatusDeadLetter,
+			"lease_owner":   "",
+			"lease_expires": nil,
+			"updated_at":    now,
+		})
+	return result.RowsAffected, result.Error
+}
+
+// RequeueDeadLetterTasks 将死信任务重新入队，jobInstanceIDs 为空时重新入队全部死信任务
+func (r *CleanupTaskRepository) RequeueDeadLetterTasks(jobInstanceIDs []string) (int64, error) {
+	query := r.db.Model(&models.CleanupTask{}).Where("status = ?", common.TaskStatusDeadLetter)
+	if len(jobInstanceIDs) > 0 {
+		query = query.Where("job_instance_id IN ?", jobInstanceIDs)
+	}
+	result := query.Updates(map[string]interface{}{
+		"status":        common.TaskStatusPending,
+		"retry_count":   0,
+		"next_retry_at": nil,
+		"lease_owner":   "",
+		"lease_expires": nil,
+		"updated_at":    time.Now(),
+	})
+	return result.RowsAffected, result.Error
+}
+
+// FindTasksByStatusWithPagination 按状态分页查找任务
+func (r *CleanupTaskRepository) FindTasksByStatusWithPagination(status common.TaskStatus, offset, limit int) ([]models.CleanupTask, int64, error) {
+	query := r.db.Model(&models.CleanupTask{}).Where("status = ?", status)
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var tasks []models.CleanupTask
+	err := query.Order("updated_at DESC").Offset(offset).Limit(limit).Find(&tasks).Error
+	return tasks, total, err
+}
+
+// FindUnfinishedJobInstanceIDs 查找尚未完成的任务对应的作业实例ID，这些作业的资源仍由清理任务负责
+func (r *CleanupTaskRepository) FindUnfinishedJobInstanceIDs() ([]string, error) {
+	var ids []string
+	err := r.db.Model(&models.CleanupTask{}).Where("status <> ?", common.TaskStatusCompleted).
+		Pluck("job_instance_id", &ids).Error
+	return ids, err
+}
@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"data-service/common"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// newMockRepository 基于 sqlmock 创建清理任务仓库
+func newMockRepository(t *testing.T) (*CleanupTaskRepository, sqlmock.Sqlmock) {
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{Conn: db, SkipInitializeWithVersion: true}), &gorm.Config{})
+	require.NoError(t, err)
+	return NewCleanupTaskRepository(gormDB), sqlMock
+}
+
+func TestFindClaimableTasksRequiresExecuteAfter(t *testing.T) {
+	repo, sqlMock := newMockRepository(t)
+	// 未被作业方触发的待执行任务（execute_after 为空）不会被领取
+	sqlMock.ExpectQuery("execute_after IS NOT NULL AND execute_after <= .*lease_expires < .* AND retry_count < max_retries").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err := repo.FindClaimableTasks(10)
+	require.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestClaimTaskOnlyClaimsGivenStatuses(t *testing.T) {
+	repo, sqlMock := newMockRepository(t)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec("UPDATE `t_data_service_cleanup_tasks` SET .*`execute_after`=COALESCE\\(execute_after, \\?\\).* WHERE id = \\? AND status IN \\(\\?,\\?\\)").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "owner", sqlmock.AnyArg(), common.TaskStatusRunning, sqlmock.AnyArg(),
+			uint(7), common.TaskStatusPending, common.TaskStatusFailed, sqlmock.AnyArg(), "owner").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectCommit()
+
+	claimed, err := repo.ClaimTask(7, "owner", time.Minute, []common.TaskStatus{common.TaskStatusPending, common.TaskStatusFailed})
+	require.NoError(t, err)
+	assert.False(t, claimed)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestReclaimExpiredTaskIncrementsRetryCount(t *testing.T) {
+	repo, sqlMock := newMockRepository(t)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec("UPDATE `t_data_service_cleanup_tasks` SET .*`retry_count`=retry_count \\+ 1.* WHERE id = \\? AND status = \\? AND lease_expires IS NOT NULL AND lease_expires < \\?").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	claimed, err := repo.ReclaimExpiredTask(7, "owner", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"data-service/database/gorm/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DistributedLockRepository struct {
+	db *gorm.DB
+}
+
+func NewDistributedLockRepository(db *gorm.DB) *DistributedLockRepository {
+	return &DistributedLockRepository{db: db}
+}
+
+// TryAcquire 锁未被持有或租约已过期时由 owner 获取，返回新的 fencing token；被他人持有时返回 0
+func (r *DistributedLockRepository) TryAcquire(key, owner string, ttl time.Duration) (int64, error) {
+	// 首次使用时插入空行，并发插入由主键冲突忽略
+	err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.DistributedLock{
+		LockKey:   key,
+		ExpiresAt: time.Unix(0, 0),
+	}).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var token int64
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		var record models.DistributedLock
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("lock_key = ?", key).First(&record).Error; err != nil {
+			return err
+		}
+		now := time.Now()
+		if record.Owner != "" && record.Owner != owner && record.ExpiresAt.After(now) {
+			return nil
+		}
+		token = record.Token + 1
+		return tx.Model(&models.DistributedLock{}).Where("lock_key = ?", key).Updates(map[string]interface{}{
+			"owner":      owner,
+			"token":      token,
+			"expires_at": now.Add(ttl),
+		}).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return token, nil
+}
+
+// Renew 延长 owner 持有的租约，租约已过期或已被他人获取时返回 false
+func (r *DistributedLockRepository) Renew(key, owner string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	result := r.db.Model(&models.DistributedLock{}).
+		Where("lock_key = ? AND owner = ? AND expires_at > ?", key, owner, now).
+		Update("expires_at", now.Add(ttl))
+	return result.RowsAffected == 1, result.Error
+}
+
+// Check owner 是否仍以 token 持有未过期的锁
+func (r *DistributedLockRepository) Check(key, owner string, token int64) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.DistributedLock{}).
+		Where("lock_key = ? AND owner = ? AND token = ? AND expires_at > ?", key, owner, token, time.Now()).
+		Count(&count).Error
+	return count == 1, err
+}
+
+// Release 释放 owner 持有的锁，token 保留以保证单调递增
+func (r *DistributedLockRepository) Release(key, owner string) error {
+	return r.db.Model(&models.DistributedLock{}).
+		Where("lock_key = ? AND owner = ?", key, owner).
+		Updates(map[string]interface{}{"owner": "", "expires_at": time.Unix(0, 0)}).Error
+}
@@ -0,0 +1,34 @@
+package repositories
+
+import (
+	"data-service/database/gorm/models"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type JobCallbackRepository struct {
+	db *gorm.DB
+}
+
+func NewJobCallbackRepository(db *gorm.DB) *JobCallbackRepository {
+	return &JobCallbackRepository{db: db}
+}
+
+// Create 保存回调
+func (r *JobCallbackRepository) Create(record *models.JobCallback) error {
+	return r.db.Create(record).Error
+}
+
+// FindLatestByJobID 查询作业最近一次回调，不存在时返回 nil
+func (r *JobCallbackRepository) FindLatestByJobID(jobID string) (*models.JobCallback, error) {
+	var record models.JobCallback
+	err := r.db.Where("job_id = ?", jobID).Order("id DESC").First(&record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
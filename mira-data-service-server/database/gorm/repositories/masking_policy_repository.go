@@ -0,0 +1,30 @@
+package repositories
+
+import (
+	"data-service/database/gorm/models"
+
+	"gorm.io/gorm"
+)
+
+type MaskingPolicyRepository struct {
+	db *gorm.DB
+}
+
+func NewMaskingPolicyRepository(db *gorm.DB) *MaskingPolicyRepository {
+	return &MaskingPolicyRepository{db: db}
+}
+
+// FindEnabled 查找所有启用的脱敏策略
+func (r *MaskingPolicyRepository) FindEnabled() ([]models.MaskingPolicy, error) {
+	var policies []models.MaskingPolicy
+	err := r.db.Where("enabled = ?", true).Order("id ASC").Find(&policies).Error
+	return policies, err
+}
+
+// CreateAuditRecords 批量写入脱敏审计记录
+func (r *MaskingPolicyRepository) CreateAuditRecords(records []models.MaskingAuditRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	return r.db.Create(&records).Error
+}
@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"data-service/database/gorm/models"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type OrphanResourceRepository struct {
+	db *gorm.DB
+}
+
+func NewOrphanResourceRepository(db *gorm.DB) *OrphanResourceRepository {
+	return &OrphanResourceRepository{db: db}
+}
+
+// Touch 记录一次发现，返回首次发现时间
+func (r *OrphanResourceRepository) Touch(kind, name string, seenAt time.Time) (time.Time, error) {
+	var record models.OrphanResource
+	err := r.db.Where("kind = ? AND name = ?", kind, name).First(&record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		record = models.OrphanResource{Kind: kind, Name: name, FirstSeenAt: seenAt, LastSeenAt: seenAt}
+		if err := r.db.Create(&record).Error; err != nil {
+			return seenAt, err
+		}
+		return seenAt, nil
+	}
+	if err != nil {
+		return seenAt, err
+	}
+	err = r.db.Model(&models.OrphanResource{}).Where("id = ?", record.ID).Update("last_seen_at", seenAt).Error
+	return record.FirstSeenAt, err
+}
+
+// Delete 资源删除后移除记录
+func (r *OrphanResourceRepository) Delete(kind, name string) error {
+	return r.db.Where("kind = ? AND name = ?", kind, name).Delete(&models.OrphanResource{}).Error
+}
+
+// DeleteNotSeenSince 清除巡检中已不存在的资源记录
+func (r *OrphanResourceRepository) DeleteNotSeenSince(t time.Time) (int64, error) {
+	result := r.db.Where("last_seen_at < ?", t).Delete(&models.OrphanResource{})
+	return result.RowsAffected, result.Error
+}
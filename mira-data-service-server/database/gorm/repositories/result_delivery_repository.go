@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"data-service/database/gorm/models"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ResultDeliveryRepository struct {
+	db *gorm.DB
+}
+
+func NewResultDeliveryRepository(db *gorm.DB) *ResultDeliveryRepository {
+	return &ResultDeliveryRepository{db: db}
+}
+
+// Create 新增投递记录
+func (r *ResultDeliveryRepository) Create(record *models.ResultDelivery) error {
+	return r.db.Create(record).Error
+}
+
+// FindByIdempotencyKey 根据幂等键查询，不存在时返回 nil
+func (r *ResultDeliveryRepository) FindByIdempotencyKey(key string) (*models.ResultDelivery, error) {
+	var record models.ResultDelivery
+	err := r.db.Where("idempotency_key = ?", key).First(&record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// ClaimDelivery 以条件更新领取投递租约，状态为 doneStatus 或租约被其他实例持有且未过期时领取失败，
+// 多副本并发领取时只有一个成功
+func (r *ResultDeliveryRepository) ClaimDelivery(key, owner string, lease time.Duration, doneStatus, claimedStatus string) (bool, error) {
+	now := time.Now()
+	expires := now.Add(lease)
+	result := r.db.Model(&models.ResultDelivery{}).
+		Where("idempotency_key = ? AND status <> ? AND (lease_expires IS NULL OR lease_expires < ? OR lease_owner = ?)",
+			key, doneStatus, now, owner).
+		Updates(map[string]interface{}{
+			"status":        claimedStatus,
+			"lease_owner":   owner,
+			"lease_expires": &expires,
+		})
+	return result.RowsAffected == 1, result.Error
+}
+
+// RenewDelivery 续期投递租约，租约已被其他实例领取时返回 false
+func (r *ResultDeliveryRepository) RenewDelivery(key, owner string, lease time.Duration) (bool, error) {
+	expires := time.Now().Add(lease)
+	result := r.db.Model(&models.ResultDelivery{}).
+		Where("idempotency_key = ? AND lease_owner = ?", key, owner).
+		Update("lease_expires", &expires)
+	return result.RowsAffected == 1, result.Error
+}
+
+// FinishDelivery 写入投递结果并释放租约，只更新仍由 owner 持有的记录
+func (r *ResultDeliveryRepository) FinishDelivery(key, owner string, updates map[string]interface{}) error {
+	values := map[string]interface{}{"lease_owner": "", "lease_expires": nil}
+	for k, v := range updates {
+		values[k] = v
+	}
+	return r.db.Model(&models.ResultDelivery{}).
+		Where("idempotency_key = ? AND lease_owner = ?", key, owner).
+		Updates(values).Error
+}
+
+// FindByJob 查询作业的投递记录，partyID 为空时返回所有参与方
+func (r *ResultDeliveryRepository) FindByJob(jobInstanceID, partyID string) ([]models.ResultDelivery, error) {
+	query := r.db.Where("job_instance_id = ?", jobInstanceID)
+	if partyID != "" {
+		query = query.Where("party_id = ?", partyID)
+	}
+	var records []models.ResultDelivery
+	err := query.Order("id ASC").Find(&records).Error
+	return records, err
+}
@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"data-service/database/gorm/models"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TableProfileRepository struct {
+	db *gorm.DB
+}
+
+func NewTableProfileRepository(db *gorm.DB) *TableProfileRepository {
+	return &TableProfileRepository{db: db}
+}
+
+// FindValid 查找指定版本且未过期的画像缓存，未命中返回 nil
+func (r *TableProfileRepository) FindValid(cacheKey, assetVersion string) (*models.TableProfileCache, error) {
+	var cache models.TableProfileCache
+	err := r.db.Where("cache_key = ? AND asset_version = ? AND expires_at > ?", cacheKey, assetVersion, time.Now()).
+		First(&cache).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &cache, nil
+}
+
+// Save 写入画像缓存，同一 cacheKey 覆盖旧版本
+func (r *TableProfileRepository) Save(cache *models.TableProfileCache) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "cache_key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"asset", "asset_version", "result", "expires_at", "updated_at"}),
+	}).Create(cache).Error
+}
@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"data-service/database/gorm/models"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type TableTTLRepository struct {
+	db *gorm.DB
+}
+
+func NewTableTTLRepository(db *gorm.DB) *TableTTLRepository {
+	return &TableTTLRepository{db: db}
+}
+
+// Register 登记表的生命周期，已登记时保留原过期时间，返回生效的记录
+func (r *TableTTLRepository) Register(record *models.TableTTL) (*models.TableTTL, error) {
+	existing, err := r.Find(record.DbName, record.Table)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+	if err := r.db.Create(record).Error; err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// Find 查找表的生命周期记录，不存在时返回 nil
+func (r *TableTTLRepository) Find(dbName, tableName string) (*models.TableTTL, error) {
+	var record models.TableTTL
+	err := r.db.Where("db_name = ? AND table_name = ?", dbName, tableName).First(&record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// FindTableNames 库内已登记的表名
+func (r *TableTTLRepository) FindTableNames(dbName string) ([]string, error) {
+	var names []string
+	err := r.db.Model(&models.TableTTL{}).Where("db_name = ? AND table_name <> ''", dbName).Pluck("table_name", &names).Error
+	return names, err
+}
+
+// FindJobInstanceIDs 已登记的作业实例ID
+func (r *TableTTLRepository) FindJobInstanceIDs() ([]string, error) {
+	var ids []string
+	err := r.db.Model(&models.TableTTL{}).Where("job_instance_id <> ''").Distinct().Pluck("job_instance_id", &ids).Error
+	return ids, err
+}
+
+// FindExpired 查找在 now 之前过期的记录，按过期时间排序
+func (r *TableTTLRepository) FindExpired(now time.Time, limit int) ([]models.TableTTL, error) {
+	var records []models.TableTTL
+	err := r.db.Where("expires_at <= ?", now).Order("expires_at ASC").Limit(limit).Find(&records).Error
+	return records, err
+}
+
+// UpdateExpiry 修改过期时间，返回是否存在该记录
+func (r *TableTTLRepository) UpdateExpiry(dbName, tableName string, expiresAt time.Time) (bool, error) {
+	result := r.db.Model(&models.TableTTL{}).Where("db_name = ? AND table_name = ?", dbName, tableName).
+		Update("expires_at", expiresAt)
+	return result.RowsAffected > 0, result.Error
+}
+
+// Delete 表删除后移除记录
+func (r *TableTTLRepository) Delete(dbName, tableName string) error {
+	return r.db.Where("db_name = ? AND table_name = ?", dbName, tableName).Delete(&models.TableTTL{}).Error
+}
+
+// DeleteByDb 库删除后移除库及库内表的记录
+func (r *TableTTLRepository) DeleteByDb(dbName string) error {
+	return r.db.Where("db_name = ?", dbName).Delete(&models.TableTTL{}).Error
+}
@@ -404,8 +404,11 @@ func (k *KingbaseStrategy) GetTableInfo(schemaName string, tableName string, isE
 	} else {
 		// 普通查询，获取表的相关信息
 		// 使用参数化查询来防止 SQL 注入，确保 schemaName 和 tableName 不被直接拼接
-		sqlQuery = "SELECT schemaname as table_schema, relname as table_name, n_live_tup as table_rows, pg_total_relation_size(relid) as data_length " +
-			"FROM pg_stat_user_tables WHERE relname = $1 AND schemaname = $2"
+		// 行数取 pg_class.reltuples（未收集统计信息时为 -1），统计时间取手动和自动 ANALYZE 中较新的一次
+		sqlQuery = "SELECT s.schemaname as table_schema, s.relname as table_name, c.reltuples::bigint as table_rows, " +
+			"pg_total_relation_size(s.relid) as data_length, " +
+			"EXTRACT(EPOCH FROM GREATEST(s.last_analyze, s.last_autoanalyze))::bigint as last_analyzed " +
+			"FROM pg_stat_user_tables s JOIN pg_class c ON c.oid = s.relid WHERE s.relname = $1 AND s.schemaname = $2"
 	}
 
 	// 记录日志
@@ -415,14 +418,13 @@ func (k *KingbaseStrategy) GetTableInfo(schemaName string, tableName string, isE
 
 	if isExactQuery {
 		// 如果是精确查询，只获取记录数
-		err := k.DB.QueryRow(sqlQuery).Scan(&result.TableRows)
+		var rowCount int64
+		err := k.DB.QueryRow(sqlQuery).Scan(&rowCount)
 		if err != nil {
 			log.Logger.Errorf("Error executing query: %v", err)
 			return nil, fmt.Errorf("error executing query: %v", err)
 		}
-		result.TableSchema = ""      // 没有表模式
-		result.TableName = tableName // 返回表名
-		result.TableSize = 0         // 精确查询不关心表的大小
+		result.setExactCount(tableName, rowCount)
 	} else {
 		// 检查是否启用估算模式
 		if ShouldUseEstimationOnly() {
@@ -431,11 +433,15 @@ func (k *KingbaseStrategy) GetTableInfo(schemaName string, tableName string, isE
 			FillTableInfoFromEstimation(k, "", tableName, "public", &result)
 		} else {
 			// 普通查询，先尝试从 pg_stat_user_tables 获取
-			err := k.DB.QueryRow(sqlQuery, tableName, "public").Scan(&result.TableSchema, &result.TableName, &result.TableRows, &result.TableSize)
-			if err != nil || result.TableName == "" || result.TableRows == 0 {
-				// 查询失败或返回空结果，使用估算方式
-				LogQueryFailure("pg_stat_user_tables", err, result.TableName, result.TableRows)
+			var lastAnalyzed sql.NullInt64
+			err := k.DB.QueryRow(sqlQuery, tableName, "public").Scan(&result.TableSchema, &result.TableName, &result.TableRows, &result.TableSize, &lastAnalyzed)
+			if err != nil || result.TableName == "" || result.TableRows <= 0 {
+				// 查询失败、返回空结果或未收集统计信息，使用估算方式
+				LogQueryFailure("pg_class", err, result.TableName, result.TableRows)
 				FillTableInfoFromEstimation(k, "", tableName, "public", &result)
+			} else {
+				result.StatsMethod = ds.TableStatsMethod_TABLE_STATS_METHOD_PG_CLASS
+				result.LastAnalyzedAt = lastAnalyzed.Int64
 			}
 		}
 	}
@@ -450,17 +456,12 @@ func (k *KingbaseStrategy) GetTableInfo(schemaName string, tableName string, isE
 	log.Logger.Infof("Table info: %+v", result)
 
 	// 返回查询结果
-	return &ds.TableInfoResponse{
-		TableName:   result.TableName,
-		RecordCount: result.TableRows,
-		RecordSize:  result.TableSize,
-		Columns:     columns,
-	}, nil
+	return result.ToTableInfoResponse(columns), nil
 }
 
 // 获取表结构信息
 func (k *KingbaseStrategy) getTableSchema(schemaName, tableName string) ([]*ds.ColumnItem, error) {
-	query := `SELECT column_name, data_type, character_maximum_length, is_nullable, column_default
+	query := `SELECT column_name, data_type, character_maximum_length, is_nullable, column_default, numeric_precision, numeric_scale
               FROM information_schema.columns 
               WHERE table_schema = $1 AND table_name = $2
               ORDER BY ordinal_position`
@@ -473,23 +474,23 @@ func (k *KingbaseStrategy) getTableSchema(schemaName, tableName string) ([]*ds.C
 
 	var columns []*ds.ColumnItem
 	for rows.Next() {
-		var col ds.ColumnItem
-		var maxLength sql.NullInt64
+		var name, dataType string
+		var maxLength, precision, scale sql.NullInt64
 		var isNullable, columnDefault sql.NullString
 
-		err := rows.Scan(&col.Name, &col.DataType, &maxLength, &isNullable, &columnDefault)
+		err := rows.Scan(&name, &dataType, &maxLength, &isNullable, &columnDefault, &precision, &scale)
 		if err != nil {
 			return nil, err
 		}
 
-		columns = append(columns, &col)
+		columns = append(columns, newColumnItem(name, dataType, isNullable, precision, scale))
 	}
 
 	return columns, nil
 }
 
 func (k *KingbaseStrategy) BuildWithConditionQuery(tableName string, fields []string, filterNames []string,
-	filterOperators []ds.FilterOperator, filterValues []*ds.FilterValue, sortRules []*ds.SortRule) (string, []interface{}, error) {
+	filterOperators []ds.FilterOperator, filterValues []*ds.FilterValue, sortRules []*ds.SortRule, window *ReadWindow) (string, []interface{}, error) {
 	quoteIdentifier := func(identifier string) string {
 		return fmt.Sprintf("\"%s\"", identifier)
 	}
@@ -520,7 +521,7 @@ func (k *KingbaseStrategy) BuildWithConditionQuery(tableName string, fields []st
 		queryBuilder.WriteString("*")
 	}
 	queryBuilder.WriteString(" FROM ")
-	queryBuilder.WriteString(quoteIdentifier(tableName))
+	queryBuilder.WriteString(window.sampleTable(quoteIdentifier(tableName), postgresWindowDialect))
 
 	// 构建 WHERE 子句
 	if len(filterNames) > 0 && len(filterOperators) > 0 && len(filterValues) > 0 {
@@ -582,9 +583,8 @@ func (k *KingbaseStrategy) BuildWithConditionQuery(tableName string, fields []st
 	}
 
 	// 构建 ORDER BY 子句
+	orderBy := ""
 	if len(sortRules) > 0 {
-		queryBuilder.WriteString(" ORDER BY ")
-
 		orders := make([]string, len(sortRules))
 		for i, rule := range sortRules {
 			order := "ASC"
@@ -593,10 +593,11 @@ func (k *KingbaseStrategy) BuildWithConditionQuery(tableName string, fields []st
 			}
 			orders[i] = fmt.Sprintf("%s %s", rule.FieldName, order)
 		}
-		queryBuilder.WriteString(strings.Join(orders, ", "))
+		orderBy = strings.Join(orders, ", ")
 	}
 
-	return queryBuilder.String(), args, nil
+	// 采样、分页
+	return window.finish(queryBuilder.String(), orderBy, postgresWindowDialect), args, nil
 }
 
 func (k *KingbaseStrategy) EnsureDatabaseExists(dbName string) error {
@@ -786,18 +787,18 @@ func writeCertToTempFileFromBase64(certContent, prefix string) (string, error) {
 	return tempFile.Name(), nil
 }
 
-func (k *KingbaseStrategy) getTableRowCount(tableName string) (int32, error) {
+func (k *KingbaseStrategy) getTableRowCount(tableName string) (int64, error) {
 	return k.GetTableRowCount("", tableName)
 }
 
-func (k *KingbaseStrategy) estimateTableSize(tableName string, totalRows int32) (int64, error) {
+func (k *KingbaseStrategy) estimateTableSize(tableName string, totalRows int64) (int64, error) {
 	return k.EstimateTableSize("", tableName, totalRows)
 }
 
 // getTableRowCount 获取表的总行数
-func (k *KingbaseStrategy) GetTableRowCount(database string, tableName string) (int32, error) {
+func (k *KingbaseStrategy) GetTableRowCount(database string, tableName string) (int64, error) {
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s", tableName)
-	var rowCount int32
+	var rowCount int64
 	if err := k.DB.QueryRow(countQuery).Scan(&rowCount); err != nil {
 		return 0, err
 	}
@@ -805,7 +806,7 @@ func (k *KingbaseStrategy) GetTableRowCount(database string, tableName string) (
 }
 
 // estimateTableSize 通过采样数据估算表的大小
-func (k *KingbaseStrategy) EstimateTableSize(database string, tableName string, totalRows int32) (int64, error) {
+func (k *KingbaseStrategy) EstimateTableSize(database string, tableName string, totalRows int64) (int64, error) {
 	// 获取100条数据估算平均行大小
 	sampleQuery := fmt.Sprintf("SELECT * FROM %s LIMIT 100", tableName)
 	rows, err := k.DB.Query(sampleQuery)
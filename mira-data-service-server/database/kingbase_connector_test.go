@@ -95,7 +95,7 @@ func TestBuildWithConditionQueryKingbase(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			kbStrategy := KingbaseStrategy{}
-			query, args, err := kbStrategy.BuildWithConditionQuery(tc.tableName, tc.fields, tc.filterNames, tc.filterOperators, tc.filterValues, tc.sortRules)
+			query, args, err := kbStrategy.BuildWithConditionQuery(tc.tableName, tc.fields, tc.filterNames, tc.filterOperators, tc.filterValues, tc.sortRules, nil)
 			if err != nil {
 				t.Errorf("Unexpected error: %v", err)
 				return
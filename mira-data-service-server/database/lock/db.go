@@ -0,0 +1,40 @@
+package lock
+
+import (
+	"context"
+	"time"
+
+	"data-service/database/gorm/repositories"
+
+	"gorm.io/gorm"
+)
+
+// dbBackend 数据库行锁，获取时通过 SELECT ... FOR UPDATE 串行化对锁行的修改
+type dbBackend struct {
+	repo *repositories.DistributedLockRepository
+}
+
+// NewDBLocker 创建基于数据库行锁的分布式锁
+func NewDBLocker(db *gorm.DB, opts Options) Locker {
+	return newLocker(&dbBackend{repo: repositories.NewDistributedLockRepository(db)}, opts)
+}
+
+func (b *dbBackend) name() string {
+	return "db"
+}
+
+func (b *dbBackend) tryAcquire(_ context.Context, key, owner string, ttl time.Duration) (int64, error) {
+	return b.repo.TryAcquire(key, owner, ttl)
+}
+
+func (b *dbBackend) renew(_ context.Context, key, owner string, ttl time.Duration) (bool, error) {
+	return b.repo.Renew(key, owner, ttl)
+}
+
+func (b *dbBackend) check(_ context.Context, key, owner string, token int64) (bool, error) {
+	return b.repo.Check(key, owner, token)
+}
+
+func (b *dbBackend) release(_ context.Context, key, owner string) error {
+	return b.repo.Release(key, owner)
+}
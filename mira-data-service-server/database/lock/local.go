@@ -0,0 +1,74 @@
+package lock
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// localBackend 进程内锁，单副本部署或测试时使用
+type localBackend struct {
+	mu    sync.Mutex
+	locks map[string]*localLock
+}
+
+type localLock struct {
+	owner     string
+	token     int64
+	expiresAt time.Time
+}
+
+// NewLocalLocker 创建进程内锁
+func NewLocalLocker(opts Options) Locker {
+	return newLocker(&localBackend{locks: make(map[string]*localLock)}, opts)
+}
+
+func (b *localBackend) name() string {
+	return "local"
+}
+
+func (b *localBackend) tryAcquire(_ context.Context, key, owner string, ttl time.Duration) (int64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	l, ok := b.locks[key]
+	if !ok {
+		l = &localLock{}
+		b.locks[key] = l
+	}
+	now := time.Now()
+	if l.owner != "" && l.expiresAt.After(now) {
+		return 0, nil
+	}
+	l.owner = owner
+	l.token++
+	l.expiresAt = now.Add(ttl)
+	return l.token, nil
+}
+
+func (b *localBackend) renew(_ context.Context, key, owner string, ttl time.Duration) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	l, ok := b.locks[key]
+	now := time.Now()
+	if !ok || l.owner != owner || !l.expiresAt.After(now) {
+		return false, nil
+	}
+	l.expiresAt = now.Add(ttl)
+	return true, nil
+}
+
+func (b *localBackend) check(_ context.Context, key, owner string, token int64) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	l, ok := b.locks[key]
+	return ok && l.owner == owner && l.token == token && l.expiresAt.After(time.Now()), nil
+}
+
+func (b *localBackend) release(_ context.Context, key, owner string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if l, ok := b.locks[key]; ok && l.owner == owner {
+		l.owner = ""
+	}
+	return nil
+}
@@ -0,0 +1,257 @@
+// Package lock 提供集群级分布式锁，支持 Redis 和数据库行锁两种实现
+package lock
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"data-service/config"
+	log "data-service/log"
+	"data-service/utils"
+
+	"github.com/google/uuid"
+	gormlib "gorm.io/gorm"
+)
+
+const (
+	defaultTTL           = 30 * time.Second
+	defaultRetryInterval = 200 * time.Millisecond
+	releaseTimeout       = 5 * time.Second
+)
+
+// 获取锁的结果，用于等待时长指标
+const (
+	ResultAcquired = "acquired"
+	ResultTimeout  = "timeout"
+	ResultError    = "error"
+)
+
+// Locker 分布式锁
+type Locker interface {
+	// Acquire 阻塞直到获得锁或 ctx 结束，持有期间自动续租
+	Acquire(ctx context.Context, key string) (*Lease, error)
+}
+
+// Metrics 锁等待与租约丢失指标
+type Metrics interface {
+	ObserveWait(backend, result string, wait time.Duration)
+	IncLost(backend string)
+}
+
+// backend 锁存储，tryAcquire 被他人持有时返回 0
+type backend interface {
+	name() string
+	tryAcquire(ctx context.Context, key, owner string, ttl time.Duration) (int64, error)
+	renew(ctx context.Context, key, owner string, ttl time.Duration) (bool, error)
+	// check owner 是否仍以 token 持有未过期的锁
+	check(ctx context.Context, key, owner string, token int64) (bool, error)
+	release(ctx context.Context, key, owner string) error
+}
+
+// Lease 已获得的锁，Token 为 fencing token，同一个锁每次获取严格递增，写入前用 Check 校验
+type Lease struct {
+	Key   string
+	Token int64
+
+	owner   string
+	locker  *locker
+	stop    chan struct{}
+	lost    chan struct{}
+	done    sync.WaitGroup
+	release sync.Once
+}
+
+// Lost 续租失败（租约可能已被其他副本获取）时关闭
+func (l *Lease) Lost() <-chan struct{} {
+	return l.lost
+}
+
+// Valid 租约是否仍然有效，执行不可重复的操作前检查
+func (l *Lease) Valid() bool {
+	select {
+	case <-l.lost:
+		return false
+	default:
+		return true
+	}
+}
+
+// Check 在锁存储中校验 token 仍是该锁最新签发的 token 且租约未过期，
+// 执行写入前调用，已被更大的 token 取代时拒绝写入
+func (l *Lease) Check(ctx context.Context) error {
+	if !l.Valid() {
+		return fmt.Errorf("lock %s (token %d) lost", l.Key, l.Token)
+	}
+	ok, err := l.locker.backend.check(ctx, l.Key, l.owner, l.Token)
+	if err != nil {
+		return fmt.Errorf("failed to check lock %s: %v", l.Key, err)
+	}
+	if !ok {
+		return fmt.Errorf("lock %s token %d is stale", l.Key, l.Token)
+	}
+	return nil
+}
+
+// Release 停止续租并释放锁，可重复调用
+func (l *Lease) Release() error {
+	var err error
+	l.release.Do(func() {
+		close(l.stop)
+		l.done.Wait()
+		ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+		defer cancel()
+		err = l.locker.backend.release(ctx, l.Key, l.owner)
+	})
+	return err
+}
+
+// Options 锁参数，为 0 时使用默认值
+type Options struct {
+	TTL           time.Duration // 租约时长，持有期间每 1/3 租约续租一次
+	RetryInterval time.Duration // 锁被占用时的重试间隔
+	WaitTimeout   time.Duration // 获取锁的最长等待时间，0 表示只受 ctx 限制
+}
+
+// locker 在 backend 之上实现等待重试、续租和指标
+type locker struct {
+	backend backend
+	opts    Options
+}
+
+func newLocker(b backend, opts Options) *locker {
+	if opts.TTL <= 0 {
+		opts.TTL = defaultTTL
+	}
+	if opts.RetryInterval <= 0 {
+		opts.RetryInterval = defaultRetryInterval
+	}
+	return &locker{backend: b, opts: opts}
+}
+
+func (l *locker) Acquire(ctx context.Context, key string) (*Lease, error) {
+	start := time.Now()
+	if l.opts.WaitTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, l.opts.WaitTimeout)
+		defer cancel()
+	}
+	owner := newOwner()
+	for {
+		token, err := l.backend.tryAcquire(ctx, key, owner, l.opts.TTL)
+		if err != nil {
+			observeWait(l.backend.name(), ResultError, time.Since(start))
+			return nil, fmt.Errorf("failed to acquire lock %s: %v", key, err)
+		}
+		if token > 0 {
+			observeWait(l.backend.name(), ResultAcquired, time.Since(start))
+			lease := &Lease{Key: key, Token: token, owner: owner, locker: l, stop: make(chan struct{}), lost: make(chan struct{})}
+			lease.done.Add(1)
+			go l.keepAlive(lease)
+			return lease, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			observeWait(l.backend.name(), ResultTimeout, time.Since(start))
+			return nil, fmt.Errorf("failed to acquire lock %s: %v", key, ctx.Err())
+		case <-time.After(l.opts.RetryInterval):
+		}
+	}
+}
+
+// keepAlive 每 1/3 租约续租一次，续租失败时标记租约丢失
+func (l *locker) keepAlive(lease *Lease) {
+	defer lease.done.Done()
+	ticker := time.NewTicker(l.opts.TTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-lease.stop:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), l.opts.TTL/3)
+			ok, err := l.backend.renew(ctx, lease.Key, lease.owner, l.opts.TTL)
+			cancel()
+			if err == nil && ok {
+				continue
+			}
+			log.Logger.Errorf("Lost lock %s (token %d): renewed=%v, err=%v", lease.Key, lease.Token, ok, err)
+			close(lease.lost)
+			if m := getMetrics(); m != nil {
+				m.IncLost(l.backend.name())
+			}
+			return
+		}
+	}
+}
+
+// newOwner 生成持有者标识：主机名加随机ID，每次获取锁都不同
+func newOwner() string {
+	host, _ := os.Hostname()
+	return host + "/" + uuid.NewString()
+}
+
+var (
+	mu      sync.RWMutex
+	current Locker = NewLocalLocker(Options{})
+	metrics Metrics
+)
+
+// Default 全局锁，未调用 SetDefault 时为进程内锁
+func Default() Locker {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// SetDefault 设置全局锁，服务启动时按配置调用
+func SetDefault(l Locker) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = l
+}
+
+// SetMetrics 设置锁指标
+func SetMetrics(m Metrics) {
+	mu.Lock()
+	defer mu.Unlock()
+	metrics = m
+}
+
+func getMetrics() Metrics {
+	mu.RLock()
+	defer mu.RUnlock()
+	return metrics
+}
+
+func observeWait(backend, result string, wait time.Duration) {
+	if m := getMetrics(); m != nil {
+		m.ObserveWait(backend, result, wait)
+	}
+}
+
+// New 按配置创建分布式锁：redis 复用 RedisConfig，db 使用 GORM 连接，local 或为空时使用进程内锁
+func New(conf *config.DataServiceConf, db *gormlib.DB) (Locker, error) {
+	lockConf := conf.DistributedLock
+	opts := Options{
+		TTL:           time.Duration(lockConf.TTLSeconds) * time.Second,
+		RetryInterval: time.Duration(lockConf.RetryIntervalMs) * time.Millisecond,
+		WaitTimeout:   time.Duration(lockConf.WaitTimeoutSeconds) * time.Second,
+	}
+	switch lockConf.Backend {
+	case "redis":
+		return NewRedisLocker(utils.NewRedisCmdable(conf.RedisConfig), opts), nil
+	case "db":
+		if db == nil {
+			return nil, fmt.Errorf("db lock requires gorm connection")
+		}
+		return NewDBLocker(db, opts), nil
+	case "", "local":
+		return NewLocalLocker(opts), nil
+	default:
+		return nil, fmt.Errorf("unsupported lock backend: %s", lockConf.Backend)
+	}
+}
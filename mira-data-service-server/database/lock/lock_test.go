@@ -0,0 +1,215 @@
+package lock
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"data-service/database/gorm/repositories"
+	"data-service/log"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	gormlib "gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestMain(m *testing.M) {
+	viper.Set("LoggerConfig.Level", "debug")
+	viper.Set("TestConfig", "true")
+	viper.SetConfigFile("")
+	log.InitLogger()
+	m.Run()
+}
+
+// recordingMetrics 记录锁指标
+type recordingMetrics struct {
+	mu      sync.Mutex
+	results []string
+	lost    int
+}
+
+func (m *recordingMetrics) ObserveWait(backend, result string, wait time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.results = append(m.results, backend+":"+result)
+}
+
+func (m *recordingMetrics) IncLost(backend string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lost++
+}
+
+func useRecordingMetrics(t *testing.T) *recordingMetrics {
+	m := &recordingMetrics{}
+	SetMetrics(m)
+	t.Cleanup(func() { SetMetrics(nil) })
+	return m
+}
+
+func TestLocalLocker_MutualExclusionAndFencing(t *testing.T) {
+	metrics := useRecordingMetrics(t)
+	locker := NewLocalLocker(Options{RetryInterval: time.Millisecond})
+
+	first, err := locker.Acquire(context.Background(), "import:db.t1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), first.Token)
+
+	// 其他键不受影响
+	other, err := locker.Acquire(context.Background(), "import:db.t2")
+	require.NoError(t, err)
+	require.NoError(t, other.Release())
+
+	acquired := make(chan *Lease)
+	go func() {
+		lease, err := locker.Acquire(context.Background(), "import:db.t1")
+		require.NoError(t, err)
+		acquired <- lease
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("lock acquired while held")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	require.NoError(t, first.Release())
+	require.NoError(t, first.Release())
+	second := <-acquired
+	assert.Equal(t, int64(2), second.Token)
+	assert.True(t, second.Valid())
+	require.NoError(t, second.Release())
+
+	assert.Equal(t, []string{"local:acquired", "local:acquired", "local:acquired"}, metrics.results)
+}
+
+func TestLocker_WaitTimeout(t *testing.T) {
+	metrics := useRecordingMetrics(t)
+	locker := NewLocalLocker(Options{RetryInterval: time.Millisecond, WaitTimeout: 20 * time.Millisecond})
+
+	held, err := locker.Acquire(context.Background(), "k")
+	require.NoError(t, err)
+	defer held.Release()
+
+	_, err = locker.Acquire(context.Background(), "k")
+	assert.ErrorContains(t, err, "deadline exceeded")
+	assert.Equal(t, []string{"local:acquired", "local:timeout"}, metrics.results)
+}
+
+// expiringBackend 续租总是失败的锁
+type expiringBackend struct {
+	localBackend
+}
+
+func (b *expiringBackend) renew(context.Context, string, string, time.Duration) (bool, error) {
+	return false, nil
+}
+
+func TestLocker_LeaseLostWhenRenewFails(t *testing.T) {
+	metrics := useRecordingMetrics(t)
+	locker := newLocker(&expiringBackend{localBackend{locks: make(map[string]*localLock)}}, Options{TTL: 30 * time.Millisecond})
+
+	lease, err := locker.Acquire(context.Background(), "k")
+	require.NoError(t, err)
+	select {
+	case <-lease.Lost():
+	case <-time.After(time.Second):
+		t.Fatal("lease was not marked lost")
+	}
+	assert.False(t, lease.Valid())
+	assert.Equal(t, 1, metrics.lost)
+	require.NoError(t, lease.Release())
+}
+
+func TestLocker_RenewKeepsLease(t *testing.T) {
+	locker := NewLocalLocker(Options{TTL: 30 * time.Millisecond, RetryInterval: time.Millisecond, WaitTimeout: 100 * time.Millisecond})
+
+	lease, err := locker.Acquire(context.Background(), "k")
+	require.NoError(t, err)
+	defer lease.Release()
+
+	// 超过租约时长后仍被续租持有
+	_, err = locker.Acquire(context.Background(), "k")
+	assert.Error(t, err)
+	assert.True(t, lease.Valid())
+}
+
+func TestLease_CheckRejectsStaleToken(t *testing.T) {
+	b := &localBackend{locks: make(map[string]*localLock)}
+	locker := newLocker(b, Options{TTL: time.Hour, RetryInterval: time.Millisecond})
+
+	first, err := locker.Acquire(context.Background(), "k")
+	require.NoError(t, err)
+	require.NoError(t, first.Check(context.Background()))
+
+	// 租约过期后被其他持有者以更大的 token 获取
+	b.mu.Lock()
+	b.locks["k"].expiresAt = time.Now().Add(-time.Second)
+	b.mu.Unlock()
+	second, err := locker.Acquire(context.Background(), "k")
+	require.NoError(t, err)
+	defer second.Release()
+
+	assert.ErrorContains(t, first.Check(context.Background()), "token 1 is stale")
+	require.NoError(t, second.Check(context.Background()))
+	require.NoError(t, first.Release())
+}
+
+func newMockGorm(t *testing.T) (*gormlib.DB, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+	db, err := gormlib.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gormlib.Config{Logger: logger.Discard, SkipDefaultTransaction: true})
+	require.NoError(t, err)
+	return db, mock
+}
+
+func TestDBBackend_TryAcquire(t *testing.T) {
+	db, mock := newMockGorm(t)
+	b := &dbBackend{repo: repositories.NewDistributedLockRepository(db)}
+
+	// 锁空闲：token 加一
+	mock.ExpectExec("INSERT INTO `t_data_service_distributed_lock`.*ON DUPLICATE KEY UPDATE").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT \\* FROM `t_data_service_distributed_lock` WHERE lock_key = \\?.*FOR UPDATE").
+		WithArgs("k", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"lock_key", "owner", "token", "expires_at"}).AddRow("k", "", 4, time.Unix(0, 0)))
+	mock.ExpectExec("UPDATE `t_data_service_distributed_lock` SET .*WHERE lock_key = \\?").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	token, err := b.tryAcquire(context.Background(), "k", "replica-a", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), token)
+
+	// 被其他副本持有且未过期：不修改
+	mock.ExpectExec("INSERT INTO `t_data_service_distributed_lock`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT \\* FROM `t_data_service_distributed_lock`").
+		WillReturnRows(sqlmock.NewRows([]string{"lock_key", "owner", "token", "expires_at"}).AddRow("k", "replica-a", 5, time.Now().Add(time.Minute)))
+	mock.ExpectCommit()
+
+	token, err = b.tryAcquire(context.Background(), "k", "replica-b", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), token)
+
+	// 续租只更新自己持有且未过期的锁
+	mock.ExpectExec("UPDATE `t_data_service_distributed_lock` SET `expires_at`=\\?,`updated_at`=\\? WHERE lock_key = \\? AND owner = \\? AND expires_at > \\?").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	ok, err := b.renew(context.Background(), "k", "replica-b", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// 校验 token 时要求仍由同一持有者以该 token 持有
+	mock.ExpectQuery("SELECT count\\(\\*\\) FROM `t_data_service_distributed_lock` WHERE lock_key = \\? AND owner = \\? AND token = \\? AND expires_at > \\?").
+		WithArgs("k", "replica-a", int64(5), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	ok, err = b.check(context.Background(), "k", "replica-a", 5)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
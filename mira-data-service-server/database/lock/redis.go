@@ -0,0 +1,73 @@
+package lock
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// 锁和 fencing token 使用相同的 hash tag，集群模式下落在同一个 slot
+const (
+	redisLockKey  = "mira:lock:{%s}"
+	redisFenceKey = "mira:lock:{%s}:fence"
+)
+
+var (
+	// 获取成功时递增并返回 fencing token，否则返回 0
+	redisAcquireScript = redis.NewScript(`
+if redis.call('SET', KEYS[1], ARGV[1], 'NX', 'PX', ARGV[2]) then
+  return redis.call('INCR', KEYS[2])
+end
+return 0`)
+	redisRenewScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+  return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0`)
+	redisCheckScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] and tonumber(redis.call('GET', KEYS[2])) == tonumber(ARGV[2]) then
+  return 1
+end
+return 0`)
+	redisReleaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+  return redis.call('DEL', KEYS[1])
+end
+return 0`)
+)
+
+// redisBackend 基于 SET NX PX 的 Redis 锁，支持单机、哨兵和集群模式的客户端
+type redisBackend struct {
+	client redis.Cmdable
+}
+
+// NewRedisLocker 创建 Redis 分布式锁
+func NewRedisLocker(client redis.Cmdable, opts Options) Locker {
+	return newLocker(&redisBackend{client: client}, opts)
+}
+
+func (b *redisBackend) name() string {
+	return "redis"
+}
+
+func (b *redisBackend) tryAcquire(ctx context.Context, key, owner string, ttl time.Duration) (int64, error) {
+	keys := []string{fmt.Sprintf(redisLockKey, key), fmt.Sprintf(redisFenceKey, key)}
+	return redisAcquireScript.Run(ctx, b.client, keys, owner, ttl.Milliseconds()).Int64()
+}
+
+func (b *redisBackend) renew(ctx context.Context, key, owner string, ttl time.Duration) (bool, error) {
+	n, err := redisRenewScript.Run(ctx, b.client, []string{fmt.Sprintf(redisLockKey, key)}, owner, ttl.Milliseconds()).Int64()
+	return n == 1, err
+}
+
+func (b *redisBackend) check(ctx context.Context, key, owner string, token int64) (bool, error) {
+	keys := []string{fmt.Sprintf(redisLockKey, key), fmt.Sprintf(redisFenceKey, key)}
+	n, err := redisCheckScript.Run(ctx, b.client, keys, owner, token).Int64()
+	return n == 1, err
+}
+
+func (b *redisBackend) release(ctx context.Context, key, owner string) error {
+	return redisReleaseScript.Run(ctx, b.client, []string{fmt.Sprintf(redisLockKey, key)}, owner).Err()
+}
@@ -39,10 +39,13 @@ var (
 
 // TableInfoResponse 结构体用于存储查询结果
 type TableInfoResponse struct {
-	TableSchema string `json:"table_schema"`
-	TableName   string `json:"table_name"`
-	TableRows   int32  `json:"table_rows"`
-	TableSize   int64  `json:"data_length"`
+	TableSchema    string              `json:"table_schema"`
+	TableName      string              `json:"table_name"`
+	TableRows      int64               `json:"table_rows"`
+	TableSize      int64               `json:"data_length"`
+	ExactCount     bool                `json:"exact_count"`
+	StatsMethod    ds.TableStatsMethod `json:"stats_method"`
+	LastAnalyzedAt int64               `json:"last_analyzed_at"` // Unix 秒，未知时为 0
 }
 
 type MySQLStrategy struct {
@@ -500,12 +503,11 @@ func (m *MySQLStrategy) GetTableInfo(database string, tableName string, isExactQ
 
 	if isExactQuery {
 		// 精确查询，只返回记录数
-		if err := m.DB.QueryRow(sqlQuery).Scan(&result.TableRows); err != nil {
+		var rowCount int64
+		if err := m.DB.QueryRow(sqlQuery).Scan(&rowCount); err != nil {
 			return nil, err
 		}
-		result.TableSchema = ""      // 没有表模式
-		result.TableName = tableName // 返回表名
-		result.TableSize = 0         // 精确查询不关心表的大小
+		result.setExactCount(tableName, rowCount)
 	} else {
 		// 检查是否启用估算模式
 		if ShouldUseEstimationOnly() {
@@ -520,6 +522,9 @@ func (m *MySQLStrategy) GetTableInfo(database string, tableName string, isExactQ
 				LogQueryFailure("information_schema.tables", err, result.TableName, result.TableRows)
 
 				FillTableInfoFromEstimation(m, database, tableName, database, &result)
+			} else {
+				result.StatsMethod = ds.TableStatsMethod_TABLE_STATS_METHOD_INFORMATION_SCHEMA
+				result.LastAnalyzedAt = m.getLastAnalyzedAt(database, tableName)
 			}
 		}
 	}
@@ -534,17 +539,24 @@ func (m *MySQLStrategy) GetTableInfo(database string, tableName string, isExactQ
 	log.Logger.Infof("Table info: %+v", result)
 
 	// 返回查询结果
-	return &ds.TableInfoResponse{
-		TableName:   result.TableName,
-		RecordCount: result.TableRows,
-		RecordSize:  result.TableSize,
-		Columns:     columns,
-	}, nil
+	return result.ToTableInfoResponse(columns), nil
+}
+
+// getLastAnalyzedAt 获取 InnoDB 统计信息最近一次收集时间，无权限或非 InnoDB 表时返回 0
+func (m *MySQLStrategy) getLastAnalyzedAt(database, tableName string) int64 {
+	var lastAnalyzed sql.NullInt64
+	err := m.DB.QueryRow("SELECT UNIX_TIMESTAMP(last_update) FROM mysql.innodb_table_stats WHERE database_name = ? AND table_name = ?",
+		database, tableName).Scan(&lastAnalyzed)
+	if err != nil {
+		log.Logger.Debugf("Failed to get last analyzed time of %s.%s: %v", database, tableName, err)
+		return 0
+	}
+	return lastAnalyzed.Int64
 }
 
 // 获取表结构信息
 func (m *MySQLStrategy) getTableSchema(database, tableName string) ([]*ds.ColumnItem, error) {
-	query := `SELECT COLUMN_NAME, DATA_TYPE, CHARACTER_MAXIMUM_LENGTH, IS_NULLABLE, COLUMN_DEFAULT, COLUMN_COMMENT
+	query := `SELECT COLUMN_NAME, DATA_TYPE, CHARACTER_MAXIMUM_LENGTH, IS_NULLABLE, COLUMN_DEFAULT, COLUMN_COMMENT, NUMERIC_PRECISION, NUMERIC_SCALE
               FROM INFORMATION_SCHEMA.COLUMNS 
               WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?
               ORDER BY ORDINAL_POSITION`
@@ -557,16 +569,16 @@ func (m *MySQLStrategy) getTableSchema(database, tableName string) ([]*ds.Column
 
 	var columns []*ds.ColumnItem
 	for rows.Next() {
-		var col ds.ColumnItem
-		var maxLength sql.NullInt64
+		var name, dataType string
+		var maxLength, precision, scale sql.NullInt64
 		var isNullable, columnDefault, comment sql.NullString
 
-		err := rows.Scan(&col.Name, &col.DataType, &maxLength, &isNullable, &columnDefault, &comment)
+		err := rows.Scan(&name, &dataType, &maxLength, &isNullable, &columnDefault, &comment, &precision, &scale)
 		if err != nil {
 			return nil, err
 		}
 
-		columns = append(columns, &col)
+		columns = append(columns, newColumnItem(name, dataType, isNullable, precision, scale))
 	}
 
 	return columns, nil
@@ -579,6 +591,7 @@ func (m *MySQLStrategy) BuildWithConditionQuery(
 	filterOperators []ds.FilterOperator,
 	filterValues []*ds.FilterValue,
 	sortRules []*ds.SortRule,
+	window *ReadWindow,
 ) (string, []interface{}, error) {
 	var queryBuilder strings.Builder
 	args := []interface{}{}
@@ -602,7 +615,7 @@ func (m *MySQLStrategy) BuildWithConditionQuery(
 		queryBuilder.WriteString("*")
 	}
 	queryBuilder.WriteString(" FROM ")
-	queryBuilder.WriteString(tableName)
+	queryBuilder.WriteString(window.sampleTable(tableName, mysqlWindowDialect))
 
 	// 构建 WHERE 子句
 	if len(filterNames) > 0 && len(filterOperators) > 0 && len(filterValues) > 0 {
@@ -656,10 +669,19 @@ func (m *MySQLStrategy) BuildWithConditionQuery(
 		queryBuilder.WriteString(strings.Join(conditions, " AND "))
 	}
 
+	// 按比例采样
+	if predicate := window.samplePredicate(mysqlWindowDialect); predicate != "" {
+		if len(filterNames) > 0 && len(filterOperators) > 0 && len(filterValues) > 0 {
+			queryBuilder.WriteString(" AND ")
+		} else {
+			queryBuilder.WriteString(" WHERE ")
+		}
+		queryBuilder.WriteString(predicate)
+	}
+
 	// 构建 ORDER BY 子句
+	orderBy := ""
 	if len(sortRules) > 0 {
-		queryBuilder.WriteString(" ORDER BY ")
-
 		orders := make([]string, len(sortRules))
 		for i, rule := range sortRules {
 			order := "ASC"
@@ -668,10 +690,11 @@ func (m *MySQLStrategy) BuildWithConditionQuery(
 			}
 			orders[i] = fmt.Sprintf("%s %s", rule.FieldName, order)
 		}
-		queryBuilder.WriteString(strings.Join(orders, ", "))
+		orderBy = strings.Join(orders, ", ")
 	}
 
-	return queryBuilder.String(), args, nil
+	// 采样、分页
+	return window.finish(queryBuilder.String(), orderBy, mysqlWindowDialect), args, nil
 }
 
 func (m *MySQLStrategy) EnsureDatabaseExists(dbName string) error {
@@ -757,9 +780,9 @@ func (m *MySQLStrategy) GetGroupCountInfo(tableName string, groupBy []string, fi
 }
 
 // getTableRowCount 获取表的总行数
-func (m *MySQLStrategy) getTableRowCount(database string, tableName string) (int32, error) {
+func (m *MySQLStrategy) getTableRowCount(database string, tableName string) (int64, error) {
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s.%s", database, tableName)
-	var rowCount int32
+	var rowCount int64
 	if err := m.DB.QueryRow(countQuery).Scan(&rowCount); err != nil {
 		return 0, err
 	}
@@ -767,7 +790,7 @@ func (m *MySQLStrategy) getTableRowCount(database string, tableName string) (int
 }
 
 // estimateTableSize 通过采样数据估算表的大小
-func (m *MySQLStrategy) estimateTableSize(database string, tableName string, totalRows int32) (int64, error) {
+func (m *MySQLStrategy) estimateTableSize(database string, tableName string, totalRows int64) (int64, error) {
 	// 获取100条数据估算平均行大小
 	sampleQuery := fmt.Sprintf("SELECT * FROM %s.%s LIMIT 100", database, tableName)
 	rows, err := m.DB.Query(sampleQuery)
@@ -815,11 +838,11 @@ func (m *MySQLStrategy) estimateTableSize(database string, tableName string, tot
 }
 
 // GetTableRowCount 实现 TableInfoEstimator 接口
-func (m *MySQLStrategy) GetTableRowCount(database string, tableName string) (int32, error) {
+func (m *MySQLStrategy) GetTableRowCount(database string, tableName string) (int64, error) {
 	return m.getTableRowCount(database, tableName)
 }
 
 // EstimateTableSize 实现 TableInfoEstimator 接口
-func (m *MySQLStrategy) EstimateTableSize(database string, tableName string, totalRows int32) (int64, error) {
+func (m *MySQLStrategy) EstimateTableSize(database string, tableName string, totalRows int64) (int64, error) {
 	return m.estimateTableSize(database, tableName, totalRows)
 }
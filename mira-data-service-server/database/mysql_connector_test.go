@@ -103,6 +103,7 @@ func TestBuildWithConditionQuery(t *testing.T) {
 				tt.filterOperators,
 				tt.filterValues,
 				tt.sortRules,
+				nil,
 			)
 
 			if gotQuery != tt.wantQuery {
@@ -160,7 +161,7 @@ func TestEstimateTableSize(t *testing.T) {
 		name          string
 		database      string
 		tableName     string
-		totalRows     int32
+		totalRows     int64
 		setupMock     func(mock sqlmock.Sqlmock)
 		expectedSize  int64
 		expectedError string
@@ -268,3 +269,56 @@ func TestEstimateTableSize(t *testing.T) {
 		})
 	}
 }
+
+// TestGetTableInfoExactCountOverInt32 精确行数超过 int32 范围时返回 64 位行数，兼容字段取最大值
+func TestGetTableInfoExactCountOverInt32(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock database: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) as table_rows FROM testdb\.testtable`).
+		WillReturnRows(sqlmock.NewRows([]string{"table_rows"}).AddRow(int64(3000000000)))
+	mock.ExpectQuery(`SELECT COLUMN_NAME, DATA_TYPE, .*NUMERIC_PRECISION, NUMERIC_SCALE`).
+		WithArgs("testdb", "testtable").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME", "DATA_TYPE", "CHARACTER_MAXIMUM_LENGTH", "IS_NULLABLE",
+			"COLUMN_DEFAULT", "COLUMN_COMMENT", "NUMERIC_PRECISION", "NUMERIC_SCALE"}).
+			AddRow("id", "bigint", nil, "NO", nil, "", 19, 0).
+			AddRow("amount", "decimal", nil, "YES", nil, "", 12, 2).
+			AddRow("name", "varchar", 64, "YES", nil, "", nil, nil))
+
+	strategy := &MySQLStrategy{DB: db}
+	info, err := strategy.GetTableInfo("testdb", "testtable", true)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	assert.Equal(t, "testtable", info.TableName)
+	assert.Equal(t, int64(3000000000), info.RecordCount64)
+	assert.Equal(t, int32(2147483647), info.RecordCount)
+	assert.True(t, info.IsExactCount)
+	assert.Equal(t, ds.TableStatsMethod_TABLE_STATS_METHOD_COUNT, info.StatsMethod)
+	assert.Equal(t, int64(0), info.LastAnalyzedAt)
+	assert.Equal(t, []*ds.ColumnItem{
+		{Name: "id", DataType: "bigint", Nullable: false, Precision: 19, Scale: 0},
+		{Name: "amount", DataType: "decimal", Nullable: true, Precision: 12, Scale: 2},
+		{Name: "name", DataType: "varchar", Nullable: true},
+	}, info.Columns)
+}
+
+func TestToTableInfoResponse(t *testing.T) {
+	result := TableInfoResponse{
+		TableName:      "t",
+		TableRows:      1000,
+		TableSize:      4096,
+		StatsMethod:    ds.TableStatsMethod_TABLE_STATS_METHOD_INFORMATION_SCHEMA,
+		LastAnalyzedAt: 1700000000,
+	}
+	info := result.ToTableInfoResponse(nil)
+	assert.Equal(t, int32(1000), info.RecordCount)
+	assert.Equal(t, int64(1000), info.RecordCount64)
+	assert.Equal(t, int64(4096), info.RecordSize)
+	assert.False(t, info.IsExactCount)
+	assert.Equal(t, ds.TableStatsMethod_TABLE_STATS_METHOD_INFORMATION_SCHEMA, info.StatsMethod)
+	assert.Equal(t, int64(1700000000), info.LastAnalyzedAt)
+}
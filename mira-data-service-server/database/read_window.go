@@ -0,0 +1,114 @@
+package database
+
+import (
+	"fmt"
+	"strconv"
+
+	pb "data-service/generated/datasource"
+)
+
+// ReadWindow 读取窗口：过滤后采样，再排序并跳过 Offset 行、最多取 Limit 行
+type ReadWindow struct {
+	Limit  int64          // 最多读取的行数，0 表示不限制
+	Offset int64          // 排序后跳过的行数
+	Sample *pb.ReadSample // 采样，nil 表示不采样
+}
+
+// NewReadWindow 校验并创建读取窗口，未设置任何限制时返回 nil
+func NewReadWindow(limit, offset int64, sample *pb.ReadSample) (*ReadWindow, error) {
+	if limit < 0 {
+		return nil, fmt.Errorf("limit must not be negative, got %d", limit)
+	}
+	if offset < 0 {
+		return nil, fmt.Errorf("offset must not be negative, got %d", offset)
+	}
+	if sample != nil {
+		if sample.Percent != 0 && sample.Rows != 0 {
+			return nil, fmt.Errorf("sample percent and rows cannot both be set")
+		}
+		if sample.Percent < 0 || sample.Percent > 100 {
+			return nil, fmt.Errorf("sample percent must be in (0, 100], got %g", sample.Percent)
+		}
+		if sample.Rows < 0 {
+			return nil, fmt.Errorf("sample rows must not be negative, got %d", sample.Rows)
+		}
+		if sample.Percent == 0 && sample.Rows == 0 {
+			sample = nil
+		}
+	}
+	if limit == 0 && offset == 0 && sample == nil {
+		return nil, nil
+	}
+	return &ReadWindow{Limit: limit, Offset: offset, Sample: sample}, nil
+}
+
+// windowDialect 各数据库的采样与分页语法
+type windowDialect struct {
+	// randomValue 可复现的 [0, 1) 随机值，按比例采样时作为过滤条件
+	randomValue func(seed int64) string
+	// randomOrder 可复现的随机排序表达式，按行数采样时使用
+	randomOrder func(seed int64) string
+	// tableSample 按比例采样的 FROM 子句，为空时使用 randomValue 过滤
+	tableSample func(table string, percent float64, seed int64) string
+	// offsetOnlyLimit 只有 offset 时 LIMIT 的取值，为空表示可单独使用 OFFSET
+	offsetOnlyLimit string
+}
+
+// mysqlWindowDialect MySQL 及兼容语法的数据库（GBase 8a、Doris）
+var mysqlWindowDialect = windowDialect{
+	randomValue:     func(seed int64) string { return fmt.Sprintf("RAND(%d)", seed) },
+	randomOrder:     func(seed int64) string { return fmt.Sprintf("RAND(%d)", seed) },
+	offsetOnlyLimit: "9223372036854775807",
+}
+
+// postgresWindowDialect PostgreSQL 系数据库（人大金仓、海量）
+var postgresWindowDialect = windowDialect{
+	randomOrder: func(seed int64) string { return fmt.Sprintf("md5(CAST(ctid AS TEXT) || '%d')", seed) },
+	tableSample: func(table string, percent float64, seed int64) string {
+		return fmt.Sprintf("%s TABLESAMPLE BERNOULLI (%s) REPEATABLE (%d)", table, formatPercent(percent), seed)
+	},
+}
+
+func formatPercent(percent float64) string {
+	return strconv.FormatFloat(percent, 'f', -1, 64)
+}
+
+// sampleTable 返回 FROM 子句中的表，按比例采样且方言支持 TABLESAMPLE 时附加采样子句
+func (w *ReadWindow) sampleTable(table string, d windowDialect) string {
+	if w == nil || w.Sample.GetPercent() == 0 || d.tableSample == nil {
+		return table
+	}
+	return d.tableSample(table, w.Sample.Percent, w.Sample.Seed)
+}
+
+// samplePredicate 返回按比例采样的过滤条件，无需过滤时返回空
+func (w *ReadWindow) samplePredicate(d windowDialect) string {
+	if w == nil || w.Sample.GetPercent() == 0 || d.tableSample != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s < %s", d.randomValue(w.Sample.Seed), strconv.FormatFloat(w.Sample.Percent/100, 'f', -1, 64))
+}
+
+// finish 在不含排序的查询 base 上追加排序和分页；按行数采样时先随机取 Rows 行作为子查询
+func (w *ReadWindow) finish(base, orderBy string, d windowDialect) string {
+	if w != nil && w.Sample.GetRows() > 0 {
+		base = fmt.Sprintf("SELECT * FROM (%s ORDER BY %s LIMIT %d) sample_src", base, d.randomOrder(w.Sample.Seed), w.Sample.Rows)
+	}
+	query := base
+	if orderBy != "" {
+		query += " ORDER BY " + orderBy
+	}
+	if w == nil {
+		return query
+	}
+	switch {
+	case w.Limit > 0:
+		query += fmt.Sprintf(" LIMIT %d", w.Limit)
+	case w.Offset > 0 && d.offsetOnlyLimit != "":
+		query += " LIMIT " + d.offsetOnlyLimit
+	}
+	if w.Offset > 0 {
+		query += fmt.Sprintf(" OFFSET %d", w.Offset)
+	}
+	return query
+}
@@ -0,0 +1,84 @@
+package database
+
+import (
+	"testing"
+
+	pb "data-service/generated/datasource"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReadWindow(t *testing.T) {
+	window, err := NewReadWindow(0, 0, nil)
+	require.NoError(t, err)
+	assert.Nil(t, window)
+
+	// 采样未设置比例和行数时视为不采样
+	window, err = NewReadWindow(0, 0, &pb.ReadSample{Seed: 7})
+	require.NoError(t, err)
+	assert.Nil(t, window)
+
+	window, err = NewReadWindow(10, 20, nil)
+	require.NoError(t, err)
+	assert.Equal(t, &ReadWindow{Limit: 10, Offset: 20}, window)
+
+	invalid := []struct {
+		limit, offset int64
+		sample        *pb.ReadSample
+	}{
+		{limit: -1},
+		{offset: -1},
+		{sample: &pb.ReadSample{Percent: 10, Rows: 5}},
+		{sample: &pb.ReadSample{Percent: 101}},
+		{sample: &pb.ReadSample{Rows: -5}},
+	}
+	for _, tc := range invalid {
+		_, err := NewReadWindow(tc.limit, tc.offset, tc.sample)
+		assert.Error(t, err, "%+v", tc)
+	}
+}
+
+func TestBuildWithConditionQueryWindowMySQL(t *testing.T) {
+	m := &MySQLStrategy{}
+	sortRules := []*pb.SortRule{{FieldName: "id", SortOrder: pb.SortOrder_DESC}}
+
+	window, err := NewReadWindow(10, 20, nil)
+	require.NoError(t, err)
+	query, args, err := m.BuildWithConditionQuery("orders", []string{"id"},
+		[]string{"status"}, []pb.FilterOperator{pb.FilterOperator_EQUAL}, []*pb.FilterValue{{StrValue: "paid"}}, sortRules, window)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT `id` FROM orders WHERE status = ? ORDER BY id DESC LIMIT 10 OFFSET 20", query)
+	assert.Equal(t, []interface{}{"paid"}, args)
+
+	// 只有 offset 时补上 LIMIT 上限
+	window, _ = NewReadWindow(0, 5, nil)
+	query, _, err = m.BuildWithConditionQuery("orders", nil, nil, nil, nil, nil, window)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM orders LIMIT 9223372036854775807 OFFSET 5", query)
+
+	window, _ = NewReadWindow(0, 0, &pb.ReadSample{Percent: 12.5, Seed: 3})
+	query, _, err = m.BuildWithConditionQuery("orders", nil, nil, nil, nil, nil, window)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM orders WHERE RAND(3) < 0.125", query)
+
+	// 按行数采样先随机取样，再排序分页
+	window, _ = NewReadWindow(2, 0, &pb.ReadSample{Rows: 100, Seed: 3})
+	query, _, err = m.BuildWithConditionQuery("orders", []string{"id"}, nil, nil, nil, sortRules, window)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM (SELECT `id` FROM orders ORDER BY RAND(3) LIMIT 100) sample_src ORDER BY id DESC LIMIT 2", query)
+}
+
+func TestBuildWithConditionQueryWindowKingbase(t *testing.T) {
+	k := &KingbaseStrategy{}
+
+	window, _ := NewReadWindow(0, 5, &pb.ReadSample{Percent: 10, Seed: 42})
+	query, _, err := k.BuildWithConditionQuery("orders", []string{"id"}, nil, nil, nil, nil, window)
+	require.NoError(t, err)
+	assert.Equal(t, `SELECT "id" FROM "orders" TABLESAMPLE BERNOULLI (10) REPEATABLE (42) OFFSET 5`, query)
+
+	window, _ = NewReadWindow(0, 0, &pb.ReadSample{Rows: 50, Seed: 1})
+	query, _, err = k.BuildWithConditionQuery("orders", []string{"id"}, nil, nil, nil, nil, window)
+	require.NoError(t, err)
+	assert.Equal(t, `SELECT * FROM (SELECT "id" FROM "orders" ORDER BY md5(CAST(ctid AS TEXT) || '1') LIMIT 50) sample_src`, query)
+}
@@ -22,8 +22,52 @@ import (
 
 type SQLGeneration interface {
 	GenerateInsertSQL(tableName string, rowData []interface{}, schema *arrow.Schema, dbType pb.DataSourceType) (string, error)
-	BuildExportSQL(request *pb.ExportCsvFileFromDorisRequest, conf *config.DataServiceConf) string
+	BuildExportSQL(request *pb.ExportCsvFileFromDorisRequest, conf *config.DataServiceConf, labelName string) string
 	BuildSelectIntoOutfileSQL(request *pb.ExportCsvFileFromDorisRequest, conf *config.DataServiceConf) string
+	BuildS3FileQuerySQL(objectName string, columns []string, format common.FileFormat, conf *config.DataServiceConf) string
+	BuildOutfileToTargetSQL(request *pb.ExportCsvFileFromDorisRequest, target *S3Target) string
+	BuildS3CountSQL(target *S3Target, objectName string, request *pb.ExportCsvFileFromDorisRequest) string
+}
+
+const defaultS3Region = "us-east-1"
+
+// S3Target Doris 读写使用的S3兼容对象存储位置
+type S3Target struct {
+	Endpoint    string // 如 http://minio:9000
+	Region      string
+	AccessKey   string
+	SecretKey   string
+	Bucket      string
+	Prefix      string // 导出路径前缀（不含桶名），为空时直接写在桶根目录
+	MaxFileSize string // 单个导出文件大小上限
+
+	// 导出时 Doris 访问对象存储的连接参数，为 0 时使用 Doris 默认值
+	RequestTimeoutSeconds    int
+	ConnectionTimeoutSeconds int
+	ConnectionMaximum        int
+}
+
+// InternalS3Target 返回内部 MinIO 批量数据桶对应的导出位置
+func InternalS3Target(conf *config.DataServiceConf) *S3Target {
+	target := &S3Target{
+		Endpoint:  fmt.Sprintf("http://%s:%d", conf.OSSConfig.Host, conf.OSSConfig.Port),
+		Region:    s3Region(conf),
+		AccessKey: conf.OSSConfig.AccessKey,
+		SecretKey: conf.OSSConfig.SecretKey,
+		Bucket:    common.BATCH_DATA_BUCKET_NAME,
+	}
+	target.ApplyExportConfig(conf.DorisConfig)
+	return target
+}
+
+// ApplyExportConfig 使用 Doris 配置中的导出文件大小和连接参数，MaxFileSize 已设置时保留
+func (t *S3Target) ApplyExportConfig(doris config.DorisConfig) {
+	if t.MaxFileSize == "" {
+		t.MaxFileSize = doris.S3ExportMaxFileSize
+	}
+	t.RequestTimeoutSeconds = doris.S3ExportRequestTimeout
+	t.ConnectionTimeoutSeconds = doris.S3ExportConnectionTimeout
+	t.ConnectionMaximum = doris.S3ExportConnectionMaximum
 }
 
 type SQLGenerator struct {
@@ -90,7 +134,7 @@ func (s *SQLGenerator) GenerateInsertSQL(tableName string, rowData []interface{}
 // buildExportSQL 构建用于从Doris导出CSV文件的EXPORT SQL语句
 //
 // 该方法根据导出请求参数和系统配置，构建完整的EXPORT SQL语句，用于将Doris表中的数据
-// 导出为CSV格式并存储到S3兼容的对象存储中（如MinIO）。
+// 按请求指定的格式（parquet/orc/csv，默认parquet）导出并存储到S3兼容的对象存储中（如MinIO）。
 //
 // 参数:
 //   - request: 导出请求参数，包含数据库名、表名、列名、分隔符等配置信息
@@ -101,8 +145,8 @@ func (s *SQLGenerator) GenerateInsertSQL(tableName string, rowData []interface{}
 //
 // 功能说明:
 //  1. 构建S3目标路径，格式为 s3://bucket/jobInstanceId/export_
-//  2. 配置CSV格式参数，包括列分隔符、行分隔符、列选择等
-//  3. 配置S3连接参数，包括端点、区域、访问密钥等
+//  2. 配置导出格式参数，CSV格式额外配置列分隔符、行分隔符和压缩方式
+//  3. 配置S3连接参数，包括端点、区域（取自OSS配置）、访问密钥等
 //  4. 组装完整的EXPORT TABLE SQL语句
 //
 // 示例生成的SQL:
@@ -131,27 +175,21 @@ func (s *SQLGenerator) BuildExportSQL(request *pb.ExportCsvFileFromDorisRequest,
 		columnsClause = fmt.Sprintf(`"columns" = "%s"`, strings.Join(request.Columns, ","))
 	}
 
-	// 构建分隔符配置
-	columnSeparator := request.ColumnSeparator
-	if columnSeparator == "" {
-		columnSeparator = ","
-	}
-
-	lineDelimiter := request.LineDelimiter
-	if lineDelimiter == "" {
-		lineDelimiter = "\\n"
-	} else {
-		lineDelimiter = strings.ReplaceAll(lineDelimiter, "\n", "\\n")
-		lineDelimiter = strings.ReplaceAll(lineDelimiter, "\r", "\\r")
-		lineDelimiter = strings.ReplaceAll(lineDelimiter, "\r\n", "\\r\\n")
-	}
+	format := exportFileFormat(request)
 
 	// 构建PROPERTIES部分
 	properties := []string{
 		fmt.Sprintf(`"label" = "%s"`, labelName),
-		`"format" = "csv_with_names"`,
-		fmt.Sprintf(`"column_separator" = "%s"`, columnSeparator),
-		fmt.Sprintf(`"line_delimiter" = "%s"`, lineDelimiter),
+		fmt.Sprintf(`"format" = "%s"`, format.DorisFormatName()),
+	}
+
+	// 构建分隔符配置
+	if format == common.FILE_FORMAT_CSV {
+		columnSeparator := request.ColumnSeparator
+		if columnSeparator == "" {
+			columnSeparator = ","
+		}
+		properties = append(properties, buildCsvFormatProperties(columnSeparator, request.LineDelimiter, request.CompressType)...)
 	}
 
 	if columnsClause != "" {
@@ -159,12 +197,11 @@ func (s *SQLGenerator) BuildExportSQL(request *pb.ExportCsvFileFromDorisRequest,
 	}
 
 	// 构建S3配置
-	config := config.GetConfigMap()
 	s3Config := []string{
-		fmt.Sprintf(`"s3.endpoint" = "http://%s:%d"`, config.OSSConfig.Host, config.OSSConfig.Port),
-		`"s3.region" = "us-east-l"`,
-		fmt.Sprintf(`"s3.secret_key" = "%s"`, config.OSSConfig.SecretKey),
-		fmt.Sprintf(`"s3.access_key" = "%s"`, config.OSSConfig.AccessKey),
+		fmt.Sprintf(`"s3.endpoint" = "http://%s:%d"`, conf.OSSConfig.Host, conf.OSSConfig.Port),
+		fmt.Sprintf(`"s3.region" = "%s"`, s3Region(conf)),
+		fmt.Sprintf(`"s3.secret_key" = "%s"`, conf.OSSConfig.SecretKey),
+		fmt.Sprintf(`"s3.access_key" = "%s"`, conf.OSSConfig.AccessKey),
 		`"use_path_style" = "true"`,
 	}
 
@@ -189,20 +226,105 @@ func (s *SQLGenerator) BuildExportSQL(request *pb.ExportCsvFileFromDorisRequest,
 
 // BuildSelectIntoOutfileSQL 构建带排序/过滤的 SELECT ... INTO OUTFILE 导出SQL
 func (s *SQLGenerator) BuildSelectIntoOutfileSQL(request *pb.ExportCsvFileFromDorisRequest, conf *config.DataServiceConf) string {
+	return s.BuildOutfileToTargetSQL(request, InternalS3Target(conf))
+}
+
+// BuildOutfileToTargetSQL 构建导出到指定S3位置的 SELECT ... INTO OUTFILE 语句
+//
+// 导出路径为 s3://bucket/prefix/jobInstanceId/export_，Doris 按 max_file_size 自动切分文件。
+func (s *SQLGenerator) BuildOutfileToTargetSQL(request *pb.ExportCsvFileFromDorisRequest, target *S3Target) string {
+	// 目标路径
+	targetPath := fmt.Sprintf("s3://%s/%s/export_", target.Bucket, target.JobPath(request.JobInstanceId))
+
+	format := exportFileFormat(request)
+
+	maxFileSize := target.MaxFileSize
+	if maxFileSize == "" {
+		maxFileSize = "200MB"
+	}
+
+	// S3 配置
+	s3Props := []string{
+		fmt.Sprintf(`"s3.endpoint" = "%s"`, target.Endpoint),
+		fmt.Sprintf(`"s3.region" = "%s"`, target.region()),
+		fmt.Sprintf(`"s3.secret_key" = "%s"`, target.SecretKey),
+		fmt.Sprintf(`"s3.access_key" = "%s"`, target.AccessKey),
+		`"use_path_style" = "true"`,
+		fmt.Sprintf(`"max_file_size" = "%s"`, maxFileSize),
+	}
+	if target.RequestTimeoutSeconds > 0 {
+		s3Props = append(s3Props, fmt.Sprintf(`"s3.connection.request.timeout" = "%d"`, target.RequestTimeoutSeconds*1000))
+	}
+	if target.ConnectionTimeoutSeconds > 0 {
+		s3Props = append(s3Props, fmt.Sprintf(`"s3.connection.timeout" = "%d"`, target.ConnectionTimeoutSeconds*1000))
+	}
+	if target.ConnectionMaximum > 0 {
+		s3Props = append(s3Props, fmt.Sprintf(`"s3.connection.maximum" = "%d"`, target.ConnectionMaximum))
+	}
+
+	// CSV 格式添加分隔符、换行符及压缩配置
+	if format == common.FILE_FORMAT_CSV {
+		s3Props = append(s3Props, outfileCsvProperties(request)...)
+	}
+
+	// 组装 SELECT ... INTO OUTFILE
+	sql := fmt.Sprintf(`
+		%s
+		INTO OUTFILE "%s"
+		FORMAT AS %s
+		PROPERTIES (
+			%s
+		)
+	`,
+		s.BuildExportQuerySQL(request),
+		targetPath,
+		format.DorisFormatName(),
+		strings.Join(s3Props, ",\n\t\t\t"),
+	)
+	return sql
+}
+
+// BuildExportQuerySQL 构建导出时 INTO OUTFILE 之前的 SELECT 查询（列、过滤、排序、采样与分页）
+func (s *SQLGenerator) BuildExportQuerySQL(request *pb.ExportCsvFileFromDorisRequest) string {
+	// 窗口参数已在导出入口校验，这里无效时不加窗口
+	window, _ := NewReadWindow(request.Limit, request.Offset, request.Sample)
+	return buildDorisWindowQuery(request.Columns, request.DbName+"."+request.TableName,
+		request.FilterConditions, request.SortRules, window)
+}
+
+// BuildWindowSourceSQL 构建 INSERT ... SELECT 的窗口子查询数据源，未设置窗口时直接返回 table。
+// 窗口在 Doris 中执行；外部数据源的分页与采样不使用该方法，由源库执行窗口查询
+func (s *SQLGenerator) BuildWindowSourceSQL(table string, columns []string, window *pb.ReadWindow) (string, error) {
+	w, err := NewReadWindow(window.GetLimit(), window.GetOffset(), window.GetSample())
+	if err != nil {
+		return "", err
+	}
+	if w == nil && len(window.GetFilterConditions()) == 0 && len(window.GetSortRules()) == 0 {
+		return table, nil
+	}
+	query := buildDorisWindowQuery(columns, table, window.GetFilterConditions(), window.GetSortRules(), w)
+	return fmt.Sprintf("(%s) window_src", query), nil
+}
+
+// buildDorisWindowQuery 构建 Doris 上带过滤、排序、采样与分页的 SELECT 查询
+func buildDorisWindowQuery(columns []string, table string, filters []*pb.FilterCondition, sortRules []*pb.SortRule, window *ReadWindow) string {
 	// 列选择
 	columnsClause := "*"
-	if len(request.Columns) > 0 {
-		columnsClause = strings.Join(request.Columns, ", ")
+	if len(columns) > 0 {
+		columnsClause = strings.Join(columns, ", ")
 	}
 
 	// WHERE 子句
 	var whereParts []string
-	for _, fc := range request.FilterConditions {
+	for _, fc := range filters {
 		if fc == nil || fc.FieldName == "" {
 			continue
 		}
 		whereParts = append(whereParts, buildSingleCondition(fc))
 	}
+	if predicate := window.samplePredicate(mysqlWindowDialect); predicate != "" {
+		whereParts = append(whereParts, predicate)
+	}
 	whereClause := ""
 	if len(whereParts) > 0 {
 		whereClause = " WHERE " + strings.Join(whereParts, " AND ")
@@ -210,7 +332,7 @@ func (s *SQLGenerator) BuildSelectIntoOutfileSQL(request *pb.ExportCsvFileFromDo
 
 	// ORDER BY 子句
 	var orderParts []string
-	for _, sr := range request.SortRules {
+	for _, sr := range sortRules {
 		if sr == nil || sr.FieldName == "" {
 			continue
 		}
@@ -220,77 +342,108 @@ func (s *SQLGenerator) BuildSelectIntoOutfileSQL(request *pb.ExportCsvFileFromDo
 		}
 		orderParts = append(orderParts, fmt.Sprintf("%s %s", sr.FieldName, dir))
 	}
-	orderClause := ""
-	if len(orderParts) > 0 {
-		orderClause = " ORDER BY " + strings.Join(orderParts, ", ")
+
+	base := fmt.Sprintf("SELECT %s FROM %s%s", columnsClause, table, whereClause)
+	return window.finish(base, strings.Join(orderParts, ", "), mysqlWindowDialect)
+}
+
+// BuildS3FileQuerySQL 构建通过 Doris S3 表函数读取导出分片的查询SQL
+//
+// 用于 Go 侧没有原生解码器的格式（如 ORC），由 Doris 负责解析文件内容。
+func (s *SQLGenerator) BuildS3FileQuerySQL(objectName string, columns []string, format common.FileFormat, conf *config.DataServiceConf) string {
+	columnsClause := "*"
+	if len(columns) > 0 {
+		columnsClause = strings.Join(columns, ", ")
 	}
+	return buildS3TableFunctionSQL(columnsClause, InternalS3Target(conf), objectName, format)
+}
 
-	// 目标路径
-	targetPath := fmt.Sprintf("s3://%s/%s/export_", common.BATCH_DATA_BUCKET_NAME, request.JobInstanceId)
+// BuildS3CountSQL 构建统计单个导出文件行数的SQL，CSV 使用与导出相同的分隔符和压缩格式读取
+func (s *SQLGenerator) BuildS3CountSQL(target *S3Target, objectName string, request *pb.ExportCsvFileFromDorisRequest) string {
+	format := exportFileFormat(request)
+	var csvProps []string
+	if format == common.FILE_FORMAT_CSV {
+		csvProps = outfileCsvProperties(request)
+	}
+	return buildS3TableFunctionSQL("COUNT(*)", target, objectName, format, csvProps...)
+}
 
-	// 添加分隔符配置
+// outfileCsvProperties 导出 CSV 的分隔符、换行符及压缩配置，默认使用 \x01 分隔以兼容 CSV 流式读取
+func outfileCsvProperties(request *pb.ExportCsvFileFromDorisRequest) []string {
 	columnSeparator := request.ColumnSeparator
 	if columnSeparator == "" {
-		columnSeparator = string([]byte{0x01}) // \x01
+		columnSeparator = `\x01`
 	}
+	return buildCsvFormatProperties(columnSeparator, request.LineDelimiter, request.CompressType)
+}
 
-	lineDelimiter := request.LineDelimiter
-	if lineDelimiter == "" {
-		lineDelimiter = "\\n"
-	} else {
-		lineDelimiter = strings.ReplaceAll(lineDelimiter, "\n", "\\n")
-		lineDelimiter = strings.ReplaceAll(lineDelimiter, "\r", "\\r")
-		lineDelimiter = strings.ReplaceAll(lineDelimiter, "\r\n", "\\r\\n")
+// buildS3TableFunctionSQL 组装 SELECT ... FROM S3(...) 表函数查询，extra 为附加的表函数属性
+func buildS3TableFunctionSQL(selectClause string, target *S3Target, objectName string, format common.FileFormat, extra ...string) string {
+	props := []string{
+		fmt.Sprintf(`"uri" = "%s/%s/%s"`, target.Endpoint, target.Bucket, objectName),
+		fmt.Sprintf(`"s3.region" = "%s"`, target.region()),
+		fmt.Sprintf(`"s3.access_key" = "%s"`, target.AccessKey),
+		fmt.Sprintf(`"s3.secret_key" = "%s"`, target.SecretKey),
+		fmt.Sprintf(`"format" = "%s"`, format.DorisFormatName()),
+		`"use_path_style" = "true"`,
 	}
+	props = append(props, extra...)
 
-	maxFileSize := conf.DorisConfig.S3ExportMaxFileSize
-	if maxFileSize == "" {
-		maxFileSize = "512MB"
+	return fmt.Sprintf("SELECT %s FROM S3(\n\t%s\n)", selectClause, strings.Join(props, ",\n\t"))
+}
+
+// JobPath 返回任务导出文件所在目录（不含桶名）
+func (t *S3Target) JobPath(jobInstanceId string) string {
+	prefix := strings.Trim(t.Prefix, "/")
+	if prefix == "" {
+		return jobInstanceId
 	}
-	requestTimeout := conf.DorisConfig.S3ExportRequestTimeout
-	if requestTimeout == 0 {
-		requestTimeout = 600000
+	return prefix + "/" + jobInstanceId
+}
+
+func (t *S3Target) region() string {
+	if t.Region != "" {
+		return t.Region
 	}
-	connectionTimeout := conf.DorisConfig.S3ExportConnectionTimeout
-	if connectionTimeout == 0 {
-		connectionTimeout = 60000
+	return defaultS3Region
+}
+
+// exportFileFormat 解析请求中的导出格式，非法格式回退为 parquet（请求校验在服务层完成）
+func exportFileFormat(request *pb.ExportCsvFileFromDorisRequest) common.FileFormat {
+	format, err := common.ParseExportFileFormat(request.FileFormat)
+	if err != nil {
+		log.Logger.Warnf("Invalid export file format %q, fallback to parquet", request.FileFormat)
+		return common.FILE_FORMAT_PARQUET
 	}
-	connectionMaximum := conf.DorisConfig.S3ExportConnectionMaximum
-	if connectionMaximum == 0 {
-		connectionMaximum = 256
+	return format
+}
+
+// s3Region 返回导出使用的S3区域，未配置时使用默认值
+func s3Region(conf *config.DataServiceConf) string {
+	if conf.OSSConfig.Region != "" {
+		return conf.OSSConfig.Region
 	}
+	return defaultS3Region
+}
 
-	// S3 配置
-	s3Props := []string{
-		fmt.Sprintf(`"s3.endpoint" = "http://%s:%d"`, conf.OSSConfig.Host, conf.OSSConfig.Port),
-		`"s3.region" = "us-east-1"`,
-		fmt.Sprintf(`"s3.secret_key" = "%s"`, conf.OSSConfig.SecretKey),
-		fmt.Sprintf(`"s3.access_key" = "%s"`, conf.OSSConfig.AccessKey),
-		`"use_path_style" = "true"`,
-		`"max_file_size" = "200MB"`,
-		// `"s3.connection.request.timeout" = "600000"`,
-		// `"s3.connection.timeout" = "60000"`,
-		// `"s3.connection.maximum" = "256"`,
-		// fmt.Sprintf(`"column_separator" = "%s"`, columnSeparator), // 添加分隔符
-		// fmt.Sprintf(`"line_delimiter" = "%s"`, lineDelimiter),     // 添加换行符
+// buildCsvFormatProperties 构建CSV导出的分隔符、换行符和压缩属性
+func buildCsvFormatProperties(columnSeparator, lineDelimiter, compressType string) []string {
+	if lineDelimiter == "" {
+		lineDelimiter = "\\n"
+	} else {
+		lineDelimiter = strings.ReplaceAll(lineDelimiter, "\r\n", "\\r\\n")
+		lineDelimiter = strings.ReplaceAll(lineDelimiter, "\n", "\\n")
+		lineDelimiter = strings.ReplaceAll(lineDelimiter, "\r", "\\r")
 	}
 
-	// 组装 SELECT ... INTO OUTFILE
-	sql := fmt.Sprintf(`
-		SELECT %s FROM %s.%s%s%s
-		INTO OUTFILE "%s"
-		FORMAT AS parquet
-		PROPERTIES (
-			%s
-		)
-	`,
-		columnsClause,
-		request.DbName, request.TableName,
-		whereClause, orderClause,
-		targetPath,
-		strings.Join(s3Props, ",\n\t\t\t"),
-	)
-	return sql
+	props := []string{
+		fmt.Sprintf(`"column_separator" = "%s"`, columnSeparator),
+		fmt.Sprintf(`"line_delimiter" = "%s"`, lineDelimiter),
+	}
+	if compressType != "" {
+		props = append(props, fmt.Sprintf(`"compress_type" = "%s"`, strings.ToLower(compressType)))
+	}
+	return props
 }
 
 // 组装单个过滤条件
@@ -10,6 +10,7 @@
 package database
 
 import (
+	"data-service/common"
 	"data-service/config"
 	pb "data-service/generated/datasource"
 	"strings"
@@ -118,9 +119,173 @@ func TestBuildSelectIntoOutfileSQL_WithWhereAndOrder(t *testing.T) {
 	mustContain(t, sql, `ORDER BY combined_join_column DESC`)
 }
 
+func TestBuildSelectIntoOutfileSQL_DefaultFormatParquet(t *testing.T) {
+	gen := &SQLGenerator{}
+	req := &pb.ExportCsvFileFromDorisRequest{
+		JobInstanceId: "job_1",
+		TableName:     "orders",
+		DbName:        "mall",
+	}
+	sql := gen.BuildSelectIntoOutfileSQL(req, testConf())
+
+	mustContain(t, sql, `FORMAT AS parquet`)
+	mustNotContain(t, sql, `"column_separator"`)
+}
+
+func TestBuildSelectIntoOutfileSQL_CsvWithCompressAndRegion(t *testing.T) {
+	gen := &SQLGenerator{}
+	conf := testConf()
+	conf.OSSConfig.Region = "cn-north-1"
+	req := &pb.ExportCsvFileFromDorisRequest{
+		JobInstanceId: "job_1",
+		TableName:     "orders",
+		DbName:        "mall",
+		FileFormat:    "CSV",
+		CompressType:  "gz",
+	}
+	sql := gen.BuildSelectIntoOutfileSQL(req, conf)
+
+	mustContain(t, sql, `FORMAT AS csv_with_names`)
+	mustContain(t, sql, `"column_separator" = "\x01"`)
+	mustContain(t, sql, `"compress_type" = "gz"`)
+	mustContain(t, sql, `"s3.region" = "cn-north-1"`)
+}
+
+func TestBuildS3FileQuerySQL_Orc(t *testing.T) {
+	gen := &SQLGenerator{}
+	sql := gen.BuildS3FileQuerySQL("job_1/export_abc_0.orc", []string{"id", "amount"}, common.FILE_FORMAT_ORC, testConf())
+
+	mustContain(t, sql, `SELECT id, amount FROM S3(`)
+	mustContain(t, sql, `"uri" = "http://minio.base1:9000/data-service/job_1/export_abc_0.orc"`)
+	mustContain(t, sql, `"format" = "orc"`)
+}
+
+func TestBuildOutfileToTargetSQL_ExternalTarget(t *testing.T) {
+	gen := &SQLGenerator{}
+	target := &S3Target{
+		Endpoint:    "https://partner.example.com:9000",
+		Region:      "ap-east-1",
+		AccessKey:   "pak",
+		SecretKey:   "psk",
+		Bucket:      "partner-bucket",
+		Prefix:      "/deliveries/2026/",
+		MaxFileSize: "64MB",
+	}
+	req := &pb.ExportCsvFileFromDorisRequest{
+		JobInstanceId: "job_1",
+		TableName:     "orders",
+		DbName:        "mall",
+		FileFormat:    "orc",
+	}
+	sql := gen.BuildOutfileToTargetSQL(req, target)
+
+	mustContain(t, sql, `INTO OUTFILE "s3://partner-bucket/deliveries/2026/job_1/export_"`)
+	mustContain(t, sql, `FORMAT AS orc`)
+	mustContain(t, sql, `"s3.endpoint" = "https://partner.example.com:9000"`)
+	mustContain(t, sql, `"s3.region" = "ap-east-1"`)
+	mustContain(t, sql, `"max_file_size" = "64MB"`)
+	mustNotContain(t, sql, `minio`)
+}
+
+func TestBuildS3CountSQL(t *testing.T) {
+	gen := &SQLGenerator{}
+	target := &S3Target{Endpoint: "http://partner:9000", Bucket: "pb", AccessKey: "ak", SecretKey: "sk"}
+	sql := gen.BuildS3CountSQL(target, "out/job_1/export_x_0.csv", &pb.ExportCsvFileFromDorisRequest{FileFormat: "csv"})
+
+	mustContain(t, sql, `SELECT COUNT(*) FROM S3(`)
+	mustContain(t, sql, `"uri" = "http://partner:9000/pb/out/job_1/export_x_0.csv"`)
+	mustContain(t, sql, `"format" = "csv_with_names"`)
+	mustContain(t, sql, `"s3.region" = "us-east-1"`)
+	mustContain(t, sql, `"column_separator" = "\x01"`)
+	mustNotContain(t, sql, `compress_type`)
+
+	// 压缩的 CSV 按导出时的压缩格式读取
+	sql = gen.BuildS3CountSQL(target, "out/job_1/export_x_0.csv.gz", &pb.ExportCsvFileFromDorisRequest{
+		FileFormat: "csv", ColumnSeparator: ",", CompressType: "GZ"})
+	mustContain(t, sql, `"column_separator" = ","`)
+	mustContain(t, sql, `"compress_type" = "gz"`)
+
+	sql = gen.BuildS3CountSQL(target, "out/job_1/export_x_0.orc", &pb.ExportCsvFileFromDorisRequest{FileFormat: "orc"})
+	mustNotContain(t, sql, `column_separator`)
+}
+
+func TestInternalS3Target_UsesExportConfig(t *testing.T) {
+	conf := testConf()
+	conf.DorisConfig = config.DorisConfig{
+		S3ExportMaxFileSize:       "1GB",
+		S3ExportRequestTimeout:    300,
+		S3ExportConnectionTimeout: 60,
+		S3ExportConnectionMaximum: 10,
+	}
+	sql := (&SQLGenerator{}).BuildSelectIntoOutfileSQL(&pb.ExportCsvFileFromDorisRequest{
+		JobInstanceId: "job_1", TableName: "orders", DbName: "mall"}, conf)
+	mustContain(t, sql, `"max_file_size" = "1GB"`)
+	mustContain(t, sql, `"s3.connection.request.timeout" = "300000"`)
+	mustContain(t, sql, `"s3.connection.timeout" = "60000"`)
+	mustContain(t, sql, `"s3.connection.maximum" = "10"`)
+
+	// 未配置时使用默认文件大小，不设置连接参数
+	sql = (&SQLGenerator{}).BuildSelectIntoOutfileSQL(&pb.ExportCsvFileFromDorisRequest{
+		JobInstanceId: "job_1", TableName: "orders", DbName: "mall"}, testConf())
+	mustContain(t, sql, `"max_file_size" = "200MB"`)
+	mustNotContain(t, sql, `s3.connection`)
+}
+
+func mustNotContain(t *testing.T, sql string, sub string) {
+	t.Helper()
+	if strings.Contains(sql, sub) {
+		t.Fatalf("SQL contains unexpected substring:\nunwanted: %s\nsql:  %s", sub, sql)
+	}
+}
+
 func mustContain(t *testing.T, sql string, sub string) {
 	t.Helper()
 	if !strings.Contains(sql, sub) {
 		t.Fatalf("SQL does not contain expected substring:\nwant: %s\nsql:  %s", sub, sql)
 	}
 }
+
+func TestBuildSelectIntoOutfileSQL_WithWindow(t *testing.T) {
+	gen := &SQLGenerator{}
+	req := &pb.ExportCsvFileFromDorisRequest{
+		JobInstanceId: "job_w",
+		TableName:     "orders",
+		DbName:        "mall",
+		Columns:       []string{"id"},
+		FilterConditions: []*pb.FilterCondition{
+			{FieldName: "age", Operator: pb.FilterOperator_GREATER_THAN, FieldValue: &pb.FilterValue{IntValue: 18}},
+		},
+		SortRules: []*pb.SortRule{{FieldName: "id", SortOrder: pb.SortOrder_ASC}},
+		Limit:     100,
+		Offset:    10,
+		Sample:    &pb.ReadSample{Percent: 50, Seed: 9},
+	}
+	sql := gen.BuildSelectIntoOutfileSQL(req, testConf())
+
+	mustContain(t, sql, `SELECT id FROM mall.orders WHERE age > 18 AND RAND(9) < 0.5 ORDER BY id ASC LIMIT 100 OFFSET 10`)
+}
+
+func TestBuildWindowSourceSQL(t *testing.T) {
+	gen := &SQLGenerator{}
+
+	source, err := gen.BuildWindowSourceSQL("`db`.`ext`", []string{"`id`"}, nil)
+	if err != nil || source != "`db`.`ext`" {
+		t.Fatalf("unexpected source without window: %q, %v", source, err)
+	}
+
+	source, err = gen.BuildWindowSourceSQL("`db`.`ext`", []string{"`id`"}, &pb.ReadWindow{
+		SortRules: []*pb.SortRule{{FieldName: "id", SortOrder: pb.SortOrder_DESC}},
+		Limit:     5,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "(SELECT `id` FROM `db`.`ext` ORDER BY id DESC LIMIT 5) window_src"
+	if source != want {
+		t.Fatalf("got %q, want %q", source, want)
+	}
+
+	if _, err := gen.BuildWindowSourceSQL("`db`.`ext`", nil, &pb.ReadWindow{Limit: -1}); err == nil {
+		t.Fatal("expected error for negative limit")
+	}
+}
@@ -10,6 +10,7 @@
 package database
 
 import (
+	"context"
 	pb "data-service/generated/datasource"
 	"database/sql"
 
@@ -47,7 +48,8 @@ type DatabaseStrategy interface {
 		filterNames []string,
 		filterOperators []pb.FilterOperator,
 		filterValues []*pb.FilterValue,
-		sortRules []*pb.SortRule) (string, []interface{}, error)
+		sortRules []*pb.SortRule,
+		window *ReadWindow) (string, []interface{}, error)
 
 	EnsureDatabaseExists(dbName string) error
 
@@ -57,3 +59,11 @@ type DatabaseStrategy interface {
 
 	CheckTableExists(tableName string) (bool, error)
 }
+
+// BulkLoader 策略的可选能力：以目标库原生的批量导入方式写入数据，比多行 INSERT 快
+// 未实现该接口的策略写入时使用 INSERT
+type BulkLoader interface {
+	// BulkLoad 把 rows 写入表 tableName 的 columns 列，返回写入行数；
+	// 返回 ErrBulkLoadUnavailable 时尚未读取 rows，调用方可改用 INSERT 写入
+	BulkLoad(ctx context.Context, tableName string, columns []string, rows RowSource) (int64, error)
+}
@@ -11,15 +11,18 @@ package database
 
 import (
 	"data-service/config"
+	ds "data-service/generated/datasource"
 	"data-service/log"
 	"database/sql"
+	"math"
+	"strings"
 )
 
 // TableInfoEstimator 表信息估算器接口
 // 用于统一各个数据库连接器的表信息估算方法
 type TableInfoEstimator interface {
-	GetTableRowCount(database string, tableName string) (int32, error)
-	EstimateTableSize(database string, tableName string, totalRows int32) (int64, error)
+	GetTableRowCount(database string, tableName string) (int64, error)
+	EstimateTableSize(database string, tableName string, totalRows int64) (int64, error)
 }
 
 // TableInfoEstimationHelper 表信息估算辅助结构
@@ -31,9 +34,9 @@ type TableInfoEstimationHelper struct {
 func (h *TableInfoEstimationHelper) EstimateTableInfo(
 	database string,
 	tableName string,
-	getRowCountFunc func() (int32, error),
-	estimateSizeFunc func(rowCount int32) (int64, error),
-) (rowCount int32, size int64, err error) {
+	getRowCountFunc func() (int64, error),
+	estimateSizeFunc func(rowCount int64) (int64, error),
+) (rowCount int64, size int64, err error) {
 	// 获取行数
 	rowCount, err = getRowCountFunc()
 	if err != nil {
@@ -55,7 +58,7 @@ func (h *TableInfoEstimationHelper) EstimateTableInfo(
 }
 
 // LogQueryFailure 记录查询失败日志，区分不同的失败原因
-func LogQueryFailure(source string, err error, tableName string, rowCount int32) {
+func LogQueryFailure(source string, err error, tableName string, rowCount int64) {
 	if err != nil {
 		log.Logger.Warnf("Failed to query from %s, will try to estimate table size: %v", source, err)
 	} else if tableName == "" {
@@ -94,6 +97,8 @@ func FillTableInfoFromEstimation(
 	}
 
 	result.TableRows = rowCount
+	result.ExactCount = true
+	result.StatsMethod = ds.TableStatsMethod_TABLE_STATS_METHOD_COUNT
 
 	// 估算表大小
 	if rowCount > 0 {
@@ -111,6 +116,46 @@ func FillTableInfoFromEstimation(
 			}
 			// 应用估算系数
 			result.TableSize = int64(float64(size) * factor)
+			result.StatsMethod = ds.TableStatsMethod_TABLE_STATS_METHOD_SAMPLING
 		}
 	}
 }
+
+// ToTableInfoResponse 转换为接口返回的表信息，兼容字段 recordCount 超过 int32 范围时取最大值
+func (r *TableInfoResponse) ToTableInfoResponse(columns []*ds.ColumnItem) *ds.TableInfoResponse {
+	recordCount := int32(math.MaxInt32)
+	if r.TableRows <= math.MaxInt32 {
+		recordCount = int32(r.TableRows)
+	}
+	return &ds.TableInfoResponse{
+		TableName:      r.TableName,
+		RecordCount:    recordCount,
+		RecordSize:     r.TableSize,
+		Columns:        columns,
+		RecordCount64:  r.TableRows,
+		IsExactCount:   r.ExactCount,
+		StatsMethod:    r.StatsMethod,
+		LastAnalyzedAt: r.LastAnalyzedAt,
+	}
+}
+
+// setExactCount 记录 COUNT(*) 精确查询的结果
+func (r *TableInfoResponse) setExactCount(tableName string, rowCount int64) {
+	r.TableSchema = ""      // 没有表模式
+	r.TableName = tableName // 返回表名
+	r.TableRows = rowCount
+	r.TableSize = 0 // 精确查询不关心表的大小
+	r.ExactCount = true
+	r.StatsMethod = ds.TableStatsMethod_TABLE_STATS_METHOD_COUNT
+}
+
+// newColumnItem 根据 information_schema.columns 的查询结果创建字段信息
+func newColumnItem(name, dataType string, isNullable sql.NullString, precision, scale sql.NullInt64) *ds.ColumnItem {
+	return &ds.ColumnItem{
+		Name:      name,
+		DataType:  dataType,
+		Nullable:  strings.EqualFold(isNullable.String, "YES"),
+		Precision: int32(precision.Int64),
+		Scale:     int32(scale.Int64),
+	}
+}
@@ -10,6 +10,8 @@
 package database
 
 import (
+	"context"
+	"data-service/database/lock"
 	pb "data-service/generated/datasource"
 	log2 "data-service/log"
 	"database/sql"
@@ -21,8 +23,12 @@ import (
 	"github.com/apache/arrow/go/v15/arrow/ipc"
 )
 
-// TableOperation 表示一个表操作任务
+// tableOperationLockWait 表操作等待表锁的最长时间
+const tableOperationLockWait = 5 * time.Minute
+
+// TableOperation 表示一个表操作任务，Ctx 为发起写入的请求上下文
 type TableOperation struct {
+	Ctx        context.Context
 	TableName  string
 	Schema     *arrow.Schema
 	DbType     pb.DataSourceType
@@ -92,7 +98,7 @@ func processOperations(queue chan *TableOperation, stopCh chan struct{}) {
 			// 在新的协程中处理操作，这样不会阻塞主循环
 			go func(operation *TableOperation) {
 				// 处理操作
-				processOperation(operation)
+				processLockedOperation(operation)
 
 				// 处理完成后，移除表的处理中标记
 				tableProcessingMutex.Lock()
@@ -108,9 +114,36 @@ func processOperations(queue chan *TableOperation, stopCh chan struct{}) {
 	}
 }
 
-// 处理单个操作
-func processOperation(op *TableOperation) {
+// processLockedOperation 持有表的分布式锁处理操作，避免多个副本同时建表和写入同一张表。
+// 等待锁受请求上下文和 tableOperationLockWait 限制
+func processLockedOperation(op *TableOperation) {
+	parent := op.Ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(parent, tableOperationLockWait)
+	defer cancel()
+	lease, err := lock.Default().Acquire(ctx, "table_operation:"+op.TableName)
+	if err != nil {
+		op.IpcReader.Release()
+		op.ResultCh <- err
+		return
+	}
+	defer func() {
+		if err := lease.Release(); err != nil {
+			log2.Logger.Warnf("Failed to release lock of table %s: %v", op.TableName, err)
+		}
+	}()
+	processOperation(op, lease)
+}
+
+// 处理单个操作，写入前校验表锁的 fencing token
+func processOperation(op *TableOperation, lease *lock.Lease) {
 	defer op.IpcReader.Release()
+	if err := lease.Check(context.Background()); err != nil {
+		op.ResultCh <- err
+		return
+	}
 	// 先创建表（如果不存在）
 	err := createTableIfNeeded(op.TableName, op.Schema, op.DbStrategy)
 	if err != nil {
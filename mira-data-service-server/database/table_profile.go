@@ -0,0 +1,328 @@
+package database
+
+import (
+	ds "data-service/generated/datasource"
+	"data-service/log"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const profileSourceAlias = "profile_src"
+
+// ProfileDialect 画像统计SQL方言
+type ProfileDialect struct {
+	IdentQuote         string // 标识符引号
+	QualifyWithDB      bool   // 表名是否带库名前缀
+	ApproxDistinctFunc string // HyperLogLog 去重函数，为空表示数据源不支持
+	SampleClause       string // 表采样子句，参数为百分比和种子，为空时使用带种子的 RAND 过滤
+}
+
+var (
+	mysqlProfileDialect    = ProfileDialect{IdentQuote: "`", QualifyWithDB: true}
+	dorisProfileDialect    = ProfileDialect{IdentQuote: "`", QualifyWithDB: true, ApproxDistinctFunc: "APPROX_COUNT_DISTINCT", SampleClause: "TABLESAMPLE(%s PERCENT) REPEATABLE %d"}
+	postgresProfileDialect = ProfileDialect{IdentQuote: "\"", SampleClause: "TABLESAMPLE BERNOULLI(%s) REPEATABLE(%d)"}
+)
+
+// ProfileDialectOf 根据数据库策略获取画像SQL方言
+func ProfileDialectOf(dbStrategy DatabaseStrategy) ProfileDialect {
+	switch dbStrategy.(type) {
+	case *DorisStrategy:
+		return dorisProfileDialect
+	case *KingbaseStrategy, *VastbaseStrategy:
+		return postgresProfileDialect
+	default:
+		return mysqlProfileDialect
+	}
+}
+
+func (d ProfileDialect) quote(identifier string) string {
+	return d.IdentQuote + strings.ReplaceAll(identifier, d.IdentQuote, d.IdentQuote+d.IdentQuote) + d.IdentQuote
+}
+
+// QualifiedTable 返回带引号的表名
+func (d ProfileDialect) QualifiedTable(dbName, tableName string) string {
+	if d.QualifyWithDB && dbName != "" {
+		return d.quote(dbName) + "." + d.quote(tableName)
+	}
+	return d.quote(tableName)
+}
+
+// ProfileTypeClass 列类型分类，决定可以计算哪些统计项
+type ProfileTypeClass int
+
+const (
+	ProfileTypeOther      ProfileTypeClass = iota // 只统计空值、去重数和高频值，如布尔
+	ProfileTypeComparable                         // 数值、日期时间，额外统计最值
+	ProfileTypeString                             // 字符串，额外统计最值和长度分布
+	ProfileTypeComplex                            // JSON、二进制、复合类型，只统计空值
+)
+
+// ClassifyProfileType 根据数据源返回的列类型分类
+func ClassifyProfileType(dataType string) ProfileTypeClass {
+	t := strings.ToLower(strings.TrimSpace(dataType))
+	if i := strings.Index(t, "("); i >= 0 {
+		t = strings.TrimSpace(t[:i])
+	}
+	switch {
+	case strings.Contains(t, "json"), strings.Contains(t, "blob"), strings.Contains(t, "binary"),
+		t == "bytea", t == "bitmap", t == "hll", t == "variant", t == "quantile_state",
+		strings.HasPrefix(t, "array"), strings.HasPrefix(t, "map"), strings.HasPrefix(t, "struct"), strings.HasSuffix(t, "[]"):
+		return ProfileTypeComplex
+	case strings.Contains(t, "char"), t == "text", t == "string", strings.HasSuffix(t, "text"):
+		return ProfileTypeString
+	case strings.Contains(t, "int"), strings.Contains(t, "decimal"), strings.Contains(t, "numeric"),
+		t == "float", t == "double", t == "real", t == "double precision", t == "largeint",
+		strings.Contains(t, "date"), strings.Contains(t, "time"), t == "year", t == "money":
+		return ProfileTypeComparable
+	default:
+		return ProfileTypeOther
+	}
+}
+
+// TableProfiler 在数据源上执行画像统计，采样时各条SQL使用同一种子。
+// 支持 TABLESAMPLE REPEATABLE 的数据源（Doris、PostgreSQL 系）各条SQL统计同一批样本；
+// MySQL 等使用 RAND(seed) 过滤，每条SQL都扫描全表，选中的行依赖扫描顺序，各条SQL的样本可能不同，结果为近似值
+type TableProfiler struct {
+	DB                  *sql.DB
+	Dialect             ProfileDialect
+	Table               string  // 带引号的表名
+	SamplePercent       float64 // 采样比例(0,100)，其余值表示全量
+	SampleSeed          int64   // 采样种子
+	ApproximateDistinct bool
+	TopK                int
+	LengthBuckets       int
+}
+
+// summaryLayout 记录汇总查询中每列统计项的位置
+type summaryLayout struct {
+	column       *ds.ColumnItem
+	class        ProfileTypeClass
+	approximate  bool
+	hasDistinct  bool
+	hasMinMax    bool
+	hasLengthAgg bool
+}
+
+// Sampled 是否采样统计
+func (p *TableProfiler) Sampled() bool {
+	return p.SamplePercent > 0 && p.SamplePercent < 100
+}
+
+// sourceSQL 返回统计使用的数据来源，采样时为固定种子的采样子查询，
+// 不支持 TABLESAMPLE 的数据源使用 RAND(seed) 过滤，只有扫描顺序相同时各条SQL才选中相同的行
+func (p *TableProfiler) sourceSQL() string {
+	if !p.Sampled() {
+		return p.Table + " " + profileSourceAlias
+	}
+	if p.Dialect.SampleClause != "" {
+		sample := fmt.Sprintf(p.Dialect.SampleClause, strconv.FormatFloat(p.SamplePercent, 'f', -1, 64), p.SampleSeed)
+		return fmt.Sprintf("(SELECT * FROM %s %s) %s", p.Table, sample, profileSourceAlias)
+	}
+	return fmt.Sprintf("(SELECT * FROM %s WHERE RAND(%d) < %s) %s",
+		p.Table, p.SampleSeed, strconv.FormatFloat(p.SamplePercent/100, 'f', -1, 64), profileSourceAlias)
+}
+
+func (p *TableProfiler) lengthExpr(column string) string {
+	return fmt.Sprintf("CHAR_LENGTH(%s)", p.Dialect.quote(column))
+}
+
+// BuildSummarySQL 生成一次扫描完成行数、空值、去重数、最值和长度汇总的SQL
+func (p *TableProfiler) BuildSummarySQL(columns []*ds.ColumnItem) (string, []summaryLayout) {
+	exprs := []string{"COUNT(*)"}
+	layouts := make([]summaryLayout, 0, len(columns))
+	for _, col := range columns {
+		quoted := p.Dialect.quote(col.Name)
+		layout := summaryLayout{column: col, class: ClassifyProfileType(col.DataType)}
+		exprs = append(exprs, fmt.Sprintf("COUNT(%s)", quoted))
+		if layout.class != ProfileTypeComplex {
+			layout.hasDistinct = true
+			if p.ApproximateDistinct && p.Dialect.ApproxDistinctFunc != "" {
+				layout.approximate = true
+				exprs = append(exprs, fmt.Sprintf("%s(%s)", p.Dialect.ApproxDistinctFunc, quoted))
+			} else {
+				exprs = append(exprs, fmt.Sprintf("COUNT(DISTINCT %s)", quoted))
+			}
+		}
+		if layout.class == ProfileTypeComparable || layout.class == ProfileTypeString {
+			layout.hasMinMax = true
+			exprs = append(exprs, fmt.Sprintf("MIN(%s)", quoted), fmt.Sprintf("MAX(%s)", quoted))
+		}
+		if layout.class == ProfileTypeString {
+			layout.hasLengthAgg = true
+			length := p.lengthExpr(col.Name)
+			exprs = append(exprs, fmt.Sprintf("MIN(%s)", length), fmt.Sprintf("MAX(%s)", length), fmt.Sprintf("AVG(%s)", length))
+		}
+		layouts = append(layouts, layout)
+	}
+	return fmt.Sprintf("SELECT %s FROM %s", strings.Join(exprs, ", "), p.sourceSQL()), layouts
+}
+
+// BuildTopKSQL 生成统计列高频值的SQL
+func (p *TableProfiler) BuildTopKSQL(column string) string {
+	quoted := p.Dialect.quote(column)
+	return fmt.Sprintf("SELECT %s, COUNT(*) AS cnt FROM %s WHERE %s IS NOT NULL GROUP BY %s ORDER BY cnt DESC LIMIT %d",
+		quoted, p.sourceSQL(), quoted, quoted, p.TopK)
+}
+
+// BuildLengthHistogramSQL 生成字符串长度分布的SQL，按 width 等宽分桶
+func (p *TableProfiler) BuildLengthHistogramSQL(column string, minLength, width int64) string {
+	bucket := fmt.Sprintf("FLOOR((%s - %d) / %d)", p.lengthExpr(column), minLength, width)
+	return fmt.Sprintf("SELECT %s AS bucket, COUNT(*) AS cnt FROM %s WHERE %s IS NOT NULL GROUP BY %s ORDER BY bucket",
+		bucket, p.sourceSQL(), p.Dialect.quote(column), bucket)
+}
+
+// Profile 统计指定列，返回参与统计的行数和每列画像
+func (p *TableProfiler) Profile(columns []*ds.ColumnItem) (int64, []*ds.ColumnProfile, error) {
+	summarySQL, layouts := p.BuildSummarySQL(columns)
+	log.Logger.Infof("Executing profile summary query: %s", summarySQL)
+
+	rowCount, profiles, err := p.scanSummary(summarySQL, layouts)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to execute profile summary query: %v", err)
+	}
+
+	for i, layout := range layouts {
+		profile := profiles[i]
+		if layout.hasDistinct && p.TopK > 0 && profile.DistinctCount > 0 {
+			if profile.TopValues, err = p.queryTopK(layout.column.Name); err != nil {
+				return 0, nil, fmt.Errorf("failed to query top values of column %s: %v", layout.column.Name, err)
+			}
+		}
+		if profile.LengthStats != nil && profile.NullCount < rowCount {
+			if profile.LengthStats.Buckets, err = p.queryLengthHistogram(layout.column.Name, profile.LengthStats); err != nil {
+				return 0, nil, fmt.Errorf("failed to query length distribution of column %s: %v", layout.column.Name, err)
+			}
+		}
+	}
+	return rowCount, profiles, nil
+}
+
+func (p *TableProfiler) scanSummary(summarySQL string, layouts []summaryLayout) (int64, []*ds.ColumnProfile, error) {
+	values := make([]sql.NullString, 1)
+	for _, layout := range layouts {
+		n := 1
+		if layout.hasDistinct {
+			n++
+		}
+		if layout.hasMinMax {
+			n += 2
+		}
+		if layout.hasLengthAgg {
+			n += 3
+		}
+		values = append(values, make([]sql.NullString, n)...)
+	}
+	dest := make([]interface{}, len(values))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := p.DB.QueryRow(summarySQL).Scan(dest...); err != nil {
+		return 0, nil, err
+	}
+
+	rowCount := parseProfileInt(values[0])
+	idx := 1
+	next := func() sql.NullString {
+		v := values[idx]
+		idx++
+		return v
+	}
+	profiles := make([]*ds.ColumnProfile, 0, len(layouts))
+	for _, layout := range layouts {
+		profile := &ds.ColumnProfile{
+			Name:     layout.column.Name,
+			DataType: layout.column.DataType,
+		}
+		profile.NullCount = rowCount - parseProfileInt(next())
+		if rowCount > 0 {
+			profile.NullRatio = float64(profile.NullCount) / float64(rowCount)
+		}
+		if layout.hasDistinct {
+			profile.DistinctCount = parseProfileInt(next())
+			profile.DistinctApproximate = layout.approximate
+		}
+		if layout.hasMinMax {
+			profile.MinValue, profile.MaxValue = next().String, next().String
+		}
+		if layout.hasLengthAgg {
+			minLength, maxLength, avgLength := next(), next(), next()
+			if minLength.Valid {
+				avg, _ := strconv.ParseFloat(avgLength.String, 64)
+				profile.LengthStats = &ds.StringLengthStats{
+					MinLength: parseProfileInt(minLength),
+					MaxLength: parseProfileInt(maxLength),
+					AvgLength: avg,
+				}
+			}
+		}
+		profiles = append(profiles, profile)
+	}
+	return rowCount, profiles, nil
+}
+
+func (p *TableProfiler) queryTopK(column string) ([]*ds.ValueFrequency, error) {
+	rows, err := p.DB.Query(p.BuildTopKSQL(column))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var values []*ds.ValueFrequency
+	for rows.Next() {
+		var value sql.NullString
+		var count int64
+		if err := rows.Scan(&value, &count); err != nil {
+			return nil, err
+		}
+		values = append(values, &ds.ValueFrequency{Value: value.String, Count: count})
+	}
+	return values, rows.Err()
+}
+
+func (p *TableProfiler) queryLengthHistogram(column string, stats *ds.StringLengthStats) ([]*ds.LengthBucket, error) {
+	bucketCount := int64(p.LengthBuckets)
+	if bucketCount <= 0 {
+		bucketCount = 1
+	}
+	width := (stats.MaxLength - stats.MinLength + bucketCount) / bucketCount
+	if width < 1 {
+		width = 1
+	}
+
+	rows, err := p.DB.Query(p.BuildLengthHistogramSQL(column, stats.MinLength, width))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var buckets []*ds.LengthBucket
+	for rows.Next() {
+		var bucketValue sql.NullString
+		var count int64
+		if err := rows.Scan(&bucketValue, &count); err != nil {
+			return nil, err
+		}
+		bucket := parseProfileInt(bucketValue)
+		lower := stats.MinLength + bucket*width
+		upper := lower + width - 1
+		if upper > stats.MaxLength {
+			upper = stats.MaxLength
+		}
+		buckets = append(buckets, &ds.LengthBucket{LowerBound: lower, UpperBound: upper, Count: count})
+	}
+	return buckets, rows.Err()
+}
+
+// parseProfileInt 解析聚合结果，部分驱动以小数形式返回整数
+func parseProfileInt(v sql.NullString) int64 {
+	if !v.Valid {
+		return 0
+	}
+	if n, err := strconv.ParseInt(v.String, 10, 64); err == nil {
+		return n
+	}
+	f, _ := strconv.ParseFloat(v.String, 64)
+	return int64(f)
+}
@@ -0,0 +1,124 @@
+package database
+
+import (
+	pb "data-service/generated/datasource"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyProfileType(t *testing.T) {
+	tests := map[string]ProfileTypeClass{
+		"varchar(64)":                 ProfileTypeString,
+		"character varying":           ProfileTypeString,
+		"mediumtext":                  ProfileTypeString,
+		"bigint":                      ProfileTypeComparable,
+		"decimal(10,2)":               ProfileTypeComparable,
+		"timestamp without time zone": ProfileTypeComparable,
+		"datetime":                    ProfileTypeComparable,
+		"boolean":                     ProfileTypeOther,
+		"json":                        ProfileTypeComplex,
+		"bytea":                       ProfileTypeComplex,
+		"array<int>":                  ProfileTypeComplex,
+	}
+	for dataType, want := range tests {
+		assert.Equal(t, want, ClassifyProfileType(dataType), dataType)
+	}
+}
+
+func TestBuildSummarySQL(t *testing.T) {
+	columns := []*pb.ColumnItem{
+		{Name: "id", DataType: "bigint"},
+		{Name: "name", DataType: "varchar"},
+		{Name: "payload", DataType: "json"},
+	}
+
+	doris := &TableProfiler{Dialect: dorisProfileDialect, Table: dorisProfileDialect.QualifiedTable("db", "users"), ApproximateDistinct: true}
+	query, layouts := doris.BuildSummarySQL(columns)
+	assert.Len(t, layouts, 3)
+	assert.Equal(t, "SELECT COUNT(*), COUNT(`id`), APPROX_COUNT_DISTINCT(`id`), MIN(`id`), MAX(`id`), "+
+		"COUNT(`name`), APPROX_COUNT_DISTINCT(`name`), MIN(`name`), MAX(`name`), "+
+		"MIN(CHAR_LENGTH(`name`)), MAX(CHAR_LENGTH(`name`)), AVG(CHAR_LENGTH(`name`)), "+
+		"COUNT(`payload`) FROM `db`.`users` profile_src", query)
+
+	// 不支持 HyperLogLog 的数据源退化为精确去重，采样使用带种子的 TABLESAMPLE
+	pg := &TableProfiler{Dialect: postgresProfileDialect, Table: postgresProfileDialect.QualifiedTable("public", "users"),
+		ApproximateDistinct: true, SamplePercent: 10, SampleSeed: 42}
+	query, layouts = pg.BuildSummarySQL(columns[:1])
+	assert.False(t, layouts[0].approximate)
+	assert.Equal(t, `SELECT COUNT(*), COUNT("id"), COUNT(DISTINCT "id"), MIN("id"), MAX("id") `+
+		`FROM (SELECT * FROM "users" TABLESAMPLE BERNOULLI(10) REPEATABLE(42)) profile_src`, query)
+}
+
+func TestSampledSourceIsSharedAcrossQueries(t *testing.T) {
+	doris := &TableProfiler{Dialect: dorisProfileDialect, Table: "`db`.`users`", SamplePercent: 5, SampleSeed: 7, TopK: 3}
+	sample := "(SELECT * FROM `db`.`users` TABLESAMPLE(5 PERCENT) REPEATABLE 7) profile_src"
+	summary, _ := doris.BuildSummarySQL([]*pb.ColumnItem{{Name: "name", DataType: "varchar"}})
+	assert.Contains(t, summary, sample)
+	assert.Contains(t, doris.BuildTopKSQL("name"), sample)
+	assert.Contains(t, doris.BuildLengthHistogramSQL("name", 1, 2), sample)
+
+	// MySQL 没有 TABLESAMPLE，使用同一种子的 RAND 过滤，样本为近似
+	mysql := &TableProfiler{Dialect: mysqlProfileDialect, Table: "`db`.`users`", SamplePercent: 5, SampleSeed: 7, TopK: 3}
+	assert.Contains(t, mysql.BuildTopKSQL("name"), "(SELECT * FROM `db`.`users` WHERE RAND(7) < 0.05) profile_src")
+}
+
+func TestBuildTopKAndLengthHistogramSQL(t *testing.T) {
+	p := &TableProfiler{Dialect: mysqlProfileDialect, Table: "`db`.`users`", TopK: 5}
+	assert.Equal(t, "SELECT `name`, COUNT(*) AS cnt FROM `db`.`users` profile_src WHERE `name` IS NOT NULL "+
+		"GROUP BY `name` ORDER BY cnt DESC LIMIT 5", p.BuildTopKSQL("name"))
+
+	histogram := p.BuildLengthHistogramSQL("name", 2, 3)
+	assert.True(t, strings.HasPrefix(histogram, "SELECT FLOOR((CHAR_LENGTH(`name`) - 2) / 3) AS bucket"), histogram)
+	assert.Contains(t, histogram, "GROUP BY FLOOR((CHAR_LENGTH(`name`) - 2) / 3) ORDER BY bucket")
+}
+
+func TestTableProfilerProfile(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	p := &TableProfiler{DB: db, Dialect: mysqlProfileDialect, Table: "`db`.`users`", TopK: 2, LengthBuckets: 2}
+	columns := []*pb.ColumnItem{
+		{Name: "active", DataType: "boolean"},
+		{Name: "name", DataType: "varchar"},
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*), COUNT(`active`)")).
+		WillReturnRows(sqlmock.NewRows([]string{"c0", "c1", "c2", "c3", "c4", "c5", "c6", "c7", "c8", "c9"}).
+			AddRow("10", "10", "2", "6", "6", "al", "zoe", "2", "5", "3.5000"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `active`, COUNT(*) AS cnt")).
+		WillReturnRows(sqlmock.NewRows([]string{"active", "cnt"}).AddRow("1", 7).AddRow("0", 3))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `name`, COUNT(*) AS cnt")).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "cnt"}).AddRow("bob", 2).AddRow("al", 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT FLOOR((CHAR_LENGTH(`name`) - 2) / 2) AS bucket")).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket", "cnt"}).AddRow("0", 4).AddRow("1", 2))
+
+	rowCount, profiles, err := p.Profile(columns)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Equal(t, int64(10), rowCount)
+
+	active := profiles[0]
+	assert.Equal(t, int64(0), active.NullCount)
+	assert.Equal(t, int64(2), active.DistinctCount)
+	assert.Empty(t, active.MinValue)
+	assert.Len(t, active.TopValues, 2)
+
+	name := profiles[1]
+	assert.Equal(t, int64(4), name.NullCount)
+	assert.InDelta(t, 0.4, name.NullRatio, 1e-9)
+	assert.Equal(t, int64(6), name.DistinctCount)
+	assert.Equal(t, "al", name.MinValue)
+	assert.Equal(t, "zoe", name.MaxValue)
+	assert.Equal(t, int64(2), name.LengthStats.MinLength)
+	assert.Equal(t, int64(5), name.LengthStats.MaxLength)
+	assert.InDelta(t, 3.5, name.LengthStats.AvgLength, 1e-9)
+	assert.Equal(t, []*pb.LengthBucket{
+		{LowerBound: 2, UpperBound: 3, Count: 4},
+		{LowerBound: 4, UpperBound: 5, Count: 2},
+	}, name.LengthStats.Buckets)
+}
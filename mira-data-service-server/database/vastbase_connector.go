@@ -257,52 +257,43 @@ func (v *VastbaseStrategy) GetTableInfo(database, tableName string, isExactQuery
 	} else {
 		// 普通查询，获取表的相关信息（包括表大小）
 		// 使用参数化查询来防止 SQL 注入
-		sqlQuery = "SELECT schemaname as table_schema, relname as table_name, n_live_tup as table_rows, pg_total_relation_size(relid) as data_length " +
-			"FROM pg_stat_user_tables WHERE relname = $1 AND schemaname = $2"
+		// 行数取 pg_class.reltuples（未收集统计信息时为 -1），统计时间取手动和自动 ANALYZE 中较新的一次
+		sqlQuery = "SELECT s.schemaname as table_schema, s.relname as table_name, c.reltuples::bigint as table_rows, " +
+			"pg_total_relation_size(s.relid) as data_length, " +
+			"EXTRACT(EPOCH FROM GREATEST(s.last_analyze, s.last_autoanalyze))::bigint as last_analyzed " +
+			"FROM pg_stat_user_tables s JOIN pg_class c ON c.oid = s.relid WHERE s.relname = $1 AND s.schemaname = $2"
 	}
 
 	log.Logger.Infof("Get table info executing query: %s with parameters: database=%s, tableName=%s", sqlQuery, database, tableName)
 
-	var rowCount int64
-	var recordSize int64
-	var tableSchema, resultTableName string
+	var result TableInfoResponse
 
 	if isExactQuery {
 		// 如果是精确查询，只获取记录数
+		var rowCount int64
 		if err := v.DB.QueryRow(sqlQuery).Scan(&rowCount); err != nil {
 			log.Logger.Errorf("Error executing query: %v", err)
 			return nil, fmt.Errorf("error executing query: %v", err)
 		}
-		recordSize = 0 // 精确查询不关心表的大小
-		resultTableName = tableName
-		tableSchema = "public"
+		result.setExactCount(tableName, rowCount)
+		result.TableSchema = "public"
 	} else {
 		// 检查是否启用估算模式
 		if ShouldUseEstimationOnly() {
 			// 直接使用估算方式，跳过 pg_stat_user_tables 查询
 			log.Logger.Infof("Using estimation mode (use_estimation_only enabled), skipping pg_stat_user_tables query")
-			// 使用临时 TableInfoResponse 结构来调用通用函数
-			var tempResult TableInfoResponse
-			FillTableInfoFromEstimation(v, database, tableName, "public", &tempResult)
-			// 将结果转换到 Vastbase 的变量
-			tableSchema = tempResult.TableSchema
-			resultTableName = tempResult.TableName
-			rowCount = int64(tempResult.TableRows)
-			recordSize = tempResult.TableSize
+			FillTableInfoFromEstimation(v, database, tableName, "public", &result)
 		} else {
 			// 普通查询，先尝试从 pg_stat_user_tables 获取
-			err := v.DB.QueryRow(sqlQuery, tableName, "public").Scan(&tableSchema, &resultTableName, &rowCount, &recordSize)
-			if err != nil || resultTableName == "" || rowCount == 0 {
-				// 查询失败或返回空结果，使用估算方式
-				LogQueryFailure("pg_stat_user_tables", err, resultTableName, int32(rowCount))
-				// 使用临时 TableInfoResponse 结构来调用通用函数
-				var tempResult TableInfoResponse
-				FillTableInfoFromEstimation(v, database, tableName, "public", &tempResult)
-				// 将结果转换到 Vastbase 的变量
-				tableSchema = tempResult.TableSchema
-				resultTableName = tempResult.TableName
-				rowCount = int64(tempResult.TableRows)
-				recordSize = tempResult.TableSize
+			var lastAnalyzed sql.NullInt64
+			err := v.DB.QueryRow(sqlQuery, tableName, "public").Scan(&result.TableSchema, &result.TableName, &result.TableRows, &result.TableSize, &lastAnalyzed)
+			if err != nil || result.TableName == "" || result.TableRows <= 0 {
+				// 查询失败、返回空结果或未收集统计信息，使用估算方式
+				LogQueryFailure("pg_class", err, result.TableName, result.TableRows)
+				FillTableInfoFromEstimation(v, database, tableName, "public", &result)
+			} else {
+				result.StatsMethod = ds.TableStatsMethod_TABLE_STATS_METHOD_PG_CLASS
+				result.LastAnalyzedAt = lastAnalyzed.Int64
 			}
 		}
 	}
@@ -313,19 +304,14 @@ func (v *VastbaseStrategy) GetTableInfo(database, tableName string, isExactQuery
 		log.Logger.Warnf("Failed to load Vastbase schema: %v", err)
 	}
 
-	log.Logger.Infof("Table info: tableName=%s, rowCount=%d, recordSize=%d", resultTableName, rowCount, recordSize)
+	log.Logger.Infof("Table info: %+v", result)
 
-	return &ds.TableInfoResponse{
-		TableName:   resultTableName,
-		RecordCount: int32(rowCount),
-		RecordSize:  recordSize,
-		Columns:     columns,
-	}, nil
+	return result.ToTableInfoResponse(columns), nil
 }
 
 func (v *VastbaseStrategy) getTableSchema(schema, table string) ([]*ds.ColumnItem, error) {
 	query := `
-		SELECT column_name, data_type
+		SELECT column_name, data_type, is_nullable, numeric_precision, numeric_scale
 		FROM information_schema.columns
 		WHERE table_schema = $1 AND table_name = $2
 		ORDER BY ordinal_position`
@@ -337,11 +323,13 @@ func (v *VastbaseStrategy) getTableSchema(schema, table string) ([]*ds.ColumnIte
 
 	var columns []*ds.ColumnItem
 	for rows.Next() {
-		var col ds.ColumnItem
-		if err := rows.Scan(&col.Name, &col.DataType); err != nil {
+		var name, dataType string
+		var isNullable sql.NullString
+		var precision, scale sql.NullInt64
+		if err := rows.Scan(&name, &dataType, &isNullable, &precision, &scale); err != nil {
 			return nil, err
 		}
-		columns = append(columns, &col)
+		columns = append(columns, newColumnItem(name, dataType, isNullable, precision, scale))
 	}
 	return columns, nil
 }
@@ -353,6 +341,7 @@ func (v *VastbaseStrategy) BuildWithConditionQuery(
 	filterOperators []ds.FilterOperator,
 	filterValues []*ds.FilterValue,
 	sortRules []*ds.SortRule,
+	window *ReadWindow,
 ) (string, []interface{}, error) {
 	quoteIdentifier := func(identifier string) string {
 		return fmt.Sprintf("\"%s\"", identifier)
@@ -384,7 +373,7 @@ func (v *VastbaseStrategy) BuildWithConditionQuery(
 		queryBuilder.WriteString("*")
 	}
 	queryBuilder.WriteString(" FROM ")
-	queryBuilder.WriteString(quoteIdentifier(tableName))
+	queryBuilder.WriteString(window.sampleTable(quoteIdentifier(tableName), postgresWindowDialect))
 
 	// 构建 WHERE 子句
 	if len(filterNames) > 0 && len(filterOperators) > 0 && len(filterValues) > 0 {
@@ -446,9 +435,8 @@ func (v *VastbaseStrategy) BuildWithConditionQuery(
 	}
 
 	// 构建 ORDER BY 子句
+	orderBy := ""
 	if len(sortRules) > 0 {
-		queryBuilder.WriteString(" ORDER BY ")
-
 		orders := make([]string, len(sortRules))
 		for i, rule := range sortRules {
 			order := "ASC"
@@ -457,10 +445,11 @@ func (v *VastbaseStrategy) BuildWithConditionQuery(
 			}
 			orders[i] = fmt.Sprintf("%s %s", rule.FieldName, order)
 		}
-		queryBuilder.WriteString(strings.Join(orders, ", "))
+		orderBy = strings.Join(orders, ", ")
 	}
 
-	return queryBuilder.String(), args, nil
+	// 采样、分页
+	return window.finish(queryBuilder.String(), orderBy, postgresWindowDialect), args, nil
 }
 
 func (v *VastbaseStrategy) EnsureDatabaseExists(dbName string) error {
@@ -585,9 +574,9 @@ func buildVastbaseTLSDSN(info *ds.ConnectionInfo, tlsConfig *ds.DatasourceTlsCon
 }
 
 // getTableRowCount 获取表的总行数
-func (v *VastbaseStrategy) GetTableRowCount(database string, tableName string) (int32, error) {
+func (v *VastbaseStrategy) GetTableRowCount(database string, tableName string) (int64, error) {
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s", pqQuoteIdentifier(tableName))
-	var rowCount int32
+	var rowCount int64
 	if err := v.DB.QueryRow(countQuery).Scan(&rowCount); err != nil {
 		return 0, err
 	}
@@ -595,7 +584,7 @@ func (v *VastbaseStrategy) GetTableRowCount(database string, tableName string) (
 }
 
 // estimateTableSize 通过采样数据估算表的大小
-func (v *VastbaseStrategy) EstimateTableSize(database string, tableName string, totalRows int32) (int64, error) {
+func (v *VastbaseStrategy) EstimateTableSize(database string, tableName string, totalRows int64) (int64, error) {
 	// 获取100条数据估算平均行大小
 	sampleQuery := fmt.Sprintf("SELECT * FROM %s LIMIT 100", pqQuoteIdentifier(tableName))
 	rows, err := v.DB.Query(sampleQuery)
@@ -642,10 +631,10 @@ func (v *VastbaseStrategy) EstimateTableSize(database string, tableName string,
 	return 0, fmt.Errorf("no sample data available for size estimation")
 }
 
-func (v *VastbaseStrategy) getTableRowCount(tableName string) (int32, error) {
+func (v *VastbaseStrategy) getTableRowCount(tableName string) (int64, error) {
 	return v.GetTableRowCount("", tableName)
 }
 
-func (v *VastbaseStrategy) estimateTableSize(tableName string, totalRows int32) (int64, error) {
+func (v *VastbaseStrategy) estimateTableSize(tableName string, totalRows int64) (int64, error) {
 	return v.EstimateTableSize("", tableName, totalRows)
 }
@@ -16,6 +16,7 @@ MINIO_HOST=${MINIO_HOST:-minio}
 MINIO_PORT=${MINIO_PORT:-9000}
 MINIO_ACCESS_KEY=${MINIO_ACCESS_KEY:-minioadmin}
 MINIO_SECRET_KEY=${MINIO_SECRET_KEY:-minioadmin}
+MINIO_REGION=${MINIO_REGION:-us-east-1}
 
 # Doris 配置
 DORIS_HOST=${DORIS_HOST:-doris-fe}
@@ -47,6 +48,7 @@ oss:
   port: ${MINIO_PORT}
   access_key: "${MINIO_ACCESS_KEY}"
   secret_key: "${MINIO_SECRET_KEY}"
+  region: "${MINIO_REGION}"
 
 dbms:
   type: "mysql"
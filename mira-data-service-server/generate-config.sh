@@ -14,6 +14,7 @@ MINIO_HOST=${MINIO_HOST:-minio}
 MINIO_PORT=${MINIO_PORT:-9000}
 MINIO_ACCESS_KEY=${MINIO_ACCESS_KEY:-minioadmin}
 MINIO_SECRET_KEY=${MINIO_SECRET_KEY:-minioadmin}
+MINIO_REGION=${MINIO_REGION:-us-east-1}
 
 LOG_LEVEL=${LOG_LEVEL:-info}
 
@@ -25,6 +26,7 @@ oss:
   port: ${MINIO_PORT}
   access_key: "${MINIO_ACCESS_KEY}"
   secret_key: "${MINIO_SECRET_KEY}"
+  region: "${MINIO_REGION}"
 
 dbms:
   type: "mysql"
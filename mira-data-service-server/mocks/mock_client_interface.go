@@ -9,6 +9,7 @@ import (
 	oss "data-service/oss"
 	io "io"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	lifecycle "github.com/minio/minio-go/v7/pkg/lifecycle"
@@ -125,6 +126,21 @@ func (mr *MockClientInterfaceMockRecorder) ListObjects(ctx, bucketName, prefix,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockClientInterface)(nil).ListObjects), ctx, bucketName, prefix, recursive)
 }
 
+// ListObjectsWithInfo mocks base method.
+func (m *MockClientInterface) ListObjectsWithInfo(ctx context.Context, bucketName, prefix string, recursive bool) ([]oss.ObjectInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjectsWithInfo", ctx, bucketName, prefix, recursive)
+	ret0, _ := ret[0].([]oss.ObjectInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectsWithInfo indicates an expected call of ListObjectsWithInfo.
+func (mr *MockClientInterfaceMockRecorder) ListObjectsWithInfo(ctx, bucketName, prefix, recursive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectsWithInfo", reflect.TypeOf((*MockClientInterface)(nil).ListObjectsWithInfo), ctx, bucketName, prefix, recursive)
+}
+
 // MakeBucket mocks base method.
 func (m *MockClientInterface) MakeBucket(bucketName, location string) error {
 	m.ctrl.T.Helper()
@@ -140,18 +156,18 @@ func (mr *MockClientInterfaceMockRecorder) MakeBucket(bucketName, location inter
 }
 
 // PresignedGetObject mocks base method.
-func (m *MockClientInterface) PresignedGetObject(bucketName, mergedFileName string) (string, error) {
+func (m *MockClientInterface) PresignedGetObject(bucketName, objectName string, expiry time.Duration) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PresignedGetObject", bucketName, mergedFileName)
+	ret := m.ctrl.Call(m, "PresignedGetObject", bucketName, objectName, expiry)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PresignedGetObject indicates an expected call of PresignedGetObject.
-func (mr *MockClientInterfaceMockRecorder) PresignedGetObject(bucketName, mergedFileName interface{}) *gomock.Call {
+func (mr *MockClientInterfaceMockRecorder) PresignedGetObject(bucketName, objectName, expiry interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresignedGetObject", reflect.TypeOf((*MockClientInterface)(nil).PresignedGetObject), bucketName, mergedFileName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresignedGetObject", reflect.TypeOf((*MockClientInterface)(nil).PresignedGetObject), bucketName, objectName, expiry)
 }
 
 // PutObject mocks base method.
@@ -182,3 +198,32 @@ func (mr *MockClientInterfaceMockRecorder) SetBucketLifecycle(bucketName, lifecy
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBucketLifecycle", reflect.TypeOf((*MockClientInterface)(nil).SetBucketLifecycle), bucketName, lifecycleConfig)
 }
+
+// SetBucketPolicy mocks base method.
+func (m *MockClientInterface) SetBucketPolicy(bucketName, policy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBucketPolicy", bucketName, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBucketPolicy indicates an expected call of SetBucketPolicy.
+func (mr *MockClientInterfaceMockRecorder) SetBucketPolicy(bucketName, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBucketPolicy", reflect.TypeOf((*MockClientInterface)(nil).SetBucketPolicy), bucketName, policy)
+}
+
+// StatObject mocks base method.
+func (m *MockClientInterface) StatObject(ctx context.Context, bucketName, objectName string) (oss.ObjectInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StatObject", ctx, bucketName, objectName)
+	ret0, _ := ret[0].(oss.ObjectInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StatObject indicates an expected call of StatObject.
+func (mr *MockClientInterfaceMockRecorder) StatObject(ctx, bucketName, objectName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatObject", reflect.TypeOf((*MockClientInterface)(nil).StatObject), ctx, bucketName, objectName)
+}
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	common "data-service/common"
 	datasource "data-service/generated/datasource"
 	sql "database/sql"
 	reflect "reflect"
@@ -237,18 +238,19 @@ func (mr *MockIDorisServiceMockRecorder) ExportDorisDataToMiraDB(arg0 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportDorisDataToMiraDB", reflect.TypeOf((*MockIDorisService)(nil).ExportDorisDataToMiraDB), arg0)
 }
 
-// ExportParquetFileFromDoris mocks base method.
-func (m *MockIDorisService) ExportParquetFileFromDoris(arg0 *datasource.ExportCsvFileFromDorisRequest) error {
+// ExportFileFromDoris mocks base method.
+func (m *MockIDorisService) ExportFileFromDoris(arg0 *datasource.ExportCsvFileFromDorisRequest) (*common.JobExportManifest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExportParquetFileFromDoris", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ExportFileFromDoris", arg0)
+	ret0, _ := ret[0].(*common.JobExportManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ExportParquetFileFromDoris indicates an expected call of ExportParquetFileFromDoris.
-func (mr *MockIDorisServiceMockRecorder) ExportParquetFileFromDoris(arg0 interface{}) *gomock.Call {
+// ExportFileFromDoris indicates an expected call of ExportFileFromDoris.
+func (mr *MockIDorisServiceMockRecorder) ExportFileFromDoris(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportParquetFileFromDoris", reflect.TypeOf((*MockIDorisService)(nil).ExportParquetFileFromDoris), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportFileFromDoris", reflect.TypeOf((*MockIDorisService)(nil).ExportFileFromDoris), arg0)
 }
 
 // GetDBName mocks base method.
@@ -295,11 +297,12 @@ func (mr *MockIDorisServiceMockRecorder) ImportArrowFileToDoris(arg0, arg1, arg2
 }
 
 // ImportCsvFileToDoris mocks base method.
-func (m *MockIDorisService) ImportCsvFileToDoris(arg0 *datasource.ImportCsvFileToDorisRequest) error {
+func (m *MockIDorisService) ImportCsvFileToDoris(arg0 *datasource.ImportCsvFileToDorisRequest) (*common.RejectSummary, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ImportCsvFileToDoris", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*common.RejectSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // ImportCsvFileToDoris indicates an expected call of ImportCsvFileToDoris.
@@ -308,6 +311,21 @@ func (mr *MockIDorisServiceMockRecorder) ImportCsvFileToDoris(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportCsvFileToDoris", reflect.TypeOf((*MockIDorisService)(nil).ImportCsvFileToDoris), arg0)
 }
 
+// ImportDeployedTableToDoris mocks base method.
+func (m *MockIDorisService) ImportDeployedTableToDoris(arg0, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportDeployedTableToDoris", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportDeployedTableToDoris indicates an expected call of ImportDeployedTableToDoris.
+func (mr *MockIDorisServiceMockRecorder) ImportDeployedTableToDoris(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportDeployedTableToDoris", reflect.TypeOf((*MockIDorisService)(nil).ImportDeployedTableToDoris), arg0, arg1, arg2)
+}
+
 // ImportMiraDBDataToDoris mocks base method.
 func (m *MockIDorisService) ImportMiraDBDataToDoris(arg0 *datasource.ImportMiraDBDataToDorisRequest) (string, error) {
 	m.ctrl.T.Helper()
@@ -352,12 +370,13 @@ func (mr *MockIDorisServiceMockRecorder) InitMiraTaskTmpDatabase() *gomock.Call
 }
 
 // ProcessDataSourceAndExport mocks base method.
-func (m *MockIDorisService) ProcessDataSourceAndExport(arg0 *datasource.ReadDataSourceStreamingRequest, arg1 string) (string, error) {
+func (m *MockIDorisService) ProcessDataSourceAndExport(arg0 *datasource.ReadDataSourceStreamingRequest, arg1 string) (string, *common.JobExportManifest, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ProcessDataSourceAndExport", arg0, arg1)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*common.JobExportManifest)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // ProcessDataSourceAndExport indicates an expected call of ProcessDataSourceAndExport.
@@ -366,6 +385,44 @@ func (mr *MockIDorisServiceMockRecorder) ProcessDataSourceAndExport(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessDataSourceAndExport", reflect.TypeOf((*MockIDorisService)(nil).ProcessDataSourceAndExport), arg0, arg1)
 }
 
+// SaveRejects mocks base method.
+func (m *MockIDorisService) SaveRejects() *common.RejectSummary {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRejects")
+	ret0, _ := ret[0].(*common.RejectSummary)
+	return ret0
+}
+
+// SaveRejects indicates an expected call of SaveRejects.
+func (mr *MockIDorisServiceMockRecorder) SaveRejects() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRejects", reflect.TypeOf((*MockIDorisService)(nil).SaveRejects))
+}
+
+// SetErrorTolerance mocks base method.
+func (m *MockIDorisService) SetErrorTolerance(arg0 common.ErrorTolerance, arg1 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetErrorTolerance", arg0, arg1)
+}
+
+// SetErrorTolerance indicates an expected call of SetErrorTolerance.
+func (mr *MockIDorisServiceMockRecorder) SetErrorTolerance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetErrorTolerance", reflect.TypeOf((*MockIDorisService)(nil).SetErrorTolerance), arg0, arg1)
+}
+
+// SetSourceWindow mocks base method.
+func (m *MockIDorisService) SetSourceWindow(arg0 *datasource.ReadWindow) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSourceWindow", arg0)
+}
+
+// SetSourceWindow indicates an expected call of SetSourceWindow.
+func (mr *MockIDorisServiceMockRecorder) SetSourceWindow(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSourceWindow", reflect.TypeOf((*MockIDorisService)(nil).SetSourceWindow), arg0)
+}
+
 // SwitchDatabase mocks base method.
 func (m *MockIDorisService) SwitchDatabase(arg0 string) error {
 	m.ctrl.T.Helper()
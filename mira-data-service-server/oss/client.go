@@ -9,6 +9,8 @@
 */
 package oss
 
+import "time"
+
 // GetOptions 定义了通用的对象下载选项
 type GetOptions struct {
 	ExtraHeaders map[string]string // 指定额外的 HTTP 请求头
@@ -23,3 +25,12 @@ type PutOptions struct {
 	StorageClass string            // 存储类型 (e.g., "STANDARD", "IA", "ARCHIVE")
 	Tagging      map[string]string // 对象标签 (key-value 形式)
 }
+
+// ObjectInfo 对象列举结果，包含大小和最后修改时间，StatObject 时还包含 ETag 和版本
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+	ETag         string
+	VersionID    string
+}
@@ -13,6 +13,7 @@ package oss
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/minio/minio-go/v7/pkg/lifecycle"
 )
@@ -30,10 +31,17 @@ type ClientInterface interface {
 
 	DirectoryExists(bucketName string, dir string) (bool, error)
 
-	PresignedGetObject(bucketName string, mergedFileName string) (string, error)
+	// 生成预签名下载地址，expiry <= 0 时默认24小时
+	PresignedGetObject(bucketName string, objectName string, expiry time.Duration) (string, error)
 
 	ListObjects(ctx context.Context, bucketName, prefix string, recursive bool) ([]string, error)
 
+	// 列出对象及其大小和最后修改时间
+	ListObjectsWithInfo(ctx context.Context, bucketName, prefix string, recursive bool) ([]ObjectInfo, error)
+
+	// 查询对象元数据，包含 ETag 和版本
+	StatObject(ctx context.Context, bucketName, objectName string) (ObjectInfo, error)
+
 	DeleteObject(ctx context.Context, bucketName, objectName string) error
 
 	DeleteObjectsByJobInstanceId(ctx context.Context, bucketName, jobInstanceId string) error
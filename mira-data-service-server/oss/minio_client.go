@@ -61,7 +61,8 @@ func (c *MinIOClient) GetObject(ctx context.Context, bucketName, objectName stri
 
 func (c *MinIOClient) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts *PutOptions) (info interface{}, err error) {
 	minioOpts := minio.PutObjectOptions{
-		ContentType: opts.ContentType,
+		ContentType:  opts.ContentType,
+		UserMetadata: opts.Metadata,
 	}
 	return c.client.PutObject(ctx, bucketName, objectName, reader, objectSize, minioOpts)
 }
@@ -107,11 +108,17 @@ func (c *MinIOClient) DirectoryExists(bucketName string, dir string) (bool, erro
 	return false, nil
 }
 
-func (c *MinIOClient) PresignedGetObject(bucketName string, mergedFileName string) (string, error) {
-	// 将过期时间设置为 24 小时 (24 * 60 * 60 秒)
-	expiration := time.Duration(24 * time.Hour)
+func (c *MinIOClient) PresignedGetObject(bucketName string, objectName string, expiry time.Duration) (string, error) {
+	// 未指定时将过期时间设置为 24 小时，S3 预签名最长 7 天
+	expiration := expiry
+	if expiration <= 0 {
+		expiration = 24 * time.Hour
+	}
+	if expiration > 7*24*time.Hour {
+		expiration = 7 * 24 * time.Hour
+	}
 	// 生成 presigned URL
-	presignedURL, err := c.client.PresignedGetObject(context.Background(), bucketName, mergedFileName, expiration, nil)
+	presignedURL, err := c.client.PresignedGetObject(context.Background(), bucketName, objectName, expiration, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate presigned URL: %v", err)
 	}
@@ -137,6 +144,39 @@ func (c *MinIOClient) ListObjects(ctx context.Context, bucketName, prefix string
 	return keys, nil
 }
 
+// ListObjectsWithInfo 列出对象及其元信息
+func (c *MinIOClient) ListObjectsWithInfo(ctx context.Context, bucketName, prefix string, recursive bool) ([]ObjectInfo, error) {
+	ch := c.client.ListObjects(ctx, bucketName, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: recursive,
+	})
+	var objects []ObjectInfo
+	for obj := range ch {
+		if obj.Err != nil {
+			return nil, fmt.Errorf("failed to list objects in bucket %s with prefix %s: %v", bucketName, prefix, obj.Err)
+		}
+		if obj.Key != "" {
+			objects = append(objects, ObjectInfo{Key: obj.Key, Size: obj.Size, LastModified: obj.LastModified})
+		}
+	}
+	return objects, nil
+}
+
+// StatObject 查询对象元数据
+func (c *MinIOClient) StatObject(ctx context.Context, bucketName, objectName string) (ObjectInfo, error) {
+	info, err := c.client.StatObject(ctx, bucketName, objectName, minio.StatObjectOptions{})
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	return ObjectInfo{
+		Key:          info.Key,
+		Size:         info.Size,
+		LastModified: info.LastModified,
+		ETag:         info.ETag,
+		VersionID:    info.VersionID,
+	}, nil
+}
+
 // DeleteObject 删除指定的对象
 func (c *MinIOClient) DeleteObject(ctx context.Context, bucketName, objectName string) error {
 	return c.client.RemoveObject(ctx, bucketName, objectName, minio.RemoveObjectOptions{})
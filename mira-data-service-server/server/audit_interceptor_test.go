@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"data-service/database/gorm/models"
+	pb "data-service/generated/datasource"
+	"data-service/service"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func readAuditLines(t *testing.T, path string) []models.AuditLog {
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	var entries []models.AuditLog
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		var entry models.AuditLog
+		assert.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestAuditUnaryInterceptor_RecordsAuditedMethod(t *testing.T) {
+	auditFile := filepath.Join(t.TempDir(), "audit.jsonl")
+	interceptor := AuditUnaryInterceptor(service.NewAuditService(nil, auditFile))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-caller-id", "partner-a"))
+	info := &grpc.UnaryServerInfo{FullMethod: "/datasource.DataSourceService/ExecuteDorisSQL"}
+	req := &pb.ExecuteDorisSQLRequest{Sql: "SELECT  id,\n name FROM users"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.ExecuteDorisSQLResponse{Success: true, Rows: []*pb.DorisSQLRow{{}, {}}}, nil
+	}
+
+	_, err := interceptor(ctx, req, info, handler)
+	assert.NoError(t, err)
+
+	entries := readAuditLines(t, auditFile)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "partner-a", entries[0].Caller)
+	// metadata 中的标识是客户端自称的
+	assert.False(t, entries[0].CallerVerified)
+	assert.Equal(t, "ExecuteDorisSQL", entries[0].Rpc)
+	assert.Equal(t, "SELECT id, name FROM users", entries[0].Statement)
+	assert.Equal(t, int64(2), entries[0].RowsReturned)
+	assert.True(t, entries[0].Success)
+}
+
+func TestCallerFromContext_PrefersVerifiedCertificate(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-caller-id", "claimed-admin"))
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "partner-b"}}
+	ctx = peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{
+		State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+	}})
+
+	caller, verified := callerFromContext(ctx)
+	assert.Equal(t, "partner-b", caller)
+	assert.True(t, verified)
+
+	// 未验证的证书不作为调用方标识
+	ctx = peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{
+		State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}},
+	}})
+	caller, verified = callerFromContext(ctx)
+	assert.Equal(t, "claimed-admin", caller)
+	assert.False(t, verified)
+}
+
+func TestAuditUnaryInterceptor_RecordsFailureAndSkipsOthers(t *testing.T) {
+	auditFile := filepath.Join(t.TempDir(), "audit.jsonl")
+	interceptor := AuditUnaryInterceptor(service.NewAuditService(nil, auditFile))
+	failing := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, errors.New("table not found")
+	}
+
+	_, err := interceptor(context.Background(), &pb.TruncateTableRequest{TableName: "t_orders"},
+		&grpc.UnaryServerInfo{FullMethod: "/datasource.DataSourceService/TruncateTable"}, failing)
+	assert.Error(t, err)
+	// 非审计方法不记录
+	_, _ = interceptor(context.Background(), &pb.JobStatusRequest{},
+		&grpc.UnaryServerInfo{FullMethod: "/datasource.DataSourceService/GetJobStatus"}, failing)
+
+	entries := readAuditLines(t, auditFile)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "t_orders", entries[0].Asset)
+	assert.False(t, entries[0].Success)
+	assert.Equal(t, "table not found", entries[0].ErrorMessage)
+}
+
+func TestCountReturnedRows_ReadsArrowHeaders(t *testing.T) {
+	schema := arrow.NewSchema([]arrow.Field{{Name: "id", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	var buf bytes.Buffer
+	writer := ipc.NewWriter(&buf, ipc.WithSchema(schema))
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+	for _, n := range []int{3, 2} {
+		for i := 0; i < n; i++ {
+			builder.Field(0).(*array.Int64Builder).Append(int64(i))
+		}
+		record := builder.NewRecord()
+		assert.NoError(t, writer.Write(record))
+		record.Release()
+	}
+	assert.NoError(t, writer.Close())
+
+	assert.Equal(t, int64(5), service.CountReturnedRows(&pb.ArrowResponse{ArrowBatch: buf.Bytes()}))
+	assert.Equal(t, int64(5), service.CountReturnedRows(&pb.ExecuteSqlResponse{Result: &pb.ExecuteSqlResponse_ArrowBatch{ArrowBatch: buf.Bytes()}}))
+	assert.Equal(t, int64(0), service.CountReturnedRows(&pb.ArrowResponse{ArrowBatch: []byte("EOF")}))
+	// 截断的数据只统计完整的批次：去掉流结束标记和最后一批的部分消息体
+	assert.Equal(t, int64(3), service.CountReturnedRows(&pb.ArrowResponse{ArrowBatch: buf.Bytes()[:buf.Len()-10]}))
+}
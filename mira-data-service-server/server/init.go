@@ -5,6 +5,7 @@ import (
 	"data-service/config"
 	"data-service/database"
 	"data-service/database/gorm"
+	"data-service/database/lock"
 	log "data-service/log"
 	"data-service/oss"
 	"data-service/service"
@@ -77,6 +78,11 @@ func (i *Initializer) Init() error {
 		return fmt.Errorf("failed to init gorm: %v", err)
 	}
 
+	// 12. 初始化分布式锁（依赖 gorm）
+	if err := i.initDistributedLock(); err != nil {
+		return fmt.Errorf("failed to init distributed lock: %v", err)
+	}
+
 	return nil
 }
 
@@ -100,6 +106,7 @@ func (i *Initializer) initDatabase() error {
 		return errors.New("config not initialized")
 	}
 	database.Init()
+	database.SetBatchMetrics(batchMetrics{})
 	return nil
 }
 
@@ -157,6 +164,17 @@ func (i *Initializer) initGorm() error {
 	return nil
 }
 
+func (i *Initializer) initDistributedLock() error {
+	locker, err := lock.New(config.GetConfigMap(), gorm.GetGormDB())
+	if err != nil {
+		return err
+	}
+	lock.SetDefault(locker)
+	lock.SetMetrics(lockMetrics{})
+	log.Logger.Infof("Distributed lock backend: %s", config.GetConfigMap().DistributedLock.Backend)
+	return nil
+}
+
 func (i *Initializer) initMiraTaskTmpDatabase() error {
 	dorisService, err := service.NewDorisService("")
 	if err != nil {
@@ -16,6 +16,10 @@ const (
 	labelServiceName = "service_name"
 	labelSuccess     = "success"
 	labelHandler     = "handler"
+	labelLockBackend = "backend"
+	labelLockResult  = "result"
+	labelDbType      = "db_type"
+	labelReason      = "reason"
 
 	serviceNameValue = "data-service"
 )
@@ -42,6 +46,82 @@ func getMetrics() []*ginmetrics.Metric {
 			Description: "Duration of import data processing in seconds (last request)",
 			Labels:      []string{labelServiceName, labelHandler, labelSuccess},
 		},
+		// 分布式锁
+		{
+			Type:        ginmetrics.Histogram,
+			Name:        "lock_wait_seconds",
+			Description: "Time spent waiting for distributed locks in seconds",
+			Labels:      []string{labelServiceName, labelLockBackend, labelLockResult},
+			Buckets:     []float64{0.01, 0.1, 1, 10, 60, 300, 1800},
+		},
+		{
+			Type:        ginmetrics.Counter,
+			Name:        "lock_lost_total",
+			Description: "Total number of distributed lock leases lost because renewal failed",
+			Labels:      []string{labelServiceName, labelLockBackend},
+		},
+		// Arrow 写入自适应批次
+		{
+			Type:        ginmetrics.Gauge,
+			Name:        "write_batch_rows",
+			Description: "Current number of rows per batch chosen by the adaptive batcher",
+			Labels:      []string{labelServiceName, labelDbType},
+		},
+		{
+			Type:        ginmetrics.Counter,
+			Name:        "write_batch_adjustments_total",
+			Description: "Total number of batch size adjustments by reason",
+			Labels:      []string{labelServiceName, labelDbType, labelReason},
+		},
+		{
+			Type:        ginmetrics.Histogram,
+			Name:        "write_batch_duration_seconds",
+			Description: "Time spent writing one batch in seconds",
+			Labels:      []string{labelServiceName, labelDbType},
+			Buckets:     []float64{0.05, 0.2, 0.5, 1, 2, 5, 15, 60},
+		},
+		{
+			Type:        ginmetrics.Counter,
+			Name:        "write_batch_bytes_total",
+			Description: "Total Arrow buffer bytes written in batches",
+			Labels:      []string{labelServiceName, labelDbType},
+		},
+	}
+}
+
+// lockMetrics 记录分布式锁等待时长和租约丢失次数
+type lockMetrics struct{}
+
+func (lockMetrics) ObserveWait(backend, result string, wait time.Duration) {
+	if m := M.GetMetric("lock_wait_seconds"); m != nil {
+		m.Observe([]string{serviceNameValue, backend, result}, wait.Seconds())
+	}
+}
+
+func (lockMetrics) IncLost(backend string) {
+	if m := M.GetMetric("lock_lost_total"); m != nil {
+		m.Inc([]string{serviceNameValue, backend})
+	}
+}
+
+// batchMetrics 记录 Arrow 写入的批次行数调整和每批写入耗时
+type batchMetrics struct{}
+
+func (batchMetrics) SetBatchRows(dbType, reason string, rows int) {
+	if m := M.GetMetric("write_batch_rows"); m != nil {
+		m.SetGaugeValue([]string{serviceNameValue, dbType}, float64(rows))
+	}
+	if m := M.GetMetric("write_batch_adjustments_total"); m != nil {
+		m.Inc([]string{serviceNameValue, dbType, reason})
+	}
+}
+
+func (batchMetrics) ObserveBatch(dbType string, rows, bytes int64, elapsed time.Duration) {
+	if m := M.GetMetric("write_batch_duration_seconds"); m != nil {
+		m.Observe([]string{serviceNameValue, dbType}, elapsed.Seconds())
+	}
+	if m := M.GetMetric("write_batch_bytes_total"); m != nil {
+		m.Add([]string{serviceNameValue, dbType}, float64(bytes))
 	}
 }
 
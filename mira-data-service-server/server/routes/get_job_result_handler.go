@@ -3,8 +3,6 @@ package routes
 import (
 	"context"
 	log "data-service/log"
-	"encoding/base64"
-	"encoding/json"
 	"os"
 
 	status "data-service/common"
@@ -14,7 +12,6 @@ import (
 	"io"
 	"io/ioutil"
 	"path/filepath"
-	"sort"
 	"strings"
 
 	"chainweaver.org.cn/chainweaver/mira/mira-common/minio_access"
@@ -204,10 +201,10 @@ func (h *GetResultHandler) mergeResults(
 	}
 
 	// 默认按软件格式解析
-	mergedContent, err := TransformSoftwareFormat(allResults)
+	mergedContent, err := utils.TransformSoftwareFormat(allResults)
 	if err != nil {
 		// 尝试按硬件格式解析
-		mergedContent, err = TransformHardwareFormat(allResults)
+		mergedContent, err = utils.TransformHardwareFormat(allResults)
 		if err != nil {
 			return "", errors.WithMessage(err, "mergeResults | transform json arrays failed")
 		}
@@ -249,7 +246,7 @@ func (h *GetResultHandler) GetResponse() *status.Response {
 func (h *GetResultHandler) ProcessFileFormat(result string) (string, error) {
 	// 兼容空结果
 	if strings.TrimSpace(result) == "" {
-		return encodeContent("[]"), nil
+		return utils.EncodeResultContent("[]"), nil
 	}
 
 	if h.req.FileType == FileFormatCsv {
@@ -257,156 +254,10 @@ func (h *GetResultHandler) ProcessFileFormat(result string) (string, error) {
 		if err != nil {
 			return "", fmt.Errorf("processResultFormat | transform to csv failed. err: %v", err)
 		}
-		return encodeContent(string(csvContent)), nil
+		return utils.EncodeResultContent(string(csvContent)), nil
 	}
 
-	return encodeContent(result), nil
-}
-
-// TransformSoftwareFormat 将多个JSON字符串合并并转换格式
-// 输入格式: 详见https://www.tapd.cn/51081496/markdown_wikis/show/#1151081496001001856
-// 输出格式: [{"column1":1,"column2":2},{"column1":1,"column2":2}]
-func TransformSoftwareFormat(allResults []string) (string, error) {
-	r, columnsIdx, err := dealRows(allResults)
-	if err != nil {
-		return "", errors.WithMessage(err, "TransformSoftwareFormat | deal row failed")
-	}
-
-	result, err := json.Marshal(r)
-	if err != nil {
-		return "", errors.WithMessage(err, "TransformSoftwareFormat | marshal result failed")
-	}
-
-	// 解析所有输入的JSON字符串
-	var columnMap = make(map[string][]interface{})
-	maxLen := 0
-	// 兼容空结果
-	if checkResultIsEmpty(string(result)) {
-		return "", fmt.Errorf("TransformSoftwareFormat | result is empty")
-	}
-
-	var resultMap map[string][]interface{}
-	if err := json.Unmarshal([]byte(result), &resultMap); err != nil {
-		return "", errors.WithMessage(err, "TransformSoftwareFormat | unmarshal input JSON failed")
-	}
-
-	// 合并所有列数据
-	for col, values := range resultMap {
-		columnMap[col] = values
-		if len(values) > maxLen {
-			maxLen = len(values)
-		}
-	}
-
-	// 兼容空结果
-	if len(columnMap) == 0 {
-		return "", nil
-	}
-
-	// 构建转换后的结果
-	// [{"column1":1,"column2":2},{"column1":1,"column2":2}]
-	var transformedResults []string
-	for i := 0; i < maxLen; i++ {
-		row := "{"
-		// 按排序后的列名顺序构建 row
-		for _, col := range columnsIdx {
-			values := columnMap[col]
-			if i < len(values) {
-				value, _ := json.Marshal(values[i])
-				row += fmt.Sprintf("\"%s\":%s,", col, string(value))
-			}
-		}
-		row = strings.TrimSuffix(row, ",")
-		row += "}"
-		transformedResults = append(transformedResults, row)
-	}
-
-	// 将结果转换回JSON字符串
-	return "[" + strings.Join(transformedResults, ",") + "]", nil
-}
-
-// 背景：目前硬件常用的板卡执行结果是json格式，每个mpc的结果格式可能不尽相同
-// TransformHardwareFormat 将多个JSON字符串合并并转换格式
-// 输入格式：[[{"id":"1","val":"这里是分包1板卡执行结果"},{"id":"2","val":"这里是分包2板卡执行结果"}],[...]]
-// 输出格式：[{"id":"1","val":"这里是分包1板卡执行结果"},{"id":"2","val":"这里是分包2板卡执行结果"}...]
-func TransformHardwareFormat(allResults []string) (string, error) {
-	var transformedResult []map[string]interface{}
-
-	for _, result := range allResults {
-		// 兼容空结果
-		if result == "" {
-			continue
-		}
-		var arrayResult []map[string]interface{}
-		if err := json.Unmarshal([]byte(result), &arrayResult); err != nil {
-			return "", errors.WithMessage(err, "TransformHardware | unmarshal input JSON failed")
-		}
-		transformedResult = append(transformedResult, arrayResult...)
-	}
-
-	if len(transformedResult) == 0 {
-		return "[]", nil
-	}
-
-	resultJSON, err := json.Marshal(transformedResult)
-	if err != nil {
-		return "", errors.WithMessage(err, "TransformHardware | marshal output JSON failed")
-	}
-
-	return string(resultJSON), nil
-}
-
-// 2025.02.24 变更：软件执行引擎将参与方信息、表信息写入minIO, 解析后展示给用户以区分同名属性
-//
-//	输入：{"id": {
-//		"value": [1, 2, 3, 4],
-//		"party_id": "party_id_1",
-//		"asset_name": "asset_name_1"
-//		}], "id2"...}
-//
-// 输出：{"party_id_1.asset_name_1.id":[1, 2, 3, 4], "party_id_2.asset_name_2.id2":[1, 2, 3, 4]}
-func dealRows(rows []string) (map[string][]interface{}, []string, error) {
-	type rowInfo struct {
-		Value      []interface{} `json:"value"`
-		PartyId    string        `json:"party_id"`
-		AssetName  string        `json:"asset_name"`
-		PartyName  string        `json:"party_name"`
-		ItemIdx    int           `json:"item_idx"`
-		ColumnName string        `json:"column"`
-	}
-	res := make(map[string][]interface{})
-	indexArray := make([]rowInfo, 0, len(rows))
-	for _, row := range rows {
-		rowInfoMap := make(map[string]rowInfo)
-		err := json.Unmarshal([]byte(row), &rowInfoMap)
-		if err != nil {
-			return nil, nil, fmt.Errorf("error unmarshalling err: %v, row: %s", err, row)
-		}
-
-		for k, v := range rowInfoMap {
-			if v.PartyName == "" || v.AssetName == "" {
-				res[k] = v.Value
-				indexArray = append(indexArray, rowInfo{ColumnName: k, ItemIdx: v.ItemIdx})
-			} else {
-				res[v.PartyName+"."+v.AssetName+"."+k] = v.Value
-				indexArray = append(indexArray, rowInfo{
-					ColumnName: v.PartyName + "." + v.AssetName + "." + k,
-					ItemIdx:    v.ItemIdx,
-				})
-			}
-		}
-	}
-
-	sort.Slice(indexArray, func(i, j int) bool {
-		return indexArray[i].ItemIdx < indexArray[j].ItemIdx
-	})
-
-	columns := make([]string, 0, len(indexArray))
-	for _, v := range indexArray {
-		columns = append(columns, v.ColumnName)
-	}
-
-	return res, columns, nil
+	return utils.EncodeResultContent(result), nil
 }
 
 // deleteFile 删除已经保存的文件，防止占用太多存储空间
@@ -417,14 +268,3 @@ func (r *GetResultReq) deleteFile(filePath string) error {
 	}
 	return nil
 }
-
-// 结果为空时也应该有表头之类的数据， 但各个执行引擎实现可能不一致， 这里对空结果做兼容
-func checkResultIsEmpty(result string) bool {
-	return strings.TrimSpace(result) == "" ||
-		strings.TrimSpace(result) == "[]" ||
-		strings.TrimSpace(result) == "{}"
-}
-
-func encodeContent(content string) string {
-	return base64.StdEncoding.EncodeToString([]byte(content))
-}
@@ -0,0 +1,130 @@
+package routes
+
+import (
+	"data-service/common"
+	"data-service/log"
+	"data-service/service"
+	"data-service/utils"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// 结果流接口的响应头，行数和下一页偏移在数据写完后以 trailer 返回
+const (
+	resultOffsetHeader      = "X-Result-Offset"
+	resultRowCountTrailer   = "X-Result-Row-Count"
+	resultNextOffsetTrailer = "X-Result-Next-Offset"
+	resultErrorTrailer      = "X-Result-Error"
+)
+
+// openJobResult 打开计算结果，测试时替换
+var openJobResult = service.OpenJobResult
+
+// jobResultStreamHandler GET /api/v1/job/result 流式返回参与方的计算结果
+// 查询参数 chainInfoId、jobInstanceId、partyId、dataId 必填；isEncrypted、pubKey 用于边读边解密；
+// offset、limit 按行分页，limit 为 0 时返回全部。输出格式由 format 参数或 Accept 头决定：
+// CSV、JSON Lines、Parquet、Arrow IPC 流
+func jobResultStreamHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	params := r.URL.Query()
+	query := &service.JobResultQuery{
+		ChainInfoId:   params.Get("chainInfoId"),
+		JobInstanceId: params.Get("jobInstanceId"),
+		PartyId:       params.Get("partyId"),
+		DataId:        params.Get("dataId"),
+		PubKey:        params.Get("pubKey"),
+	}
+	if query.ChainInfoId == "" || query.JobInstanceId == "" || query.PartyId == "" || query.DataId == "" {
+		writeJSONError(w, http.StatusBadRequest, "chainInfoId, jobInstanceId, partyId and dataId are required")
+		return
+	}
+	if v := params.Get("isEncrypted"); v != "" {
+		encrypted, err := strconv.ParseBool(v)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "invalid isEncrypted: "+v)
+			return
+		}
+		query.IsEncrypted = encrypted
+	}
+	offset, err := parseNonNegative(params.Get("offset"))
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid offset: "+err.Error())
+		return
+	}
+	limit, err := parseNonNegative(params.Get("limit"))
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid limit: "+err.Error())
+		return
+	}
+
+	var format common.FileFormat
+	if v := params.Get("format"); v != "" {
+		format = common.FileFormat(v)
+		if format.ResultContentType() == "" {
+			writeJSONError(w, http.StatusNotAcceptable, "unsupported result format: "+v)
+			return
+		}
+	} else if format, err = common.NegotiateResultFormat(r.Header.Get("Accept")); err != nil {
+		writeJSONError(w, http.StatusNotAcceptable, err.Error())
+		return
+	}
+
+	rows, err := openJobResult(query)
+	if err != nil {
+		log.Logger.Errorf("Failed to open job result %s/%s: %v", query.JobInstanceId, query.PartyId, err)
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer rows.Close()
+
+	writer, err := utils.NewResultRowWriter(format, w)
+	if err != nil {
+		writeJSONError(w, http.StatusNotAcceptable, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ResultContentType())
+	w.Header().Set(resultOffsetHeader, strconv.FormatInt(offset, 10))
+	w.Header().Set("Trailer", resultRowCountTrailer+", "+resultNextOffsetTrailer+", "+resultErrorTrailer)
+	w.WriteHeader(http.StatusOK)
+
+	written, hasMore, err := service.CopyJobResultRows(rows, writer, offset, limit)
+	w.Header().Set(resultRowCountTrailer, strconv.FormatInt(written, 10))
+	if hasMore {
+		w.Header().Set(resultNextOffsetTrailer, strconv.FormatInt(offset+written, 10))
+	}
+	if err != nil {
+		// 已开始输出，只能通过 trailer 返回错误
+		log.Logger.Errorf("Failed to stream job result %s/%s: %v", query.JobInstanceId, query.PartyId, err)
+		w.Header().Set(resultErrorTrailer, strings.Join(strings.Fields(err.Error()), " "))
+	}
+}
+
+func parseNonNegative(v string) (int64, error) {
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, strconv.ErrRange
+	}
+	return n, nil
+}
+
+func writeJSONError(w http.ResponseWriter, code int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": false,
+		"message": msg,
+	})
+}
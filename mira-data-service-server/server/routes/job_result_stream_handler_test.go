@@ -0,0 +1,82 @@
+package routes
+
+import (
+	"data-service/service"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testResultQuery = "/api/v1/job/result?chainInfoId=c1&jobInstanceId=j1&partyId=p1&dataId=d1"
+
+func stubOpenJobResult(t *testing.T, content string) *service.JobResultQuery {
+	var opened service.JobResultQuery
+	original := openJobResult
+	openJobResult = func(query *service.JobResultQuery) (*service.JobResultRows, error) {
+		opened = *query
+		return service.NewJobResultRows(io.NopCloser(strings.NewReader(content)))
+	}
+	t.Cleanup(func() { openJobResult = original })
+	return &opened
+}
+
+func serveJobResult(url, accept string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	rr := httptest.NewRecorder()
+	jobResultStreamHandler(rr, req)
+	return rr
+}
+
+func TestJobResultStreamHandler_CSVPage(t *testing.T) {
+	setup()
+	opened := stubOpenJobResult(t, "id,name\n1,a\n2,b\n3,c\n")
+
+	rr := serveJobResult(testResultQuery+"&isEncrypted=true&pubKey=k&offset=1&limit=1", "")
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "text/csv", rr.Header().Get("Content-Type"))
+	assert.Equal(t, "id,name\n2,b\n", rr.Body.String())
+	assert.Equal(t, "1", rr.Header().Get(resultOffsetHeader))
+	assert.Equal(t, "1", rr.Header().Get(resultRowCountTrailer))
+	assert.Equal(t, "2", rr.Header().Get(resultNextOffsetTrailer))
+	assert.True(t, opened.IsEncrypted)
+	assert.Equal(t, "k", opened.PubKey)
+}
+
+func TestJobResultStreamHandler_AcceptJSONLines(t *testing.T) {
+	setup()
+	stubOpenJobResult(t, `[{"id":"1"},{"id":"2"}]`)
+
+	rr := serveJobResult(testResultQuery, "application/x-ndjson")
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/x-ndjson", rr.Header().Get("Content-Type"))
+	assert.Equal(t, "{\"id\":\"1\"}\n{\"id\":\"2\"}\n", rr.Body.String())
+	assert.Equal(t, "2", rr.Header().Get(resultRowCountTrailer))
+	assert.Empty(t, rr.Header().Get(resultNextOffsetTrailer))
+}
+
+func TestJobResultStreamHandler_Errors(t *testing.T) {
+	setup()
+	stubOpenJobResult(t, "id\n1\n")
+
+	assert.Equal(t, http.StatusBadRequest, serveJobResult("/api/v1/job/result?jobInstanceId=j1", "").Code)
+	assert.Equal(t, http.StatusBadRequest, serveJobResult(testResultQuery+"&offset=-1", "").Code)
+	assert.Equal(t, http.StatusNotAcceptable, serveJobResult(testResultQuery, "application/xml").Code)
+	assert.Equal(t, http.StatusNotAcceptable, serveJobResult(testResultQuery+"&format=xml", "").Code)
+
+	openJobResult = func(*service.JobResultQuery) (*service.JobResultRows, error) {
+		return nil, errors.New("object not found")
+	}
+	rr := serveJobResult(testResultQuery, "")
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Contains(t, rr.Body.String(), "object not found")
+}
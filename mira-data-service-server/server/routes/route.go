@@ -12,20 +12,20 @@ package routes
 import (
 	pb "data-service/generated/datasource"
 	"data-service/log"
+	"data-service/service"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 )
 
-// 定义一个全局通道用于传递作业完成信息
-var MinioUrlChan = make(chan struct {
-	Data   map[string]interface{}
-	Status pb.JobStatus
-	Error  error
-})
+// jobIdHeader 回调请求头中的作业ID，也可通过查询参数 jobId 或请求体中的 jobId/podName 指定
+const jobIdHeader = "X-Mira-Job-Id"
 
-// jobCompletionHandler 处理来自客户端的作业完成请求
+// jobCallbacks 作业回调分发器，测试时替换
+var jobCallbacks = service.GetJobCallbackHub
+
+// jobCompletionHandler 处理 Spark 作业的完成回调，按作业ID持久化并通知等待该作业的调用方
 func jobCompletionHandler(w http.ResponseWriter, r *http.Request) {
 	log.Logger.Infof("Received request at /api/job/completed with method %s", r.Method)
 
@@ -46,16 +46,24 @@ func jobCompletionHandler(w http.ResponseWriter, r *http.Request) {
 		var data map[string]interface{}
 		if err := json.Unmarshal(body, &data); err != nil {
 			log.Logger.Errorf("Error parsing JSON data: %v", err)
-			sendErrorToChannel(fmt.Sprintf("Error parsing JSON data: %v", err))
+			publishFailedCallback(r, nil, fmt.Sprintf("Error parsing JSON data: %v", err))
 			http.Error(w, "Error parsing JSON data", http.StatusBadRequest)
 			return
 		}
 
+		podName := callbackPodName(r, data)
+		if podName == "" {
+			log.Logger.Error("Missing job id in callback")
+			http.Error(w, "Missing 'jobId' or 'podName' parameter", http.StatusBadRequest)
+			return
+		}
+		jobId := service.JobIdFromPodName(podName)
+
 		// 检查必要字段
 		statusStr, statusOk := data["status"].(string)
 		if !statusOk {
-			log.Logger.Error("Missing 'status' parameter")
-			sendErrorToChannel("Missing 'status' parameter")
+			log.Logger.Errorf("Missing 'status' parameter in callback of job %s", jobId)
+			publishFailedCallback(r, data, "Missing 'status' parameter")
 			http.Error(w, "Missing 'status' parameter", http.StatusBadRequest)
 			return
 		}
@@ -63,8 +71,8 @@ func jobCompletionHandler(w http.ResponseWriter, r *http.Request) {
 
 		// 检查是否有错误信息
 		if errorMsg, hasError := data["error"].(string); hasError {
-			log.Logger.Errorf("Job completed with error: %s", errorMsg)
-			sendErrorToChannel(errorMsg)
+			log.Logger.Errorf("Job %s completed with error: %s", jobId, errorMsg)
+			publishFailedCallback(r, data, errorMsg)
 			http.Error(w, errorMsg, http.StatusInternalServerError)
 			return
 		}
@@ -78,18 +86,11 @@ func jobCompletionHandler(w http.ResponseWriter, r *http.Request) {
 			}
 		}
 
-		// 发送信息到通道
-		go func(dynamicData map[string]interface{}, status pb.JobStatus) {
-			MinioUrlChan <- struct {
-				Data   map[string]interface{}
-				Status pb.JobStatus
-				Error  error
-			}{
-				Data:   dynamicData,
-				Status: status,
-				Error:  nil,
-			}
-		}(dynamicData, status)
+		if err := jobCallbacks().Publish(service.NewJobCallback(jobId, status, dynamicData, ""), podName); err != nil {
+			log.Logger.Errorf("Failed to publish callback of job %s: %v", jobId, err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
 
 		// 返回成功响应
 		response := map[string]interface{}{
@@ -97,6 +98,7 @@ func jobCompletionHandler(w http.ResponseWriter, r *http.Request) {
 			"message": "Job completed successfully",
 			"data":    dynamicData,
 			"status":  statusStr,
+			"jobId":   jobId,
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -108,23 +110,39 @@ func jobCompletionHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// RegisterRoutes 注册所有的 HTTP 路由
+// RegisterRoutes 注册所有的 HTTP 路由，注册在默认路由上，只由本机监听的内部端口提供，不对外暴露
 func RegisterRoutes() {
 	http.HandleFunc("/api/job/completed", jobCompletionHandler)
+	http.HandleFunc("/api/v1/job/result", jobResultStreamHandler)
 }
 
-// sendErrorToChannel 将错误信息发送到 MinioUrlChan 通道
-func sendErrorToChannel(errMsg string) {
-	go func() {
-		MinioUrlChan <- struct {
-			Data   map[string]interface{}
-			Status pb.JobStatus
-			Error  error
-		}{
-			Status: pb.JobStatus_JOB_STATUS_FAILED,
-			Error:  fmt.Errorf(errMsg),
+// callbackPodName 回调对应的 Pod 名称，依次取请求头、查询参数、请求体中的 jobId 和 podName
+func callbackPodName(r *http.Request, data map[string]interface{}) string {
+	if v := r.Header.Get(jobIdHeader); v != "" {
+		return v
+	}
+	if v := r.URL.Query().Get("jobId"); v != "" {
+		return v
+	}
+	for _, key := range []string{"jobId", "podName"} {
+		if v, ok := data[key].(string); ok && v != "" {
+			return v
 		}
-	}()
+	}
+	return ""
+}
+
+// publishFailedCallback 记录失败回调，无法确定作业ID时只记录日志
+func publishFailedCallback(r *http.Request, data map[string]interface{}, errMsg string) {
+	podName := callbackPodName(r, data)
+	if podName == "" {
+		log.Logger.Warnf("Drop failed callback without job id: %s", errMsg)
+		return
+	}
+	callback := service.NewJobCallback(service.JobIdFromPodName(podName), pb.JobStatus_JOB_STATUS_FAILED, nil, errMsg)
+	if err := jobCallbacks().Publish(callback, podName); err != nil {
+		log.Logger.Errorf("Failed to publish callback of job %s: %v", callback.JobId, err)
+	}
 }
 
 // 字符串转 JobStatus 枚举
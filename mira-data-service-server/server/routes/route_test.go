@@ -10,12 +10,15 @@
 package routes
 
 import (
+	"context"
 	"data-service/log"
+	"data-service/service"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -33,10 +36,21 @@ func setup() {
 	log.InitLogger()
 }
 
+// useTestCallbackHub 替换为内存回调分发器
+func useTestCallbackHub(t *testing.T) *service.JobCallbackHub {
+	hub := service.NewJobCallbackHub(nil)
+	original := jobCallbacks
+	jobCallbacks = func() *service.JobCallbackHub { return hub }
+	t.Cleanup(func() { jobCallbacks = original })
+	return hub
+}
+
 func TestJobCompletionHandler_PostRequest_ValidJson_Success(t *testing.T) {
 	setup()
+	hub := useTestCallbackHub(t)
 	// 构造有效的 JSON 请求体
 	body := `{
+		"podName": "spark-job-1",
 		"dbName": "test_db",
 		"tableName": "test_table",
 		"status": "succeeded",
@@ -49,16 +63,22 @@ func TestJobCompletionHandler_PostRequest_ValidJson_Success(t *testing.T) {
 
 	rr := httptest.NewRecorder()
 
-	// 启动 goroutine 监听 MinioUrlChan，断言内容
+	// 等待该作业的回调
 	done := make(chan struct{})
+	waiting := make(chan struct{})
 	go func() {
-		result := <-MinioUrlChan
-		assert.Equal(t, "test_db", result.Data["dbName"])
-		assert.Equal(t, "test_table", result.Data["tableName"])
+		close(waiting)
+		result, err := hub.Wait(context.Background(), "spark-job-1", time.Time{}, time.Second)
+		assert.NoError(t, err)
+		var data map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(result.Data), &data))
+		assert.Equal(t, "test_db", data["dbName"])
+		assert.Equal(t, "test_table", data["tableName"])
 		assert.Equal(t, pb.JobStatus_JOB_STATUS_SUCCEEDED, result.Status)
-		assert.Nil(t, result.Error)
+		assert.Empty(t, result.Error)
 		close(done)
 	}()
+	<-waiting
 
 	// 调用 handler
 	jobCompletionHandler(rr, req)
@@ -71,7 +91,38 @@ func TestJobCompletionHandler_PostRequest_ValidJson_Success(t *testing.T) {
 	assert.Equal(t, true, response["success"])
 	assert.Equal(t, "Job completed successfully", response["message"])
 	assert.Equal(t, "succeeded", response["status"])
+	assert.Equal(t, "spark-job-1", response["jobId"])
 
 	// 等待 goroutine 校验完成
 	<-done
 }
+
+func TestJobCompletionHandler_CorrelatesRetryPod(t *testing.T) {
+	setup()
+	hub := useTestCallbackHub(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/job/completed?jobId=spark-job-2-retry-1",
+		strings.NewReader(`{"status": "failed", "error": "executor lost"}`))
+	rr := httptest.NewRecorder()
+	jobCompletionHandler(rr, req)
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+
+	callback, err := hub.Latest("spark-job-2")
+	assert.NoError(t, err)
+	if assert.NotNil(t, callback) {
+		assert.Equal(t, pb.JobStatus_JOB_STATUS_FAILED, callback.Status)
+		assert.Equal(t, "executor lost", callback.Error)
+	}
+	other, _ := hub.Latest("spark-job-1")
+	assert.Nil(t, other)
+}
+
+func TestJobCompletionHandler_MissingJobId(t *testing.T) {
+	setup()
+	useTestCallbackHub(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/job/completed", strings.NewReader(`{"status": "succeeded"}`))
+	rr := httptest.NewRecorder()
+	jobCompletionHandler(rr, req)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
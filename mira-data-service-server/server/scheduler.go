@@ -2,6 +2,7 @@ package main
 
 import (
 	"data-service/config"
+	"data-service/service"
 )
 
 // Schedule 启动所有定时任务
@@ -10,6 +11,18 @@ func Schedule(conf *config.DataServiceConf) {
 	tempManager := GetManager()
 	go tempManager.StartCleanupTask()
 
+	// 登记本副本运行中的作业，供其他副本的孤儿巡检跳过
+	service.StartActiveJobRegistry()
+
+	// 启动清理任务后台重试
+	service.StartCleanupWorker(conf.CleanupWorker)
+
+	// 启动孤儿资源巡检
+	service.StartOrphanReconciler(conf.OrphanReconciler)
+
+	// 启动表生命周期调度，删除过期的临时表和库
+	service.StartTableTTLScheduler(conf.TableTTL, conf.CleanupConfig.RetentionDays)
+
 	// 启动 Spark Pod 清理任务
 	// go utils.StartPodCleanupTask(conf.SparkPodConfig.Namespace, time.Duration(conf.SparkPodConfig.CleanInterval)*time.Hour)
 
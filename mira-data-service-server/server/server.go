@@ -6,12 +6,14 @@ import (
 	"data-service/common"
 	"data-service/config"
 	"data-service/database"
+	"data-service/database/gorm/models"
 	pb "data-service/generated/datasource"
 	log2 "data-service/log"
 	"data-service/oss"
 	"data-service/server/routes"
 	"data-service/service"
 	"data-service/utils"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -161,6 +163,7 @@ func (s Server) WriteInternalData(g grpc.ClientStreamingServer[pb.WriterInternal
 
 		// 创建表操作并提交到队列
 		op := &database.TableOperation{
+			Ctx:        g.Context(),
 			TableName:  tableName,
 			Schema:     schema,
 			DbType:     dbType,
@@ -185,6 +188,8 @@ func (s Server) WriteInternalData(g grpc.ClientStreamingServer[pb.WriterInternal
 }
 
 func (s Server) ReadInternalData(request *pb.InternalReadRequest, g grpc.ServerStreamingServer[pb.ArrowResponse]) error {
+	g, finishMasking := service.WrapArrowStreamWithMasking("ReadInternalData", request.TableName, g)
+	defer finishMasking()
 	conf := config.GetConfigMap()
 	dbType := utils.ConvertDBType(conf.Dbms.Type)
 	connInfo := &pb.ConnectionInfo{Host: conf.Dbms.Host,
@@ -202,8 +207,12 @@ func (s Server) ReadInternalData(request *pb.InternalReadRequest, g grpc.ServerS
 	if request.JobInstanceId != "" {
 		tableName = request.JobInstanceId + "_" + tableName
 	}
+	window, err := database.NewReadWindow(request.Limit, request.Offset, request.Sample)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid read window: %v", err)
+	}
 	query, args, err := dbStrategy.BuildWithConditionQuery(tableName, request.DbFields,
-		request.FilterNames, request.FilterOperators, request.FilterValues, request.SortRules)
+		request.FilterNames, request.FilterOperators, request.FilterValues, request.SortRules, window)
 	if err != nil {
 		return fmt.Errorf("error building query: %v", err)
 	}
@@ -314,7 +323,7 @@ func (s Server) ReadStreamingData(request *pb.StreamReadRequest, g grpc.ServerSt
 		s.logger.Errorf("failed to get table info: %v", err)
 		return fmt.Errorf("failed to get table info: %v", err)
 	}
-	expectedTotal := int64(tableInfo.RecordCount)
+	expectedTotal := tableInfo.GetRecordCount64()
 
 	// 获取要连接的数据库信息
 	connInfo, err := utils.GetDatasourceByAssetName(request.GetRequestId(), request.AssetName,
@@ -338,7 +347,7 @@ func (s Server) ReadStreamingData(request *pb.StreamReadRequest, g grpc.ServerSt
 		return fmt.Errorf("faild to connect to database: %v", err)
 	}
 	query, args, err := dbStrategy.BuildWithConditionQuery(connInfo.TableName, request.DbFields,
-		request.FilterNames, request.FilterOperators, request.FilterValues, request.SortRules)
+		request.FilterNames, request.FilterOperators, request.FilterValues, request.SortRules, nil)
 	if err != nil {
 		return fmt.Errorf("error building query: %v", err)
 	}
@@ -783,9 +792,14 @@ func (s Server) PushJobResultToExternalDB(ctx context.Context, request *pb.PushJ
 	log2.Logger.Infof("  PartyId: %s", request.PartyId)
 	log2.Logger.Infof("  DataId: %s", request.DataId)
 	log2.Logger.Infof("  IsEncrypted: %t", request.IsEncrypted)
+	log2.Logger.Infof("  SinkType: %s", request.GetSink().GetType())
 	return service.NewJobResultService().PushJobResultToExternalDB(ctx, request)
 }
 
+func (s Server) GetResultDeliveryStatus(ctx context.Context, request *pb.GetResultDeliveryStatusRequest) (*pb.GetResultDeliveryStatusResponse, error) {
+	return service.NewJobResultService().GetResultDeliveryStatus(ctx, request)
+}
+
 func (s Server) ExecuteDorisSQL(ctx context.Context, request *pb.ExecuteDorisSQLRequest) (*pb.ExecuteDorisSQLResponse, error) {
 	dorisService, err := service.NewDorisService(common.MIRA_TMP_TASK_DB)
 	if err != nil {
@@ -849,13 +863,15 @@ func (s Server) ImportCsvFileToDoris(ctx context.Context, request *pb.ImportCsvF
 	if err != nil {
 		return nil, fmt.Errorf("failed to create doris service: %v", err)
 	}
-	err = dorisService.ImportCsvFileToDoris(request)
+	rejects, err := dorisService.ImportCsvFileToDoris(request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to import csv file to doris: %v", err)
 	}
 	return &pb.Response{
-		Success: true,
-		Message: "CSV file imported to Doris successfully",
+		Success:       true,
+		Message:       "CSV file imported to Doris successfully",
+		RejectedRows:  rejects.Rows,
+		RejectsObject: rejects.Object,
 	}, nil
 }
 
@@ -864,13 +880,23 @@ func (s Server) ExportCsvFileFromDoris(ctx context.Context, request *pb.ExportCs
 	if err != nil {
 		return nil, fmt.Errorf("failed to create doris service: %v", err)
 	}
-	err = dorisService.ExportParquetFileFromDoris(request)
+	// 指定外部导出目标时导出到合作方对象存储
+	if request.Target != nil {
+		return service.NewExternalExportService(dorisService).Export(request)
+	}
+	manifest, err := dorisService.ExportFileFromDoris(request)
 	if err != nil {
-		return nil, fmt.Errorf("failed to export parquet file from doris: %v", err)
+		return nil, fmt.Errorf("failed to export file from doris: %v", err)
+	}
+	files := make([]*pb.ExportedFile, 0, len(manifest.Parts))
+	for _, part := range manifest.Parts {
+		files = append(files, &pb.ExportedFile{ObjectName: part})
 	}
 	return &pb.ExportCsvFileFromDorisResponse{
-		BucketName:    common.BATCH_DATA_BUCKET_NAME,
+		BucketName:    manifest.Bucket,
 		JobInstanceId: request.JobInstanceId,
+		FileFormat:    string(manifest.Format),
+		Files:         files,
 	}, nil
 }
 
@@ -928,7 +954,18 @@ func (s Server) GetRetryCleanupTask(ctx context.Context, request *pb.GetRetryCle
 		pageSize = 10
 	}
 
-	tasks, totalCount, err := cleanupService.GetRetryCleanupTasks(page, pageSize)
+	var tasks []models.CleanupTask
+	var totalCount int64
+	var err error
+	if request.Status != "" {
+		taskStatus := common.TaskStatus(request.Status)
+		if !taskStatus.IsValid() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cleanup task status: %s", request.Status)
+		}
+		tasks, totalCount, err = cleanupService.GetCleanupTasksByStatus(taskStatus, page, pageSize)
+	} else {
+		tasks, totalCount, err = cleanupService.GetRetryCleanupTasks(page, pageSize)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get retry cleanup tasks: %v", err)
 	}
@@ -940,6 +977,12 @@ func (s Server) GetRetryCleanupTask(ctx context.Context, request *pb.GetRetryCle
 			Id:            uint32(task.ID),
 			JobInstanceId: task.JobInstanceID,
 			Status:        string(task.Status),
+			RetryCount:    int32(task.RetryCount),
+			MaxRetries:    int32(task.MaxRetries),
+			ErrorMessage:  task.ErrorMessage,
+		}
+		if task.NextRetryAt != nil {
+			taskInfo.NextRetryAt = task.NextRetryAt.UnixMilli()
 		}
 		taskInfos = append(taskInfos, taskInfo)
 	}
@@ -952,6 +995,96 @@ func (s Server) GetRetryCleanupTask(ctx context.Context, request *pb.GetRetryCle
 	}, nil
 }
 
+func (s Server) RequeueCleanupTask(ctx context.Context, request *pb.RequeueCleanupTaskRequest) (*pb.RequeueCleanupTaskResponse, error) {
+	cleanupService := service.NewCleanupTaskService()
+	count, err := cleanupService.RequeueDeadLetterTasks(request.JobInstanceIds)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RequeueCleanupTaskResponse{RequeuedCount: count}, nil
+}
+
+func (s Server) GetOrphanReport(ctx context.Context, request *pb.GetOrphanReportRequest) (*pb.GetOrphanReportResponse, error) {
+	if request.GracePeriodSeconds < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grace_period_seconds: %d", request.GracePeriodSeconds)
+	}
+	reconciler, err := service.NewOrphanReconciler(config.GetConfigMap().OrphanReconciler)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create orphan reconciler: %v", err)
+	}
+	grace := time.Duration(request.GracePeriodSeconds) * time.Second
+	if grace == 0 {
+		grace = reconciler.GracePeriod()
+	}
+	orphans, err := reconciler.Report(grace, request.Kinds)
+	if err != nil {
+		s.logger.Errorf("Failed to build orphan report: %v", err)
+		return nil, err
+	}
+
+	response := &pb.GetOrphanReportResponse{
+		Resources:          make([]*pb.OrphanResourceInfo, 0, len(orphans)),
+		GracePeriodSeconds: int64(grace / time.Second),
+		DryRun:             reconciler.DryRun(),
+		GeneratedAt:        time.Now().UnixMilli(),
+	}
+	for _, orphan := range orphans {
+		response.Resources = append(response.Resources, &pb.OrphanResourceInfo{
+			Kind:          orphan.Kind,
+			Name:          orphan.Name,
+			Location:      orphan.Location,
+			JobInstanceId: orphan.JobInstanceId,
+			FirstSeenAt:   orphan.FirstSeenAt.UnixMilli(),
+			AgeSeconds:    int64(orphan.Age / time.Second),
+		})
+	}
+	return response, nil
+}
+
+func (s Server) UpdateTableTTL(ctx context.Context, request *pb.UpdateTableTTLRequest) (*pb.UpdateTableTTLResponse, error) {
+	if request.DbName == "" {
+		return nil, status.Error(codes.InvalidArgument, "db_name is required")
+	}
+	var expiresAt time.Time
+	switch {
+	case request.TtlSeconds > 0:
+		expiresAt = time.Now().Add(time.Duration(request.TtlSeconds) * time.Second)
+	case request.TtlSeconds == 0 && request.ExpiresAt > 0:
+		expiresAt = time.UnixMilli(request.ExpiresAt)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ttl_seconds %d or expires_at %d", request.TtlSeconds, request.ExpiresAt)
+	}
+
+	err := service.UpdateTableTTL(request.DbName, request.TableName, expiresAt)
+	switch {
+	case errors.Is(err, service.ErrTableTTLNotFound):
+		return nil, status.Errorf(codes.NotFound, "no ttl registered for %s.%s", request.DbName, request.TableName)
+	case errors.Is(err, service.ErrTableTTLDisabled):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		s.logger.Errorf("Failed to update ttl of %s.%s: %v", request.DbName, request.TableName, err)
+		return nil, err
+	}
+	return &pb.UpdateTableTTLResponse{ExpiresAt: expiresAt.UnixMilli()}, nil
+}
+
+func (s Server) QueryAuditLog(ctx context.Context, request *pb.QueryAuditLogRequest) (*pb.QueryAuditLogResponse, error) {
+	auditService := service.GetAuditService()
+	if auditService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "audit log is not enabled")
+	}
+	return auditService.Query(request)
+}
+
+func (s Server) ProfileTable(ctx context.Context, request *pb.ProfileTableRequest) (*pb.ProfileTableResponse, error) {
+	response, err := service.NewTableProfileService().Profile(request)
+	if err != nil {
+		s.logger.Errorf("Failed to profile table: %v", err)
+		return nil, err
+	}
+	return response, nil
+}
+
 func (s Server) mustEmbedUnimplementedDataSourceServiceServer() {
 	//TODO implement me
 	panic("implement me")
@@ -965,7 +1098,21 @@ func (s Server) GetJobStatus(ctx context.Context, request *pb.JobStatusRequest)
 	return s.k8sService.GetJobStatus(ctx, request)
 }
 
+func (s Server) CancelBatchJob(ctx context.Context, request *pb.CancelBatchJobRequest) (*pb.BatchResponse, error) {
+	return s.k8sService.CancelBatchJob(ctx, request)
+}
+
+func (s Server) GetBatchJobLogs(request *pb.GetBatchJobLogsRequest, stream pb.DataSourceService_GetBatchJobLogsServer) error {
+	return s.k8sService.GetBatchJobLogs(request, stream)
+}
+
+func (s Server) ListBatchJobHistory(ctx context.Context, request *pb.ListBatchJobHistoryRequest) (*pb.ListBatchJobHistoryResponse, error) {
+	return s.k8sService.ListBatchJobHistory(ctx, request)
+}
+
 func (s Server) ReadDataSourceStreaming(request *pb.ReadDataSourceStreamingRequest, g grpc.ServerStreamingServer[pb.ArrowResponse]) error {
+	g, finishMasking := service.WrapArrowStreamWithMasking("ReadDataSourceStreaming", service.MaskingAssetOf(request), g)
+	defer finishMasking()
 	dorisService, err := service.NewDorisService(common.MIRA_TMP_TASK_DB)
 	if err != nil {
 		return fmt.Errorf("failed to create doris service: %v", err)
@@ -977,19 +1124,22 @@ func (s Server) ReadDataSourceStreaming(request *pb.ReadDataSourceStreamingReque
 		return fmt.Errorf("failed to generate random suffix: %v", err)
 	}
 	enhancedJobInstanceId := request.JobInstanceId + "_" + randomSuffix
+	defer service.TrackActiveJob(enhancedJobInstanceId)()
+	// 作业库在清理任务执行前到期时由生命周期调度删除
+	service.RegisterTableTTL(enhancedJobInstanceId, "", request.JobInstanceId)
 	// 1.从数据源拉取数据到doris并导出到minio
-	tableName, err = dorisService.ProcessDataSourceAndExport(request, enhancedJobInstanceId)
+	tableName, manifest, err := dorisService.ProcessDataSourceAndExport(request, enhancedJobInstanceId)
 	if err != nil {
 		return fmt.Errorf("failed to process data source and export: %v", err)
 	}
 
-	// 2.执行导出parquet文件到minio
-	// 3.从minio流式读取arrow文件
+	// 2.执行导出文件到minio（格式由请求指定，默认parquet）
+	// 3.从minio按导出清单流式读取arrow批次
 	chunkService := service.NewChunkService(s.ossClient)
-	parquetStreamingService := service.NewParquetStreamingService(chunkService, s.ossClient)
+	exportStreamingService := service.NewExportStreamingService(chunkService, s.ossClient, dorisService)
 
-	if err := parquetStreamingService.StreamParquetFileFromOSS(tableName, enhancedJobInstanceId, request.Columns, g); err != nil {
-		return fmt.Errorf("failed to stream parquet file from OSS: %v", err)
+	if err := exportStreamingService.StreamExportFromOSS(manifest, tableName, request.Columns, g); err != nil {
+		return fmt.Errorf("failed to stream %s file from OSS: %v", manifest.Format, err)
 	}
 
 	// 清理导出文件
@@ -1012,12 +1162,22 @@ func (s Server) ReadDataSourceStreaming(request *pb.ReadDataSourceStreamingReque
 }
 
 func (s Server) ExecuteSql(request *pb.ExecuteSqlRequest, g grpc.ServerStreamingServer[pb.ExecuteSqlResponse]) error {
+	g, finishMasking, err := service.WrapSqlStreamWithMasking("ExecuteSql", request.DbName, request.Sql, request.TargetTableName != "", g)
+	if err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	defer finishMasking()
 	// 创建SQL执行服务
 	sqlService, err := service.NewSqlExecutionService(request.DbName)
 	if err != nil {
 		return fmt.Errorf("failed to create sql execution service: %v", err)
 	}
 
+	// 试运行只返回执行计划
+	if request.DryRun {
+		return sqlService.DryRunSql(request.Sql, request.TargetTableName, g)
+	}
+
 	// 如果有目标表名，将查询结果写入到目标表
 	if request.TargetTableName != "" {
 		return sqlService.ExecuteSqlWithTableOutput(request.Sql, request.TargetTableName, g)
@@ -1029,6 +1189,8 @@ func (s Server) ExecuteSql(request *pb.ExecuteSqlRequest, g grpc.ServerStreaming
 }
 
 func (s Server) Read(request *pb.ReadRequest, g grpc.ServerStreamingServer[pb.ArrowResponse]) error {
+	g, finishMasking := service.WrapArrowStreamWithMasking("Read", service.MaskingAssetOf(request), g)
+	defer finishMasking()
 	readService, err := service.NewReadService(s.ossClient)
 	if err != nil {
 		g.SetTrailer(metadata.Pairs("x-error-code", strconv.Itoa(common.ErrCodeInternalError)))
@@ -1085,6 +1247,13 @@ func (s Server) ImportData(ctx context.Context, request *pb.ImportDataRequest) (
 	return response, err
 }
 
+func (s Server) ImportDataStream(request *pb.ImportDataRequest, stream pb.DataSourceService_ImportDataStreamServer) error {
+	start := time.Now()
+	err := service.NewImportService().ImportDataStream(request, stream)
+	ObserveDuration("ImportData", start, err == nil)
+	return err
+}
+
 func main() {
 	// 程序退出时关闭 IDA 服务
 	defer utils.CloseIDAService()
@@ -1108,9 +1277,12 @@ func main() {
 	}
 
 	// 创建 gRPC 服务器选项，设置消息大小限制
+	auditService := service.GetAuditService()
 	grpcOptions := []grpc.ServerOption{
 		grpc.MaxRecvMsgSize(common.GRPC_TRANSFER_SIZE),
 		grpc.MaxSendMsgSize(common.GRPC_TRANSFER_SIZE),
+		grpc.ChainUnaryInterceptor(AuditUnaryInterceptor(auditService)),
+		grpc.ChainStreamInterceptor(AuditStreamInterceptor(auditService)),
 	}
 
 	grpcServer := grpc.NewServer(grpcOptions...)
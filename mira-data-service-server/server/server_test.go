@@ -14,7 +14,7 @@ import (
 	"data-service/database"
 	pb "data-service/generated/datasource"
 	"data-service/log"
-	"data-service/server/routes"
+	"data-service/service"
 	"data-service/utils"
 	"testing"
 
@@ -167,22 +167,11 @@ func TestReadBatchData_Success(t *testing.T) {
 		return &v1.Pod{}, nil
 	})
 
-	// 模拟 Spark job 返回的 Minio URL（模拟成功返回数据）
-	go func() {
-		// 立即向 MinioUrlChan 发送数据来模拟成功响应
-		routes.MinioUrlChan <- struct {
-			Data   map[string]interface{}
-			Status pb.JobStatus
-			Error  error
-		}{
-			Data: map[string]interface{}{
-				"dbName":    "example-db",
-				"tableName": "example-table",
-			},
-			Status: pb.JobStatus_JOB_STATUS_SUCCEEDED,
-			Error:  nil,
-		}
-	}()
+	// 模拟 Spark job 的完成回调
+	service.GetJobCallbackHub().Publish(service.NewJobCallback("example-job", pb.JobStatus_JOB_STATUS_SUCCEEDED, map[string]interface{}{
+		"dbName":    "example-db",
+		"tableName": "example-table",
+	}, ""), "example-job")
 
 	resp, err := server.SubmitBatchJob(context.Background(), req)
 
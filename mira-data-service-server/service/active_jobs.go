@@ -0,0 +1,135 @@
+package service
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"data-service/database/gorm"
+	"data-service/database/gorm/repositories"
+	"data-service/log"
+)
+
+const (
+	activeJobTTL       = 2 * time.Minute
+	activeJobHeartbeat = 30 * time.Second
+)
+
+// activeJobs 本进程内正在执行的作业实例ID及引用计数，孤儿巡检据此跳过运行中作业的资源
+var (
+	activeJobsMu sync.Mutex
+	activeJobs   = map[string]int{}
+)
+
+// activeJobRegistry 在数据库中登记本副本运行中的作业，多副本部署时巡检据此跳过其他副本上的作业
+var activeJobRegistry struct {
+	sync.RWMutex
+	repo  *repositories.ActiveJobRepository
+	owner string
+}
+
+// StartActiveJobRegistry 启动运行中作业的数据库登记并定期续期，数据库未初始化时只在本进程内登记
+func StartActiveJobRegistry() {
+	db := gorm.GetGormDB()
+	if db == nil {
+		log.Logger.Warnf("Active job registry disabled: gorm database is not initialized")
+		return
+	}
+	activeJobRegistry.Lock()
+	activeJobRegistry.repo = repositories.NewActiveJobRepository(db)
+	activeJobRegistry.owner = cleanupLeaseOwner()
+	activeJobRegistry.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(activeJobHeartbeat)
+		defer ticker.Stop()
+		for {
+			renewActiveJobs()
+			<-ticker.C
+		}
+	}()
+}
+
+// registeredActiveJobs 返回数据库登记仓库和本副本标识，未启用时 repo 为 nil
+func registeredActiveJobs() (*repositories.ActiveJobRepository, string) {
+	activeJobRegistry.RLock()
+	defer activeJobRegistry.RUnlock()
+	return activeJobRegistry.repo, activeJobRegistry.owner
+}
+
+// renewActiveJobs 续期本副本全部运行中作业的登记，并删除已过期的登记
+func renewActiveJobs() {
+	repo, owner := registeredActiveJobs()
+	if repo == nil {
+		return
+	}
+	now := time.Now()
+	for _, id := range ActiveJobIds() {
+		if err := repo.Register(id, owner, now.Add(activeJobTTL)); err != nil {
+			log.Logger.Warnf("Failed to renew active job %s: %v", id, err)
+		}
+	}
+	if _, err := repo.DeleteExpired(now); err != nil {
+		log.Logger.Warnf("Failed to delete expired active jobs: %v", err)
+	}
+}
+
+// TrackActiveJob 登记运行中的作业，返回的函数在作业结束时调用
+func TrackActiveJob(jobInstanceId string) func() {
+	activeJobsMu.Lock()
+	activeJobs[jobInstanceId]++
+	first := activeJobs[jobInstanceId] == 1
+	activeJobsMu.Unlock()
+
+	repo, owner := registeredActiveJobs()
+	if first && repo != nil {
+		if err := repo.Register(jobInstanceId, owner, time.Now().Add(activeJobTTL)); err != nil {
+			log.Logger.Warnf("Failed to register active job %s: %v", jobInstanceId, err)
+		}
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			activeJobsMu.Lock()
+			last := activeJobs[jobInstanceId] <= 1
+			if last {
+				delete(activeJobs, jobInstanceId)
+			} else {
+				activeJobs[jobInstanceId]--
+			}
+			activeJobsMu.Unlock()
+
+			if last && repo != nil {
+				if err := repo.Unregister(jobInstanceId, owner); err != nil {
+					log.Logger.Warnf("Failed to unregister active job %s: %v", jobInstanceId, err)
+				}
+			}
+		})
+	}
+}
+
+// ActiveJobIds 返回本进程内运行中的作业实例ID
+func ActiveJobIds() []string {
+	activeJobsMu.Lock()
+	defer activeJobsMu.Unlock()
+	ids := make([]string, 0, len(activeJobs))
+	for id := range activeJobs {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// ClusterActiveJobIds 返回所有副本上运行中的作业实例ID，未启用数据库登记时只返回本进程内的作业
+func ClusterActiveJobIds() ([]string, error) {
+	ids := ActiveJobIds()
+	repo, _ := registeredActiveJobs()
+	if repo == nil {
+		return ids, nil
+	}
+	registered, err := repo.FindActiveJobInstanceIDs(time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load active jobs: %v", err)
+	}
+	return append(ids, registered...), nil
+}
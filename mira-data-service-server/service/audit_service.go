@@ -0,0 +1,330 @@
+package service
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"data-service/config"
+	"data-service/database/gorm"
+	"data-service/database/gorm/models"
+	"data-service/database/gorm/repositories"
+	pb "data-service/generated/datasource"
+	"data-service/log"
+	"data-service/utils"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	gormlib "gorm.io/gorm"
+)
+
+const auditStatementMaxLen = 2000
+
+// AuditTarget 从请求中提取的审计对象
+type AuditTarget struct {
+	Asset     string
+	Columns   []string
+	Filters   string
+	Statement string
+}
+
+// AuditService 记录数据访问与变更审计日志，写入数据库并可选写入 JSONL 文件
+type AuditService struct {
+	repo     *repositories.AuditLogRepository
+	filePath string
+	fileMu   sync.Mutex
+}
+
+var (
+	auditService     *AuditService
+	auditServiceOnce sync.Once
+)
+
+// GetAuditService 获取全局审计服务，未启用审计时返回 nil
+func GetAuditService() *AuditService {
+	auditServiceOnce.Do(func() {
+		conf := config.GetConfigMap().AuditConfig
+		if !conf.Enabled {
+			return
+		}
+		auditService = NewAuditService(gorm.GetGormDB(), conf.FilePath)
+	})
+	return auditService
+}
+
+// NewAuditService 创建审计服务，db 为空时只写文件
+func NewAuditService(db *gormlib.DB, filePath string) *AuditService {
+	s := &AuditService{filePath: filePath}
+	if db != nil {
+		s.repo = repositories.NewAuditLogRepository(db)
+	}
+	return s
+}
+
+// Record 追加一条审计记录，写入失败只记录日志，不影响业务调用
+func (s *AuditService) Record(entry *models.AuditLog) {
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+	if s.repo != nil {
+		if err := s.repo.Create(entry); err != nil {
+			log.Logger.Warnf("Failed to save audit log for rpc %s: %v", entry.Rpc, err)
+		}
+	}
+	if s.filePath != "" {
+		if err := s.appendToFile(entry); err != nil {
+			log.Logger.Warnf("Failed to write audit log file %s: %v", s.filePath, err)
+		}
+	}
+}
+
+func (s *AuditService) appendToFile(entry *models.AuditLog) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	s.fileMu.Lock()
+	defer s.fileMu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(s.filePath), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(s.filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0640)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+// Query 按时间范围、调用方、RPC 和资产分页查询审计记录
+func (s *AuditService) Query(request *pb.QueryAuditLogRequest) (*pb.QueryAuditLogResponse, error) {
+	if s.repo == nil {
+		return nil, fmt.Errorf("audit log store is not available")
+	}
+	page := int(request.Page)
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	filter := repositories.AuditLogFilter{
+		Caller: request.Caller,
+		Rpc:    request.Rpc,
+		Asset:  request.Asset,
+	}
+	if request.StartTime > 0 {
+		t := time.UnixMilli(request.StartTime)
+		filter.StartTime = &t
+	}
+	if request.EndTime > 0 {
+		t := time.UnixMilli(request.EndTime)
+		filter.EndTime = &t
+	}
+
+	logs, total, err := s.repo.FindWithPagination(filter, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit logs: %v", err)
+	}
+
+	records := make([]*pb.AuditLogRecord, 0, len(logs))
+	for _, l := range logs {
+		var columns []string
+		if l.Columns != "" {
+			columns = strings.Split(l.Columns, ",")
+		}
+		records = append(records, &pb.AuditLogRecord{
+			Id:             uint64(l.ID),
+			Timestamp:      l.CreatedAt.UnixMilli(),
+			Caller:         l.Caller,
+			CallerVerified: l.CallerVerified,
+			Peer:           l.Peer,
+			Rpc:            l.Rpc,
+			Asset:          l.Asset,
+			Columns:        columns,
+			Filters:        l.Filters,
+			Statement:      l.Statement,
+			RowsReturned:   l.RowsReturned,
+			Success:        l.Success,
+			ErrorMessage:   l.ErrorMessage,
+			DurationMs:     l.DurationMs,
+		})
+	}
+	return &pb.QueryAuditLogResponse{
+		Records:    records,
+		TotalCount: total,
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+	}, nil
+}
+
+// ExtractAuditTarget 从请求中提取资产、列、过滤条件和SQL
+func ExtractAuditTarget(request interface{}) AuditTarget {
+	switch r := request.(type) {
+	case *pb.ReadRequest:
+		return AuditTarget{Asset: MaskingAssetOf(r), Columns: r.Columns, Filters: formatFilterConditions(r.FilterConditions)}
+	case *pb.ReadDataSourceStreamingRequest:
+		return AuditTarget{Asset: MaskingAssetOf(r), Columns: r.Columns, Filters: formatFilterConditions(r.FilterConditions)}
+	case *pb.InternalReadRequest:
+		return AuditTarget{Asset: r.TableName, Columns: r.DbFields, Filters: formatFilterTriples(r.FilterNames, r.FilterOperators, r.FilterValues)}
+	case *pb.StreamReadRequest:
+		return AuditTarget{Asset: r.AssetName, Columns: r.DbFields, Filters: formatFilterTriples(r.FilterNames, r.FilterOperators, r.FilterValues)}
+	case *pb.ExecuteSqlRequest:
+		return AuditTarget{Asset: r.DbName, Statement: utils.PreviewSQL(r.Sql, auditStatementMaxLen)}
+	case *pb.ExecuteDorisSQLRequest:
+		return AuditTarget{Statement: utils.PreviewSQL(r.Sql, auditStatementMaxLen)}
+	case *pb.TruncateTableRequest:
+		return AuditTarget{Asset: r.TableName}
+	case *pb.CleanTmpDataRequest:
+		return AuditTarget{Asset: r.JobInstanceId}
+	case *pb.ExportCsvFileFromDorisRequest:
+		return AuditTarget{Asset: r.DbName + "." + r.TableName, Columns: r.Columns, Filters: formatFilterConditions(r.FilterConditions)}
+	case *pb.ImportCsvFileToDorisRequest:
+		return AuditTarget{Asset: r.TableName, Columns: r.Columns}
+	case *pb.WriterInternalDataRequest:
+		return AuditTarget{Asset: r.TableName}
+	case *pb.WriterExternalDataRequest:
+		return AuditTarget{Asset: r.AssetName}
+	case *pb.WriteRequest:
+		return AuditTarget{Asset: r.TableName}
+	case *pb.OSSReadRequest:
+		return AuditTarget{Asset: r.BucketName + "/" + r.ObjectName}
+	case *pb.PushJobResultRequest:
+		return AuditTarget{Asset: r.JobInstanceId}
+	case *pb.RequeueCleanupTaskRequest:
+		return AuditTarget{Asset: strings.Join(r.JobInstanceIds, ",")}
+	case *pb.CancelBatchJobRequest:
+		return AuditTarget{Asset: r.JobId}
+	case *pb.ProfileTableRequest:
+		if r.AssetName != "" {
+			return AuditTarget{Asset: r.AssetName, Columns: r.Columns}
+		}
+		return AuditTarget{Asset: r.DbName + "." + r.TableName, Columns: r.Columns}
+	case *pb.ImportDataRequest:
+		var assets []string
+		for _, t := range r.Targets {
+			if t.GetExternal() != nil {
+				assets = append(assets, t.GetExternal().AssetName)
+			}
+		}
+		return AuditTarget{Asset: strings.Join(assets, ",")}
+	default:
+		return AuditTarget{}
+	}
+}
+
+// CountReturnedRows 统计响应中返回的数据行数
+func CountReturnedRows(response interface{}) int64 {
+	switch r := response.(type) {
+	case *pb.ArrowResponse:
+		return countArrowRows(r.ArrowBatch)
+	case *pb.ExecuteSqlResponse:
+		return countArrowRows(r.GetArrowBatch())
+	case *pb.ExecuteDorisSQLResponse:
+		return int64(len(r.Rows))
+	default:
+		return 0
+	}
+}
+
+// arrowRecordBatchHeader Arrow IPC 消息头中 RecordBatch 的类型值
+const arrowRecordBatchHeader = 3
+
+// countArrowRows 只读取 Arrow IPC 流中每条消息的元数据累加 RecordBatch 行数，跳过消息体不解码数据
+func countArrowRows(data []byte) int64 {
+	if len(data) == 0 || string(data) == "EOF" {
+		return 0
+	}
+	var n int64
+	for pos := 0; pos+4 <= len(data); {
+		metaLen := int(int32(binary.LittleEndian.Uint32(data[pos:])))
+		pos += 4
+		if uint32(metaLen) == 0xFFFFFFFF {
+			if pos+4 > len(data) {
+				return n
+			}
+			metaLen = int(int32(binary.LittleEndian.Uint32(data[pos:])))
+			pos += 4
+		}
+		if metaLen <= 0 || pos+metaLen > len(data) {
+			return n
+		}
+		rows, bodyLen := parseArrowMessageHeader(data[pos : pos+metaLen])
+		pos += metaLen + int(bodyLen)
+		if bodyLen < 0 || pos > len(data) {
+			return n
+		}
+		n += rows
+	}
+	return n
+}
+
+// parseArrowMessageHeader 解析 flatbuffers 编码的 Message，返回 RecordBatch 的行数和消息体长度
+func parseArrowMessageHeader(meta []byte) (int64, int64) {
+	if len(meta) < 4 {
+		return 0, 0
+	}
+	message := flatbuffers.Table{Bytes: meta, Pos: flatbuffers.GetUOffsetT(meta)}
+	var bodyLen int64
+	if o := flatbuffers.UOffsetT(message.Offset(10)); o != 0 {
+		bodyLen = message.GetInt64(o + message.Pos)
+	}
+	o := flatbuffers.UOffsetT(message.Offset(6))
+	if o == 0 || message.GetByte(o+message.Pos) != arrowRecordBatchHeader {
+		return 0, bodyLen
+	}
+	o = flatbuffers.UOffsetT(message.Offset(8))
+	if o == 0 {
+		return 0, bodyLen
+	}
+	var header flatbuffers.Table
+	message.Union(&header, o)
+	var rows int64
+	if o := flatbuffers.UOffsetT(header.Offset(4)); o != 0 {
+		rows = header.GetInt64(o + header.Pos)
+	}
+	return rows, bodyLen
+}
+
+func formatFilterConditions(conditions []*pb.FilterCondition) string {
+	parts := make([]string, 0, len(conditions))
+	for _, fc := range conditions {
+		if fc == nil {
+			continue
+		}
+		parts = append(parts, protoJSON(fc))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func formatFilterTriples(names []string, operators []pb.FilterOperator, values []*pb.FilterValue) string {
+	parts := make([]string, 0, len(names))
+	for i, name := range names {
+		part := name
+		if i < len(operators) {
+			part += " " + operators[i].String()
+		}
+		if i < len(values) && values[i] != nil {
+			part += " " + protoJSON(values[i])
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, "; ")
+}
+
+func protoJSON(m proto.Message) string {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
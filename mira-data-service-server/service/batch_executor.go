@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pb "data-service/generated/datasource"
+	"data-service/utils"
+)
+
+// 批处理作业执行后端
+const (
+	batchBackendAuto  = "auto" // 按数据量选择，需显式配置
+	batchBackendSpark = "spark"
+	batchBackendDoris = "doris"
+)
+
+// BatchExecutor 批处理操作的执行后端，各后端的作业ID、输出位置和状态查询方式一致
+type BatchExecutor interface {
+	// Name 后端名称，记录在作业历史中
+	Name() string
+	// Supports 判断该后端能否执行作业
+	Supports(job *BatchJob) bool
+	// Submit 异步启动作业
+	Submit(ctx context.Context, job *BatchJob) error
+}
+
+// BatchJob 一次批处理作业的执行参数
+type BatchJob struct {
+	JobId       string
+	Request     *pb.BatchReadRequest
+	Spec        *pb.SparkDBConnInfo   // 操作参数
+	ConnInfo    *pb.ConnectionInfo    // 源表连接信息
+	SparkConfig *pb.SparkConfig       // 按表信息调整后的 Spark 配置
+	TableInfo   *pb.TableInfoResponse // 源表信息，可能为空
+}
+
+// sparkBatchExecutor 在 K8s 中创建 Spark Pod 执行作业
+type sparkBatchExecutor struct {
+	s *k8sService
+}
+
+func (e *sparkBatchExecutor) Name() string {
+	return batchBackendSpark
+}
+
+// Supports Spark 作业的哈希列只支持不加盐的 SHA-256
+func (e *sparkBatchExecutor) Supports(job *BatchJob) bool {
+	if op := job.Request.GetAddHashColumn(); op != nil {
+		algorithm, err := utils.NormalizeHashAlgorithm(op.GetHashAlgorithm())
+		return err == nil && algorithm == "sha256" && op.GetSalt() == ""
+	}
+	return true
+}
+
+func (e *sparkBatchExecutor) Submit(ctx context.Context, job *BatchJob) error {
+	pod, err := utils.CreateSparkPod(e.s.k8sClient, job.JobId, job.Spec, job.SparkConfig, job.ConnInfo, job.TableInfo.GetRecordCount64())
+	if err != nil {
+		e.s.logger.Errorf("Failed to create Pod: %v", err)
+		return err
+	}
+
+	// 打印 Pod 信息
+	e.s.logPodInfo(pod)
+
+	// 记录作业历史，保存调整后的 Spark 配置
+	e.s.recordSubmittedJob(job.JobId, job.Request.GetRequestId(), job.Spec.GetMode().String(), e.Name(), job.SparkConfig, job.TableInfo.GetRecordCount64())
+
+	// 后台监控作业状态，失败时按重试策略重试，结束后按配置清理 Pod
+	go e.s.monitorJob(job.JobId, job.SparkConfig.GetRetryPolicy())
+	return nil
+}
+
+// selectBatchExecutor 按配置选择执行后端，默认 Spark，配置为 auto 时按源表数据量选择；只有一个后端支持时使用该后端
+func (s *k8sService) selectBatchExecutor(job *BatchJob) (BatchExecutor, error) {
+	spark := &sparkBatchExecutor{s: s}
+	dorisSupported := s.dorisExecutor != nil && s.dorisExecutor.Supports(job)
+	if !spark.Supports(job) {
+		if dorisSupported {
+			return s.dorisExecutor, nil
+		}
+		return nil, fmt.Errorf("no batch executor supports %s job %s", job.Spec.GetMode(), job.JobId)
+	}
+	if !dorisSupported {
+		return spark, nil
+	}
+
+	conf := s.executorConfig
+	switch strings.ToLower(conf.Backend) {
+	case "", batchBackendSpark:
+		return spark, nil
+	case batchBackendDoris:
+		return s.dorisExecutor, nil
+	case batchBackendAuto:
+	default:
+		s.logger.Warnf("Unknown batch executor backend %q, using spark", conf.Backend)
+		return spark, nil
+	}
+
+	// auto：PSI 求交和哈希列的结果需与 Spark 逐字节一致，Spark 支持时始终使用 Spark
+	switch job.Spec.GetMode() {
+	case pb.OperationMode_OPERATION_MODE_PSI_JOIN, pb.OperationMode_OPERATION_MODE_ADD_HASH_COLUMN:
+		return spark, nil
+	}
+
+	// 其余操作小表在 Doris 中执行，表信息未知时使用 Spark
+	if job.TableInfo == nil || conf.DorisMaxRecordCount <= 0 {
+		return spark, nil
+	}
+	if job.TableInfo.GetRecordCount64() > conf.DorisMaxRecordCount {
+		return spark, nil
+	}
+	if conf.DorisMaxRecordSizeMB > 0 && job.TableInfo.GetRecordSize() > conf.DorisMaxRecordSizeMB*1024*1024 {
+		return spark, nil
+	}
+	return s.dorisExecutor, nil
+}
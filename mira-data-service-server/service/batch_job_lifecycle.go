@@ -0,0 +1,369 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"data-service/database/gorm/models"
+	"data-service/database/gorm/repositories"
+	pb "data-service/generated/datasource"
+	"data-service/utils"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// batchJobLogChunkSize 日志流单次发送的最大字节数
+const batchJobLogChunkSize = 32 * 1024
+
+// BatchJobHistoryStore 批处理作业历史存储
+type BatchJobHistoryStore interface {
+	Create(record *models.BatchJobHistory) error
+	FindByJobID(jobID string) (*models.BatchJobHistory, error)
+	UpdateByJobID(jobID string, updates map[string]interface{}) error
+	FindWithPagination(filter repositories.BatchJobHistoryFilter, offset, limit int) ([]models.BatchJobHistory, int64, error)
+}
+
+// CancelBatchJob 取消批处理作业：先在作业历史中记录取消，其他副本的监控据此停止重试，再删除作业所有尝试的 Pod
+func (s *k8sService) CancelBatchJob(ctx context.Context, request *pb.CancelBatchJobRequest) (*pb.BatchResponse, error) {
+	jobId := request.GetJobId()
+	if jobId == "" {
+		return nil, fmt.Errorf("jobId is required")
+	}
+
+	record := s.findHistory(jobId)
+	if record != nil {
+		switch parseJobStatus(record.Status) {
+		case pb.JobStatus_JOB_STATUS_SUCCEEDED, pb.JobStatus_JOB_STATUS_FAILED:
+			// 已结束的作业不再取消，已取消的作业重新删除可能残留的 Pod
+			return &pb.BatchResponse{
+				Status:  parseJobStatus(record.Status),
+				Mode:    record.Mode,
+				Error:   record.ErrorMessage,
+				JobId:   jobId,
+				Attempt: int32(record.Attempt),
+			}, nil
+		}
+	}
+
+	reason := request.GetReason()
+	if reason == "" {
+		reason = "cancelled by user"
+	}
+
+	// 在 Doris 中执行的作业没有 Pod，直接中止执行
+	if resp, ok := s.dorisExecutor.cancel(jobId, reason); ok {
+		if resp.Status == pb.JobStatus_JOB_STATUS_CANCELLED {
+			s.finishHistory(jobId, pb.JobStatus_JOB_STATUS_CANCELLED, reason)
+			s.logger.Infof("Cancelled batch job %s: %s", jobId, reason)
+		}
+		return resp, nil
+	}
+
+	pods, err := s.listJobPods(ctx, jobId)
+	if err != nil {
+		return nil, err
+	}
+	if len(pods) == 0 {
+		// 没有作业标签的旧 Pod 按名称查找
+		pod, err := s.k8sClient.CoreV1().Pods(s.namespace).Get(ctx, jobId, metav1.GetOptions{})
+		if err == nil {
+			pods = append(pods, *pod)
+		} else if record == nil {
+			return nil, fmt.Errorf("batch job %s not found: %v", jobId, err)
+		}
+	}
+
+	s.finishHistory(jobId, pb.JobStatus_JOB_STATUS_CANCELLED, reason)
+	var attempt int32
+	for i := range pods {
+		if a := podAttempt(&pods[i]); a > attempt {
+			attempt = a
+		}
+		err := s.k8sClient.CoreV1().Pods(s.namespace).Delete(ctx, pods[i].Name, metav1.DeleteOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete pod %s: %v", pods[i].Name, err)
+		}
+		s.logger.Infof("Deleted Pod %s of cancelled job %s", pods[i].Name, jobId)
+	}
+	s.logger.Infof("Cancelled batch job %s: %s", jobId, reason)
+
+	return &pb.BatchResponse{
+		Status:  pb.JobStatus_JOB_STATUS_CANCELLED,
+		Error:   reason,
+		JobId:   jobId,
+		Attempt: attempt,
+	}, nil
+}
+
+// GetBatchJobLogs 通过 Pod 日志接口流式返回 driver 日志
+func (s *k8sService) GetBatchJobLogs(request *pb.GetBatchJobLogsRequest, stream pb.DataSourceService_GetBatchJobLogsServer) error {
+	ctx := stream.Context()
+	jobId := request.GetJobId()
+	if jobId == "" {
+		return fmt.Errorf("jobId is required")
+	}
+
+	var pod *corev1.Pod
+	if request.GetAttempt() > 0 {
+		pods, err := s.listJobPods(ctx, jobId)
+		if err != nil {
+			return err
+		}
+		for i := range pods {
+			if podAttempt(&pods[i]) == request.GetAttempt() {
+				pod = &pods[i]
+				break
+			}
+		}
+		if pod == nil {
+			return fmt.Errorf("attempt %d of batch job %s not found", request.GetAttempt(), jobId)
+		}
+	} else {
+		var err error
+		pod, err = s.findJobPod(ctx, jobId)
+		if err != nil {
+			return fmt.Errorf("failed to find pod of batch job %s: %v", jobId, err)
+		}
+	}
+
+	opts := &corev1.PodLogOptions{
+		Container: utils.SparkContainerName,
+		Follow:    request.GetFollow(),
+	}
+	if request.GetTailLines() > 0 {
+		tailLines := request.GetTailLines()
+		opts.TailLines = &tailLines
+	}
+	logs, err := s.k8sClient.CoreV1().Pods(s.namespace).GetLogs(pod.Name, opts).Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to open logs of pod %s: %v", pod.Name, err)
+	}
+	defer logs.Close()
+
+	buf := make([]byte, batchJobLogChunkSize)
+	for {
+		n, err := logs.Read(buf)
+		if n > 0 {
+			chunk := &pb.BatchJobLogChunk{PodName: pod.Name, Data: append([]byte(nil), buf[:n]...)}
+			if sendErr := stream.Send(chunk); sendErr != nil {
+				return fmt.Errorf("failed to send log chunk: %v", sendErr)
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read logs of pod %s: %v", pod.Name, err)
+		}
+	}
+}
+
+// ListBatchJobHistory 分页查询批处理作业历史
+func (s *k8sService) ListBatchJobHistory(ctx context.Context, request *pb.ListBatchJobHistoryRequest) (*pb.ListBatchJobHistoryResponse, error) {
+	if s.history == nil {
+		return nil, fmt.Errorf("batch job history store is not available")
+	}
+	page := int(request.Page)
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	filter := repositories.BatchJobHistoryFilter{
+		JobID:     request.JobId,
+		RequestID: request.RequestId,
+	}
+	if request.Status != pb.JobStatus_JOB_STATUS_UNKNOWN {
+		filter.Status = request.Status.String()
+	}
+	records, total, err := s.history.FindWithPagination(filter, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query batch job history: %v", err)
+	}
+
+	response := &pb.ListBatchJobHistoryResponse{
+		Records:    make([]*pb.BatchJobHistoryRecord, 0, len(records)),
+		TotalCount: total,
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+	}
+	for i := range records {
+		response.Records = append(response.Records, s.historyToProto(&records[i]))
+	}
+	return response, nil
+}
+
+// recordSubmittedJob 记录新提交的作业
+func (s *k8sService) recordSubmittedJob(jobId, requestId, mode, backend string, sparkConfig *pb.SparkConfig, recordCount int64) {
+	if s.history == nil {
+		return
+	}
+	configJSON, err := protojson.Marshal(sparkConfig)
+	if err != nil {
+		s.logger.Warnf("Failed to marshal spark config of job %s: %v", jobId, err)
+	}
+	// 在 Doris 中执行的作业没有 Pod
+	podName := jobId
+	if backend == batchBackendDoris {
+		podName = ""
+	}
+	record := &models.BatchJobHistory{
+		JobID:       jobId,
+		RequestID:   requestId,
+		Mode:        mode,
+		Backend:     backend,
+		Status:      pb.JobStatus_JOB_STATUS_PENDING.String(),
+		Attempt:     1,
+		MaxRetries:  int(sparkConfig.GetRetryPolicy().GetMaxRetries()),
+		PodName:     podName,
+		SparkConfig: string(configJSON),
+		RecordCount: recordCount,
+		SubmittedAt: time.Now(),
+	}
+	if err := s.history.Create(record); err != nil {
+		s.logger.Warnf("Failed to save history of batch job %s: %v", jobId, err)
+	}
+}
+
+// findHistory 查询作业历史，未启用或查询失败时返回 nil
+func (s *k8sService) findHistory(jobId string) *models.BatchJobHistory {
+	if s.history == nil {
+		return nil
+	}
+	record, err := s.history.FindByJobID(jobId)
+	if err != nil {
+		s.logger.Warnf("Failed to query history of batch job %s: %v", jobId, err)
+		return nil
+	}
+	return record
+}
+
+// jobCancelled 作业历史中是否已记录取消
+func (s *k8sService) jobCancelled(jobId string) bool {
+	record := s.findHistory(jobId)
+	return record != nil && parseJobStatus(record.Status) == pb.JobStatus_JOB_STATUS_CANCELLED
+}
+
+// updateHistory 更新作业历史，失败只记录日志
+func (s *k8sService) updateHistory(jobId string, updates map[string]interface{}) {
+	if s.history == nil {
+		return
+	}
+	if err := s.history.UpdateByJobID(jobId, updates); err != nil {
+		s.logger.Warnf("Failed to update history of batch job %s: %v", jobId, err)
+	}
+}
+
+// finishHistory 记录作业最终状态
+func (s *k8sService) finishHistory(jobId string, status pb.JobStatus, errorMessage string) {
+	s.updateHistory(jobId, map[string]interface{}{
+		"status":        status.String(),
+		"error_message": errorMessage,
+		"finished_at":   time.Now(),
+	})
+}
+
+func (s *k8sService) historyToProto(record *models.BatchJobHistory) *pb.BatchJobHistoryRecord {
+	result := &pb.BatchJobHistoryRecord{
+		JobId:       record.JobID,
+		RequestId:   record.RequestID,
+		Mode:        record.Mode,
+		Backend:     record.Backend,
+		Status:      parseJobStatus(record.Status),
+		Attempt:     int32(record.Attempt),
+		MaxRetries:  int32(record.MaxRetries),
+		PodName:     record.PodName,
+		RecordCount: record.RecordCount,
+		Error:       record.ErrorMessage,
+		SubmittedAt: record.SubmittedAt.UnixMilli(),
+	}
+	if record.FinishedAt != nil {
+		result.FinishedAt = record.FinishedAt.UnixMilli()
+	}
+	if record.SparkConfig != "" {
+		sparkConfig := &pb.SparkConfig{}
+		if err := protojson.Unmarshal([]byte(record.SparkConfig), sparkConfig); err != nil {
+			s.logger.Warnf("Failed to parse spark config of job %s: %v", record.JobID, err)
+		} else {
+			result.SparkConfig = sparkConfig
+		}
+	}
+	return result
+}
+
+// retryPod 按失败 Pod 的规格重新创建 Pod，名称为 <jobId>-retry-<n>
+func (s *k8sService) retryPod(failed *corev1.Pod, jobId string, attempt int) (*corev1.Pod, error) {
+	labels := make(map[string]string, len(failed.Labels)+2)
+	for k, v := range failed.Labels {
+		labels[k] = v
+	}
+	labels[utils.SparkJobLabel] = jobId
+	labels[utils.SparkAttemptLabel] = strconv.Itoa(attempt)
+
+	spec := failed.Spec.DeepCopy()
+	// 由调度器重新选择节点
+	spec.NodeName = ""
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-retry-%d", jobId, attempt-1),
+			Namespace:   s.namespace,
+			Labels:      labels,
+			Annotations: failed.Annotations,
+		},
+		Spec: *spec,
+	}
+	return s.k8sClient.CoreV1().Pods(s.namespace).Create(context.Background(), pod, metav1.CreateOptions{})
+}
+
+// waitRetryBackoff 等待重试间隔，期间作业被取消时返回 false
+func (s *k8sService) waitRetryBackoff(jobId string, backoff time.Duration) bool {
+	deadline := time.Now().Add(backoff)
+	for {
+		if s.jobCancelled(jobId) {
+			s.logger.Infof("Job %s was cancelled, skip retry", jobId)
+			return false
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return true
+		}
+		if remaining > time.Second {
+			remaining = time.Second
+		}
+		time.Sleep(remaining)
+	}
+}
+
+// retryBackoff 第 attempt 次失败后的等待时间，按2倍递增
+func retryBackoff(policy *pb.SparkRetryPolicy, attempt int) time.Duration {
+	backoff := time.Duration(policy.GetBackoffSeconds()) * time.Second
+	limit := time.Duration(policy.GetMaxBackoffSeconds()) * time.Second
+	for i := 1; i < attempt && (limit <= 0 || backoff < limit); i++ {
+		backoff *= 2
+	}
+	if limit > 0 && backoff > limit {
+		return limit
+	}
+	return backoff
+}
+
+// podAttempt 读取 Pod 的尝试次数标签，没有标签时视为第1次
+func podAttempt(pod *corev1.Pod) int32 {
+	attempt, err := strconv.Atoi(pod.Labels[utils.SparkAttemptLabel])
+	if err != nil || attempt <= 0 {
+		return 1
+	}
+	return int32(attempt)
+}
+
+func parseJobStatus(status string) pb.JobStatus {
+	return pb.JobStatus(pb.JobStatus_value[status])
+}
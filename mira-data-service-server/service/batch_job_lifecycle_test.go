@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"data-service/database/gorm/models"
+	"data-service/database/gorm/repositories"
+	pb "data-service/generated/datasource"
+	"data-service/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const testSparkNamespace = "spark"
+
+// memoryBatchJobHistory 内存作业历史
+type memoryBatchJobHistory struct {
+	mu      sync.Mutex
+	records map[string]models.BatchJobHistory
+}
+
+func newMemoryBatchJobHistory() *memoryBatchJobHistory {
+	return &memoryBatchJobHistory{records: make(map[string]models.BatchJobHistory)}
+}
+
+func (h *memoryBatchJobHistory) Create(record *models.BatchJobHistory) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records[record.JobID] = *record
+	return nil
+}
+
+func (h *memoryBatchJobHistory) FindByJobID(jobID string) (*models.BatchJobHistory, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	record, ok := h.records[jobID]
+	if !ok {
+		return nil, nil
+	}
+	return &record, nil
+}
+
+func (h *memoryBatchJobHistory) UpdateByJobID(jobID string, updates map[string]interface{}) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	record, ok := h.records[jobID]
+	if !ok || record.FinishedAt != nil {
+		return nil
+	}
+	for k, v := range updates {
+		switch k {
+		case "status":
+			record.Status = v.(string)
+		case "attempt":
+			record.Attempt = v.(int)
+		case "pod_name":
+			record.PodName = v.(string)
+		case "error_message":
+			record.ErrorMessage = v.(string)
+		case "finished_at":
+			t := v.(time.Time)
+			record.FinishedAt = &t
+		}
+	}
+	h.records[jobID] = record
+	return nil
+}
+
+func (h *memoryBatchJobHistory) FindWithPagination(filter repositories.BatchJobHistoryFilter, offset, limit int) ([]models.BatchJobHistory, int64, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var matched []models.BatchJobHistory
+	for _, r := range h.records {
+		if (filter.JobID == "" || r.JobID == filter.JobID) &&
+			(filter.RequestID == "" || r.RequestID == filter.RequestID) &&
+			(filter.Status == "" || r.Status == filter.Status) {
+			matched = append(matched, r)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].JobID < matched[j].JobID })
+	total := int64(len(matched))
+	if offset >= len(matched) {
+		return nil, total, nil
+	}
+	matched = matched[offset:]
+	if len(matched) > limit {
+		matched = matched[:limit]
+	}
+	return matched, total, nil
+}
+
+// recordingLogStream 记录发送的日志块
+type recordingLogStream struct {
+	grpc.ServerStream
+	chunks []*pb.BatchJobLogChunk
+}
+
+func (s *recordingLogStream) Send(chunk *pb.BatchJobLogChunk) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func (s *recordingLogStream) Context() context.Context {
+	return context.Background()
+}
+
+func newTestK8sService(objects ...*corev1.Pod) (*k8sService, *fake.Clientset, *memoryBatchJobHistory) {
+	client := fake.NewSimpleClientset()
+	for _, pod := range objects {
+		_, _ = client.CoreV1().Pods(testSparkNamespace).Create(context.Background(), pod, metav1.CreateOptions{})
+	}
+	history := newMemoryBatchJobHistory()
+	return &k8sService{
+		logger:       zap.NewNop().Sugar(),
+		k8sClient:    client,
+		namespace:    testSparkNamespace,
+		history:      history,
+		pollInterval: 5 * time.Millisecond,
+	}, client, history
+}
+
+func sparkJobPod(jobId string, phase corev1.PodPhase) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobId,
+			Namespace: testSparkNamespace,
+			Labels:    map[string]string{utils.SparkJobLabel: jobId, utils.SparkAttemptLabel: "1"},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:      "node-1",
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers:    []corev1.Container{{Name: utils.SparkContainerName, Image: "spark:test"}},
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+// setPodPhase 模拟 Pod 运行结束
+func setPodPhase(t *testing.T, client *fake.Clientset, name string, phase corev1.PodPhase) {
+	pod, err := client.CoreV1().Pods(testSparkNamespace).Get(context.Background(), name, metav1.GetOptions{})
+	require.NoError(t, err)
+	pod.Status.Phase = phase
+	if phase == corev1.PodFailed {
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+			Name:  utils.SparkContainerName,
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Message: "executor lost"}},
+		}}
+	}
+	_, err = client.CoreV1().Pods(testSparkNamespace).UpdateStatus(context.Background(), pod, metav1.UpdateOptions{})
+	require.NoError(t, err)
+}
+
+func waitForPod(t *testing.T, client *fake.Clientset, name string) *corev1.Pod {
+	var pod *corev1.Pod
+	require.Eventually(t, func() bool {
+		p, err := client.CoreV1().Pods(testSparkNamespace).Get(context.Background(), name, metav1.GetOptions{})
+		pod = p
+		return err == nil
+	}, 2*time.Second, 5*time.Millisecond, "pod %s was not created", name)
+	return pod
+}
+
+func TestBatchJobRetryUntilSucceeded(t *testing.T) {
+	jobId := "spark-job-req1"
+	s, client, history := newTestK8sService(sparkJobPod(jobId, corev1.PodFailed))
+	setPodPhase(t, client, jobId, corev1.PodFailed)
+
+	sparkConfig := &pb.SparkConfig{ExecutorMemoryMB: 2048, RetryPolicy: &pb.SparkRetryPolicy{MaxRetries: 2}}
+	s.recordSubmittedJob(jobId, "req1", pb.OperationMode_OPERATION_MODE_QUERY.String(), batchBackendSpark, sparkConfig, 100)
+
+	done := make(chan struct{})
+	go func() {
+		s.monitorJob(jobId, sparkConfig.GetRetryPolicy())
+		close(done)
+	}()
+
+	retry1 := waitForPod(t, client, jobId+"-retry-1")
+	assert.Equal(t, "2", retry1.Labels[utils.SparkAttemptLabel])
+	assert.Equal(t, jobId, retry1.Labels[utils.SparkJobLabel])
+	assert.Empty(t, retry1.Spec.NodeName, "retry should be rescheduled")
+	setPodPhase(t, client, retry1.Name, corev1.PodFailed)
+
+	retry2 := waitForPod(t, client, jobId+"-retry-2")
+	setPodPhase(t, client, retry2.Name, corev1.PodSucceeded)
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("monitorJob did not finish")
+	}
+
+	record, err := history.FindByJobID(jobId)
+	require.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_SUCCEEDED.String(), record.Status)
+	assert.Equal(t, 3, record.Attempt)
+	assert.Equal(t, retry2.Name, record.PodName)
+	assert.NotNil(t, record.FinishedAt)
+
+	status, err := s.GetJobStatus(context.Background(), &pb.JobStatusRequest{JobId: jobId})
+	require.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_SUCCEEDED, status.Status)
+	assert.Equal(t, int32(3), status.Attempt)
+
+	resp, err := s.ListBatchJobHistory(context.Background(), &pb.ListBatchJobHistoryRequest{RequestId: "req1"})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 1)
+	assert.Equal(t, int32(2048), resp.Records[0].SparkConfig.GetExecutorMemoryMB())
+	assert.Equal(t, int32(2), resp.Records[0].SparkConfig.GetRetryPolicy().GetMaxRetries())
+	assert.Equal(t, int32(2), resp.Records[0].MaxRetries)
+}
+
+func TestBatchJobFailsWhenRetriesExhausted(t *testing.T) {
+	jobId := "spark-job-req2"
+	s, client, history := newTestK8sService(sparkJobPod(jobId, corev1.PodFailed))
+	setPodPhase(t, client, jobId, corev1.PodFailed)
+	s.recordSubmittedJob(jobId, "req2", "", batchBackendSpark, &pb.SparkConfig{}, 0)
+
+	s.monitorJob(jobId, nil)
+
+	record, err := history.FindByJobID(jobId)
+	require.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_FAILED.String(), record.Status)
+	assert.Contains(t, record.ErrorMessage, "executor lost")
+
+	status, err := s.GetJobStatus(context.Background(), &pb.JobStatusRequest{JobId: jobId})
+	require.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_FAILED, status.Status)
+
+	// Pod 清理后仍可从作业历史获取状态
+	require.NoError(t, client.CoreV1().Pods(testSparkNamespace).Delete(context.Background(), jobId, metav1.DeleteOptions{}))
+	status, err = s.GetJobStatus(context.Background(), &pb.JobStatusRequest{JobId: jobId})
+	require.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_FAILED, status.Status)
+	assert.Contains(t, status.Error, "executor lost")
+}
+
+func TestCancelBatchJob(t *testing.T) {
+	jobId := "spark-job-req3"
+	retry := sparkJobPod(jobId+"-retry-1", corev1.PodRunning)
+	retry.Labels = map[string]string{utils.SparkJobLabel: jobId, utils.SparkAttemptLabel: "2"}
+	s, client, history := newTestK8sService(sparkJobPod(jobId, corev1.PodFailed), retry)
+	s.recordSubmittedJob(jobId, "req3", "", batchBackendSpark, &pb.SparkConfig{RetryPolicy: &pb.SparkRetryPolicy{MaxRetries: 1}}, 0)
+
+	resp, err := s.CancelBatchJob(context.Background(), &pb.CancelBatchJobRequest{JobId: jobId, Reason: "no longer needed"})
+	require.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_CANCELLED, resp.Status)
+	assert.Equal(t, int32(2), resp.Attempt)
+
+	pods, err := client.CoreV1().Pods(testSparkNamespace).List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, pods.Items)
+
+	record, err := history.FindByJobID(jobId)
+	require.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_CANCELLED.String(), record.Status)
+	assert.Equal(t, "no longer needed", record.ErrorMessage)
+
+	status, err := s.GetJobStatus(context.Background(), &pb.JobStatusRequest{JobId: jobId})
+	require.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_CANCELLED, status.Status)
+
+	// 取消记录在作业历史中，其他副本的监控看到失败的 Pod 也不再重试
+	_, err = client.CoreV1().Pods(testSparkNamespace).Create(context.Background(), sparkJobPod(jobId, corev1.PodFailed), metav1.CreateOptions{})
+	require.NoError(t, err)
+	replica := &k8sService{logger: s.logger, k8sClient: client, namespace: testSparkNamespace, history: history, pollInterval: s.pollInterval}
+	replica.monitorJob(jobId, &pb.SparkRetryPolicy{MaxRetries: 1})
+	_, err = client.CoreV1().Pods(testSparkNamespace).Get(context.Background(), jobId+"-retry-1", metav1.GetOptions{})
+	assert.Error(t, err)
+
+	// 已结束的作业历史不会被后续状态覆盖
+	replica.finishHistory(jobId, pb.JobStatus_JOB_STATUS_FAILED, "pod deleted")
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_CANCELLED.String(), findRecord(history, jobId).Status)
+
+	// 重复取消时删除残留的 Pod
+	resp, err = replica.CancelBatchJob(context.Background(), &pb.CancelBatchJobRequest{JobId: jobId})
+	require.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_CANCELLED, resp.Status)
+	pods, err = client.CoreV1().Pods(testSparkNamespace).List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, pods.Items)
+
+	_, err = s.CancelBatchJob(context.Background(), &pb.CancelBatchJobRequest{JobId: "spark-job-missing"})
+	assert.Error(t, err)
+}
+
+func TestGetBatchJobLogs(t *testing.T) {
+	jobId := "spark-job-req4"
+	retry := sparkJobPod(jobId+"-retry-1", corev1.PodRunning)
+	retry.Labels = map[string]string{utils.SparkJobLabel: jobId, utils.SparkAttemptLabel: "2"}
+	s, _, _ := newTestK8sService(sparkJobPod(jobId, corev1.PodFailed), retry)
+
+	stream := &recordingLogStream{}
+	require.NoError(t, s.GetBatchJobLogs(&pb.GetBatchJobLogsRequest{JobId: jobId, TailLines: 100}, stream))
+	require.NotEmpty(t, stream.chunks)
+	assert.Equal(t, retry.Name, stream.chunks[0].PodName)
+	// fake clientset 的日志内容固定为 "fake logs"
+	assert.Equal(t, "fake logs", string(stream.chunks[0].Data))
+
+	stream = &recordingLogStream{}
+	require.NoError(t, s.GetBatchJobLogs(&pb.GetBatchJobLogsRequest{JobId: jobId, Attempt: 1}, stream))
+	require.NotEmpty(t, stream.chunks)
+	assert.Equal(t, jobId, stream.chunks[0].PodName)
+
+	assert.Error(t, s.GetBatchJobLogs(&pb.GetBatchJobLogsRequest{JobId: jobId, Attempt: 3}, &recordingLogStream{}))
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := &pb.SparkRetryPolicy{MaxRetries: 5, BackoffSeconds: 10, MaxBackoffSeconds: 60}
+	assert.Equal(t, 10*time.Second, retryBackoff(policy, 1))
+	assert.Equal(t, 20*time.Second, retryBackoff(policy, 2))
+	assert.Equal(t, 40*time.Second, retryBackoff(policy, 3))
+	assert.Equal(t, 60*time.Second, retryBackoff(policy, 4))
+	assert.Equal(t, time.Duration(0), retryBackoff(nil, 3))
+	assert.Equal(t, 80*time.Second, retryBackoff(&pb.SparkRetryPolicy{BackoffSeconds: 10}, 4))
+}
@@ -8,23 +8,67 @@ import (
 	"data-service/database/gorm/repositories"
 	"data-service/log"
 	"data-service/oss"
-	"errors"
 	"fmt"
+	"time"
 
 	gormlib "gorm.io/gorm"
 
 	"data-service/common"
 )
 
+// runCleanupTask 执行已领取的清理任务，测试时替换
+var runCleanupTask = (*CleanupTaskService).runTask
+
 type CleanupTaskService struct {
 	taskRepo *repositories.CleanupTaskRepository
+	conf     config.CleanupWorkerConfig
 }
 
 func NewCleanupTaskService() *CleanupTaskService {
 	db := gorm.GetGormDB()
 	return &CleanupTaskService{
 		taskRepo: repositories.NewCleanupTaskRepository(db),
+		conf:     config.GetConfigMap().CleanupWorker,
+	}
+}
+
+func (s *CleanupTaskService) leaseDuration() time.Duration {
+	if s.conf.LeaseSeconds > 0 {
+		return time.Duration(s.conf.LeaseSeconds) * time.Second
+	}
+	return defaultCleanupLease
+}
+
+func (s *CleanupTaskService) baseBackoff() time.Duration {
+	if s.conf.BaseBackoffSeconds > 0 {
+		return time.Duration(s.conf.BaseBackoffSeconds) * time.Second
+	}
+	return defaultCleanupBaseBackoff
+}
+
+func (s *CleanupTaskService) maxBackoff() time.Duration {
+	if s.conf.MaxBackoffSeconds > 0 {
+		return time.Duration(s.conf.MaxBackoffSeconds) * time.Second
 	}
+	return defaultCleanupMaxBackoff
+}
+
+// CleanupBackoff 计算第 retryCount 次失败后的等待时间：base * 2^(retryCount-1)，不超过 max
+func CleanupBackoff(retryCount int, base, max time.Duration) time.Duration {
+	if retryCount < 1 {
+		retryCount = 1
+	}
+	backoff := base
+	for i := 1; i < retryCount; i++ {
+		if backoff >= max/2 {
+			return max
+		}
+		backoff *= 2
+	}
+	if backoff > max {
+		return max
+	}
+	return backoff
 }
 
 // CreateCleanupTask 创建清理任务
@@ -66,84 +110,163 @@ func (s *CleanupTaskService) GetOrCreateCleanupTask(jobInstanceID, taskType stri
 	return s.CreateCleanupTask(jobInstanceID, taskType)
 }
 
-// ExecuteCleanupTask 执行清理任务
+// ExecuteCleanupTask 立即执行清理任务，先领取租约避免与后台重试并发执行
 func (s *CleanupTaskService) ExecuteCleanupTask(jobInstanceId string) error {
 	task, err := s.GetCleanupTaskByJobInstanceId(jobInstanceId)
 	if err != nil {
 		return fmt.Errorf("failed to get cleanup task: %v", err)
 	}
 
-	// 更新状态为运行中
-	err = s.taskRepo.UpdateStatus(task.ID, common.TaskStatusRunning, "")
+	switch task.Status {
+	case common.TaskStatusCompleted:
+		log.Logger.Infof("Cleanup task for job %s already completed", jobInstanceId)
+		return nil
+	case common.TaskStatusDeadLetter:
+		return fmt.Errorf("cleanup task for job %s is in dead letter, requeue it first", jobInstanceId)
+	}
+
+	owner := cleanupLeaseOwner()
+	claimed, err := s.claimTask(task, owner)
 	if err != nil {
-		return fmt.Errorf("failed to update task status: %v", err)
+		return fmt.Errorf("failed to claim cleanup task: %v", err)
+	}
+	if !claimed {
+		return fmt.Errorf("cleanup task for job %s is being processed by %s", jobInstanceId, task.LeaseOwner)
+	}
+	return s.runClaimedTask(task, owner)
+}
+
+// claimTask 领取待执行或失败的任务；运行中的任务只有租约过期才能领取，并计入一次重试
+func (s *CleanupTaskService) claimTask(task *models.CleanupTask, owner string) (bool, error) {
+	if task.Status != common.TaskStatusRunning {
+		return s.taskRepo.ClaimTask(task.ID, owner, s.leaseDuration(), claimableCleanupStatuses)
+	}
+	claimed, err := s.taskRepo.ReclaimExpiredTask(task.ID, owner, s.leaseDuration())
+	if claimed {
+		task.RetryCount++
+	}
+	return claimed, err
+}
+
+// runClaimedTask 执行已领取的任务，执行期间定期续约；成功标记完成，失败按指数退避安排重试或转入死信
+func (s *CleanupTaskService) runClaimedTask(task *models.CleanupTask, owner string) error {
+	stopRenew := s.keepLease(task, owner)
+	err := runCleanupTask(s, task)
+	stopRenew()
+	if err == nil {
+		if updateErr := s.taskRepo.CompleteTask(task.ID, owner); updateErr != nil {
+			return fmt.Errorf("failed to mark cleanup task %d completed: %v", task.ID, updateErr)
+		}
+		log.Logger.Infof("Successfully completed cleanup task %d for job %s", task.ID, task.JobInstanceID)
+		return nil
+	}
+
+	retryCount := task.RetryCount + 1
+	var nextRetryAt *time.Time
+	if retryCount < task.MaxRetries {
+		next := time.Now().Add(CleanupBackoff(retryCount, s.baseBackoff(), s.maxBackoff()))
+		nextRetryAt = &next
+		log.Logger.Warnf("Cleanup task %d for job %s failed (%d/%d), next retry at %s: %v",
+			task.ID, task.JobInstanceID, retryCount, task.MaxRetries, next.Format(time.RFC3339), err)
+	} else {
+		log.Logger.Errorf("Cleanup task %d for job %s failed after %d retries, moved to dead letter: %v",
+			task.ID, task.JobInstanceID, retryCount, err)
+	}
+	if updateErr := s.taskRepo.FailTask(task.ID, owner, err.Error(), nextRetryAt); updateErr != nil {
+		log.Logger.Errorf("Failed to record failure of cleanup task %d: %v", task.ID, updateErr)
+	}
+	return fmt.Errorf("cleanup task %d for job %s failed: %v", task.ID, task.JobInstanceID, err)
+}
+
+// keepLease 每隔三分之一租约时长续约一次，租约被他人领取后停止续约；返回的函数停止续约并等待续约协程退出
+func (s *CleanupTaskService) keepLease(task *models.CleanupTask, owner string) func() {
+	lease := s.leaseDuration()
+	stopCh := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(lease / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopCh:
+				return
+			case <-ticker.C:
+				renewed, err := s.taskRepo.RenewLease(task.ID, owner, lease)
+				if err != nil {
+					log.Logger.Warnf("Failed to renew lease of cleanup task %d: %v", task.ID, err)
+				} else if !renewed {
+					log.Logger.Warnf("Lease of cleanup task %d is no longer held by %s", task.ID, owner)
+					return
+				}
+			}
+		}
+	}()
+	return func() {
+		close(stopCh)
+		<-done
 	}
+}
+
+// runTask 清理导出文件并按任务类型执行清理逻辑
+func (s *CleanupTaskService) runTask(task *models.CleanupTask) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic during cleanup: %v", r)
+		}
+	}()
 
 	// 清理导出的数据文件
-	err = s.cleanupExportFiles(jobInstanceId)
-	if err != nil {
-		log.Logger.Warnf("failed to cleanup export files: %v", err)
+	exportErr := s.cleanupExportFiles(task.JobInstanceID)
+	if exportErr != nil {
+		log.Logger.Warnf("failed to cleanup export files: %v", exportErr)
 	}
 
 	// 根据任务类型执行不同的清理逻辑
 	switch task.TaskType {
 	case "doris_table":
-		return s.executeDorisTableCleanup(task)
+		err = s.executeDorisTableCleanup(task)
 	case "mira_table":
-		return s.executeMiraTableCleanup(task)
+		err = s.executeMiraTableCleanup(task)
 	default:
-		errorMsg := fmt.Sprintf("unknown task type: %s", task.TaskType)
-		s.taskRepo.UpdateStatus(task.ID, common.TaskStatusFailed, errorMsg)
-		return errors.New(errorMsg)
+		err = fmt.Errorf("unknown task type: %s", task.TaskType)
 	}
+	if err != nil {
+		return err
+	}
+	return exportErr
 }
 
-// executeDorisTableCleanup 执行Doris表清理
+// executeDorisTableCleanup 执行Doris表清理：删除库内 FILE 及对应的 TLS 证书对象，然后删除数据库
 func (s *CleanupTaskService) executeDorisTableCleanup(task *models.CleanupTask) error {
-	defer func() {
-		if r := recover(); r != nil {
-			errorMsg := fmt.Sprintf("panic during cleanup: %v", r)
-			s.taskRepo.UpdateStatus(task.ID, "failed", errorMsg)
-		}
-	}()
+	if err := dropDorisJobDatabase(task.JobInstanceID); err != nil {
+		return err
+	}
+	ForgetTableTTL(task.JobInstanceID)
+	return nil
+}
 
+// dropDorisJobDatabase 删除作业库，删库前清理库内 FILE 和对应的证书对象
+func dropDorisJobDatabase(dbName string) error {
 	// 创建Doris服务
-	dorisService, err := NewDorisService(task.JobInstanceID)
+	dorisService, err := NewDorisService(dbName)
 	if err != nil {
-		errorMsg := fmt.Sprintf("failed to create doris service: %v", err)
-		s.taskRepo.UpdateStatus(task.ID, "failed", errorMsg)
-		return errors.New(errorMsg)
+		return fmt.Errorf("failed to create doris service: %v", err)
 	}
 
 	// 删库前：无视 requestId，直接清理库内所有通过 CREATE FILE 创建的文件
 	if ds, ok := dorisService.(*DorisService); ok {
-		log.Logger.Infof("Cleaning up all FILE entries in db %s before dropping database", task.JobInstanceID)
-		ds.CleanupAllFilesInDatabase(task.JobInstanceID)
+		log.Logger.Infof("Cleaning up all FILE entries in db %s before dropping database", dbName)
+		droppedFiles := ds.CleanupAllFilesInDatabase(dbName)
+		if err := ds.cleanupMinioTlsObjectsByFileNames(droppedFiles); err != nil {
+			return fmt.Errorf("failed to cleanup tls cert objects: %v", err)
+		}
 	}
 
 	// 执行清理
-	err = dorisService.DropDatabase(task.JobInstanceID)
-	if err != nil {
-		// 增加重试次数
-		s.taskRepo.IncrementRetryCount(task.ID)
-
-		// 检查是否超过最大重试次数
-		task.RetryCount++
-		if task.RetryCount >= task.MaxRetries {
-			errorMsg := fmt.Sprintf("cleanup failed after %d retries: %v", task.MaxRetries, err)
-			s.taskRepo.UpdateStatus(task.ID, "failed", errorMsg)
-			return fmt.Errorf("cleanup failed after %d retries: %v", task.MaxRetries, err)
-		}
-
-		// 更新状态为pending，等待下次重试
-		s.taskRepo.UpdateStatus(task.ID, "pending", "")
-		return fmt.Errorf("cleanup failed after %d retries: %v", task.MaxRetries, err)
+	if err := dorisService.DropDatabase(dbName); err != nil {
+		return fmt.Errorf("failed to drop database %s: %v", dbName, err)
 	}
-
-	// 清理成功
-	s.taskRepo.UpdateStatus(task.ID, "completed", "")
-	log.Logger.Infof("Successfully completed cleanup task %d for job %s", task.ID, task.JobInstanceID)
-
 	return nil
 }
 
@@ -173,6 +296,25 @@ func (s *CleanupTaskService) GetRetryCleanupTasks(page, pageSize int) ([]models.
 	return tasks, totalCount, nil
 }
 
+// GetCleanupTasksByStatus 按状态分页获取清理任务，用于查看死信任务
+func (s *CleanupTaskService) GetCleanupTasksByStatus(status common.TaskStatus, page, pageSize int) ([]models.CleanupTask, int64, error) {
+	tasks, totalCount, err := s.taskRepo.FindTasksByStatusWithPagination(status, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get %s tasks: %v", status, err)
+	}
+	return tasks, totalCount, nil
+}
+
+// RequeueDeadLetterTasks 将死信任务重置为待执行，jobInstanceIDs 为空时重新入队全部死信任务
+func (s *CleanupTaskService) RequeueDeadLetterTasks(jobInstanceIDs []string) (int64, error) {
+	count, err := s.taskRepo.RequeueDeadLetterTasks(jobInstanceIDs)
+	if err != nil {
+		return 0, fmt.Errorf("failed to requeue dead letter tasks: %v", err)
+	}
+	log.Logger.Infof("Requeued %d dead letter cleanup tasks, jobs: %v", count, jobInstanceIDs)
+	return count, nil
+}
+
 // GetCleanupTaskByJobInstanceId 根据作业实例ID查找清理任务
 func (s *CleanupTaskService) GetCleanupTaskByJobInstanceId(jobInstanceID string) (*models.CleanupTask, error) {
 	task, err := s.taskRepo.FindByJobInstanceID(jobInstanceID)
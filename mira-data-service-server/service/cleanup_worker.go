@@ -0,0 +1,149 @@
+package service
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"data-service/common"
+	"data-service/config"
+	"data-service/database/gorm"
+	"data-service/database/gorm/models"
+	"data-service/log"
+)
+
+const (
+	defaultCleanupWorkers      = 2
+	defaultCleanupPollInterval = 30 * time.Second
+	defaultCleanupBatchSize    = 10
+	defaultCleanupLease        = 10 * time.Minute
+	defaultCleanupBaseBackoff  = time.Minute
+	defaultCleanupMaxBackoff   = time.Hour
+)
+
+// claimableCleanupStatuses 可领取执行的状态，已完成和死信任务不会被再次执行
+var claimableCleanupStatuses = []common.TaskStatus{common.TaskStatusPending, common.TaskStatusFailed}
+
+// CleanupWorker 后台清理任务执行器，多副本通过数据库租约避免重复执行
+type CleanupWorker struct {
+	taskService  *CleanupTaskService
+	owner        string
+	workers      int
+	batchSize    int
+	pollInterval time.Duration
+	stopCh       chan struct{}
+	stopOnce     sync.Once
+}
+
+// StartCleanupWorker 按配置启动后台清理任务执行器，未启用或数据库未初始化时返回 nil
+func StartCleanupWorker(conf config.CleanupWorkerConfig) *CleanupWorker {
+	if !conf.Enabled {
+		return nil
+	}
+	if gorm.GetGormDB() == nil {
+		log.Logger.Warnf("Cleanup worker disabled: gorm database is not initialized")
+		return nil
+	}
+	worker := NewCleanupWorker(NewCleanupTaskService(), conf)
+	worker.Start()
+	return worker
+}
+
+func NewCleanupWorker(taskService *CleanupTaskService, conf config.CleanupWorkerConfig) *CleanupWorker {
+	w := &CleanupWorker{
+		taskService:  taskService,
+		owner:        cleanupLeaseOwner(),
+		workers:      conf.Workers,
+		batchSize:    conf.BatchSize,
+		pollInterval: time.Duration(conf.PollIntervalSeconds) * time.Second,
+		stopCh:       make(chan struct{}),
+	}
+	if w.workers <= 0 {
+		w.workers = defaultCleanupWorkers
+	}
+	if w.batchSize <= 0 {
+		w.batchSize = defaultCleanupBatchSize
+	}
+	if w.pollInterval <= 0 {
+		w.pollInterval = defaultCleanupPollInterval
+	}
+	return w
+}
+
+// Start 启动轮询
+func (w *CleanupWorker) Start() {
+	log.Logger.Infof("Starting cleanup worker %s, workers: %d, poll interval: %v", w.owner, w.workers, w.pollInterval)
+	go func() {
+		ticker := time.NewTicker(w.pollInterval)
+		defer ticker.Stop()
+		for {
+			w.RunOnce()
+			select {
+			case <-w.stopCh:
+				log.Logger.Infof("Cleanup worker %s stopped", w.owner)
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop 停止轮询，正在执行的任务会继续完成
+func (w *CleanupWorker) Stop() {
+	w.stopOnce.Do(func() { close(w.stopCh) })
+}
+
+// RunOnce 执行一轮：重试耗尽的任务转入死信，有空闲执行槽位时才领取到期任务并执行，
+// 领取后立即开始执行，租约不会在排队等待时过期
+func (w *CleanupWorker) RunOnce() {
+	repo := w.taskService.taskRepo
+	if moved, err := repo.MoveExhaustedToDeadLetter(); err != nil {
+		log.Logger.Warnf("Failed to move exhausted cleanup tasks to dead letter: %v", err)
+	} else if moved > 0 {
+		log.Logger.Warnf("Moved %d exhausted cleanup tasks to dead letter", moved)
+	}
+
+	tasks, err := repo.FindClaimableTasks(w.batchSize)
+	if err != nil {
+		log.Logger.Errorf("Failed to find claimable cleanup tasks: %v", err)
+		return
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, w.workers)
+	for i := range tasks {
+		task := &tasks[i]
+		sem <- struct{}{}
+		claimed, err := w.taskService.claimTask(task, w.owner)
+		if err != nil {
+			log.Logger.Warnf("Failed to claim cleanup task %d: %v", task.ID, err)
+		}
+		if !claimed {
+			<-sem
+			continue
+		}
+		wg.Add(1)
+		go func(task *models.CleanupTask) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := w.taskService.runClaimedTask(task, w.owner); err != nil {
+				log.Logger.Warnf("Cleanup worker %s: %v", w.owner, err)
+			}
+		}(task)
+	}
+	wg.Wait()
+}
+
+// cleanupLeaseOwner 生成租约持有者标识：主机名-进程号-随机串
+func cleanupLeaseOwner() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "unknown"
+	}
+	suffix, err := common.GenerateRandomString(6)
+	if err != nil {
+		suffix = fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), suffix)
+}
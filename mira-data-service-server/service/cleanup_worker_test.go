@@ -0,0 +1,144 @@
+package service
+
+import (
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"data-service/common"
+	"data-service/config"
+	"data-service/database/gorm/models"
+	"data-service/database/gorm/repositories"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	gormlib "gorm.io/gorm"
+)
+
+func TestCleanupBackoff(t *testing.T) {
+	base, max := time.Minute, 10*time.Minute
+	tests := []struct {
+		retryCount int
+		want       time.Duration
+	}{
+		{0, time.Minute},
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{3, 4 * time.Minute},
+		{4, 8 * time.Minute},
+		{5, 10 * time.Minute},
+		{64, 10 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := CleanupBackoff(tt.retryCount, base, max); got != tt.want {
+			t.Errorf("CleanupBackoff(%d) = %v, want %v", tt.retryCount, got, tt.want)
+		}
+	}
+}
+
+// newMockCleanupWorker 基于 sqlmock 创建单槽位的清理任务执行器
+func newMockCleanupWorker(t *testing.T, conf config.CleanupWorkerConfig) (*CleanupWorker, sqlmock.Sqlmock) {
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	gormDB, err := gormlib.Open(mysql.New(mysql.Config{Conn: db, SkipInitializeWithVersion: true}), &gormlib.Config{})
+	require.NoError(t, err)
+	conf.Workers = 1
+	taskService := &CleanupTaskService{taskRepo: repositories.NewCleanupTaskRepository(gormDB), conf: conf}
+	return NewCleanupWorker(taskService, conf), sqlMock
+}
+
+// stubCleanupRun 替换任务执行逻辑，记录执行的任务
+func stubCleanupRun(t *testing.T, run func(task *models.CleanupTask) error) {
+	original := runCleanupTask
+	runCleanupTask = func(_ *CleanupTaskService, task *models.CleanupTask) error { return run(task) }
+	t.Cleanup(func() { runCleanupTask = original })
+}
+
+func expectCleanupUpdate(sqlMock sqlmock.Sqlmock, pattern string, rowsAffected int64, args ...driver.Value) {
+	sqlMock.ExpectBegin()
+	exec := sqlMock.ExpectExec(pattern)
+	if len(args) > 0 {
+		exec.WithArgs(args...)
+	}
+	exec.WillReturnResult(sqlmock.NewResult(0, rowsAffected))
+	sqlMock.ExpectCommit()
+}
+
+var cleanupTaskColumns = []string{"id", "job_instance_id", "task_type", "status", "retry_count", "max_retries", "lease_owner", "lease_expires"}
+
+func TestCleanupWorker_ReclaimsExpiredLease(t *testing.T) {
+	worker, sqlMock := newMockCleanupWorker(t, config.CleanupWorkerConfig{})
+	var ran []*models.CleanupTask
+	stubCleanupRun(t, func(task *models.CleanupTask) error {
+		ran = append(ran, task)
+		return nil
+	})
+
+	expectCleanupUpdate(sqlMock, "SET .*`status`=.* WHERE retry_count >= max_retries", 0)
+	// 另一副本持有的租约已过期，任务仍为运行中
+	sqlMock.ExpectQuery("SELECT \\* FROM `t_data_service_cleanup_tasks`").WillReturnRows(sqlmock.NewRows(cleanupTaskColumns).
+		AddRow(5, "job-5", "doris_table", common.TaskStatusRunning, 0, 3, "crashed-replica", time.Now().Add(-time.Minute)))
+	expectCleanupUpdate(sqlMock, "SET .*`retry_count`=retry_count \\+ 1.* WHERE id = \\? AND status = \\? AND lease_expires IS NOT NULL AND lease_expires < \\?", 1)
+	expectCleanupUpdate(sqlMock, "SET .*`status`=\\?.* WHERE id = \\? AND lease_owner = \\?", 1,
+		sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), common.TaskStatusCompleted,
+		sqlmock.AnyArg(), uint(5), worker.owner)
+
+	worker.RunOnce()
+
+	require.Len(t, ran, 1)
+	assert.Equal(t, "job-5", ran[0].JobInstanceID)
+	// 过期的执行计入一次重试
+	assert.Equal(t, 1, ran[0].RetryCount)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestCleanupWorker_LastFailureMovesToDeadLetter(t *testing.T) {
+	worker, sqlMock := newMockCleanupWorker(t, config.CleanupWorkerConfig{})
+	stubCleanupRun(t, func(task *models.CleanupTask) error { return errors.New("doris unavailable") })
+
+	// 重试次数已耗尽的任务先转入死信
+	expectCleanupUpdate(sqlMock, "SET .*`status`=\\?.* WHERE retry_count >= max_retries", 2,
+		sqlmock.AnyArg(), sqlmock.AnyArg(), common.TaskStatusDeadLetter, sqlmock.AnyArg(),
+		common.TaskStatusPending, common.TaskStatusFailed, common.TaskStatusRunning, sqlmock.AnyArg())
+	sqlMock.ExpectQuery("SELECT \\* FROM `t_data_service_cleanup_tasks`").WillReturnRows(sqlmock.NewRows(cleanupTaskColumns).
+		AddRow(9, "job-9", "doris_table", common.TaskStatusFailed, 2, 3, "", nil))
+	expectCleanupUpdate(sqlMock, "WHERE id = \\? AND status IN \\(\\?,\\?\\)", 1)
+	// 最后一次重试失败，不再安排重试
+	expectCleanupUpdate(sqlMock, "SET .*`retry_count`=retry_count \\+ 1.* WHERE id = \\? AND lease_owner = \\?", 1,
+		"doris unavailable", nil, "", nil, common.TaskStatusDeadLetter, sqlmock.AnyArg(), uint(9), worker.owner)
+
+	worker.RunOnce()
+
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestCleanupWorker_ClaimsOnlyWithFreeSlotAndRenewsLease(t *testing.T) {
+	// 租约 1 秒，执行期间每 1/3 秒续约
+	worker, sqlMock := newMockCleanupWorker(t, config.CleanupWorkerConfig{LeaseSeconds: 1})
+	stubCleanupRun(t, func(task *models.CleanupTask) error {
+		if task.ID == 1 {
+			time.Sleep(500 * time.Millisecond)
+		}
+		return nil
+	})
+
+	expectCleanupUpdate(sqlMock, "WHERE retry_count >= max_retries", 0)
+	sqlMock.ExpectQuery("SELECT \\* FROM `t_data_service_cleanup_tasks`").WillReturnRows(sqlmock.NewRows(cleanupTaskColumns).
+		AddRow(1, "job-1", "doris_table", common.TaskStatusPending, 0, 3, "", nil).
+		AddRow(2, "job-2", "doris_table", common.TaskStatusPending, 0, 3, "", nil))
+	// 按顺序匹配：第二个任务在第一个任务完成、槽位释放后才领取
+	expectCleanupUpdate(sqlMock, "WHERE id = \\? AND status IN", 1)
+	expectCleanupUpdate(sqlMock, "SET `lease_expires`=\\?,`updated_at`=\\? WHERE id = \\? AND status = \\? AND lease_owner = \\?", 1,
+		sqlmock.AnyArg(), sqlmock.AnyArg(), uint(1), common.TaskStatusRunning, worker.owner)
+	expectCleanupUpdate(sqlMock, "WHERE id = \\? AND lease_owner = \\?", 1)
+	expectCleanupUpdate(sqlMock, "WHERE id = \\? AND status IN", 1)
+	expectCleanupUpdate(sqlMock, "WHERE id = \\? AND lease_owner = \\?", 1)
+
+	worker.RunOnce()
+
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
@@ -20,7 +20,6 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
-	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -42,7 +41,8 @@ import (
 type CSVStreamingService struct {
 	chunkService *ChunkService
 	ossClient    oss.ClientInterface
-	dorisService IDorisService // 添加DorisService
+	dorisService IDorisService   // 添加DorisService
+	rejects      *RejectRecorder // 为空时无法转换的值按原逻辑写入，不记录
 }
 
 // NewCSVStreamingService 创建CSV流式读取服务
@@ -54,20 +54,55 @@ func NewCSVStreamingService(chunkService *ChunkService, ossClient oss.ClientInte
 	}
 }
 
-// StreamCSVFileFromOSS 从OSS下载CSV文件并分块流式读取
-func (s *CSVStreamingService) StreamCSVFileFromOSS(tableName, jobInstanceId string, columns []string, stream datasource.DataSourceService_ReadDataSourceStreamingServer) error {
-	// 1) 列出并排序 CSV 分片
-	bucketName := common.BATCH_DATA_BUCKET_NAME
-	keys, err := s.listAndSortCSVParts(bucketName, jobInstanceId)
-	if err != nil {
-		return fmt.Errorf("failed to list and sort csv parts: %v", err)
-	}
-	if len(keys) == 0 {
-		return fmt.Errorf("no csv parts found for jobInstanceId %s in bucket %s", jobInstanceId, bucketName)
+// StreamCSVFileFromOSS 按导出清单下载CSV分片并分块流式读取
+func (s *CSVStreamingService) StreamCSVFileFromOSS(manifest *common.JobExportManifest, tableName string, columns []string, stream datasource.DataSourceService_ReadDataSourceStreamingServer) error {
+	// 1) 只读取清单中的 CSV 分片
+	if len(manifest.Parts) == 0 {
+		return fmt.Errorf("no csv parts found for export %s in bucket %s", manifest.ExportId(), manifest.Bucket)
 	}
 
 	// 2) 分块流式读取本地文件
-	return s.streamCSVPartsSequentially(bucketName, keys, tableName, jobInstanceId, columns, stream)
+	return s.streamCSVPartsSequentially(manifest, tableName, columns, stream)
+}
+
+// SetRejectRecorder 设置被拒绝行记录器，无法转换为列类型的值写入 NULL，所在行记录到 rejects
+func (s *CSVStreamingService) SetRejectRecorder(rejects *RejectRecorder) {
+	s.rejects = rejects
+}
+
+// rowRejects 收集一批行中无法转换的值，每行只记录一次
+type rowRejects struct {
+	rows    [][]string
+	reasons map[int][]string
+}
+
+func (s *CSVStreamingService) newRowRejects(rows [][]string) *rowRejects {
+	if s.rejects == nil {
+		return nil
+	}
+	return &rowRejects{rows: rows, reasons: make(map[int][]string)}
+}
+
+// reject 返回第 rowIdx 行第 column 列的拒绝回调，未设置记录器时返回 nil
+func (r *rowRejects) reject(rowIdx int, column string) utils.ValueRejectFunc {
+	if r == nil {
+		return nil
+	}
+	return func(reason string) {
+		r.reasons[rowIdx] = append(r.reasons[rowIdx], fmt.Sprintf("column %s: %s", column, reason))
+	}
+}
+
+// flush 把收集到的被拒绝行写入记录器
+func (r *rowRejects) flush(rejects *RejectRecorder) {
+	if r == nil {
+		return
+	}
+	for rowIdx := range r.rows {
+		if reasons, ok := r.reasons[rowIdx]; ok {
+			rejects.RecordRow(strings.Join(reasons, "; "), strings.Join(r.rows[rowIdx], ","))
+		}
+	}
 }
 
 // cleanupTempFile 清理临时文件
@@ -102,7 +137,9 @@ func (s *CSVStreamingService) readChunkData(filePath string, chunk *common.Chunk
 }
 
 // streamCSVPartsSequentially 按顺序逐个处理 CSV 分片文件
-func (s *CSVStreamingService) streamCSVPartsSequentially(bucketName string, keys []string, tableName, jobInstanceId string, columns []string, stream datasource.DataSourceService_ReadDataSourceStreamingServer) error {
+func (s *CSVStreamingService) streamCSVPartsSequentially(manifest *common.JobExportManifest, tableName string, columns []string, stream datasource.DataSourceService_ReadDataSourceStreamingServer) error {
+	keys := manifest.Parts
+	jobInstanceId := manifest.JobInstanceId
 	var totalRecords int64
 	var sentData bool
 
@@ -123,7 +160,7 @@ func (s *CSVStreamingService) streamCSVPartsSequentially(bucketName string, keys
 
 		var localFilePath string
 		err := utils.WithRetryCtx(stream.Context(), 5, 200*time.Millisecond, 10*time.Second, func() error {
-			p, e := s.downloadSinglePart(stream.Context(), bucketName, key, tableName, jobInstanceId, i)
+			p, e := s.downloadSinglePart(stream.Context(), manifest.Bucket, key, tableName, manifest.ExportId(), i)
 			if e != nil {
 				return e
 			}
@@ -189,9 +226,9 @@ func (s *CSVStreamingService) streamCSVPartsSequentially(bucketName string, keys
 }
 
 // downloadSinglePart 下载单个分片文件到本地
-func (s *CSVStreamingService) downloadSinglePart(ctx context.Context, bucketName, objectName, tableName, jobInstanceId string, partIndex int) (string, error) {
+func (s *CSVStreamingService) downloadSinglePart(ctx context.Context, bucketName, objectName, tableName, exportId string, partIndex int) (string, error) {
 	tempDir := common.DATA_DIR
-	localFileName := fmt.Sprintf("csv_part_%s_%s_%d_%d.csv", tableName, jobInstanceId, partIndex, time.Now().Unix())
+	localFileName := fmt.Sprintf("csv_part_%s_%s_%d_%d.csv", tableName, exportId, partIndex, time.Now().Unix())
 	localFilePath := filepath.Join(tempDir, localFileName)
 
 	// 从OSS获取对象
@@ -468,6 +505,8 @@ func (s *CSVStreamingService) createArrowRecordFromCSV(records [][]string, schem
 	if colCount == 0 {
 		colCount = len(schema.Fields())
 	}
+	rejected := s.newRowRejects(records)
+	defer rejected.flush(s.rejects)
 
 	for colIdx := 0; colIdx < colCount; colIdx++ {
 		field := schema.Field(colIdx)
@@ -656,7 +695,7 @@ func (s *CSVStreamingService) createArrowRecordFromCSV(records [][]string, schem
 					b.AppendNull()
 					continue
 				}
-				if err := utils.AppendValueToBuilder(fieldBuilder, raw); err != nil {
+				if err := utils.AppendValueToBuilderWithRejects(fieldBuilder, raw, rejected.reject(rowIdx, field.Name)); err != nil {
 					return nil, fmt.Errorf("column %d parse time32 failed: %v", colIdx, err)
 				}
 			}
@@ -751,7 +790,7 @@ func (s *CSVStreamingService) createArrowRecordFromCSV(records [][]string, schem
 					if colIdx < len(records[rowIdx]) {
 						raw = normalizeNullToken(records[rowIdx][colIdx]) // 保持与其他类型一致的标准化
 					}
-					if err := utils.AppendValueToBuilder(fieldBuilder, raw); err != nil {
+					if err := utils.AppendValueToBuilderWithRejects(fieldBuilder, raw, rejected.reject(rowIdx, field.Name)); err != nil {
 						return fmt.Errorf("failed to append value to column %d: %v", colIdx, err)
 					}
 				}
@@ -829,8 +868,11 @@ func (s *CSVStreamingService) convertRowsToArrowRecord(rows [][]string, schema *
 		defer builders[i].Release()
 	}
 
+	rejected := s.newRowRejects(rows)
+	defer rejected.flush(s.rejects)
+
 	// 逐行处理数据
-	for _, row := range rows {
+	for rowIdx, row := range rows {
 		for i, col := range columns {
 			// 根据列名在CSV行中找到对应的值
 			colIndex, exists := headerMap[strings.ToLower(col.Name)]
@@ -842,7 +884,7 @@ func (s *CSVStreamingService) convertRowsToArrowRecord(rows [][]string, schema *
 			}
 
 			// 根据列类型转换并添加值
-			if err := s.appendValueToBuilder(builders[i], value, col.DataType); err != nil {
+			if err := s.appendValueToBuilder(builders[i], value, col.DataType, rejected.reject(rowIdx, col.Name)); err != nil {
 				return nil, fmt.Errorf("failed to append value to column %s: %v", col.Name, err)
 			}
 		}
@@ -858,17 +900,23 @@ func (s *CSVStreamingService) convertRowsToArrowRecord(rows [][]string, schema *
 	return array.NewRecord(schema, arrays, int64(len(rows))), nil
 }
 
-// appendValueToBuilder 将值添加到对应的数组构建器中
-func (s *CSVStreamingService) appendValueToBuilder(builder array.Builder, value string, dataType string) error {
+// appendValueToBuilder 将值添加到对应的数组构建器中，无法转换的值写入 NULL，reject 不为空时记录原因
+func (s *CSVStreamingService) appendValueToBuilder(builder array.Builder, value string, dataType string, reject utils.ValueRejectFunc) error {
+	rejectValue := func(err error) {
+		builder.AppendNull()
+		if reject != nil {
+			reject(fmt.Sprintf("invalid %s value %q: %v", dataType, value, err))
+		}
+	}
 	switch strings.ToUpper(dataType) {
 	case "INT", "INTEGER":
 		if value == "" {
 			builder.(*array.Int32Builder).AppendNull()
 		} else {
-			if intVal, err := strconv.Atoi(value); err == nil {
+			if intVal, err := strconv.ParseInt(value, 10, 32); err == nil {
 				builder.(*array.Int32Builder).Append(int32(intVal))
 			} else {
-				builder.(*array.Int32Builder).AppendNull()
+				rejectValue(err)
 			}
 		}
 	case "BIGINT":
@@ -878,7 +926,7 @@ func (s *CSVStreamingService) appendValueToBuilder(builder array.Builder, value
 			if intVal, err := strconv.ParseInt(value, 10, 64); err == nil {
 				builder.(*array.Int64Builder).Append(intVal)
 			} else {
-				builder.(*array.Int64Builder).AppendNull()
+				rejectValue(err)
 			}
 		}
 	case "VARCHAR", "STRING", "TEXT":
@@ -890,7 +938,7 @@ func (s *CSVStreamingService) appendValueToBuilder(builder array.Builder, value
 			if floatVal, err := strconv.ParseFloat(value, 64); err == nil {
 				builder.(*array.Float64Builder).Append(floatVal)
 			} else {
-				builder.(*array.Float64Builder).AppendNull()
+				rejectValue(err)
 			}
 		}
 	case "BOOLEAN", "BOOL":
@@ -908,44 +956,6 @@ func (s *CSVStreamingService) appendValueToBuilder(builder array.Builder, value
 	return nil
 }
 
-// listAndSortCSVParts 列出 jobInstanceId 下的 CSV 分片并按文件名末尾数字升序排序
-func (s *CSVStreamingService) listAndSortCSVParts(bucketName, jobInstanceId string) ([]string, error) {
-	prefix := fmt.Sprintf("%s/", jobInstanceId)
-	keys, err := s.ossClient.ListObjects(context.Background(), bucketName, prefix, true)
-	if err != nil {
-		return nil, err
-	}
-
-	// 只匹配文件名末尾的数字，不管前缀是什么
-	re := regexp.MustCompile(`(\d+)\.csv$`)
-
-	type item struct {
-		key   string
-		index int
-	}
-	var parts []item
-	for _, k := range keys {
-		m := re.FindStringSubmatch(k)
-		if len(m) == 0 {
-			continue
-		}
-		n, err := strconv.Atoi(m[1])
-		if err != nil {
-			continue
-		}
-		parts = append(parts, item{key: k, index: n})
-	}
-
-	sort.Slice(parts, func(i, j int) bool { return parts[i].index < parts[j].index })
-
-	out := make([]string, 0, len(parts))
-	for _, p := range parts {
-		out = append(out, p.key)
-	}
-	log.Logger.Infof("Found %d csv parts for jobInstanceId %s", len(out), jobInstanceId)
-	return out, nil
-}
-
 // debugLogFirstLines 调试打印文件的前 n 行（不改变原有读取流程）
 func (s *CSVStreamingService) debugLogFirstLines(filePath string, n int) {
 	f, err := os.Open(filePath)
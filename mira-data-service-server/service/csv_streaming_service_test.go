@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"runtime"
 	"strings"
@@ -435,6 +436,33 @@ func TestCSVStreamingService_convertDorisTypeToArrowType_Decimal(t *testing.T) {
 	}
 }
 
+func TestCSVStreamingService_convertRowsToArrowRecord_RecordsRejects(t *testing.T) {
+	uploaded := mockRejectsClient(t)
+	s := &CSVStreamingService{}
+	rejects := NewRejectRecorder("read_x")
+	s.SetRejectRecorder(rejects)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "score", Type: arrow.PrimitiveTypes.Float64},
+	}, nil)
+	columns := []*ds.ColumnItem{{Name: "id", DataType: "INT"}, {Name: "score", DataType: "DOUBLE"}}
+	rows := [][]string{{"1", "9.5"}, {"x", "y"}, {"3", ""}}
+
+	record, err := s.convertRowsToArrowRecord(rows, schema, columns, []string{"id", "score"})
+	require.NoError(t, err)
+	defer record.Release()
+	assert.Equal(t, int64(3), record.NumRows())
+	assert.True(t, record.Column(0).IsNull(1))
+
+	// 一行多个值无法转换时只记录一次
+	assert.Equal(t, int64(1), rejects.Rejected())
+	_, err = rejects.Save(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, string(*uploaded), `invalid INT value ""x""`)
+	assert.Contains(t, string(*uploaded), "x,y")
+}
+
 func Test_cleanCSVRecord_RemovesLineBreaksInPlace(t *testing.T) {
 	tests := []struct {
 		name string
@@ -2,13 +2,16 @@ package service
 
 import (
 	"bytes"
+	"context"
 	"data-service/common"
 	"data-service/config"
 	"data-service/generated/datasource"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -33,6 +36,7 @@ type DataWriteService struct {
 	dorisService IDorisService
 	initOnce     sync.Once
 	initError    error
+	streamLoad   *StreamLoadClient // 为空时按配置创建
 }
 
 // NewDataWriteService 创建数据写入服务
@@ -42,6 +46,10 @@ func NewDataWriteService() (*DataWriteService, error) {
 
 // 1. 主要的流处理函数
 func (s *DataWriteService) ProcessWriteStream(stream grpc.ClientStreamingServer[datasource.WriteRequest, datasource.WriteResponse]) error {
+	if s.streamLoad != nil || config.GetConfigMap().StreamLoad.Enabled {
+		return s.processWriteStreamLoad(stream)
+	}
+
 	// 初始化流处理器
 	processor, err := s.createStreamProcessor()
 	if err != nil {
@@ -55,14 +63,17 @@ func (s *DataWriteService) ProcessWriteStream(stream grpc.ClientStreamingServer[
 	}
 
 	// 导入数据到Doris
-	if err := s.importToDoris(processor); err != nil {
+	rejected, err := s.importToDoris(processor)
+	if err != nil {
 		return fmt.Errorf("failed to import to Doris: %v", err)
 	}
 
 	// 返回成功响应
 	return stream.SendAndClose(&datasource.WriteResponse{
-		Success: true,
-		Message: fmt.Sprintf("Successfully imported data to table %s.%s", processor.dbName, processor.tableName),
+		Success:       true,
+		Message:       fmt.Sprintf("Successfully imported data to table %s.%s", processor.dbName, processor.tableName),
+		RejectedRows:  rejected.Rows,
+		RejectsObject: rejected.Object,
 	})
 }
 
@@ -75,6 +86,7 @@ type StreamProcessor struct {
 	tableName     string
 	dbName        string
 	dorisService  IDorisService
+	tolerance     common.ErrorTolerance
 }
 
 // 3. 创建流处理器
@@ -154,8 +166,111 @@ func (s *DataWriteService) processStreamData(stream grpc.ClientStreamingServer[d
 	return nil
 }
 
+// processWriteStreamLoad 边接收边通过 Stream Load 写入 Doris，不落本地文件
+func (s *DataWriteService) processWriteStreamLoad(stream grpc.ClientStreamingServer[datasource.WriteRequest, datasource.WriteResponse]) error {
+	client := s.streamLoad
+	if client == nil {
+		client = NewStreamLoadClient(config.GetConfigMap())
+	}
+
+	processor := &StreamProcessor{}
+	var writer *StreamLoadWriter
+	var rejects *RejectRecorder
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			log.Logger.Infof("Received EOF, write stream for database finished: %s, table: %s", processor.dbName, processor.tableName)
+			break
+		}
+		if err != nil {
+			if writer != nil {
+				writer.Abort()
+			}
+			return fmt.Errorf("failed to receive request: %v", err)
+		}
+
+		if writer == nil {
+			if err := s.prepareWriteTarget(req, processor); err != nil {
+				return fmt.Errorf("failed to initialize first request: %v", err)
+			}
+			// 未指定请求标识时每次写入使用新的 label，不做去重
+			requestId := req.RequestId
+			if requestId == "" {
+				requestId = uuid.New().String()
+			}
+			label := streamLoadLabel("write", processor.dbName, processor.tableName, requestId)
+			writer = NewStreamLoadWriter(stream.Context(), client, processor.dbName, processor.tableName, processor.schema, label)
+			// 被过滤的行写入以 label 命名的对象，相同请求标识的重试覆盖同一对象
+			rejects = NewRejectRecorder(label)
+			writer.SetErrorTolerance(processor.tolerance, rejects)
+		}
+
+		if err := s.streamArrowBatch(req.ArrowBatch, writer); err != nil {
+			writer.Abort()
+			return rejectsError(rejects, fmt.Errorf("failed to process arrow batch: %v", err))
+		}
+	}
+
+	response := &datasource.WriteResponse{Success: true}
+	if writer != nil {
+		summary, err := writer.Commit()
+		if err != nil {
+			writer.Abort()
+			return rejectsError(rejects, fmt.Errorf("failed to import to Doris: %v", err))
+		}
+		response.LoadedRows = summary.LoadedRows
+		response.FilteredRows = summary.FilteredRows
+		response.UnselectedRows = summary.UnselectedRows
+		response.Label = summary.Label
+		saved := saveRejects(rejects)
+		response.RejectedRows = saved.Rows
+		response.RejectsObject = saved.Object
+	}
+	response.Message = fmt.Sprintf("Successfully imported data to table %s.%s", processor.dbName, processor.tableName)
+	return stream.SendAndClose(response)
+}
+
+// streamArrowBatch 把一个请求中的 Arrow 数据交给 Stream Load 写入器
+func (s *DataWriteService) streamArrowBatch(arrowBatch []byte, writer *StreamLoadWriter) error {
+	if len(arrowBatch) == 0 {
+		return nil
+	}
+	ipcReader, err := ipc.NewReader(bytes.NewReader(arrowBatch))
+	if err != nil {
+		return fmt.Errorf("failed to create arrow reader: %v", err)
+	}
+	defer ipcReader.Release()
+
+	for ipcReader.Next() {
+		if err := writer.Write(ipcReader.Record()); err != nil {
+			return err
+		}
+	}
+	return ipcReader.Err()
+}
+
 // 6. 初始化第一个请求
 func (s *DataWriteService) initializeFirstRequest(req *datasource.WriteRequest, processor *StreamProcessor) error {
+	if err := s.prepareWriteTarget(req, processor); err != nil {
+		return err
+	}
+
+	// 初始化 Parquet 写入器
+	parquetProps := parquet.NewWriterProperties(
+		parquet.WithCompression(compress.Codecs.Snappy),
+	)
+	arrowProps := pqarrow.DefaultWriterProps()
+
+	pw, err := pqarrow.NewFileWriter(processor.schema, processor.tempFile, parquetProps, arrowProps)
+	if err != nil {
+		return fmt.Errorf("failed to create parquet writer: %v", err)
+	}
+	processor.parquetWriter = pw
+	return nil
+}
+
+// prepareWriteTarget 校验首个请求，确认数据库存在并按 Arrow schema 建表
+func (s *DataWriteService) prepareWriteTarget(req *datasource.WriteRequest, processor *StreamProcessor) error {
 	processor.tableName = req.TableName
 	processor.dbName = req.DbName
 
@@ -165,6 +280,11 @@ func (s *DataWriteService) initializeFirstRequest(req *datasource.WriteRequest,
 	if processor.dbName == "" {
 		return fmt.Errorf("database name is required")
 	}
+	tolerance, err := common.NewErrorTolerance(req.MaxErrorRatio, req.MaxErrorRows)
+	if err != nil {
+		return err
+	}
+	processor.tolerance = tolerance
 
 	// 创建Doris服务
 	s.initOnce.Do(func() {
@@ -202,18 +322,6 @@ func (s *DataWriteService) initializeFirstRequest(req *datasource.WriteRequest,
 	if err := s.createTableInDoris(processor.dorisService, processor.dbName, processor.tableName, schema); err != nil {
 		return fmt.Errorf("failed to create table in Doris: %v", err)
 	}
-
-	// 初始化 Parquet 写入器
-	parquetProps := parquet.NewWriterProperties(
-		parquet.WithCompression(compress.Codecs.Snappy),
-	)
-	arrowProps := pqarrow.DefaultWriterProps()
-
-	pw, err := pqarrow.NewFileWriter(processor.schema, processor.tempFile, parquetProps, arrowProps)
-	if err != nil {
-		return fmt.Errorf("failed to create parquet writer: %v", err)
-	}
-	processor.parquetWriter = pw
 	return nil
 }
 
@@ -253,14 +361,14 @@ func (s *DataWriteService) processArrowBatch(arrowBatch []byte, processor *Strea
 }
 
 // 9. 导入到Doris
-func (s *DataWriteService) importToDoris(processor *StreamProcessor) error {
+func (s *DataWriteService) importToDoris(processor *StreamProcessor) (*common.RejectSummary, error) {
 	if processor.parquetWriter != nil {
 		_ = processor.parquetWriter.Close()
 	}
 	if processor.tempFile != nil {
 		_ = processor.tempFile.Close()
 	}
-	return s.importLocalFileToDoris(processor.dbName, processor.tableName, processor.filePath, processor.schema)
+	return s.importLocalFileToDoris(processor.dbName, processor.tableName, processor.filePath, processor.schema, processor.tolerance)
 }
 
 // getArrowValueAsString 将Arrow值转换为字符串
@@ -360,16 +468,17 @@ func (s *DataWriteService) createTableInDoris(dorisService IDorisService, dbName
 	return nil
 }
 
-// importLocalFileToDoris 使用doris-streamloader导入本地文件
-func (s *DataWriteService) importLocalFileToDoris(dbName, tableName, filePath string, schema *arrow.Schema) error {
+// importLocalFileToDoris 以两阶段提交导入本地 parquet 文件，被拒绝行数未超出阈值时提交，否则中止；
+// 被过滤的行写入以 label 命名的对象
+func (s *DataWriteService) importLocalFileToDoris(dbName, tableName, filePath string, schema *arrow.Schema, tolerance common.ErrorTolerance) (*common.RejectSummary, error) {
 	// 添加空指针检查
 	if schema == nil {
-		return fmt.Errorf("send arrow batch schema cannot be nil")
+		return nil, fmt.Errorf("send arrow batch schema cannot be nil")
 	}
 
 	conf := config.GetConfigMap()
 	if conf == nil {
-		return fmt.Errorf("config is nil")
+		return nil, fmt.Errorf("config is nil")
 	}
 
 	feURL := fmt.Sprintf("http://%s:%d/api/%s/%s/_stream_load",
@@ -378,7 +487,7 @@ func (s *DataWriteService) importLocalFileToDoris(dbName, tableName, filePath st
 	// label 用于幂等；使用随机函数生成
 	randomStr, err := common.GenerateRandomString(10)
 	if err != nil {
-		return fmt.Errorf("failed to generate random string for label: %v", err)
+		return nil, fmt.Errorf("failed to generate random string for label: %v", err)
 	}
 	label := fmt.Sprintf("import_%d_%s", time.Now().UnixNano(), randomStr)
 
@@ -392,9 +501,12 @@ func (s *DataWriteService) importLocalFileToDoris(dbName, tableName, filePath st
 		"-H", "Expect: 100-continue",
 		"-H", "format:parquet", // 某些版本也支持 file_type:parquet
 		"-H", fmt.Sprintf("label:%s", label),
-		"-T", filePath,
-		feURL,
+		"-H", "two_phase_commit:true",
 	}
+	if ratio := tolerance.FilterRatio(); ratio > 0 {
+		args = append(args, "-H", fmt.Sprintf("max_filter_ratio:%s", strconv.FormatFloat(ratio, 'f', -1, 64)))
+	}
+	args = append(args, "-T", filePath, feURL)
 
 	cmd := exec.Command("curl", args...)
 
@@ -407,14 +519,35 @@ func (s *DataWriteService) importLocalFileToDoris(dbName, tableName, filePath st
 	if err := cmd.Run(); err != nil {
 		log.Logger.Errorf("curl stderr: %s", stderr.String())
 		log.Logger.Errorf("curl stdout: %s", stdout.String())
-		return fmt.Errorf("curl stream load failed: %v, stderr: %s", err, stderr.String())
+		return nil, fmt.Errorf("curl stream load failed: %v, stderr: %s", err, stderr.String())
 	}
 
 	log.Logger.Infof("curl stream load output: %s", stdout.String())
 
+	// 记录被过滤的行，导入失败时同样保留
+	rejects := NewRejectRecorder(label)
+	result := &StreamLoadResult{}
+	if err := json.Unmarshal(stdout.Bytes(), result); err != nil {
+		log.Logger.Warnf("Failed to parse stream load result, rejected rows not recorded: %v", err)
+	} else if result.NumberFilteredRows > 0 || result.ErrorURL != "" {
+		rejects.RecordLoadErrors(context.Background(), result.NumberFilteredRows, result.ErrorURL)
+	}
+
 	// Doris FE 返回 JSON，沿用现有解析与校验
 	if err := checkStreamloaderOutput(stdout.String()); err != nil {
-		return err
+		return nil, rejectsError(rejects, err)
+	}
+	// 预提交后校验行数阈值，超出时中止，数据不生效
+	client := newStreamLoadClient(fmt.Sprintf("http://%s:%d", conf.DorisConfig.Address, conf.DorisConfig.Port),
+		conf.DorisConfig.User, conf.DorisConfig.Password, conf.StreamLoad.TimeoutSeconds, 0)
+	if err := tolerance.Check(result.NumberFilteredRows, result.NumberTotalRows); err != nil {
+		if abortErr := client.Abort(context.Background(), dbName, label); abortErr != nil {
+			log.Logger.Warnf("Failed to abort stream load %s: %v", label, abortErr)
+		}
+		return nil, rejectsError(rejects, fmt.Errorf("stream load %s: %v", label, err))
 	}
-	return nil
+	if err := client.Commit(context.Background(), dbName, label); err != nil {
+		return nil, rejectsError(rejects, fmt.Errorf("failed to commit stream load %s: %v", label, err))
+	}
+	return saveRejects(rejects), nil
 }
@@ -2,10 +2,10 @@ package service
 
 import (
 	"bytes"
+	"context"
 	"data-service/common"
 	"data-service/generated/datasource"
 	"data-service/mocks"
-	"encoding/csv"
 	"os"
 	"path/filepath"
 	"strings"
@@ -15,6 +15,8 @@ import (
 	"github.com/apache/arrow/go/v15/arrow/array"
 	"github.com/apache/arrow/go/v15/arrow/ipc"
 	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/apache/arrow/go/v15/parquet/file"
+	"github.com/apache/arrow/go/v15/parquet/pqarrow"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -83,32 +85,6 @@ func TestDataWriteService_ParseArrowSchema(t *testing.T) {
 	assert.Equal(t, "age", schema.Field(2).Name)
 }
 
-func TestDataWriteService_WriteCSVHeaders(t *testing.T) {
-	// 创建临时文件
-	tempFile, err := os.CreateTemp("", "test_*.csv")
-	assert.NoError(t, err)
-	defer os.Remove(tempFile.Name())
-	defer tempFile.Close()
-
-	writer := csv.NewWriter(tempFile)
-
-	processor := &StreamProcessor{
-		writer: writer,
-		schema: createTestSchema(),
-	}
-
-	service := &DataWriteService{}
-	err = service.writeCSVHeaders(processor)
-
-	assert.NoError(t, err)
-	writer.Flush()
-
-	// 验证文件内容
-	content, err := os.ReadFile(tempFile.Name())
-	assert.NoError(t, err)
-	assert.Equal(t, "id,name,age\n", string(content))
-}
-
 func TestDataWriteService_GetArrowValueAsString(t *testing.T) {
 	service := &DataWriteService{}
 
@@ -171,24 +147,28 @@ func TestDataWriteService_InitializeFirstRequest(t *testing.T) {
 	defer ctrl.Finish()
 
 	// 创建临时文件
-	tempFile, err := os.CreateTemp("", "test_*.csv")
+	tempFile, err := os.CreateTemp("", "test_*.parquet")
 	assert.NoError(t, err)
 	defer os.Remove(tempFile.Name())
 	defer tempFile.Close()
 
-	writer := csv.NewWriter(tempFile)
-
 	processor := &StreamProcessor{
-		writer: writer,
+		tempFile: tempFile,
+		filePath: tempFile.Name(),
 	}
 
-	// 创建mock服务并预先设置
+	// 创建mock服务并预先设置，跳过真实 Doris 连接
 	mockDorisService := mocks.NewMockIDorisService(ctrl)
 	service := &DataWriteService{
 		dorisService: mockDorisService, // 预先设置mock
 	}
+	service.initOnce.Do(func() {})
 
 	// 设置mock期望
+	mockDorisService.EXPECT().
+		EnsureDorisDatabaseExists("test_db").
+		Return(nil).
+		Times(1)
 	mockDorisService.EXPECT().
 		ExecuteUpdate(gomock.Any()).
 		Return(int64(1), nil).
@@ -210,10 +190,19 @@ func TestDataWriteService_InitializeFirstRequest(t *testing.T) {
 	assert.Equal(t, "test_db", processor.dbName)
 	assert.NotNil(t, processor.schema)
 	assert.NotNil(t, processor.dorisService)
+	assert.NotNil(t, processor.parquetWriter)
+	_ = processor.parquetWriter.Close()
 }
 
 func TestDataWriteService_InitializeFirstRequest_ValidationErrors(t *testing.T) {
-	service := &DataWriteService{}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// 解析数据前会先确认数据库存在
+	mockDorisService := mocks.NewMockIDorisService(ctrl)
+	mockDorisService.EXPECT().EnsureDorisDatabaseExists("test_db").Return(nil).AnyTimes()
+	service := &DataWriteService{dorisService: mockDorisService}
+	service.initOnce.Do(func() {})
 	processor := &StreamProcessor{}
 
 	// 测试空表名
@@ -250,47 +239,49 @@ func TestDataWriteService_InitializeFirstRequest_ValidationErrors(t *testing.T)
 
 func TestDataWriteService_ProcessArrowBatch(t *testing.T) {
 	// 创建临时文件
-	tempFile, err := os.CreateTemp("", "test_*.csv")
+	tempFile, err := os.CreateTemp("", "test_*.parquet")
 	assert.NoError(t, err)
 	defer os.Remove(tempFile.Name())
 	defer tempFile.Close()
 
-	writer := csv.NewWriter(tempFile)
+	pw, err := pqarrow.NewFileWriter(createTestSchema(), tempFile, nil, pqarrow.DefaultWriterProps())
+	assert.NoError(t, err)
 
 	processor := &StreamProcessor{
-		writer: writer,
-		schema: createTestSchema(),
+		tempFile:      tempFile,
+		filePath:      tempFile.Name(),
+		parquetWriter: pw,
+		schema:        createTestSchema(),
 	}
 
 	service := &DataWriteService{}
 
-	// 先写入CSV头部
-	err = service.writeCSVHeaders(processor)
-	assert.NoError(t, err)
-
 	// 创建测试数据
 	arrowData := createTestArrowData()
 
 	// 执行测试
 	err = service.processArrowBatch(arrowData, processor)
-
 	assert.NoError(t, err)
-	writer.Flush()
+	assert.NoError(t, pw.Close())
 
 	// 验证文件内容
-	content, err := os.ReadFile(tempFile.Name())
+	pf, err := file.OpenParquetFile(tempFile.Name(), false)
 	assert.NoError(t, err)
-
-	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
-	assert.Equal(t, 4, len(lines)) // 1个header + 3行数据
-
-	// 验证表头
-	assert.Equal(t, "id,name,age", lines[0])
-
-	// 验证数据行
-	assert.Equal(t, "1,Alice,25", lines[1])
-	assert.Equal(t, "2,Bob,30", lines[2])
-	assert.Equal(t, "3,Charlie,35", lines[3])
+	defer pf.Close()
+	reader, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{}, memory.NewGoAllocator())
+	assert.NoError(t, err)
+	table, err := reader.ReadTable(context.Background())
+	assert.NoError(t, err)
+	defer table.Release()
+
+	// 验证表头与数据行
+	assert.Equal(t, int64(3), table.NumRows())
+	assert.Equal(t, "id", table.Schema().Field(0).Name)
+	assert.Equal(t, "name", table.Schema().Field(1).Name)
+	assert.Equal(t, "age", table.Schema().Field(2).Name)
+	names := table.Column(1).Data().Chunk(0).(*array.String)
+	assert.Equal(t, "Alice", names.Value(0))
+	assert.Equal(t, "Charlie", names.Value(2))
 }
 
 func TestDataWriteService_CreateStreamProcessor(t *testing.T) {
@@ -306,9 +297,8 @@ func TestDataWriteService_CreateStreamProcessor(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, processor)
 	assert.NotNil(t, processor.tempFile)
-	assert.NotNil(t, processor.writer)
 	assert.True(t, strings.HasPrefix(filepath.Base(processor.filePath), "arrow_stream_"))
-	assert.True(t, strings.HasSuffix(processor.filePath, ".csv"))
+	assert.True(t, strings.HasSuffix(processor.filePath, ".parquet"))
 
 	// 清理
 	processor.cleanup()
@@ -316,16 +306,13 @@ func TestDataWriteService_CreateStreamProcessor(t *testing.T) {
 
 func TestStreamProcessor_Cleanup(t *testing.T) {
 	// 创建临时文件
-	tempFile, err := os.CreateTemp("", "test_*.csv")
+	tempFile, err := os.CreateTemp("", "test_*.parquet")
 	assert.NoError(t, err)
 	tempFile.Close()
 
-	writer := csv.NewWriter(tempFile)
-
 	processor := &StreamProcessor{
 		tempFile: tempFile,
 		filePath: tempFile.Name(),
-		writer:   writer,
 	}
 
 	// 执行清理
@@ -0,0 +1,600 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"data-service/common"
+	pb "data-service/generated/datasource"
+	"data-service/utils"
+)
+
+const (
+	// dorisBatchJobRetention 已结束的 Doris 作业在内存中保留的时长，之后与 Spark 作业一样从 Redis 和作业历史查询
+	dorisBatchJobRetention = time.Hour
+	// batchHashColumnName ADD_HASH_COLUMN 新增的哈希列名
+	batchHashColumnName = "hash"
+)
+
+// dorisBatchExecutor 在 Doris 中以 SQL 执行作业，结果写入与 Spark 作业相同的位置：
+// QUERY/COUNT/GROUPBY_COUNT 的结果行以 JSON 写入 Redis，键为 jobId（RedisClient 统一加 job_result: 前缀），
+// SORT/PSI_JOIN 写入 mira_task_tmp 中的 targetTable，JOIN/ADD_HASH_COLUMN 写入 tempTable
+type dorisBatchExecutor struct {
+	s               *k8sService
+	newDorisService func() (IDorisService, error)
+	jobs            sync.Map // jobId -> *dorisBatchJob
+}
+
+// dorisBatchJob Doris 作业的运行状态
+type dorisBatchJob struct {
+	mu     sync.Mutex
+	status pb.JobStatus
+	err    string
+	cancel context.CancelFunc
+}
+
+// dorisBatchInputs 导入 Doris 的作业输入表
+type dorisBatchInputs struct {
+	source  string   // 源表
+	objects []string // dataObject/inObjects 对应的表
+}
+
+func newDorisBatchExecutor(s *k8sService, newDorisService func() (IDorisService, error)) *dorisBatchExecutor {
+	return &dorisBatchExecutor{s: s, newDorisService: newDorisService}
+}
+
+func (e *dorisBatchExecutor) Name() string {
+	return batchBackendDoris
+}
+
+func (e *dorisBatchExecutor) Supports(job *BatchJob) bool {
+	spec := job.Spec
+	switch spec.GetMode() {
+	case pb.OperationMode_OPERATION_MODE_QUERY,
+		pb.OperationMode_OPERATION_MODE_COUNT,
+		pb.OperationMode_OPERATION_MODE_GROUPBY_COUNT:
+		return true
+	case pb.OperationMode_OPERATION_MODE_ADD_HASH_COLUMN:
+		return len(spec.GetJoinColumns()) > 0 && spec.GetTempTable() != ""
+	case pb.OperationMode_OPERATION_MODE_SORT:
+		return spec.GetDataObject() != "" && spec.GetTargetTable() != ""
+	case pb.OperationMode_OPERATION_MODE_JOIN:
+		return spec.GetDataObject() != "" && len(spec.GetJoinColumns()) > 0
+	case pb.OperationMode_OPERATION_MODE_PSI_JOIN:
+		return len(spec.GetInObjects()) > 0 && len(spec.GetJoinColumns()) > 0 && spec.GetTargetTable() != ""
+	default:
+		return false
+	}
+}
+
+func (e *dorisBatchExecutor) Submit(ctx context.Context, job *BatchJob) error {
+	runCtx, cancel := context.WithCancel(context.Background())
+	state := &dorisBatchJob{status: pb.JobStatus_JOB_STATUS_PENDING, cancel: cancel}
+	if _, loaded := e.jobs.LoadOrStore(job.JobId, state); loaded {
+		cancel()
+		return fmt.Errorf("batch job %s already exists", job.JobId)
+	}
+	e.s.logger.Infof("Running batch job %s in Doris: mode=%s", job.JobId, job.Spec.GetMode())
+
+	e.s.recordSubmittedJob(job.JobId, job.Request.GetRequestId(), job.Spec.GetMode().String(), e.Name(), job.SparkConfig, job.TableInfo.GetRecordCount64())
+
+	go e.run(runCtx, job, state)
+	return nil
+}
+
+// status 返回内存中 Doris 作业的状态，未找到时返回 false
+func (e *dorisBatchExecutor) status(ctx context.Context, jobId string) (*pb.BatchResponse, bool) {
+	if e == nil {
+		return nil, false
+	}
+	v, ok := e.jobs.Load(jobId)
+	if !ok {
+		return nil, false
+	}
+	resp := v.(*dorisBatchJob).response(jobId)
+	if resp.Status == pb.JobStatus_JOB_STATUS_SUCCEEDED {
+		resp.Data, _ = e.s.jobResult(ctx, jobId)
+	}
+	return resp, true
+}
+
+// cancel 取消运行中的 Doris 作业，返回作业当前状态；未找到时返回 false
+func (e *dorisBatchExecutor) cancel(jobId, reason string) (*pb.BatchResponse, bool) {
+	if e == nil {
+		return nil, false
+	}
+	v, ok := e.jobs.Load(jobId)
+	if !ok {
+		return nil, false
+	}
+	state := v.(*dorisBatchJob)
+	if state.finish(pb.JobStatus_JOB_STATUS_CANCELLED, reason) {
+		state.cancel()
+	}
+	return state.response(jobId), true
+}
+
+func (e *dorisBatchExecutor) run(ctx context.Context, job *BatchJob, state *dorisBatchJob) {
+	defer state.cancel()
+	defer func() {
+		time.AfterFunc(dorisBatchJobRetention, func() { e.jobs.Delete(job.JobId) })
+	}()
+
+	if !state.start() {
+		return
+	}
+	e.s.updateHistory(job.JobId, map[string]interface{}{"status": pb.JobStatus_JOB_STATUS_RUNNING.String()})
+
+	if err := e.execute(ctx, job); err != nil {
+		if state.finish(pb.JobStatus_JOB_STATUS_FAILED, err.Error()) {
+			e.s.logger.Errorf("Batch job %s failed in Doris: %v", job.JobId, err)
+			e.s.finishHistory(job.JobId, pb.JobStatus_JOB_STATUS_FAILED, err.Error())
+		}
+		return
+	}
+	if state.finish(pb.JobStatus_JOB_STATUS_SUCCEEDED, "") {
+		e.s.logger.Infof("Batch job %s completed successfully in Doris", job.JobId)
+		e.s.finishHistory(job.JobId, pb.JobStatus_JOB_STATUS_SUCCEEDED, "")
+	}
+}
+
+// execute 导入输入表、执行操作 SQL 并写出结果，结束后删除作业创建的 Doris 表
+func (e *dorisBatchExecutor) execute(ctx context.Context, job *BatchJob) error {
+	dorisService, err := e.newDorisService()
+	if err != nil {
+		return fmt.Errorf("failed to connect to doris: %v", err)
+	}
+	defer dorisService.Close()
+
+	prefix := dorisBatchTablePrefix(job.JobId)
+	defer func() {
+		if err := dorisService.CleanDorisTableWithPrefix(prefix); err != nil {
+			e.s.logger.Warnf("Failed to clean doris tables of batch job %s: %v", job.JobId, err)
+		}
+	}()
+
+	inputs, err := e.loadInputs(ctx, dorisService, job, prefix)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	spec := job.Spec
+	switch spec.GetMode() {
+	case pb.OperationMode_OPERATION_MODE_QUERY,
+		pb.OperationMode_OPERATION_MODE_COUNT,
+		pb.OperationMode_OPERATION_MODE_GROUPBY_COUNT:
+		query, err := dorisBatchResultSQL(job, inputs.source)
+		if err != nil {
+			return err
+		}
+		return e.writeResult(ctx, dorisService, job.JobId, query)
+	}
+
+	var query, outputTable string
+	var orderBy []string
+	if spec.GetOrderByColumn() != "" {
+		orderBy = []string{spec.GetOrderByColumn()}
+	}
+	switch spec.GetMode() {
+	case pb.OperationMode_OPERATION_MODE_SORT:
+		query = "SELECT * FROM " + inputs.objects[0]
+		outputTable = spec.GetTargetTable()
+	case pb.OperationMode_OPERATION_MODE_JOIN:
+		leftColumns, err := dorisTableColumns(dorisService, inputs.source)
+		if err != nil {
+			return err
+		}
+		rightColumns, err := dorisTableColumns(dorisService, inputs.objects[0])
+		if err != nil {
+			return err
+		}
+		query = dorisJoinSQL(inputs.source, inputs.objects[0], leftColumns, rightColumns, spec.GetJoinColumns(), spec.GetJoinType())
+		outputTable = spec.GetTempTable()
+	case pb.OperationMode_OPERATION_MODE_ADD_HASH_COLUMN:
+		op := job.Request.GetAddHashColumn()
+		query, err = dorisHashColumnSQL(inputs.source, spec.GetDbFields(), spec.GetJoinColumns(), op.GetHashAlgorithm(), op.GetSalt())
+		if err != nil {
+			return err
+		}
+		outputTable = spec.GetTempTable()
+	case pb.OperationMode_OPERATION_MODE_PSI_JOIN:
+		query = dorisIntersectSQL(inputs.objects, spec.GetJoinColumns())
+		outputTable = spec.GetTargetTable()
+	default:
+		return fmt.Errorf("operation mode %s is not supported by doris executor", spec.GetMode())
+	}
+	if outputTable == "" {
+		return fmt.Errorf("output table of %s is empty", spec.GetMode())
+	}
+	if spec.GetMode() != pb.OperationMode_OPERATION_MODE_SORT && spec.GetMode() != pb.OperationMode_OPERATION_MODE_PSI_JOIN {
+		orderBy = nil
+	}
+	return e.writeTable(ctx, dorisService, prefix+"_result", outputTable, query, orderBy)
+}
+
+// loadInputs 将源表和 MinIO 数据文件导入 Doris
+func (e *dorisBatchExecutor) loadInputs(ctx context.Context, dorisService IDorisService, job *BatchJob, prefix string) (*dorisBatchInputs, error) {
+	spec := job.Spec
+	inputs := &dorisBatchInputs{}
+
+	switch spec.GetMode() {
+	case pb.OperationMode_OPERATION_MODE_SORT, pb.OperationMode_OPERATION_MODE_PSI_JOIN:
+		// 只读取数据文件
+	default:
+		var err error
+		switch src := job.Request.GetDataSource().(type) {
+		case *pb.BatchReadRequest_External:
+			inputs.source, err = dorisService.CreateExternalAndInternalTableAndImportData(src.External.AssetName, src.External.ChainInfoId,
+				src.External.Alias, prefix, prefix+"_source", common.MIRA_TMP_TASK_DB)
+		case *pb.BatchReadRequest_Internal:
+			inputs.source, err = dorisService.ImportDeployedTableToDoris(job.ConnInfo.GetDbName(), job.ConnInfo.GetTableName(), prefix+"_source")
+		default:
+			return nil, fmt.Errorf("未指定数据源类型")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to import source table to doris: %v", err)
+		}
+	}
+
+	objects := spec.GetInObjects()
+	if spec.GetMode() != pb.OperationMode_OPERATION_MODE_PSI_JOIN && spec.GetDataObject() != "" {
+		objects = []string{spec.GetDataObject()}
+	}
+	for i, object := range objects {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		tableName := fmt.Sprintf("%s_object_%d", prefix, i)
+		if err := dorisService.ImportArrowFileToDoris(spec.GetBucketName(), object, tableName, common.MIRA_TMP_TASK_DB); err != nil {
+			return nil, fmt.Errorf("failed to import data object %s to doris: %v", object, err)
+		}
+		inputs.objects = append(inputs.objects, tableName)
+	}
+	return inputs, nil
+}
+
+// writeResult 执行查询并将结果行以 JSON 写入 Redis
+func (e *dorisBatchExecutor) writeResult(ctx context.Context, dorisService IDorisService, jobId, query string) error {
+	if e.s.redisClient == nil {
+		return fmt.Errorf("redis client is not available")
+	}
+	rows, done, err := dorisService.ExecuteSQL(query)
+	if err != nil {
+		return fmt.Errorf("failed to execute batch query: %v", err)
+	}
+	if done != nil {
+		defer done()
+	} else if rows != nil {
+		defer rows.Close()
+	}
+
+	result, err := dorisRowsToJSON(rows)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	// 与 Spark 作业相同，RedisClient 写入 job_result:<jobId>
+	if err := e.s.redisClient.Set(ctx, jobId, result, 0).Err(); err != nil {
+		return fmt.Errorf("failed to save job result to redis: %v", err)
+	}
+	return nil
+}
+
+// writeTable 在 Doris 中物化查询结果，再写入 mira_task_tmp 中的输出表。
+// CTAS 不保留行顺序，orderBy 在写入输出表时排序
+func (e *dorisBatchExecutor) writeTable(ctx context.Context, dorisService IDorisService, resultTable, outputTable, query string, orderBy []string) error {
+	createSQL := fmt.Sprintf(`CREATE TABLE %s PROPERTIES ("replication_num" = "1") AS %s`, resultTable, query)
+	if _, err := dorisService.ExecuteUpdate(createSQL); err != nil {
+		return fmt.Errorf("failed to create result table: %v", err)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	columns, err := dorisService.GetDorisTableSchema(common.MIRA_TMP_TASK_DB, resultTable)
+	if err != nil {
+		return fmt.Errorf("failed to get result table schema: %v", err)
+	}
+	err = dorisService.ExportDorisDataToMiraDB(&pb.ExportDorisDataToMiraDBRequest{
+		TableName:       outputTable,
+		SourceTableName: resultTable,
+		Columns:         columns,
+		OrderByColumns:  orderBy,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write result to table %s: %v", outputTable, err)
+	}
+	return nil
+}
+
+// dorisBatchResultSQL 构建结果写入 Redis 的操作 SQL
+func dorisBatchResultSQL(job *BatchJob, source string) (string, error) {
+	switch job.Spec.GetMode() {
+	case pb.OperationMode_OPERATION_MODE_QUERY:
+		return utils.BuildQueryWithOrder(source, job.Spec.GetDbFields(), job.Spec.GetSortRules()), nil
+	case pb.OperationMode_OPERATION_MODE_COUNT:
+		return fmt.Sprintf("SELECT COUNT(*) AS `count` FROM %s", source), nil
+	case pb.OperationMode_OPERATION_MODE_GROUPBY_COUNT:
+		fields := job.Request.GetGroupbyCount().GetGroupByFields()
+		if len(fields) == 0 {
+			return "", fmt.Errorf("groupByFields is required")
+		}
+		groupBy := quoteDorisIdentifiers(fields)
+		return fmt.Sprintf("SELECT %s, COUNT(*) AS `count` FROM %s GROUP BY %s", groupBy, source, groupBy), nil
+	default:
+		return "", fmt.Errorf("operation mode %s does not produce a job result", job.Spec.GetMode())
+	}
+}
+
+// dorisJoinSQL 构建源表与数据文件的关联 SQL，关联列只保留一份，右表重名列加 _right 后缀
+func dorisJoinSQL(left, right string, leftColumns, rightColumns, joinColumns []string, joinType pb.JoinType) string {
+	isJoinColumn := make(map[string]bool, len(joinColumns))
+	conditions := make([]string, 0, len(joinColumns))
+	for _, col := range joinColumns {
+		isJoinColumn[col] = true
+		conditions = append(conditions, fmt.Sprintf("l.%s = r.%s", quoteDorisIdentifier(col), quoteDorisIdentifier(col)))
+	}
+
+	joinKeyword := "INNER JOIN"
+	switch joinType {
+	case pb.JoinType_JOIN_TYPE_LEFT:
+		joinKeyword = "LEFT JOIN"
+	case pb.JoinType_JOIN_TYPE_RIGHT:
+		joinKeyword = "RIGHT JOIN"
+	case pb.JoinType_JOIN_TYPE_OUTER:
+		joinKeyword = "FULL OUTER JOIN"
+	}
+
+	leftNames := make(map[string]bool, len(leftColumns))
+	var fields []string
+	for _, col := range leftColumns {
+		leftNames[col] = true
+		quoted := quoteDorisIdentifier(col)
+		if !isJoinColumn[col] {
+			fields = append(fields, "l."+quoted)
+			continue
+		}
+		switch joinType {
+		case pb.JoinType_JOIN_TYPE_RIGHT:
+			fields = append(fields, fmt.Sprintf("r.%s AS %s", quoted, quoted))
+		case pb.JoinType_JOIN_TYPE_OUTER:
+			fields = append(fields, fmt.Sprintf("COALESCE(l.%s, r.%s) AS %s", quoted, quoted, quoted))
+		default:
+			fields = append(fields, "l."+quoted)
+		}
+	}
+	for _, col := range rightColumns {
+		if isJoinColumn[col] {
+			continue
+		}
+		if leftNames[col] {
+			fields = append(fields, fmt.Sprintf("r.%s AS %s", quoteDorisIdentifier(col), quoteDorisIdentifier(col+"_right")))
+		} else {
+			fields = append(fields, "r."+quoteDorisIdentifier(col))
+		}
+	}
+
+	return fmt.Sprintf("SELECT %s FROM %s l %s %s r ON %s",
+		strings.Join(fields, ", "), left, joinKeyword, right, strings.Join(conditions, " AND "))
+}
+
+// dorisHashColumnSQL 构建新增哈希列的 SQL，哈希值为 盐值+join字段拼接 的小写十六进制摘要，
+// 与 utils.HashValue(concat(joinColumns), salt, algorithm) 逐字节一致，NULL 按空串拼接
+func dorisHashColumnSQL(source string, fields, hashColumns []string, algorithm, salt string) (string, error) {
+	algorithm, err := utils.NormalizeHashAlgorithm(algorithm)
+	if err != nil {
+		return "", err
+	}
+	columns := "*"
+	if len(fields) > 0 {
+		columns = quoteDorisIdentifiers(fields)
+	}
+	input := fmt.Sprintf("CONCAT_WS('', %s)", quoteDorisIdentifiers(hashColumns))
+	if salt != "" {
+		// 盐值以十六进制传入，避免转义问题
+		input = fmt.Sprintf("CONCAT(UNHEX('%s'), %s)", hex.EncodeToString([]byte(salt)), input)
+	}
+	digest := fmt.Sprintf("SHA2(%s, 256)", input)
+	if algorithm == "sm3" {
+		digest = fmt.Sprintf("SM3(%s)", input)
+	}
+	return fmt.Sprintf("SELECT %s, %s AS %s FROM %s", columns, digest, quoteDorisIdentifier(batchHashColumnName), source), nil
+}
+
+// dorisIntersectSQL 构建数据文件按关联列求交的 SQL，与 Spark 求交一致对结果行去重，排序在写入输出表时进行
+func dorisIntersectSQL(tables, joinColumns []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "SELECT DISTINCT t0.* FROM %s t0", tables[0])
+	for i := 1; i < len(tables); i++ {
+		conditions := make([]string, 0, len(joinColumns))
+		for _, col := range joinColumns {
+			conditions = append(conditions, fmt.Sprintf("t0.%s = t%d.%s", quoteDorisIdentifier(col), i, quoteDorisIdentifier(col)))
+		}
+		fmt.Fprintf(&b, " LEFT SEMI JOIN %s t%d ON %s", tables[i], i, strings.Join(conditions, " AND "))
+	}
+	return b.String()
+}
+
+// dorisTableColumns 查询 mira_task_tmp 中 Doris 表的列名
+func dorisTableColumns(dorisService IDorisService, tableName string) ([]string, error) {
+	columns, err := dorisService.GetDorisTableSchema(common.MIRA_TMP_TASK_DB, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema of %s: %v", tableName, err)
+	}
+	names := make([]string, 0, len(columns))
+	for _, col := range columns {
+		names = append(names, col.Name)
+	}
+	return names, nil
+}
+
+// dorisRowsToJSON 将查询结果转换为与 Spark 作业相同格式的 JSON 数组（Dataset.toJSON 逐行收集）：
+// 每行一个对象，字段按列顺序输出，NULL 字段省略，整数、浮点和 DECIMAL 输出为 JSON 数字，其余输出为字符串
+func dorisRowsToJSON(rows *sql.Rows) (string, error) {
+	if rows == nil {
+		return "[]", nil
+	}
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return "", fmt.Errorf("failed to get result columns: %v", err)
+	}
+	keys := make([][]byte, len(columnTypes))
+	numeric := make([]bool, len(columnTypes))
+	for i, ct := range columnTypes {
+		keys[i], _ = marshalSparkJSON(ct.Name())
+		numeric[i] = isNumericDorisType(ct.DatabaseTypeName())
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	values := make([]interface{}, len(columnTypes))
+	pointers := make([]interface{}, len(columnTypes))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	for n := 0; rows.Next(); n++ {
+		if err := rows.Scan(pointers...); err != nil {
+			return "", fmt.Errorf("failed to scan result row: %v", err)
+		}
+		if n > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('{')
+		written := 0
+		for i, v := range values {
+			value, ok, err := sparkJSONValue(v, numeric[i])
+			if err != nil {
+				return "", fmt.Errorf("failed to marshal column %s: %v", columnTypes[i].Name(), err)
+			}
+			if !ok {
+				continue
+			}
+			if written > 0 {
+				buf.WriteByte(',')
+			}
+			buf.Write(keys[i])
+			buf.WriteByte(':')
+			buf.Write(value)
+			written++
+		}
+		buf.WriteByte('}')
+	}
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("failed to read result rows: %v", err)
+	}
+	buf.WriteByte(']')
+	return buf.String(), nil
+}
+
+// sparkJSONValue 将扫描出的值编码为 JSON，NULL 返回 false
+func sparkJSONValue(v interface{}, numeric bool) ([]byte, bool, error) {
+	switch val := v.(type) {
+	case nil:
+		return nil, false, nil
+	case []byte:
+		if numeric && isJSONNumber(string(val)) {
+			return val, true, nil
+		}
+		data, err := marshalSparkJSON(string(val))
+		return data, true, err
+	case time.Time:
+		data, err := marshalSparkJSON(val.Format("2006-01-02 15:04:05"))
+		return data, true, err
+	default:
+		data, err := marshalSparkJSON(val)
+		return data, true, err
+	}
+}
+
+// marshalSparkJSON 与 Spark 一致不转义 <、>、&
+func marshalSparkJSON(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// isNumericDorisType 判断 MySQL 协议返回的列类型是否为数值类型
+func isNumericDorisType(typeName string) bool {
+	typeName = strings.ToUpper(typeName)
+	for _, t := range []string{"INT", "DECIMAL", "FLOAT", "DOUBLE"} {
+		if strings.Contains(typeName, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func isJSONNumber(s string) bool {
+	var n json.Number
+	return json.Unmarshal([]byte(s), &n) == nil
+}
+
+// dorisBatchTablePrefix 作业在 Doris 中创建的表名前缀，取作业ID的哈希使不同作业的前缀互不匹配
+func dorisBatchTablePrefix(jobId string) string {
+	sum := sha256.Sum256([]byte(jobId))
+	return "batch_" + hex.EncodeToString(sum[:8])
+}
+
+func quoteDorisIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func quoteDorisIdentifiers(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = quoteDorisIdentifier(name)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// start 作业开始运行，已取消时返回 false
+func (j *dorisBatchJob) start() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.status != pb.JobStatus_JOB_STATUS_PENDING {
+		return false
+	}
+	j.status = pb.JobStatus_JOB_STATUS_RUNNING
+	return true
+}
+
+// finish 记录作业最终状态，作业已结束时返回 false
+func (j *dorisBatchJob) finish(status pb.JobStatus, errorMessage string) bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	switch j.status {
+	case pb.JobStatus_JOB_STATUS_SUCCEEDED, pb.JobStatus_JOB_STATUS_FAILED, pb.JobStatus_JOB_STATUS_CANCELLED:
+		return false
+	}
+	j.status = status
+	j.err = errorMessage
+	return true
+}
+
+func (j *dorisBatchJob) response(jobId string) *pb.BatchResponse {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return &pb.BatchResponse{
+		Status:  j.status,
+		Error:   j.err,
+		JobId:   jobId,
+		Attempt: 1,
+	}
+}
@@ -0,0 +1,398 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"data-service/common"
+	"data-service/config"
+	"data-service/database/gorm/models"
+	pb "data-service/generated/datasource"
+	"data-service/mocks"
+	"data-service/utils"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryRedis 内存 Redis，仅实现作业结果读写
+type memoryRedis struct {
+	redis.Cmdable
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func (r *memoryRedis) Get(ctx context.Context, key string) *redis.StringCmd {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cmd := redis.NewStringCmd(ctx)
+	if v, ok := r.values[key]; ok {
+		cmd.SetVal(v)
+	} else {
+		cmd.SetErr(redis.Nil)
+	}
+	return cmd
+}
+
+func (r *memoryRedis) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.values[key] = value.(string)
+	cmd := redis.NewStatusCmd(ctx)
+	cmd.SetVal("OK")
+	return cmd
+}
+
+// newTestDorisExecutor 返回使用 mock Doris 和内存 Redis 的服务
+func newTestDorisExecutor(doris IDorisService) (*k8sService, *memoryBatchJobHistory, *memoryRedis) {
+	s, _, history := newTestK8sService()
+	store := &memoryRedis{values: make(map[string]string)}
+	s.redisClient = &RedisClient{client: store}
+	s.executorConfig = config.BatchExecutorConfig{Backend: batchBackendDoris}
+	s.dorisExecutor = newDorisBatchExecutor(s, func() (IDorisService, error) { return doris, nil })
+	return s, history, store
+}
+
+// findRecord 查询作业历史，不存在时返回空记录
+func findRecord(history *memoryBatchJobHistory, jobId string) *models.BatchJobHistory {
+	record, err := history.FindByJobID(jobId)
+	if err != nil || record == nil {
+		return &models.BatchJobHistory{}
+	}
+	return record
+}
+
+// waitForJob 等待作业结束并返回最终状态
+func waitForJob(t *testing.T, s *k8sService, jobId string) *pb.BatchResponse {
+	var resp *pb.BatchResponse
+	require.Eventually(t, func() bool {
+		var err error
+		resp, err = s.GetJobStatus(context.Background(), &pb.JobStatusRequest{JobId: jobId})
+		require.NoError(t, err)
+		return resp.Status != pb.JobStatus_JOB_STATUS_PENDING && resp.Status != pb.JobStatus_JOB_STATUS_RUNNING
+	}, 5*time.Second, 5*time.Millisecond)
+	return resp
+}
+
+func TestSelectBatchExecutor(t *testing.T) {
+	query := &pb.SparkDBConnInfo{Mode: pb.OperationMode_OPERATION_MODE_QUERY}
+	write := &pb.SparkDBConnInfo{Mode: pb.OperationMode_OPERATION_MODE_WRITE}
+	hash := &pb.SparkDBConnInfo{Mode: pb.OperationMode_OPERATION_MODE_ADD_HASH_COLUMN, TempTable: "job1_hashed", JoinColumns: []string{"id"}}
+	psi := &pb.SparkDBConnInfo{Mode: pb.OperationMode_OPERATION_MODE_PSI_JOIN, TargetTable: "job1_psi", InObjects: []string{"a.arrow"}, JoinColumns: []string{"id"}}
+	sm3Hash := &pb.BatchReadRequest{Operation: &pb.BatchReadRequest_AddHashColumn{AddHashColumn: &pb.AddHashColumnOperation{HashAlgorithm: "SM3"}}}
+	saltedHash := &pb.BatchReadRequest{Operation: &pb.BatchReadRequest_AddHashColumn{AddHashColumn: &pb.AddHashColumnOperation{Salt: "s1"}}}
+	small := &pb.TableInfoResponse{RecordCount64: 1000, RecordSize: 1 << 20}
+	large := &pb.TableInfoResponse{RecordCount64: 5000000, RecordSize: 1 << 30}
+	auto := config.BatchExecutorConfig{Backend: batchBackendAuto, DorisMaxRecordCount: 1000000, DorisMaxRecordSizeMB: 512}
+
+	tests := []struct {
+		name      string
+		conf      config.BatchExecutorConfig
+		request   *pb.BatchReadRequest
+		spec      *pb.SparkDBConnInfo
+		tableInfo *pb.TableInfoResponse
+		want      string
+	}{
+		{"auto small table", auto, nil, query, small, batchBackendDoris},
+		{"auto large table", auto, nil, query, large, batchBackendSpark},
+		{"auto size over limit", auto, nil, query, &pb.TableInfoResponse{RecordCount64: 10, RecordSize: 600 << 20}, batchBackendSpark},
+		{"auto without table info", auto, nil, query, nil, batchBackendSpark},
+		{"auto without threshold", config.BatchExecutorConfig{Backend: batchBackendAuto}, nil, query, small, batchBackendSpark},
+		{"default spark", config.BatchExecutorConfig{DorisMaxRecordCount: 1000000}, nil, query, small, batchBackendSpark},
+		{"unknown backend", config.BatchExecutorConfig{Backend: "presto", DorisMaxRecordCount: 1000000}, nil, query, small, batchBackendSpark},
+		{"auto psi join on small table", auto, nil, psi, small, batchBackendSpark},
+		{"auto hash column on small table", auto, nil, hash, small, batchBackendSpark},
+		{"auto sm3 hash column", auto, sm3Hash, hash, large, batchBackendDoris},
+		{"forced spark", config.BatchExecutorConfig{Backend: batchBackendSpark, DorisMaxRecordCount: 1000000}, nil, query, small, batchBackendSpark},
+		{"forced spark with sm3 hash", config.BatchExecutorConfig{Backend: batchBackendSpark}, sm3Hash, hash, small, batchBackendDoris},
+		{"forced spark with salted hash", config.BatchExecutorConfig{Backend: batchBackendSpark}, saltedHash, hash, small, batchBackendDoris},
+		{"forced doris", config.BatchExecutorConfig{Backend: batchBackendDoris}, nil, query, large, batchBackendDoris},
+		{"unsupported mode", config.BatchExecutorConfig{Backend: batchBackendDoris}, nil, write, small, batchBackendSpark},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _, _ := newTestK8sService()
+			s.executorConfig = tt.conf
+			s.dorisExecutor = newDorisBatchExecutor(s, nil)
+			executor, err := s.selectBatchExecutor(&BatchJob{Request: tt.request, Spec: tt.spec, TableInfo: tt.tableInfo})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, executor.Name())
+		})
+	}
+
+	s, _, _ := newTestK8sService()
+	s.executorConfig = config.BatchExecutorConfig{Backend: batchBackendDoris}
+	executor, err := s.selectBatchExecutor(&BatchJob{Spec: query})
+	require.NoError(t, err)
+	assert.Equal(t, batchBackendSpark, executor.Name(), "doris executor not configured")
+	_, err = s.selectBatchExecutor(&BatchJob{Request: sm3Hash, Spec: hash})
+	assert.Error(t, err, "spark cannot compute sm3 hash column")
+}
+
+func TestDorisBatchExecutor_CountWritesJobResult(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	doris := mocks.NewMockIDorisService(ctrl)
+
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	sqlMock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(42)))
+	rows, err := db.Query("SELECT")
+	require.NoError(t, err)
+
+	jobId := "spark-job-count1"
+	prefix := dorisBatchTablePrefix(jobId)
+	doris.EXPECT().ImportDeployedTableToDoris("mira_db", "orders", prefix+"_source").Return(prefix+"_source_internal", nil)
+	doris.EXPECT().ExecuteSQL("SELECT COUNT(*) AS `count` FROM "+prefix+"_source_internal").Return(rows, nil, nil)
+	doris.EXPECT().CleanDorisTableWithPrefix(prefix).Return(nil)
+	doris.EXPECT().Close().Return(nil)
+
+	s, history, store := newTestDorisExecutor(doris)
+	job := &BatchJob{
+		JobId:       jobId,
+		Request:     &pb.BatchReadRequest{RequestId: "count1", DataSource: &pb.BatchReadRequest_Internal{Internal: &pb.InternalDataSource{DbName: "mira_db", TableName: "orders"}}},
+		Spec:        &pb.SparkDBConnInfo{Mode: pb.OperationMode_OPERATION_MODE_COUNT},
+		ConnInfo:    &pb.ConnectionInfo{DbName: "mira_db", TableName: "orders"},
+		SparkConfig: &pb.SparkConfig{},
+	}
+	require.NoError(t, s.dorisExecutor.Submit(context.Background(), job))
+
+	resp := waitForJob(t, s, jobId)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_SUCCEEDED, resp.Status)
+	assert.JSONEq(t, `[{"count":42}]`, resp.Data)
+	assert.JSONEq(t, `[{"count":42}]`, store.values["job_result:"+jobId])
+
+	record := findRecord(history, jobId)
+	assert.Equal(t, batchBackendDoris, record.Backend)
+	assert.Empty(t, record.PodName)
+	assert.Eventually(t, func() bool {
+		return parseJobStatus(findRecord(history, jobId).Status) == pb.JobStatus_JOB_STATUS_SUCCEEDED
+	}, time.Second, 5*time.Millisecond)
+
+	// 内存状态过期后与 Spark 作业一样从 Redis 获取结果
+	s.dorisExecutor.jobs.Delete(jobId)
+	resp, err = s.GetJobStatus(context.Background(), &pb.JobStatusRequest{JobId: jobId})
+	require.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_SUCCEEDED, resp.Status)
+	assert.JSONEq(t, `[{"count":42}]`, resp.Data)
+}
+
+func TestDorisBatchExecutor_PsiJoinWritesTargetTable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	doris := mocks.NewMockIDorisService(ctrl)
+
+	jobId := "spark-job-psi1"
+	prefix := dorisBatchTablePrefix(jobId)
+	columns := []*pb.ColumnItem{{Name: "id", DataType: "VARCHAR"}, {Name: "score", DataType: "INT"}}
+	doris.EXPECT().ImportArrowFileToDoris(common.BATCH_DATA_BUCKET_NAME, "party_a.arrow", prefix+"_object_0", common.MIRA_TMP_TASK_DB).Return(nil)
+	doris.EXPECT().ImportArrowFileToDoris(common.BATCH_DATA_BUCKET_NAME, "party_b.arrow", prefix+"_object_1", common.MIRA_TMP_TASK_DB).Return(nil)
+	doris.EXPECT().ExecuteUpdate(gomock.Any()).DoAndReturn(func(query string, args ...interface{}) (int64, error) {
+		assert.Equal(t, `CREATE TABLE `+prefix+`_result PROPERTIES ("replication_num" = "1") AS `+
+			"SELECT DISTINCT t0.* FROM "+prefix+"_object_0 t0 LEFT SEMI JOIN "+prefix+"_object_1 t1 ON t0.`id` = t1.`id`", query)
+		return 0, nil
+	})
+	doris.EXPECT().GetDorisTableSchema(common.MIRA_TMP_TASK_DB, prefix+"_result").Return(columns, nil)
+	doris.EXPECT().ExportDorisDataToMiraDB(&pb.ExportDorisDataToMiraDBRequest{
+		TableName:       "job1_psi_result",
+		SourceTableName: prefix + "_result",
+		Columns:         columns,
+		OrderByColumns:  []string{"id"},
+	}).Return(nil)
+	doris.EXPECT().CleanDorisTableWithPrefix(prefix).Return(nil)
+	doris.EXPECT().Close().Return(nil)
+
+	s, history, _ := newTestDorisExecutor(doris)
+	job := &BatchJob{
+		JobId:   jobId,
+		Request: &pb.BatchReadRequest{RequestId: "psi1"},
+		Spec: &pb.SparkDBConnInfo{
+			Mode:          pb.OperationMode_OPERATION_MODE_PSI_JOIN,
+			BucketName:    common.BATCH_DATA_BUCKET_NAME,
+			InObjects:     []string{"party_a.arrow", "party_b.arrow"},
+			JoinColumns:   []string{"id"},
+			OrderByColumn: "id",
+			TargetTable:   "job1_psi_result",
+		},
+		SparkConfig: &pb.SparkConfig{},
+	}
+	require.True(t, s.dorisExecutor.Supports(job))
+	require.NoError(t, s.dorisExecutor.Submit(context.Background(), job))
+
+	resp := waitForJob(t, s, jobId)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_SUCCEEDED, resp.Status)
+	assert.Empty(t, resp.Data)
+	assert.Eventually(t, func() bool {
+		return parseJobStatus(findRecord(history, jobId).Status) == pb.JobStatus_JOB_STATUS_SUCCEEDED
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestDorisBatchExecutor_FailureAndCancel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	doris := mocks.NewMockIDorisService(ctrl)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	finished := make(chan struct{})
+	doris.EXPECT().ImportDeployedTableToDoris(gomock.Any(), "orders", gomock.Any()).Return("", errors.New("jdbc connection refused"))
+	doris.EXPECT().ImportDeployedTableToDoris(gomock.Any(), "blocked", gomock.Any()).DoAndReturn(func(dbName, tableName, tablePrefix string) (string, error) {
+		close(started)
+		<-release
+		return "", errors.New("jdbc connection refused")
+	})
+	doris.EXPECT().CleanDorisTableWithPrefix(gomock.Any()).Return(nil).Times(2)
+	doris.EXPECT().Close().Return(nil)
+	doris.EXPECT().Close().DoAndReturn(func() error {
+		close(finished)
+		return nil
+	})
+
+	s, history, _ := newTestDorisExecutor(doris)
+	newJob := func(jobId, table string) *BatchJob {
+		return &BatchJob{
+			JobId:       jobId,
+			Request:     &pb.BatchReadRequest{RequestId: jobId, DataSource: &pb.BatchReadRequest_Internal{Internal: &pb.InternalDataSource{TableName: table}}},
+			Spec:        &pb.SparkDBConnInfo{Mode: pb.OperationMode_OPERATION_MODE_QUERY},
+			ConnInfo:    &pb.ConnectionInfo{TableName: table},
+			SparkConfig: &pb.SparkConfig{},
+		}
+	}
+
+	require.NoError(t, s.dorisExecutor.Submit(context.Background(), newJob("spark-job-fail1", "orders")))
+	resp := waitForJob(t, s, "spark-job-fail1")
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_FAILED, resp.Status)
+	assert.Contains(t, resp.Error, "jdbc connection refused")
+	assert.Error(t, s.dorisExecutor.Submit(context.Background(), newJob("spark-job-fail1", "orders")), "duplicate job id")
+
+	require.NoError(t, s.dorisExecutor.Submit(context.Background(), newJob("spark-job-cancel1", "blocked")))
+	<-started
+	resp, err := s.CancelBatchJob(context.Background(), &pb.CancelBatchJobRequest{JobId: "spark-job-cancel1", Reason: "no longer needed"})
+	require.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_CANCELLED, resp.Status)
+	close(release)
+	<-finished
+
+	// 取消后执行失败不会覆盖取消状态
+	status, ok := s.dorisExecutor.status(context.Background(), "spark-job-cancel1")
+	require.True(t, ok)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_CANCELLED, status.Status)
+	assert.Equal(t, "no longer needed", status.Error)
+	assert.Eventually(t, func() bool {
+		record := findRecord(history, "spark-job-cancel1")
+		return parseJobStatus(record.Status) == pb.JobStatus_JOB_STATUS_CANCELLED && record.ErrorMessage == "no longer needed"
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestDorisJoinSQL(t *testing.T) {
+	query := dorisJoinSQL("src", "obj", []string{"id", "name", "age"}, []string{"id", "name", "city"}, []string{"id"}, pb.JoinType_JOIN_TYPE_OUTER)
+	assert.Equal(t, "SELECT COALESCE(l.`id`, r.`id`) AS `id`, l.`name`, l.`age`, r.`name` AS `name_right`, r.`city` "+
+		"FROM src l FULL OUTER JOIN obj r ON l.`id` = r.`id`", query)
+
+	query = dorisJoinSQL("src", "obj", []string{"id", "age"}, []string{"id", "city"}, []string{"id"}, pb.JoinType_JOIN_TYPE_UNKNOWN)
+	assert.True(t, strings.Contains(query, "FROM src l INNER JOIN obj r"), query)
+
+}
+
+func TestDorisHashColumnSQL(t *testing.T) {
+	query, err := dorisHashColumnSQL("src", []string{"id", "phone"}, []string{"id", "phone"}, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT `id`, `phone`, SHA2(CONCAT_WS('', `id`, `phone`), 256) AS `hash` FROM src", query)
+
+	// 盐值以十六进制传入，含引号也不会破坏 SQL
+	query, err = dorisHashColumnSQL("src", nil, []string{"phone"}, "SM3", "s'1")
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT *, SM3(CONCAT(UNHEX('732731'), CONCAT_WS('', `phone`))) AS `hash` FROM src", query)
+
+	_, err = dorisHashColumnSQL("src", nil, []string{"phone"}, "md5", "")
+	assert.Error(t, err)
+}
+
+// TestDorisHashColumn_MatchesSparkGolden 哈希值与 Spark 作业对 joinColumns 以空串拼接后 sha2(..., 256) 的输出一致，
+// 黄金数据按 Spark 的拼接规则（跳过 NULL）独立生成，SM3 结果按同一拼接规则计算
+func TestDorisHashColumn_MatchesSparkGolden(t *testing.T) {
+	data, err := os.ReadFile("testdata/spark_hash_column_golden.json")
+	require.NoError(t, err)
+	var golden struct {
+		JoinColumns []string `json:"joinColumns"`
+		Rows        []struct {
+			Values []*string `json:"values"`
+			SHA256 string    `json:"sha256"`
+			SM3    string    `json:"sm3"`
+		} `json:"rows"`
+	}
+	require.NoError(t, json.Unmarshal(data, &golden))
+	require.NotEmpty(t, golden.Rows)
+
+	// Doris 端与 Spark 使用相同的 CONCAT_WS('', ...) 拼接
+	query, err := dorisHashColumnSQL("src", nil, golden.JoinColumns, "", "")
+	require.NoError(t, err)
+	assert.Contains(t, query, "SHA2(CONCAT_WS('', `id`, `name`, `phone`), 256)")
+
+	for _, row := range golden.Rows {
+		var concat strings.Builder
+		for _, v := range row.Values {
+			if v != nil {
+				concat.WriteString(*v)
+			}
+		}
+		got, err := utils.HashValue(concat.String(), "", "sha256")
+		require.NoError(t, err)
+		assert.Equal(t, row.SHA256, got, "values: %v", row.Values)
+		got, err = utils.HashValue(concat.String(), "", "SM3")
+		require.NoError(t, err)
+		assert.Equal(t, row.SM3, got, "values: %v", row.Values)
+	}
+}
+
+func TestDorisIntersectSQL(t *testing.T) {
+	// Spark 求交结果不含重复行，LEFT SEMI JOIN 会保留左表的重复行，需去重
+	assert.Equal(t, "SELECT DISTINCT t0.* FROM a t0 LEFT SEMI JOIN b t1 ON t0.`id` = t1.`id` AND t0.`day` = t1.`day` "+
+		"LEFT SEMI JOIN c t2 ON t0.`id` = t2.`id` AND t0.`day` = t2.`day`",
+		dorisIntersectSQL([]string{"a", "b", "c"}, []string{"id", "day"}))
+}
+
+// TestDorisRowsToJSON_MatchesSparkFormat 结果与 Spark 作业 Dataset.toJSON 的输出逐字节一致：
+// 字段按列顺序，NULL 字段省略，数值与 DECIMAL 为 JSON 数字
+func TestDorisRowsToJSON_MatchesSparkFormat(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// MySQL 协议以文本返回各列
+	sqlMock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRowsWithColumnDefinition(
+		sqlmock.NewColumn("region").OfType("VARCHAR", ""),
+		sqlmock.NewColumn("count").OfType("BIGINT", int64(0)),
+		sqlmock.NewColumn("amount").OfType("DECIMAL", ""),
+		sqlmock.NewColumn("ratio").OfType("DOUBLE", float64(0)),
+		sqlmock.NewColumn("day").OfType("DATE", ""),
+	).
+		AddRow([]byte("north"), []byte("42"), []byte("1024.50"), []byte("0.25"), []byte("2024-03-01")).
+		AddRow([]byte("中\"南"), []byte("7"), nil, []byte("1e-05"), nil).
+		AddRow([]byte("a<b&c"), []byte("0"), []byte("-3.00"), nil, []byte("2024-03-02")))
+	rows, err := db.Query("SELECT")
+	require.NoError(t, err)
+	defer rows.Close()
+
+	got, err := dorisRowsToJSON(rows)
+	require.NoError(t, err)
+	// Spark: spark.sql(...).toJSON().collect() 拼接为 JSON 数组
+	const spark = `[{"region":"north","count":42,"amount":1024.50,"ratio":0.25,"day":"2024-03-01"},` +
+		`{"region":"中\"南","count":7,"ratio":1e-05},` +
+		`{"region":"a<b&c","count":0,"amount":-3.00,"day":"2024-03-02"}]`
+	assert.Equal(t, spark, got)
+
+	empty, err := dorisRowsToJSON(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "[]", empty)
+}
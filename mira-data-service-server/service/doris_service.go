@@ -20,8 +20,11 @@ import (
 	"data-service/utils"
 	"database/sql"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"os/exec"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -39,6 +42,7 @@ import (
 	"github.com/apache/arrow/go/v15/arrow"
 	"github.com/apache/arrow/go/v15/arrow/ipc"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -46,6 +50,12 @@ var (
 	resourceInitOnce   sync.Once
 )
 
+// errTooManyRejects 从外部表导入被过滤的行超出阈值，不再重试
+var errTooManyRejects = errors.New("too many rejected rows")
+
+// etlAbnormalRowsPattern SHOW LOAD 中 EtlInfo 的不合格行数
+var etlAbnormalRowsPattern = regexp.MustCompile(`dpp\.abnorm\.ALL=(\d+)`)
+
 // IDorisService Doris服务接口
 type IDorisService interface {
 	ExecuteSQL(sql string, args ...interface{}) (*sql.Rows, func(), error)
@@ -55,11 +65,15 @@ type IDorisService interface {
 	CreateExternalAndInternalTableAndImportData(assetName string, chainInfoId string, alias string, jobInstanceId string, targetTableName string, targetDbName string, columns ...string) (string, error)
 	CreateExternalAndInternalTableAndImportDataBatched(assetName string, chainInfoId string, alias string, jobInstanceId string, targetTableName string, targetDbName string, pkColumn string, batchSize int, columns ...string) (string, error)
 	ImportArrowFileToDoris(bucketName string, objectName string, tableName string, dbName string) error
-	ImportCsvFileToDoris(request *ds.ImportCsvFileToDorisRequest) error
-	ExportParquetFileFromDoris(request *ds.ExportCsvFileFromDorisRequest) error
+	ImportCsvFileToDoris(request *ds.ImportCsvFileToDorisRequest) (*common.RejectSummary, error)
+	SetErrorTolerance(tolerance common.ErrorTolerance, jobId string)
+	SaveRejects() *common.RejectSummary
+	SetSourceWindow(window *ds.ReadWindow)
+	ExportFileFromDoris(request *ds.ExportCsvFileFromDorisRequest) (*common.JobExportManifest, error)
 	ExportDorisDataToMiraDB(request *ds.ExportDorisDataToMiraDBRequest) error
 	ImportMiraDBDataToDoris(request *ds.ImportMiraDBDataToDorisRequest) (string, error)
-	ProcessDataSourceAndExport(request *ds.ReadDataSourceStreamingRequest, enhancedJobInstanceId string) (string, error)
+	ImportDeployedTableToDoris(dbName, tableName, tablePrefix string) (string, error)
+	ProcessDataSourceAndExport(request *ds.ReadDataSourceStreamingRequest, enhancedJobInstanceId string) (string, *common.JobExportManifest, error)
 	CreateExternalTableFromAsset(assetName string, chainInfoId string, alias string, jobInstanceId string, targetTableName string, targetDbName string, columnList ...string) ([]string, string, error)
 	GetDorisTableSchema(dbName, tableName string) ([]*ds.ColumnItem, error)
 	ConvertRequestToArrowSchema(request *ds.ExportDorisDataToMiraDBRequest) (*arrow.Schema, error)
@@ -74,7 +88,10 @@ type IDorisService interface {
 
 // DorisService 提供Doris数据库操作的服务
 type DorisService struct {
-	dbStrategy database.DatabaseStrategy
+	dbStrategy   database.DatabaseStrategy
+	tolerance    common.ErrorTolerance // 从外部表导入时的容错阈值
+	rejects      *RejectRecorder       // 从外部表导入时被过滤的行
+	sourceWindow *ds.ReadWindow        // 从外部表导入时的读取窗口
 }
 
 // FileInfo 文件信息结构体
@@ -127,7 +144,7 @@ func NewDorisService(dbName ...string) (IDorisService, error) {
 
 // ExecuteSQL 执行SQL语句并返回结果
 func (s *DorisService) ExecuteSQL(sql string, args ...interface{}) (*sql.Rows, func(), error) {
-	log.Logger.Infof("Executing SQL on Doris: %s", sql)
+	log.Logger.Infof("Executing SQL on Doris: %s", redactCredentials(sql))
 	s.printCurrentDatabase()
 	// 检查SQL类型（不区分大小写）
 	sqlUpper := strings.ToUpper(strings.TrimSpace(sql))
@@ -190,7 +207,7 @@ func (s *DorisService) ExecuteUpdate(sql string, args ...interface{}) (int64, er
 	//log.Logger.Infof("Executing update SQL on Doris: %s", sql)
 	log.Logger.Infow("Executing SQL on Doris",
 		"len", len(sql),
-		"preview", utils.PreviewSQL(sql, 500),
+		"preview", utils.PreviewSQL(redactCredentials(sql), 500),
 	)
 
 	// 优先使用 DorisStrategy 的专用连接（保障并发不同库隔离）
@@ -276,8 +293,19 @@ func (s *DorisService) CreateExternalResourceAndTables(config ExternalTableConfi
 
 // createExternalResource 创建外部资源
 func (s *DorisService) createExternalResource(config ExternalTableConfig) error {
-	// 构建创建外部资源的SQL
-	resourceSQL := fmt.Sprintf(`
+	// 执行创建资源SQL
+	_, err := s.ExecuteUpdate(externalResourceSQL(config))
+	if err != nil {
+		return fmt.Errorf("failed to create external resource '%s': %v", config.ResourceName, err)
+	}
+
+	log.Logger.Infof("Created external resource: %s", config.ResourceName)
+	return nil
+}
+
+// externalResourceSQL 构建创建 JDBC 外部资源的SQL
+func externalResourceSQL(config ExternalTableConfig) string {
+	return fmt.Sprintf(`
 		CREATE EXTERNAL RESOURCE IF NOT EXISTS "%s"
 		PROPERTIES (
 			"type" = "jdbc",
@@ -289,15 +317,6 @@ func (s *DorisService) createExternalResource(config ExternalTableConfig) error
 		)
 	`, config.ResourceName, config.Username, config.Password,
 		config.JdbcURL, config.DriverURL, config.DriverClass)
-
-	// 执行创建资源SQL
-	_, err := s.ExecuteUpdate(resourceSQL)
-	if err != nil {
-		return fmt.Errorf("failed to create external resource '%s': %v", config.ResourceName, err)
-	}
-
-	log.Logger.Infof("Created external resource: %s", config.ResourceName)
-	return nil
 }
 
 func (s *DorisService) dropResource(resourceName string) error {
@@ -312,6 +331,18 @@ func (s *DorisService) dropResource(resourceName string) error {
 
 // createExternalTable 创建外部表
 func (s *DorisService) createExternalTable(config ExternalTableConfig) error {
+	// 执行创建表SQL
+	_, err := s.ExecuteUpdate(externalTableSQL(config))
+	if err != nil {
+		return fmt.Errorf("failed to create external table '%s': %v", config.TableName, err)
+	}
+
+	log.Logger.Infof("Created external table: %s", config.TableName)
+	return nil
+}
+
+// externalTableSQL 构建创建 JDBC 外部表的SQL
+func externalTableSQL(config ExternalTableConfig) string {
 	// 构建列定义
 	var columnDefs []string
 	for _, col := range config.Columns {
@@ -329,7 +360,7 @@ func (s *DorisService) createExternalTable(config ExternalTableConfig) error {
 	}
 
 	// 构建创建外部表的SQL
-	tableSQL := fmt.Sprintf(`
+	return fmt.Sprintf(`
 		CREATE EXTERNAL TABLE IF NOT EXISTS %s (
 			%s
 		)
@@ -341,15 +372,6 @@ func (s *DorisService) createExternalTable(config ExternalTableConfig) error {
 		)
 	`, config.TableName, strings.Join(columnDefs, ",\n\t\t"),
 		config.ResourceName, config.SourceTableName, config.TableType)
-
-	// 执行创建表SQL
-	_, err := s.ExecuteUpdate(tableSQL)
-	if err != nil {
-		return fmt.Errorf("failed to create external table '%s': %v", config.TableName, err)
-	}
-
-	log.Logger.Infof("Created external table: %s", config.TableName)
-	return nil
 }
 
 // dropExternalResource 删除外部资源
@@ -415,6 +437,16 @@ func (s *DorisService) getAssetInfo(assetName string, chainInfoId string, alias
 		}
 	}
 
+	assetInfo, err := s.assetInfoFromConnection(connInfo, assetName, requestId, targetDbName)
+	if err != nil {
+		return nil, err
+	}
+	ok = true
+	return assetInfo, nil
+}
+
+// assetInfoFromConnection 根据数据源信息构建外部表所需的 JDBC 信息，不上传证书、不创建任何对象
+func (s *DorisService) assetInfoFromConnection(connInfo *ds.ConnectionInfo, assetName, requestId, targetDbName string) (*AssetInfo, error) {
 	// 根据数据库类型确定表类型和驱动信息
 	tableType, driverURL, driverClass := s.getDatabaseTypeInfo(connInfo.Dbtype)
 
@@ -436,7 +468,6 @@ func (s *DorisService) getAssetInfo(assetName string, chainInfoId string, alias
 	if err != nil {
 		return nil, fmt.Errorf("failed to get table columns: %v", err)
 	}
-	ok = true
 
 	return &AssetInfo{
 		JdbcURL:         jdbcURL,
@@ -835,23 +866,11 @@ func (s *DorisService) CreateExternalTableFromAsset(assetName string, chainInfoI
 	// 使用过滤后的列信息继续处理
 	assetInfo.Columns = filteredColumns
 
-	// 构建外部表配置，表名加external_前缀
-	externalTableName := fmt.Sprintf("%s_external", jobInstanceId)
+	// 构建外部表配置，表名加external_后缀
+	config := assetTableConfig(assetInfo, jobInstanceId)
+	externalTableName := config.TableName
 	internalTableName := targetTableName
-	resourceName := fmt.Sprintf("%s_resource", jobInstanceId)
-
-	config := ExternalTableConfig{
-		ResourceName:    resourceName,
-		TableName:       externalTableName,
-		JdbcURL:         assetInfo.JdbcURL,
-		DriverURL:       assetInfo.DriverURL,
-		DriverClass:     assetInfo.DriverClass,
-		Username:        assetInfo.Username,
-		Password:        assetInfo.Password,
-		SourceTableName: assetInfo.SourceTableName,
-		TableType:       assetInfo.TableType,
-		Columns:         assetInfo.Columns,
-	}
+	resourceName := config.ResourceName
 
 	log.Logger.Debugf("config: %+v", config)
 	// 导入前清理可能存在的外部表和内部表（重试的情况下）
@@ -898,6 +917,22 @@ func (s *DorisService) CreateExternalTableFromAsset(assetName string, chainInfoI
 	return columns, assetInfo.RequestId, nil
 }
 
+// assetTableConfig 构建数据资产对应的外部资源和外部表配置，名称为 jobInstanceId 加 _resource/_external 后缀
+func assetTableConfig(assetInfo *AssetInfo, jobInstanceId string) ExternalTableConfig {
+	return ExternalTableConfig{
+		ResourceName:    fmt.Sprintf("%s_resource", jobInstanceId),
+		TableName:       fmt.Sprintf("%s_external", jobInstanceId),
+		JdbcURL:         assetInfo.JdbcURL,
+		DriverURL:       assetInfo.DriverURL,
+		DriverClass:     assetInfo.DriverClass,
+		Username:        assetInfo.Username,
+		Password:        assetInfo.Password,
+		SourceTableName: assetInfo.SourceTableName,
+		TableType:       assetInfo.TableType,
+		Columns:         assetInfo.Columns,
+	}
+}
+
 // AssetInfo 数据资产信息
 type AssetInfo struct {
 	JdbcURL         string
@@ -913,14 +948,28 @@ type AssetInfo struct {
 
 // createInternalTable 创建内部表，结构与外部表一致
 func (s *DorisService) createInternalTable(config ExternalTableConfig) error {
-	// 构建列定义，添加一个独立的主键字段
-	var columnDefs []string
+	primaryKeyFieldName := autoIncrementKeyName()
+	_, err := s.ExecuteUpdate(internalTableSQL(config, primaryKeyFieldName))
+	if err != nil {
+		return fmt.Errorf("failed to create internal table '%s': %v", config.TableName, err)
+	}
+	log.Logger.Infof("Created internal table: %s with auto-increment primary key %s", config.TableName, primaryKeyFieldName)
+	return nil
+}
 
+// autoIncrementKeyName 生成内部表自增主键字段名 pk_xxxxx
+func autoIncrementKeyName() string {
 	// 使用UUID生成5位随机字符串作为主键字段名的一部分
 	uuidStr := uuid.New().String()
 	// 取UUID的前5位字符（去掉连字符）
 	randomSuffix := strings.ReplaceAll(uuidStr[:8], "-", "")[:5]
-	primaryKeyFieldName := fmt.Sprintf("pk_%s", randomSuffix)
+	return fmt.Sprintf("pk_%s", randomSuffix)
+}
+
+// internalTableSQL 构建创建内部表的SQL，结构与外部表一致，另加自增主键 primaryKeyFieldName
+func internalTableSQL(config ExternalTableConfig, primaryKeyFieldName string) string {
+	// 构建列定义，添加一个独立的主键字段
+	var columnDefs []string
 	primaryKeyField := fmt.Sprintf("`%s` BIGINT NOT NULL AUTO_INCREMENT", primaryKeyFieldName)
 	columnDefs = append(columnDefs, primaryKeyField)
 
@@ -937,7 +986,7 @@ func (s *DorisService) createInternalTable(config ExternalTableConfig) error {
 	}
 
 	// 构建创建内部表的SQL，使用主键语法
-	tableSQL := fmt.Sprintf(`
+	return fmt.Sprintf(`
 		CREATE TABLE IF NOT EXISTS %s (
 			%s
 		)
@@ -948,13 +997,6 @@ func (s *DorisService) createInternalTable(config ExternalTableConfig) error {
 			"replication_num" = "1"
 		)
 	`, config.TableName, strings.Join(columnDefs, ",\n\t\t"), primaryKeyFieldName, primaryKeyFieldName)
-
-	_, err := s.ExecuteUpdate(tableSQL)
-	if err != nil {
-		return fmt.Errorf("failed to create internal table '%s': %v", config.TableName, err)
-	}
-	log.Logger.Infof("Created internal table: %s with auto-increment primary key %s", config.TableName, primaryKeyFieldName)
-	return nil
 }
 
 // CreateExternalAndInternalTableAndImportData 根据数据资产创建external/internal表并导入数据
@@ -1011,11 +1053,22 @@ func (s *DorisService) CreateExternalAndInternalTableAndImportData(assetName str
 		qualifiedExternalTable = externalTableName
 	}
 
-	// 3. 执行数据导入
-	columnList := strings.Join(columns, ", ")
-	insertSQL := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s",
-		qualifiedInternalTable, columnList, columnList, qualifiedExternalTable)
-	_, err = s.ExecuteUpdate(insertSQL)
+	// 3. 执行数据导入。设置了分页或采样时在源库执行窗口查询并以 Stream Load 写入内部表；
+	// 否则由 Doris 读取外部表导入，过滤与排序在 Doris 中执行
+	window, err := database.NewReadWindow(s.sourceWindow.GetLimit(), s.sourceWindow.GetOffset(), s.sourceWindow.GetSample())
+	if err != nil {
+		return "", fmt.Errorf("invalid read window: %v", err)
+	}
+	if window != nil {
+		_, err = s.importSourceWindow(reqId, assetName, chainInfoId, alias, targetDbName, internalTableName, columns)
+	} else {
+		columnList := strings.Join(columns, ", ")
+		source, buildErr := (&database.SQLGenerator{}).BuildWindowSourceSQL(qualifiedExternalTable, columns, s.sourceWindow)
+		if buildErr != nil {
+			return "", fmt.Errorf("invalid read window: %v", buildErr)
+		}
+		_, err = s.insertFromExternal(targetDbName, qualifiedInternalTable, columnList, source)
+	}
 	if err != nil {
 		assetInfo, assetErr := s.getAssetInfo(assetName, chainInfoId, alias, targetDbName)
 		if assetErr == nil {
@@ -1157,8 +1210,7 @@ func (s *DorisService) CreateExternalAndInternalTableAndImportDataBatched(
 	if !minID.Valid || !maxID.Valid {
 		// 值无效，退化为一次性导入
 		columnList := strings.Join(columns, ", ")
-		insertSQL := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s", internalTableName, columnList, columnList, externalTableName)
-		if _, err := s.ExecuteUpdate(insertSQL); err != nil {
+		if _, err := s.insertFromExternal(targetDbName, internalTableName, columnList, externalTableName); err != nil {
 			return "", fmt.Errorf("failed to import (fallback single insert): %v", err)
 		}
 		return internalTableName, nil
@@ -1172,20 +1224,23 @@ func (s *DorisService) CreateExternalAndInternalTableAndImportDataBatched(
 	}
 	for start := minID.Int64; start <= maxID.Int64; start += int64(batchSize) {
 		end := start + int64(batchSize) - 1
-		insertSQL := fmt.Sprintf(
-			"INSERT INTO %s (%s) SELECT %s FROM %s WHERE %s BETWEEN %d AND %d",
-			internalTableName, columnList, columnList, externalTableName, quotedPk, start, end,
-		)
+		source := fmt.Sprintf("%s WHERE %s BETWEEN %d AND %d", externalTableName, quotedPk, start, end)
 
 		var lastErr error
 		for attempt := 1; attempt <= maxRetry; attempt++ {
-			_, lastErr = s.ExecuteUpdate(insertSQL)
-			if lastErr == nil {
+			_, lastErr = s.insertFromExternal(targetDbName, internalTableName, columnList, source)
+			if lastErr == nil || errors.Is(lastErr, errTooManyRejects) {
 				break
 			}
 			log.Logger.Warnf("Batch import failed (attempt %d/%d) for range [%d, %d]: %v", attempt, maxRetry, start, end, lastErr)
 		}
 		if lastErr != nil {
+			if errors.Is(lastErr, errTooManyRejects) {
+				// 之前的批次已写入，被拒绝行累计超出阈值时清空内部表，不保留部分数据
+				if _, err := s.ExecuteUpdate(fmt.Sprintf("TRUNCATE TABLE `%s`.`%s`", targetDbName, internalTableName)); err != nil {
+					log.Logger.Warnf("Failed to truncate %s.%s after rejected import: %v", targetDbName, internalTableName, err)
+				}
+			}
 			return "", fmt.Errorf("failed to import batch range [%d, %d] after %d attempts: %v", start, end, maxRetry, lastErr)
 		}
 	}
@@ -1193,8 +1248,140 @@ func (s *DorisService) CreateExternalAndInternalTableAndImportDataBatched(
 	return internalTableName, nil
 }
 
+// SetErrorTolerance 设置从外部表导入时的容错阈值，被过滤的行记录到以 jobId 命名的对象
+func (s *DorisService) SetErrorTolerance(tolerance common.ErrorTolerance, jobId string) {
+	s.tolerance = tolerance
+	s.rejects = NewRejectRecorder(jobId)
+}
+
+// SetSourceWindow 设置从外部数据源导入时的读取窗口，设置了分页或采样时窗口查询在源库执行
+func (s *DorisService) SetSourceWindow(window *ds.ReadWindow) {
+	s.sourceWindow = window
+}
+
+// SaveRejects 保存从外部表导入时被过滤的行，未设置容错阈值时返回空结果
+func (s *DorisService) SaveRejects() *common.RejectSummary {
+	if s.rejects == nil {
+		return &common.RejectSummary{}
+	}
+	return saveRejects(s.rejects)
+}
+
+// insertFromExternal 执行 INSERT INTO table (columns) SELECT columns FROM source。
+// 设置了容错阈值时以非严格模式导入并指定 label，导入后按 label 查询被过滤的行；
+// 比例阈值由 Doris 在导入时校验，行数阈值只能导入后校验，此时先导入临时表，未超出阈值才写入 table
+func (s *DorisService) insertFromExternal(dbName, table, columnList, source string) (int64, error) {
+	if !s.tolerance.Enabled() {
+		return s.ExecuteUpdate(insertSelectSQL(table, columnList, source))
+	}
+	if dbName == "" {
+		dbName = s.GetDBName()
+	}
+	if s.tolerance.MaxErrorRows <= 0 {
+		return s.insertWithTolerance(dbName, table, columnList, source)
+	}
+
+	var loaded int64
+	err := s.withStagingTable(table, columnList, func(stage string) error {
+		var err error
+		loaded, err = s.insertWithTolerance(dbName, stage, columnList, source)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return loaded, nil
+}
+
+// insertWithTolerance 以非严格模式执行带 label 的 INSERT ... SELECT，累计被过滤的行超出行数阈值时返回 errTooManyRejects
+func (s *DorisService) insertWithTolerance(dbName, table, columnList, source string) (int64, error) {
+	label := "insert_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	insertSQL := fmt.Sprintf("INSERT INTO %s WITH LABEL %s (%s) SELECT /*+ SET_VAR(enable_insert_strict=false, insert_max_filter_ratio=%s) */ %s FROM %s",
+		table, label, columnList, strconv.FormatFloat(s.tolerance.FilterRatio(), 'f', -1, 64), columnList, source)
+	loaded, err := s.ExecuteUpdate(insertSQL)
+	if err != nil {
+		// 过滤行超出比例时导入失败，失败信息中带有错误日志地址
+		if url := loadErrorURL(err.Error()); url != "" {
+			if s.rejects != nil {
+				s.rejects.RecordLoadErrors(context.Background(), 0, url)
+			}
+			return 0, fmt.Errorf("%w: %v", errTooManyRejects, err)
+		}
+		return 0, err
+	}
+
+	filtered, url, err := s.insertLoadErrors(dbName, label)
+	if err != nil {
+		log.Logger.Warnf("Failed to query filtered rows of insert %s: %v", label, err)
+		return loaded, nil
+	}
+	if filtered > 0 && s.rejects != nil {
+		s.rejects.RecordLoadErrors(context.Background(), filtered, url)
+		if s.tolerance.ExceedsRows(s.rejects.Rejected()) {
+			return loaded, fmt.Errorf("%w: %d rows rejected, exceeds max error rows %d",
+				errTooManyRejects, s.rejects.Rejected(), s.tolerance.MaxErrorRows)
+		}
+	}
+	return loaded, nil
+}
+
+// insertSelectSQL 构建从 source 导入 table 的 INSERT ... SELECT
+func insertSelectSQL(table, columnList, source string) string {
+	return fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s", table, columnList, columnList, source)
+}
+
+// insertLoadErrors 按 label 查询 INSERT 导入被过滤的行数和错误日志地址
+func (s *DorisService) insertLoadErrors(dbName, label string) (int64, string, error) {
+	rows, done, err := s.ExecuteSQL(fmt.Sprintf("SHOW LOAD FROM `%s` WHERE LABEL = '%s'", dbName, label))
+	if err != nil {
+		return 0, "", err
+	}
+	if done != nil {
+		defer done()
+	} else {
+		defer rows.Close()
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, "", err
+	}
+	if !rows.Next() {
+		return 0, "", fmt.Errorf("load %s not found", label)
+	}
+	values := make([]sql.NullString, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return 0, "", err
+	}
+
+	var filtered int64
+	var url string
+	for i, column := range columns {
+		switch column {
+		case "EtlInfo":
+			// 形如 dpp.abnorm.ALL=10; dpp.norm.ALL=90
+			if m := etlAbnormalRowsPattern.FindStringSubmatch(values[i].String); m != nil {
+				filtered, _ = strconv.ParseInt(m[1], 10, 64)
+			}
+		case "URL":
+			if values[i].String != "NULL" {
+				url = values[i].String
+			}
+		}
+	}
+	return filtered, url, nil
+}
+
 // ImportArrowFileToDoris 导入Arrow格式文件到Doris
 func (s *DorisService) ImportArrowFileToDoris(bucketName string, objectName string, tableName string, dbName string) error {
+	if config.GetConfigMap().StreamLoad.Enabled {
+		return s.streamArrowObjectToDoris(bucketName, objectName, tableName, dbName)
+	}
+
 	// 使用项目中定义的DATA_DIR常量
 	dataDir := common.DATA_DIR
 
@@ -1259,6 +1446,64 @@ func (s *DorisService) ImportArrowFileToDoris(bucketName string, objectName stri
 	return nil
 }
 
+// streamArrowObjectToDoris 直接从对象存储读取Arrow文件，通过 Stream Load 写入Doris，不落本地文件
+// label 由对象内容版本（ETag/VersionID）和目标表生成，重试导入同一版本时按 label 去重，对象被覆盖后重新导入
+func (s *DorisService) streamArrowObjectToDoris(bucketName, objectName, tableName, dbName string) error {
+	conf := config.GetConfigMap()
+	ossClient, err := oss.NewOSSFactory(conf).NewOSSClient()
+	if err != nil {
+		return fmt.Errorf("failed to create OSS client: %v", err)
+	}
+	info, err := ossClient.StatObject(context.Background(), bucketName, objectName)
+	if err != nil {
+		return fmt.Errorf("failed to stat object %s/%s: %v", bucketName, objectName, err)
+	}
+	object, err := ossClient.GetObject(context.Background(), bucketName, objectName, &oss.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get object from MinIO: %v", err)
+	}
+	defer object.Close()
+
+	// Arrow 文件格式的 schema 和记录索引在文件末尾，需要随机读取
+	readerAt, ok := object.(ipc.ReadAtSeeker)
+	if !ok {
+		return fmt.Errorf("object %s/%s does not support random access", bucketName, objectName)
+	}
+	reader, err := ipc.NewFileReader(readerAt)
+	if err != nil {
+		return fmt.Errorf("failed to create Arrow file reader: %v", err)
+	}
+	defer reader.Close()
+
+	schema := reader.Schema()
+	if err := s.createDorisTableFromArrowSchema(dbName, tableName, schema); err != nil {
+		return fmt.Errorf("failed to create Doris table: %v", err)
+	}
+
+	label := streamLoadLabel("import", bucketName, objectName, info.ETag, info.VersionID, dbName, tableName)
+	writer := NewStreamLoadWriter(context.Background(), NewStreamLoadClient(conf), dbName, tableName, schema, label)
+	for i := 0; i < reader.NumRecords(); i++ {
+		record, err := reader.Record(i)
+		if err != nil {
+			writer.Abort()
+			return fmt.Errorf("failed to read Arrow record %d: %v", i, err)
+		}
+		if err := writer.Write(record); err != nil {
+			writer.Abort()
+			return fmt.Errorf("failed to import Arrow file: %v", err)
+		}
+	}
+	summary, err := writer.Commit()
+	if err != nil {
+		writer.Abort()
+		return fmt.Errorf("failed to import Arrow file: %v", err)
+	}
+
+	log.Logger.Infof("Successfully stream loaded Arrow file %s to Doris table %s.%s, label: %s, loaded: %d, filtered: %d, deduplicated batches: %d",
+		objectName, dbName, tableName, summary.Label, summary.LoadedRows, summary.FilteredRows, summary.Deduplicated)
+	return nil
+}
+
 // readArrowFileSchema 读取Arrow文件的schema
 func (s *DorisService) readArrowFileSchema(filePath string) (*arrow.Schema, error) {
 	file, err := os.Open(filePath)
@@ -1393,8 +1638,112 @@ func (s *DorisService) importArrowFileWithStreamloader(filePath, dbName, tableNa
 	return nil
 }
 
-// ImportCsvFileToDoris 使用doris-streamloader导入CSV文件到Doris
-func (s *DorisService) ImportCsvFileToDoris(request *ds.ImportCsvFileToDorisRequest) error {
+// ImportCsvFileToDoris 导入CSV文件到Doris，被过滤的行写入以导入 label 命名的对象。
+// label 由对象内容版本（ETag/VersionID）和目标表生成，对象被覆盖后重新导入不会被去重
+func (s *DorisService) ImportCsvFileToDoris(request *ds.ImportCsvFileToDorisRequest) (*common.RejectSummary, error) {
+	tolerance, err := common.NewErrorTolerance(request.MaxErrorRatio, request.MaxErrorRows)
+	if err != nil {
+		return nil, err
+	}
+	conf := config.GetConfigMap()
+	ossClient, err := oss.NewOSSFactory(conf).NewOSSClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OSS client: %v", err)
+	}
+	info, err := ossClient.StatObject(context.Background(), request.BucketName, request.ObjectName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat object %s/%s: %v", request.BucketName, request.ObjectName, err)
+	}
+	label := streamLoadLabel("import_csv", request.BucketName, request.ObjectName, info.ETag, info.VersionID, request.DbName, request.TableName)
+	rejects := NewRejectRecorder(label)
+
+	if conf.StreamLoad.CsvEnabled {
+		err = s.streamCsvObjectToDoris(request, label, tolerance, rejects)
+	} else {
+		err = s.importCsvFileWithStreamloader(request, tolerance, rejects)
+	}
+	if err != nil {
+		return nil, rejectsError(rejects, err)
+	}
+	return saveRejects(rejects), nil
+}
+
+// streamCsvObjectToDoris 直接从对象存储读取CSV文件，通过 Stream Load 写入Doris；
+// 以两阶段提交导入，过滤行超出阈值时中止，重试导入同一对象时按 label 去重
+func (s *DorisService) streamCsvObjectToDoris(request *ds.ImportCsvFileToDorisRequest, label string, tolerance common.ErrorTolerance, rejects *RejectRecorder) error {
+	conf := config.GetConfigMap()
+	ossClient, err := oss.NewOSSFactory(conf).NewOSSClient()
+	if err != nil {
+		return fmt.Errorf("failed to create OSS client: %v", err)
+	}
+	object, err := ossClient.GetObject(context.Background(), request.BucketName, request.ObjectName, &oss.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get object from MinIO: %v", err)
+	}
+	defer object.Close()
+
+	return loadCsvWithTolerance(context.Background(), NewStreamLoadClient(conf), request, label, object, tolerance, rejects)
+}
+
+// loadCsvWithTolerance 以两阶段提交执行一次 CSV Stream Load，按容错阈值提交或中止
+func loadCsvWithTolerance(ctx context.Context, client *StreamLoadClient, request *ds.ImportCsvFileToDorisRequest, label string,
+	body io.Reader, tolerance common.ErrorTolerance, rejects *RejectRecorder) error {
+	opts := StreamLoadOptions{
+		Label:           label,
+		Format:          "csv",
+		Columns:         request.Columns,
+		ColumnSeparator: request.ColumnSeparator,
+		TwoPhaseCommit:  true,
+		MaxFilterRatio:  tolerance.FilterRatio(),
+	}
+	result, err := client.Load(ctx, request.DbName, request.TableName, opts, body)
+	if err != nil {
+		return fmt.Errorf("failed to import CSV file: %v", err)
+	}
+	if result.NumberFilteredRows > 0 || result.ErrorURL != "" {
+		rejects.RecordLoadErrors(ctx, result.NumberFilteredRows, result.ErrorURL)
+	}
+
+	switch {
+	case result.Deduplicated():
+		log.Logger.Infof("Stream load %s already finished, skip duplicated CSV import", label)
+		return nil
+	case result.Precommitted():
+		log.Logger.Infof("Stream load %s already precommitted, commit with current import", label)
+	case result.Succeeded():
+		if err := tolerance.Check(result.NumberFilteredRows, result.NumberTotalRows); err != nil {
+			if abortErr := client.Abort(ctx, request.DbName, label); abortErr != nil {
+				log.Logger.Warnf("Failed to abort stream load %s: %v", label, abortErr)
+			}
+			return fmt.Errorf("stream load %s: %v", label, err)
+		}
+	default:
+		return result.failure()
+	}
+	if err := client.Commit(ctx, request.DbName, label); err != nil {
+		return fmt.Errorf("failed to commit CSV import: %v", err)
+	}
+	log.Logger.Infof("Successfully stream loaded CSV file %s to Doris table %s.%s, label: %s, loaded: %d, filtered: %d",
+		request.ObjectName, request.DbName, request.TableName, label, result.NumberLoadedRows, result.NumberFilteredRows)
+	return nil
+}
+
+// importCsvFileWithStreamloader 下载CSV文件后使用doris-streamloader导入；
+// streamloader 不返回错误日志地址，只记录被过滤的行数。
+// 设置了容错阈值时先导入临时表，被拒绝行数校验通过后再一次写入目标表，超出阈值时目标表不变
+func (s *DorisService) importCsvFileWithStreamloader(request *ds.ImportCsvFileToDorisRequest, tolerance common.ErrorTolerance, rejects *RejectRecorder) error {
+	if !tolerance.Enabled() {
+		// 未设置容错阈值时 max_filter_ratio 为 0，任一行不合格 Doris 即整体失败
+		return s.runCsvStreamloader(request, request.TableName, tolerance, rejects)
+	}
+	target := fmt.Sprintf("`%s`.`%s`", request.DbName, request.TableName)
+	return s.withStagingTable(target, "", func(stage string) error {
+		return s.runCsvStreamloader(request, unquoteTableName(stage), tolerance, rejects)
+	})
+}
+
+// runCsvStreamloader 下载CSV文件后使用doris-streamloader导入 request.DbName 下的 tableName
+func (s *DorisService) runCsvStreamloader(request *ds.ImportCsvFileToDorisRequest, tableName string, tolerance common.ErrorTolerance, rejects *RejectRecorder) error {
 	// 使用项目中定义的DATA_DIR常量
 	dataDir := common.DATA_DIR
 
@@ -1447,7 +1796,7 @@ func (s *DorisService) ImportCsvFileToDoris(request *ds.ImportCsvFileToDorisRequ
 		"--p", config.DorisConfig.Password,
 		"--url", fmt.Sprintf("http://%s:%d", config.DorisConfig.Address, config.DorisConfig.Port),
 		"--db", request.DbName,
-		"--table", request.TableName,
+		"--table", tableName,
 		"--workers", "0", // 0表示自动选择最佳线程数
 	}
 
@@ -1459,6 +1808,9 @@ func (s *DorisService) ImportCsvFileToDoris(request *ds.ImportCsvFileToDorisRequ
 	if len(request.Columns) > 0 {
 		headerParts = append(headerParts, fmt.Sprintf("columns:%s", strings.Join(request.Columns, ",")))
 	}
+	if ratio := tolerance.FilterRatio(); ratio > 0 {
+		headerParts = append(headerParts, fmt.Sprintf("max_filter_ratio:%s", strconv.FormatFloat(ratio, 'f', -1, 64)))
+	}
 	if len(headerParts) > 0 {
 		args = append(args, "--header", strings.Join(headerParts, "?"))
 	}
@@ -1486,10 +1838,63 @@ func (s *DorisService) ImportCsvFileToDoris(request *ds.ImportCsvFileToDorisRequ
 	if err := checkStreamloaderOutput(stdout.String()); err != nil {
 		return err
 	}
+	filtered, total := streamloaderFilteredRows(stdout.String())
+	if filtered > 0 {
+		rejects.RecordLoadErrors(context.Background(), filtered, "")
+	}
+	// 行数阈值导入后校验，设置了容错阈值时导入的是临时表，失败时随临时表删除
+	return tolerance.Check(filtered, total)
+}
+
+// withStagingTable 建立与 table 结构相同的临时表交给 load 导入，load 成功后以一条 INSERT 写入 table，
+// 无论成败都删除临时表；load 失败时 table 不变。columnList 为空时写入全部列
+func (s *DorisService) withStagingTable(table, columnList string, load func(stage string) error) error {
+	suffix, err := common.GenerateRandomString(8)
+	if err != nil {
+		return fmt.Errorf("failed to generate staging table suffix: %v", err)
+	}
+	stage := stagingTableName(table, strings.ToLower(suffix))
+	if _, err := s.ExecuteUpdate(fmt.Sprintf("CREATE TABLE %s LIKE %s", stage, table)); err != nil {
+		return fmt.Errorf("failed to create staging table %s: %v", stage, err)
+	}
+	defer func() {
+		if _, err := s.ExecuteUpdate(fmt.Sprintf("DROP TABLE IF EXISTS %s FORCE", stage)); err != nil {
+			log.Logger.Warnf("Failed to drop staging table %s: %v", stage, err)
+		}
+	}()
+
+	if err := load(stage); err != nil {
+		return err
+	}
+	insertSQL := fmt.Sprintf("INSERT INTO %s SELECT * FROM %s", table, stage)
+	if columnList != "" {
+		insertSQL = insertSelectSQL(table, columnList, stage)
+	}
+	if _, err := s.ExecuteUpdate(insertSQL); err != nil {
+		return fmt.Errorf("failed to move rows from staging table %s: %v", stage, err)
+	}
 	return nil
 }
 
-func (s *DorisService) ExportParquetFileFromDoris(request *ds.ExportCsvFileFromDorisRequest) error {
+// stagingTableName 在表名（可带库名和反引号）后加临时后缀
+func stagingTableName(table, suffix string) string {
+	if strings.HasSuffix(table, "`") {
+		return strings.TrimSuffix(table, "`") + "_stage_" + suffix + "`"
+	}
+	return table + "_stage_" + suffix
+}
+
+// unquoteTableName 去掉库名和反引号，返回表名
+func unquoteTableName(table string) string {
+	if i := strings.LastIndex(table, "."); i >= 0 {
+		table = table[i+1:]
+	}
+	return strings.Trim(table, "`")
+}
+
+// ExportFileFromDoris 按请求指定的文件格式（默认parquet）导出Doris表数据到MinIO，返回本次导出的分片清单。
+// 每次导出写入 <jobInstanceId>/<label>/ 子目录，相同作业并发导出互不覆盖
+func (s *DorisService) ExportFileFromDoris(request *ds.ExportCsvFileFromDorisRequest) (*common.JobExportManifest, error) {
 	// log.Logger.Debugf("ExportCsvFileFromDoris request: %v", request)
 	// // 获取配置信息
 	// conf := config.GetConfigMap()
@@ -1533,38 +1938,63 @@ func (s *DorisService) ExportParquetFileFromDoris(request *ds.ExportCsvFileFromD
 
 	// Validate request parameters
 	if request == nil {
-		return fmt.Errorf("request cannot be nil")
+		return nil, fmt.Errorf("request cannot be nil")
 	}
 	if request.DbName == "" {
 		log.Logger.Errorf("DbName is empty in export request")
-		return fmt.Errorf("export failed: DbName is empty")
+		return nil, fmt.Errorf("export failed: DbName is empty")
 	}
 	if request.TableName == "" {
 		log.Logger.Errorf("TableName is empty in export request, DbName: %s", request.DbName)
-		return fmt.Errorf("export failed: TableName is empty")
+		return nil, fmt.Errorf("export failed: TableName is empty")
 	}
-	log.Logger.Debugf("ExportParquetFileFromDoris request: %v", request)
+	format, err := common.ParseExportFileFormat(request.FileFormat)
+	if err != nil {
+		return nil, fmt.Errorf("export failed: %v", err)
+	}
+	if err := common.ValidateExportCompressType(request.CompressType, format); err != nil {
+		return nil, fmt.Errorf("export failed: %v", err)
+	}
+	if _, err := database.NewReadWindow(request.Limit, request.Offset, request.Sample); err != nil {
+		return nil, fmt.Errorf("export failed: %v", err)
+	}
+	log.Logger.Debugf("ExportFileFromDoris request: %v", request)
 	conf := config.GetConfigMap()
-	sqlGenerator := &database.SQLGenerator{}
-
-	// 统一使用 SELECT ... INTO OUTFILE
-	exportSQL := sqlGenerator.BuildSelectIntoOutfileSQL(request, conf)
-	log.Logger.Infof("Executing export SQL: %s", exportSQL)
 
-	// Execute with retry mechanism
+	// 统一使用 SELECT ... INTO OUTFILE 写入本次导出的子目录，分片清单由列出该子目录得到。
+	// 每次重试使用新的子目录，失败尝试残留的文件不会进入清单，随作业目录一起清理
 	maxRetries := 5
 	baseDelay := 1 * time.Second
-	err := utils.WithRetry(maxRetries, baseDelay, func() error {
-		_, _, err := s.ExecuteSQL(exportSQL)
+	var label string
+	err = utils.WithRetry(maxRetries, baseDelay, func() error {
+		var err error
+		if label, err = common.GenerateRandomString(common.SUFFIX_RANDOM_LENGTH); err != nil {
+			return fmt.Errorf("failed to generate export label: %v", err)
+		}
+		exportRequest := proto.Clone(request).(*ds.ExportCsvFileFromDorisRequest)
+		exportRequest.JobInstanceId = common.ExportJobPath(request.JobInstanceId, label)
+		exportSQL := (&database.SQLGenerator{}).BuildSelectIntoOutfileSQL(exportRequest, conf)
+		log.Logger.Infof("Executing export SQL: %s", redactCredentials(exportSQL))
+		_, _, err = s.ExecuteSQL(exportSQL)
 		return err
 	}, utils.IsRetryableNetErr)
 	if err != nil {
-		return fmt.Errorf("failed to execute export SQL after %d retries: %v", maxRetries+1, err)
+		return nil, fmt.Errorf("failed to execute export SQL after %d retries: %v", maxRetries+1, err)
 	}
 
-	log.Logger.Infof("Successfully exported Parquet file from Doris table %s.%s to MinIO",
-		request.DbName, request.TableName)
-	return nil
+	ossClient, err := oss.NewOSSFactory(conf).NewOSSClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OSS client: %v", err)
+	}
+	manifest, err := buildJobExportManifest(context.Background(), ossClient, request.JobInstanceId, label,
+		common.BATCH_DATA_BUCKET_NAME, format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build export manifest: %v", err)
+	}
+
+	log.Logger.Infof("Successfully exported %s file from Doris table %s.%s to MinIO %s/, parts: %d",
+		format, request.DbName, request.TableName, manifest.Dir(), len(manifest.Parts))
+	return manifest, nil
 }
 
 // ExportDorisDataToMiraDB 导出Doris数据到Mira数据库
@@ -1614,9 +2044,12 @@ func (s *DorisService) ExportDorisDataToMiraDB(request *ds.ExportDorisDataToMira
 
 	columnList := s.buildColumnList(request.Columns)
 
-	// 导入数据到外表，明确指定字段名
+	// 导入数据到外表，明确指定字段名；指定排序列时在写入的查询中排序，使目标表按该顺序写入
 	insertSQL := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s",
 		request.TableName+"_"+uuidStr+"_external", columnList, columnList, request.SourceTableName)
+	if len(request.OrderByColumns) > 0 {
+		insertSQL += " ORDER BY " + quoteDorisIdentifiers(request.OrderByColumns)
+	}
 	_, err = s.ExecuteUpdate(insertSQL)
 	if err != nil {
 		return fmt.Errorf("failed to insert data from external to internal table: %v", err)
@@ -1626,38 +2059,23 @@ func (s *DorisService) ExportDorisDataToMiraDB(request *ds.ExportDorisDataToMira
 }
 
 func (s *DorisService) ImportMiraDBDataToDoris(request *ds.ImportMiraDBDataToDorisRequest) (string, error) {
-	// 在doris创建外表
-	resourceName, err := GetGlobalResourceName()
+	miraTableName := request.JobInstanceId + "_" + request.MiraTableName
+	internalTableName, err := s.ImportDeployedTableToDoris(common.MIRA_TMP_TASK_DB, miraTableName, miraTableName)
 	if err != nil {
-		return "", fmt.Errorf("failed to get global resource name: %v", err)
-	}
-
-	// 使用重构后的方法构建连接信息
-	connInfo, _, err := s.buildDeployedDBConnectionInfo(common.MIRA_TMP_TASK_DB)
-	if err != nil {
-		return "", fmt.Errorf("failed to build connection info: %v", err)
+		return "", err
 	}
+	RegisterTableTTL(common.MIRA_TMP_TASK_DB, internalTableName, request.JobInstanceId)
+	return internalTableName, nil
+}
 
-	// 源表字段信息
-	sourceTableColumns, err := s.getMiraTableSchema(request.JobInstanceId + "_" + request.MiraTableName)
+// ImportDeployedTableToDoris 将部署数据库中指定库表的数据导入doris，返回doris内部表名 tablePrefix_internal
+func (s *DorisService) ImportDeployedTableToDoris(dbName, tableName, tablePrefix string) (string, error) {
+	// 在doris创建外表
+	config, sourceTableColumns, err := s.deployedTableConfig(dbName, tableName, tablePrefix)
 	if err != nil {
-		return "", fmt.Errorf("failed to get mira table schema: %v", err)
+		return "", err
 	}
-
-	// 转换列信息
-	columnInfos := s.convertColumnsToColumnInfo(sourceTableColumns)
-
-	externalTableName := request.JobInstanceId + "_" + request.MiraTableName + "_external"
-	internalTableName := request.JobInstanceId + "_" + request.MiraTableName + "_internal"
-
-	// 使用重构后的方法构建外部表配置
-	config := s.buildExternalTableConfig(
-		externalTableName,
-		resourceName,
-		connInfo,
-		columnInfos,
-		request.JobInstanceId+"_"+request.MiraTableName,
-	)
+	internalTableName := tablePrefix + "_internal"
 
 	if err := s.createExternalTable(config); err != nil {
 		return "", fmt.Errorf("failed to create external table: %v", err)
@@ -1672,8 +2090,7 @@ func (s *DorisService) ImportMiraDBDataToDoris(request *ds.ImportMiraDBDataToDor
 
 	// 导入数据到内部表
 	columnList := s.buildColumnList(sourceTableColumns)
-	insertSQL := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s",
-		internalTableName, columnList, columnList, externalTableName)
+	insertSQL := insertSelectSQL(internalTableName, columnList, config.TableName)
 	log.Logger.Infof("Executing insert SQL: %s", insertSQL)
 	_, err = s.ExecuteUpdate(insertSQL)
 	if err != nil {
@@ -1683,6 +2100,36 @@ func (s *DorisService) ImportMiraDBDataToDoris(request *ds.ImportMiraDBDataToDor
 	return internalTableName, nil
 }
 
+// deployedTableConfig 构建部署数据库中库表对应的外部表配置（外部表名 tablePrefix_external，使用全局资源），并返回源表字段
+func (s *DorisService) deployedTableConfig(dbName, tableName, tablePrefix string) (ExternalTableConfig, []*ds.ColumnItem, error) {
+	resourceName, err := GetGlobalResourceName()
+	if err != nil {
+		return ExternalTableConfig{}, nil, fmt.Errorf("failed to get global resource name: %v", err)
+	}
+
+	// 使用重构后的方法构建连接信息
+	connInfo, _, err := s.buildDeployedDBConnectionInfo(dbName)
+	if err != nil {
+		return ExternalTableConfig{}, nil, fmt.Errorf("failed to build connection info: %v", err)
+	}
+
+	// 源表字段信息
+	sourceTableColumns, err := s.getDeployedTableSchema(dbName, tableName)
+	if err != nil {
+		return ExternalTableConfig{}, nil, fmt.Errorf("failed to get mira table schema: %v", err)
+	}
+
+	// 使用重构后的方法构建外部表配置
+	config := s.buildExternalTableConfig(
+		tablePrefix+"_external",
+		resourceName,
+		connInfo,
+		s.convertColumnsToColumnInfo(sourceTableColumns),
+		tableName,
+	)
+	return config, sourceTableColumns, nil
+}
+
 // createTableByDeployedDBType 根据部署的数据库类型创建表
 func (s *DorisService) createTableByDeployedDBType(tableName string, schema *arrow.Schema, connInfo *ds.ConnectionInfo, dbType ds.DataSourceType) error {
 
@@ -1991,8 +2438,13 @@ func (s *DorisService) convertColumnsToColumnInfo(columns []*ds.ColumnItem) []co
 
 // getMiraTableSchema 获取Mira数据库表的字段信息
 func (s *DorisService) getMiraTableSchema(tableName string) ([]*ds.ColumnItem, error) {
+	return s.getDeployedTableSchema(common.MIRA_TMP_TASK_DB, tableName)
+}
+
+// getDeployedTableSchema 获取部署数据库中指定库表的字段信息
+func (s *DorisService) getDeployedTableSchema(dbName, tableName string) ([]*ds.ColumnItem, error) {
 	// 构建连接信息
-	connInfo, dbType, err := s.buildDeployedDBConnectionInfo(common.MIRA_TMP_TASK_DB)
+	connInfo, dbType, err := s.buildDeployedDBConnectionInfo(dbName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build connection info: %v", err)
 	}
@@ -2014,7 +2466,7 @@ func (s *DorisService) getMiraTableSchema(tableName string) ([]*ds.ColumnItem, e
 	case ds.DataSourceType_DATA_SOURCE_TYPE_MYSQL,
 		ds.DataSourceType_DATA_SOURCE_TYPE_TDSQL,
 		ds.DataSourceType_DATA_SOURCE_TYPE_TIDB:
-		tableInfo, err = dbStrategy.GetTableInfo(common.MIRA_TMP_TASK_DB, tableName, false)
+		tableInfo, err = dbStrategy.GetTableInfo(dbName, tableName, false)
 	case ds.DataSourceType_DATA_SOURCE_TYPE_KINGBASE:
 		tableInfo, err = dbStrategy.GetTableInfo("public", tableName, false)
 	default:
@@ -2029,7 +2481,7 @@ func (s *DorisService) getMiraTableSchema(tableName string) ([]*ds.ColumnItem, e
 		return nil, fmt.Errorf("no columns found for table %s", tableName)
 	}
 
-	log.Logger.Infof("Retrieved %d columns for Mira table %s", len(tableInfo.Columns), tableName)
+	log.Logger.Infof("Retrieved %d columns for table %s.%s", len(tableInfo.Columns), dbName, tableName)
 	return tableInfo.Columns, nil
 }
 
@@ -2373,8 +2825,8 @@ func (s *DorisService) convertMySQLTypeToDorisType(dbTypeName string) string {
 	return "VARCHAR"
 }
 
-// trackExportTaskStatusByLabel 使用SHOW EXPORT命令根据Label追踪导出任务状态直到完成
-func (s *DorisService) trackExportTaskStatusByJobId(dbName string, label string) error {
+// trackExportTaskStatusByLabel 使用SHOW EXPORT命令根据Label追踪导出任务状态直到完成，返回完成时的任务信息
+func (s *DorisService) trackExportTaskStatusByJobId(dbName string, label string) (*common.ExportTaskInfo, error) {
 	maxRetries := 300                // 最大重试次数，避免无限等待
 	retryInterval := 2 * time.Second // 重试间隔
 
@@ -2396,11 +2848,11 @@ func (s *DorisService) trackExportTaskStatusByJobId(dbName string, label string)
 		switch taskInfo.State {
 		case common.ExportStatusFinished:
 			log.Logger.Infof("Export task completed successfully for job %s", label)
-			return nil
+			return taskInfo, nil
 		case common.ExportStatusCancelled:
-			return fmt.Errorf("export task was cancelled for job %s", label)
+			return nil, fmt.Errorf("export task was cancelled for job %s", label)
 		case common.ExportStatusFailed:
-			return fmt.Errorf("export task failed for job %s: %s", label, taskInfo.ErrorMsg)
+			return nil, fmt.Errorf("export task failed for job %s: %s", label, taskInfo.ErrorMsg)
 		case common.ExportStatusRunning, common.ExportStatusPending:
 			log.Logger.Infof("Export task is still running for job %s, progress: %s (attempt %d/%d)",
 				label, taskInfo.Progress, i+1, maxRetries)
@@ -2412,7 +2864,7 @@ func (s *DorisService) trackExportTaskStatusByJobId(dbName string, label string)
 		}
 	}
 
-	return fmt.Errorf("export task timeout after %d attempts for job %s", maxRetries, label)
+	return nil, fmt.Errorf("export task timeout after %d attempts for job %s", maxRetries, label)
 }
 
 // GetExportTaskByLabel 获取指定Label的导出任务状态
@@ -2477,37 +2929,48 @@ func (s *DorisService) GetExportTaskByLabel(dbName string, label string) (*commo
 	return nil, nil
 }
 
-// ProcessDataSourceAndExport 处理数据源并导出到 MinIO
-func (s *DorisService) ProcessDataSourceAndExport(request *ds.ReadDataSourceStreamingRequest, enhancedJobInstanceId string) (string, error) {
+// ProcessDataSourceAndExport 处理数据源并导出到 MinIO，返回表名和本次导出的分片清单
+func (s *DorisService) ProcessDataSourceAndExport(request *ds.ReadDataSourceStreamingRequest, enhancedJobInstanceId string) (string, *common.JobExportManifest, error) {
 	var tableName string
+	var manifest *common.JobExportManifest
 	var err error
 
+	// 导入前先校验导出格式，避免无效请求创建临时表
+	if _, err = common.ParseExportFileFormat(request.FileFormat); err != nil {
+		return "", nil, err
+	}
+
 	// 1.从数据源拉取数据到doris
 	switch src := request.DataSource.(type) {
 	case *ds.ReadDataSourceStreamingRequest_External:
-		// 1.1 从外部数据源拉取数据到doris
+		// 1.1 从外部数据源拉取数据到doris，分页与采样在导入时执行
+		if window := sourceWindow(request.FilterConditions, request.SortRules, request.Limit, request.Offset, request.Sample); window != nil {
+			s.SetSourceWindow(window)
+			defer s.SetSourceWindow(nil)
+		}
 		tableName, err = s.CreateExternalAndInternalTableAndImportData(src.External.AssetName, src.External.ChainInfoId, src.External.Alias, enhancedJobInstanceId, enhancedJobInstanceId+"_"+"internal", enhancedJobInstanceId)
 		if err != nil {
 			log.Logger.Errorf("failed to create external table from asset: %v", err)
-			return "", fmt.Errorf("failed to create external table from asset: %v", err)
+			return "", nil, fmt.Errorf("failed to create external table from asset: %v", err)
 		}
 		if len(tableName) == 0 {
 			log.Logger.Errorf("tableName is empty after import, import failed")
-			return "", fmt.Errorf("import failed: tableName is empty")
+			return "", nil, fmt.Errorf("import failed: tableName is empty")
 		}
 
 		// 1.2 执行导出csv文件到minio
-		err = s.ExportParquetFileFromDoris(&ds.ExportCsvFileFromDorisRequest{
+		manifest, err = s.ExportFileFromDoris(&ds.ExportCsvFileFromDorisRequest{
 			DbName:           common.MIRA_TMP_TASK_DB,
 			TableName:        tableName,
 			JobInstanceId:    enhancedJobInstanceId,
 			Columns:          request.Columns,
 			SortRules:        request.SortRules,
 			FilterConditions: request.FilterConditions,
+			FileFormat:       request.FileFormat,
 		})
 		if err != nil {
 			log.Logger.Errorf("failed to export csv file from doris: %v", err)
-			return "", fmt.Errorf("failed to export csv file from doris: %v", err)
+			return "", nil, fmt.Errorf("failed to export csv file from doris: %v", err)
 		}
 
 	case *ds.ReadDataSourceStreamingRequest_Internal:
@@ -2518,43 +2981,51 @@ func (s *DorisService) ProcessDataSourceAndExport(request *ds.ReadDataSourceStre
 		})
 		if err != nil {
 			log.Logger.Errorf("failed to import mira db data to doris: %v", err)
-			return "", fmt.Errorf("failed to import mira db data to doris: %v", err)
+			return "", nil, fmt.Errorf("failed to import mira db data to doris: %v", err)
 		}
 		// 1.2 执行导出arrow文件到minio
-		err = s.ExportParquetFileFromDoris(&ds.ExportCsvFileFromDorisRequest{
+		manifest, err = s.ExportFileFromDoris(&ds.ExportCsvFileFromDorisRequest{
 			DbName:           common.MIRA_TMP_TASK_DB,
 			TableName:        tableName,
 			JobInstanceId:    enhancedJobInstanceId,
 			Columns:          request.Columns,
 			SortRules:        request.SortRules,
 			FilterConditions: request.FilterConditions,
+			FileFormat:       request.FileFormat,
+			Limit:            request.Limit,
+			Offset:           request.Offset,
+			Sample:           request.Sample,
 		})
 		if err != nil {
-			log.Logger.Errorf("failed to export parquet file from doris: %v", err)
-			return "", fmt.Errorf("failed to export parquet file from doris: %v", err)
+			log.Logger.Errorf("failed to export file from doris: %v", err)
+			return "", nil, fmt.Errorf("failed to export file from doris: %v", err)
 		}
 
 	case *ds.ReadDataSourceStreamingRequest_Doris:
 		// 1.2 执行导出arrow文件到minio
-		err = s.ExportParquetFileFromDoris(&ds.ExportCsvFileFromDorisRequest{
+		manifest, err = s.ExportFileFromDoris(&ds.ExportCsvFileFromDorisRequest{
 			DbName:           src.Doris.DbName,
 			TableName:        src.Doris.TableName,
 			JobInstanceId:    enhancedJobInstanceId,
 			Columns:          request.Columns,
 			SortRules:        request.SortRules,
 			FilterConditions: request.FilterConditions,
+			FileFormat:       request.FileFormat,
+			Limit:            request.Limit,
+			Offset:           request.Offset,
+			Sample:           request.Sample,
 		})
 		if err != nil {
-			log.Logger.Errorf("failed to export parquet file from doris: %v", err)
-			return "", fmt.Errorf("failed to export parquet file from doris: %v", err)
+			log.Logger.Errorf("failed to export file from doris: %v", err)
+			return "", nil, fmt.Errorf("failed to export file from doris: %v", err)
 		}
 		tableName = src.Doris.TableName
 
 	default:
-		return "", fmt.Errorf("unknown data source type")
+		return "", nil, fmt.Errorf("unknown data source type")
 	}
 
-	return tableName, nil
+	return tableName, manifest, nil
 }
 
 // DropDatabase 删除指定的数据库
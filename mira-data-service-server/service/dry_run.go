@@ -0,0 +1,468 @@
+package service
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"data-service/common"
+	"data-service/config"
+	"data-service/database"
+	pb "data-service/generated/datasource"
+	"data-service/log"
+	"data-service/utils"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// 试运行计划步骤
+const (
+	planActionResolveSource  = "resolve_source"
+	planActionSourceQuery    = "source_query"
+	planActionCreateDatabase = "create_database"
+	planActionCreateResource = "create_resource"
+	planActionCreateTable    = "create_table"
+	planActionInsert         = "insert"
+	planActionReplace        = "replace"
+	planActionExport         = "export"
+	planActionStream         = "stream"
+	planActionQuery          = "query"
+	planActionExecute        = "execute"
+	planActionCleanup        = "cleanup"
+)
+
+// planObjectDorisTable 试运行计划中的 Doris 内部表，其余对象类型与孤儿资源一致
+const planObjectDorisTable = "doris_table"
+
+// 计划中随机后缀和导出 label 的占位符，实际值在执行时随机生成
+const (
+	planRandomSuffix = "{suffix}"
+	planExportLabel  = "{label}"
+)
+
+var (
+	// credentialPropertyPattern SQL 属性中的凭证，如 "password" = "..."、"s3.secret_key" = "..."
+	credentialPropertyPattern = regexp.MustCompile(`(?i)("[^"]*(?:password|secret|access_key|token)[^"]*"\s*=\s*")((?:[^"\\]|\\.)*)(")`)
+	// credentialParamPattern URL 参数中的凭证键，如 jdbc_url 中的 password=、trustCertificateKeyStorePassword=
+	credentialParamPattern = regexp.MustCompile(`(?i)(?:[?&;]|%26)[a-z_.]*(?:password|passwd|pwd|token|secret)[a-z_.]*=`)
+	// explainCardinalityPattern Doris EXPLAIN 输出中的行数估算，第一个为最终输出节点
+	explainCardinalityPattern = regexp.MustCompile(`cardinality\s*=\s*(\d+)`)
+)
+
+// dryRunPlanner 生成试运行执行计划：只读取元数据、生成 SQL 并执行 EXPLAIN，不创建任何对象
+type dryRunPlanner struct {
+	doris IDorisService
+	conf  *config.DataServiceConf
+
+	// resolveAsset 解析数据资产的连接与 JDBC 信息，不上传证书
+	resolveAsset func(source *pb.ExternalDataSource, dbName string) (*AssetInfo, *pb.ConnectionInfo, error)
+	// deployedTable 构建部署数据库中表对应的外部表配置
+	deployedTable func(dbName, tableName, tablePrefix string) (ExternalTableConfig, []*pb.ColumnItem, error)
+	// estimateSource 读取源表的行数和大小估算
+	estimateSource func(connInfo *pb.ConnectionInfo) (*pb.TableInfoResponse, error)
+}
+
+// newDryRunPlanner 创建试运行计划生成器，doris 用于 EXPLAIN 和元数据查询
+func newDryRunPlanner(doris IDorisService, conf *config.DataServiceConf) *dryRunPlanner {
+	p := &dryRunPlanner{
+		doris: doris,
+		conf:  conf,
+		estimateSource: func(connInfo *pb.ConnectionInfo) (*pb.TableInfoResponse, error) {
+			return NewTableInfoService(log.Logger).GetTableInfoByDataSource(connInfo, connInfo.TableName, false)
+		},
+	}
+	if dorisService, ok := doris.(*DorisService); ok {
+		p.resolveAsset = dorisService.resolveAssetForPlan
+		p.deployedTable = dorisService.deployedTableConfig
+	}
+	return p
+}
+
+// resolveAssetForPlan 获取数据资产的 JDBC 信息，与 getAssetInfo 相同但不上传 TLS 证书
+func (s *DorisService) resolveAssetForPlan(source *pb.ExternalDataSource, dbName string) (*AssetInfo, *pb.ConnectionInfo, error) {
+	requestId := uuid.New().String()
+	connInfo, err := utils.GetDatasourceByAssetName(requestId, source.AssetName, source.ChainInfoId, source.Alias)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get datasource: %v", err)
+	}
+	assetInfo, err := s.assetInfoFromConnection(connInfo, source.AssetName, requestId, dbName)
+	if err != nil {
+		return nil, nil, err
+	}
+	return assetInfo, connInfo, nil
+}
+
+// newExecutionPlan 创建执行计划，估算值默认未知
+func newExecutionPlan(path string) *pb.ExecutionPlan {
+	return &pb.ExecutionPlan{Path: path, EstimatedRows: -1, EstimatedBytes: -1}
+}
+
+// addPlanStep 追加计划步骤，SQL 中的凭证替换为 utils.RedactedValue
+func addPlanStep(plan *pb.ExecutionPlan, action, description, query string) *pb.PlanStep {
+	step := &pb.PlanStep{
+		Action:      action,
+		Description: description,
+		Sql:         redactCredentials(strings.TrimSpace(query)),
+	}
+	plan.Steps = append(plan.Steps, step)
+	return step
+}
+
+func addPlanObject(plan *pb.ExecutionPlan, kind, name string) {
+	plan.Objects = append(plan.Objects, &pb.PlanObject{Kind: kind, Name: name})
+}
+
+func addPlanWarning(plan *pb.ExecutionPlan, format string, args ...interface{}) {
+	plan.Warnings = append(plan.Warnings, redactCredentials(fmt.Sprintf(format, args...)))
+}
+
+// redactCredentials 隐藏 SQL 属性和 URL 参数中的密码、密钥和令牌
+func redactCredentials(s string) string {
+	s = credentialPropertyPattern.ReplaceAllString(s, "${1}"+utils.RedactedValue+"${3}")
+
+	var b strings.Builder
+	last := 0
+	for _, loc := range credentialParamPattern.FindAllStringIndex(s, -1) {
+		if loc[0] < last {
+			continue
+		}
+		// 嵌套在参数值中的参数以 %26 分隔
+		nested := strings.HasPrefix(s[loc[0]:], "%26")
+		end := loc[1]
+		for end < len(s) && !isParamEnd(s[end:], nested) {
+			end++
+		}
+		b.WriteString(s[last:loc[1]])
+		b.WriteString(utils.RedactedValue)
+		last = end
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// isParamEnd 判断 URL 参数值是否在此结束，nested 为 true 时 %26 也视为结束
+func isParamEnd(s string, nested bool) bool {
+	return strings.ContainsRune("&;\"' \t\r\n", rune(s[0])) || nested && strings.HasPrefix(s, "%26")
+}
+
+// explainCardinality 从 EXPLAIN 输出中取最终输出的行数估算，没有时返回 -1
+func explainCardinality(explain string) int64 {
+	match := explainCardinalityPattern.FindStringSubmatch(explain)
+	if match == nil {
+		return -1
+	}
+	rows, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return -1
+	}
+	return rows
+}
+
+// explain 在 Doris 上执行 EXPLAIN，返回计划文本
+func (p *dryRunPlanner) explain(query string) (string, error) {
+	rows, done, err := p.doris.ExecuteSQL("EXPLAIN " + query)
+	if err != nil {
+		return "", err
+	}
+	if done != nil {
+		defer done()
+	} else {
+		defer rows.Close()
+	}
+
+	var lines []string
+	for rows.Next() {
+		var line sql.NullString
+		if err := rows.Scan(&line); err != nil {
+			return "", err
+		}
+		lines = append(lines, line.String)
+	}
+	return strings.Join(lines, "\n"), rows.Err()
+}
+
+// estimateDorisTable 按 EXPLAIN 行数和 information_schema 中的表统计估算行数和字节数
+func (p *dryRunPlanner) estimateDorisTable(plan *pb.ExecutionPlan, dbName, tableName, explain string) {
+	rows, done, err := p.doris.ExecuteSQL(
+		"SELECT TABLE_ROWS, DATA_LENGTH FROM information_schema.tables WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?",
+		dbName, tableName)
+	var tableRows, tableBytes sql.NullInt64
+	if err == nil {
+		if rows.Next() {
+			err = rows.Scan(&tableRows, &tableBytes)
+		}
+		if done != nil {
+			done()
+		} else {
+			rows.Close()
+		}
+	}
+	if err != nil {
+		addPlanWarning(plan, "failed to read statistics of %s.%s: %v", dbName, tableName, err)
+	}
+
+	plan.EstimatedRows = explainCardinality(explain)
+	if plan.EstimatedRows < 0 && tableRows.Valid {
+		plan.EstimatedRows = tableRows.Int64
+	}
+	if tableBytes.Valid {
+		plan.EstimatedBytes = tableBytes.Int64
+		// 过滤后的行数少于全表时按比例折算
+		if tableRows.Valid && tableRows.Int64 > 0 && plan.EstimatedRows >= 0 && plan.EstimatedRows < tableRows.Int64 {
+			plan.EstimatedBytes = tableBytes.Int64 * plan.EstimatedRows / tableRows.Int64
+		}
+	}
+}
+
+// planImportTarget 生成单个导入目标的计划：解析源、建库、建外部资源与表、建内部表、导入、清理资源
+func (p *dryRunPlanner) planImportTarget(plan *pb.ExecutionPlan, target *pb.ImportTarget, tableName, jobInstanceId string) error {
+	if target.GetExternal() == nil {
+		return fmt.Errorf("external data source is required")
+	}
+	tolerance, err := common.NewErrorTolerance(target.MaxErrorRatio, target.MaxErrorRows)
+	if err != nil {
+		return err
+	}
+	if p.resolveAsset == nil {
+		return fmt.Errorf("asset resolution is not available")
+	}
+
+	// 1. 解析数据资产
+	assetInfo, connInfo, err := p.resolveAsset(target.External, target.DbName)
+	if err != nil {
+		return fmt.Errorf("failed to resolve asset %s: %v", target.External.AssetName, err)
+	}
+	addPlanStep(plan, planActionResolveSource, fmt.Sprintf("resolve asset %s to %s table %s.%s at %s:%d",
+		target.External.AssetName, assetInfo.TableType, connInfo.DbName, connInfo.TableName, connInfo.Host, connInfo.Port), "")
+	if info, err := p.estimateSource(connInfo); err != nil {
+		addPlanWarning(plan, "failed to estimate size of %s: %v", target.External.AssetName, err)
+	} else {
+		plan.EstimatedRows = info.RecordCount64
+		plan.EstimatedBytes = info.RecordSize
+	}
+
+	columns, err := common.FilterColumnsWithRowidHandling(assetInfo.Columns, target.Columns)
+	if err != nil {
+		return fmt.Errorf("failed to filter columns with rowid handling: %v", err)
+	}
+	assetInfo.Columns = columns
+	columnNames := make([]string, len(columns))
+	for i, col := range columns {
+		columnNames[i] = col.Name
+	}
+
+	// 2. 导入读取的源表行，以源库方言表示；设置了分页或采样时该查询在源库执行
+	window, err := database.NewReadWindow(target.Window.GetLimit(), target.Window.GetOffset(), target.Window.GetSample())
+	if err != nil {
+		return fmt.Errorf("invalid read window: %v", err)
+	}
+	filterNames, filterOperators, filterValues := splitFilterConditions(target.Window.GetFilterConditions())
+	if strategy, err := database.DatabaseFactory(utils.ConvertDataSourceType(connInfo.Dbtype), connInfo); err != nil {
+		addPlanWarning(plan, "failed to build source query: %v", err)
+	} else if query, args, err := strategy.BuildWithConditionQuery(connInfo.TableName, columnNames,
+		filterNames, filterOperators, filterValues, target.Window.GetSortRules(), window); err != nil {
+		addPlanWarning(plan, "failed to build source query: %v", err)
+	} else {
+		description := "rows read from the source through the JDBC external table"
+		if window != nil {
+			description = fmt.Sprintf("read window executed on the source, args %v", args)
+		} else if target.Window != nil {
+			description = "rows read from the source through the JDBC external table; Doris applies the filters and sort, " +
+				"only simple filter predicates may be pushed down to the source"
+		}
+		addPlanStep(plan, planActionSourceQuery, description, query)
+	}
+
+	// 3. 建库、外部资源与表、内部表
+	addPlanStep(plan, planActionCreateDatabase, fmt.Sprintf("create database %s if not exists", target.DbName),
+		fmt.Sprintf("CREATE DATABASE IF NOT EXISTS `%s`", target.DbName))
+	addPlanObject(plan, OrphanKindDorisDatabase, target.DbName)
+	if connInfo.TlsConfig != nil && connInfo.TlsConfig.UseTls == 2 {
+		addPlanStep(plan, planActionCreateResource, fmt.Sprintf("upload TLS certificates of the source to MinIO and create them as FILE in %s", target.DbName), "")
+		addPlanObject(plan, OrphanKindDorisTlsFile, target.DbName+"."+assetInfo.RequestId)
+	}
+
+	config := assetTableConfig(assetInfo, jobInstanceId)
+	addPlanStep(plan, planActionCreateResource, fmt.Sprintf("create JDBC resource %s", config.ResourceName), externalResourceSQL(config))
+	addPlanObject(plan, OrphanKindDorisResource, config.ResourceName)
+	addPlanStep(plan, planActionCreateTable, fmt.Sprintf("create external table %s.%s", target.DbName, config.TableName), externalTableSQL(config))
+	addPlanObject(plan, OrphanKindDorisExternalTable, target.DbName+"."+config.TableName)
+
+	internalConfig := config
+	internalConfig.TableName = tableName
+	addPlanStep(plan, planActionCreateTable, fmt.Sprintf("create internal table %s.%s with an auto-increment primary key", target.DbName, tableName),
+		internalTableSQL(internalConfig, autoIncrementKeyName()))
+	addPlanObject(plan, planObjectDorisTable, target.DbName+"."+tableName)
+
+	// 4. 导入
+	description := fmt.Sprintf("import %s into %s.%s", target.External.AssetName, target.DbName, tableName)
+	if target.Window != nil {
+		description += ", only rows in the read window"
+	} else if pk, ok := getAutoIncrementPrimaryKey(target); ok {
+		description += fmt.Sprintf(", in batches by ranges of primary key %s", pk)
+	}
+	if tolerance.Enabled() {
+		description += fmt.Sprintf(", tolerating max_filter_ratio %g / max_error_rows %d", tolerance.MaxErrorRatio, tolerance.MaxErrorRows)
+	}
+	if window != nil {
+		addPlanStep(plan, planActionInsert, description+", stream loading the rows returned by the source window query", "")
+	} else {
+		source, err := (&database.SQLGenerator{}).BuildWindowSourceSQL(
+			fmt.Sprintf("`%s`.`%s`", target.DbName, config.TableName), columnNames, target.Window)
+		if err != nil {
+			return fmt.Errorf("invalid read window: %v", err)
+		}
+		addPlanStep(plan, planActionInsert, description, insertSelectSQL(
+			fmt.Sprintf("`%s`.`%s`", target.DbName, tableName),
+			strings.Join(columnNames, ", "),
+			source))
+	}
+
+	// 5. 导入完成后删除资源
+	addPlanStep(plan, planActionCleanup, fmt.Sprintf("drop resource %s after import", config.ResourceName),
+		fmt.Sprintf(`DROP RESOURCE "%s"`, config.ResourceName))
+	return nil
+}
+
+// planExport 生成从 Doris 表导出到 MinIO 的步骤，表已存在时附带 EXPLAIN 和估算
+func (p *dryRunPlanner) planExport(plan *pb.ExecutionPlan, request *pb.ExportCsvFileFromDorisRequest, tableExists bool) error {
+	format, err := common.ParseExportFileFormat(request.FileFormat)
+	if err != nil {
+		return err
+	}
+	exportRequest := proto.Clone(request).(*pb.ExportCsvFileFromDorisRequest)
+	exportRequest.JobInstanceId = common.ExportJobPath(request.JobInstanceId, planExportLabel)
+	exportSQL := (&database.SQLGenerator{}).BuildSelectIntoOutfileSQL(exportRequest, p.conf)
+
+	step := addPlanStep(plan, planActionExport, fmt.Sprintf("export %s.%s as %s to %s/%s/",
+		request.DbName, request.TableName, format, common.BATCH_DATA_BUCKET_NAME, exportRequest.JobInstanceId), exportSQL)
+	addPlanObject(plan, OrphanKindMinioExport, request.JobInstanceId)
+	if !tableExists {
+		return nil
+	}
+
+	explain, err := p.explain((&database.SQLGenerator{}).BuildExportQuerySQL(request))
+	if err != nil {
+		addPlanWarning(plan, "failed to explain export query: %v", err)
+	}
+	step.Explain = explain
+	p.estimateDorisTable(plan, request.DbName, request.TableName, explain)
+	return nil
+}
+
+// planRead 生成 Read 的执行计划：导入 Doris（外部/内部源）、导出到 MinIO、按 Arrow 流式返回、清理
+func (p *dryRunPlanner) planRead(request *pb.ReadRequest, jobInstanceId string) (*pb.ExecutionPlan, error) {
+	export := &pb.ExportCsvFileFromDorisRequest{
+		JobInstanceId:    jobInstanceId,
+		Columns:          request.Columns,
+		SortRules:        request.SortRules,
+		FilterConditions: request.FilterConditions,
+		FileFormat:       request.FileFormat,
+	}
+
+	var plan *pb.ExecutionPlan
+	switch {
+	case request.GetExternal() != nil:
+		plan = newExecutionPlan("external->doris->minio->arrow")
+		target := &pb.ImportTarget{
+			External:        request.GetExternal(),
+			DbName:          jobInstanceId,
+			TargetTableName: jobInstanceId + "_internal",
+			Columns:         request.Columns,
+			Keys:            request.Keys,
+			Window:          sourceWindow(request.FilterConditions, request.SortRules, request.Limit, request.Offset, request.Sample),
+		}
+		// 经 ImportData 导入，资源与外部表名使用导入任务自己的 jobInstanceId
+		if err := p.planImportTarget(plan, target, target.TargetTableName, target.DbName+"_"+planRandomSuffix); err != nil {
+			return nil, err
+		}
+		export.DbName, export.TableName = target.DbName, target.TargetTableName
+		if err := p.planExport(plan, export, false); err != nil {
+			return nil, err
+		}
+
+	case request.GetInternal() != nil:
+		plan = newExecutionPlan("internal->doris->minio->arrow")
+		export.Limit, export.Offset, export.Sample = request.Limit, request.Offset, request.Sample
+		tablePrefix := jobInstanceId + "_" + request.GetInternal().TableName
+		export.DbName, export.TableName = common.MIRA_TMP_TASK_DB, tablePrefix+"_internal"
+		p.planDeployedTable(plan, tablePrefix)
+		if err := p.planExport(plan, export, false); err != nil {
+			return nil, err
+		}
+
+	case request.GetDoris() != nil:
+		plan = newExecutionPlan("doris->minio->arrow")
+		export.DbName, export.TableName = request.GetDoris().DbName, request.GetDoris().TableName
+		export.Limit, export.Offset, export.Sample = request.Limit, request.Offset, request.Sample
+		exists, err := dorisTableExists(p.doris, export.DbName, export.TableName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check table %s.%s: %v", export.DbName, export.TableName, err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("table %s.%s does not exist", export.DbName, export.TableName)
+		}
+		if err := p.planExport(plan, export, true); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("no valid data source specified")
+	}
+
+	addPlanStep(plan, planActionStream, "read the exported files listed in the export manifest and stream them as Arrow batches", "")
+	switch {
+	case request.GetExternal() != nil:
+		addPlanStep(plan, planActionCleanup, fmt.Sprintf("drop database %s and delete MinIO objects of %s", jobInstanceId, jobInstanceId),
+			fmt.Sprintf("DROP DATABASE IF EXISTS `%s` FORCE", jobInstanceId))
+	case request.GetDoris() != nil:
+		addPlanStep(plan, planActionCleanup, fmt.Sprintf("delete MinIO objects of %s", jobInstanceId), "")
+	}
+	return plan, nil
+}
+
+// planDeployedTable 生成内部数据源导入 Doris 临时库的步骤，无法读取部署库元数据时只记录警告
+func (p *dryRunPlanner) planDeployedTable(plan *pb.ExecutionPlan, tablePrefix string) {
+	if p.deployedTable == nil {
+		addPlanWarning(plan, "deployed table metadata is not available")
+		return
+	}
+	config, columns, err := p.deployedTable(common.MIRA_TMP_TASK_DB, tablePrefix, tablePrefix)
+	if err != nil {
+		addPlanWarning(plan, "failed to read deployed table %s: %v", tablePrefix, err)
+		return
+	}
+	internalConfig := config
+	internalConfig.TableName = tablePrefix + "_internal"
+	columnNames := make([]string, len(columns))
+	for i, col := range columns {
+		columnNames[i] = col.Name
+	}
+
+	addPlanStep(plan, planActionCreateTable, fmt.Sprintf("create external table %s on resource %s", config.TableName, config.ResourceName), externalTableSQL(config))
+	addPlanObject(plan, OrphanKindDorisExternalTable, common.MIRA_TMP_TASK_DB+"."+config.TableName)
+	addPlanStep(plan, planActionCreateTable, fmt.Sprintf("create internal table %s with an auto-increment primary key", internalConfig.TableName),
+		internalTableSQL(internalConfig, autoIncrementKeyName()))
+	addPlanObject(plan, planObjectDorisTable, common.MIRA_TMP_TASK_DB+"."+internalConfig.TableName)
+	addPlanStep(plan, planActionInsert, fmt.Sprintf("import deployed table %s into %s", tablePrefix, internalConfig.TableName),
+		insertSelectSQL(internalConfig.TableName, strings.Join(columnNames, ", "), config.TableName))
+}
+
+// splitFilterConditions 将过滤条件拆成 BuildWithConditionQuery 使用的字段名、操作符和值
+func splitFilterConditions(filters []*pb.FilterCondition) ([]string, []pb.FilterOperator, []*pb.FilterValue) {
+	var names []string
+	var operators []pb.FilterOperator
+	var values []*pb.FilterValue
+	for _, fc := range filters {
+		if fc == nil || fc.FieldName == "" {
+			continue
+		}
+		names = append(names, fc.FieldName)
+		operators = append(operators, fc.Operator)
+		values = append(values, fc.FieldValue)
+	}
+	return names, operators, values
+}
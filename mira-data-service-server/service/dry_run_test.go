@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+	"testing"
+
+	"data-service/common"
+	"data-service/config"
+	pb "data-service/generated/datasource"
+	"data-service/mocks"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// sqlTable 构造多列查询结果
+func sqlTable(t *testing.T, columns []string, values ...[]driver.Value) *sql.Rows {
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	result := sqlmock.NewRows(columns)
+	for _, row := range values {
+		result.AddRow(row...)
+	}
+	sqlMock.ExpectQuery("SELECT").WillReturnRows(result)
+	rows, err := db.Query("SELECT")
+	require.NoError(t, err)
+	return rows
+}
+
+// recordingArrowStream 记录发送的 Arrow 响应
+type recordingArrowStream struct {
+	grpc.ServerStream
+	responses []*pb.ArrowResponse
+}
+
+func (s *recordingArrowStream) Send(resp *pb.ArrowResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func TestRedactCredentials(t *testing.T) {
+	cases := map[string]string{
+		`"password" = "p@ss\"word"`:                                      `"password" = "******"`,
+		`"s3.secret_key" = "sk", "user" = "u"`:                           `"s3.secret_key" = "******", "user" = "u"`,
+		`jdbc:mysql://h:3306/db?useSSL=true&password=abc%26def&user=u`:   `jdbc:mysql://h:3306/db?useSSL=true&password=******&user=u`,
+		`jdbc:kingbase8://h/db?sslkey=k%26sslpassword=secret%26ssl=true`: `jdbc:kingbase8://h/db?sslkey=k%26sslpassword=******%26ssl=true`,
+		`jdbc:gbase://h/db;trustStorePassword=x;user=u`:                  `jdbc:gbase://h/db;trustStorePassword=******;user=u`,
+		`SELECT password FROM users`:                                     `SELECT password FROM users`,
+	}
+	for input, want := range cases {
+		assert.Equal(t, want, redactCredentials(input), input)
+	}
+}
+
+func TestExplainCardinality(t *testing.T) {
+	explain := "PLAN FRAGMENT 0\n  0:VRESULT SINK\n     cardinality=42, avgRowSize=8\n  1:VOlapScanNode\n     cardinality=1000"
+	assert.Equal(t, int64(42), explainCardinality(explain))
+	assert.Equal(t, int64(-1), explainCardinality("PLAN FRAGMENT 0"))
+}
+
+func TestReadDryRunDoris(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	doris := mocks.NewMockIDorisService(ctrl)
+
+	doris.EXPECT().ExecuteSQL("SHOW TABLES FROM `db` LIKE 't'").Return(sqlRows(t, "t"), nil, nil)
+	doris.EXPECT().ExecuteSQL("EXPLAIN SELECT a, b FROM db.t WHERE a = 1").
+		Return(sqlRows(t, "PLAN FRAGMENT 0", "   cardinality=25"), nil, nil)
+	doris.EXPECT().ExecuteSQL(gomock.Any(), "db", "t").
+		Return(sqlTable(t, []string{"TABLE_ROWS", "DATA_LENGTH"}, []driver.Value{100, 4000}), nil, nil)
+
+	request := &pb.ReadRequest{
+		DataSource: &pb.ReadRequest_Doris{Doris: &pb.DorisDataSource{DbName: "db", TableName: "t"}},
+		Columns:    []string{"a", "b"},
+		FilterConditions: []*pb.FilterCondition{{
+			FieldName:  "a",
+			Operator:   pb.FilterOperator_EQUAL,
+			FieldValue: &pb.FilterValue{IntValue: 1},
+		}},
+		DryRun: true,
+	}
+	planner := newDryRunPlanner(doris, &config.DataServiceConf{OSSConfig: config.OSSConfig{AccessKey: "ak-123", SecretKey: "sk-456"}})
+	plan, err := planner.planRead(request, readJobInstanceId(request, planRandomSuffix))
+	require.NoError(t, err)
+
+	assert.Equal(t, "doris->minio->arrow", plan.Path)
+	var actions []string
+	for _, step := range plan.Steps {
+		actions = append(actions, step.Action)
+		assert.NotContains(t, step.Sql, "ak-123")
+		assert.NotContains(t, step.Sql, "sk-456")
+	}
+	assert.Equal(t, []string{planActionExport, planActionStream, planActionCleanup}, actions)
+	assert.Contains(t, plan.Steps[0].Sql, "INTO OUTFILE")
+	assert.Contains(t, plan.Steps[0].Explain, "cardinality=25")
+	assert.Equal(t, int64(25), plan.EstimatedRows)
+	assert.Equal(t, int64(1000), plan.EstimatedBytes)
+	assert.Equal(t, []*pb.PlanObject{{Kind: OrphanKindMinioExport, Name: "db@" + planRandomSuffix}}, plan.Objects)
+}
+
+func TestReadDryRunSendsPlanOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	doris := mocks.NewMockIDorisService(ctrl)
+	doris.EXPECT().ExecuteSQL(gomock.Any()).Return(sqlRows(t), nil, nil)
+
+	// 表不存在时返回错误，不导出
+	s := &ReadService{dorisService: doris}
+	stream := &recordingArrowStream{}
+	err := s.ProcessReadRequest(&pb.ReadRequest{
+		DataSource: &pb.ReadRequest_Doris{Doris: &pb.DorisDataSource{DbName: "db", TableName: "missing"}},
+		DryRun:     true,
+	}, stream)
+	assert.ErrorContains(t, err, "does not exist")
+	assert.Empty(t, stream.responses)
+}
+
+func TestImportDataDryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	// 只允许查询目标表是否存在，建库、建表、导入都不应发生
+	doris := mocks.NewMockIDorisService(ctrl)
+	doris.EXPECT().ExecuteSQL("SHOW TABLES FROM `job_db` LIKE 'orders'").Return(sqlRows(t, "orders"), nil, nil)
+
+	s, cleaned := newTestImportService(doris)
+	s.newPlanner = func(d IDorisService) *dryRunPlanner {
+		p := newDryRunPlanner(d, nil)
+		p.resolveAsset = func(source *pb.ExternalDataSource, dbName string) (*AssetInfo, *pb.ConnectionInfo, error) {
+			return &AssetInfo{
+				JdbcURL:         "jdbc:mysql://src:3306/shop?useSSL=false&password=jdbc-secret",
+				Username:        "reader",
+				Password:        "db-secret",
+				SourceTableName: "orders",
+				TableType:       "mysql",
+				Columns:         []common.ColumnInfo{{Name: "id", DataType: "BIGINT"}, {Name: "amount", DataType: "DECIMAL(10,2)"}},
+				RequestId:       "req1",
+			}, &pb.ConnectionInfo{
+				Host: "src", Port: 3306, DbName: "shop", TableName: "orders", Dbtype: int32(pb.DataSourceType_DATA_SOURCE_TYPE_MYSQL),
+			}, nil
+		}
+		p.estimateSource = func(*pb.ConnectionInfo) (*pb.TableInfoResponse, error) {
+			return &pb.TableInfoResponse{RecordCount64: 500, RecordSize: 64000}, nil
+		}
+		return p
+	}
+
+	target := importTarget("orders")
+	target.Window = &pb.ReadWindow{
+		FilterConditions: []*pb.FilterCondition{{FieldName: "amount", Operator: pb.FilterOperator_GREATER_THAN,
+			FieldValue: &pb.FilterValue{IntValue: 10}}},
+		Limit: 100,
+	}
+	response, err := s.ImportData(context.Background(), &pb.ImportDataRequest{
+		Targets: []*pb.ImportTarget{target},
+		Atomic:  true,
+		DryRun:  true,
+	})
+	require.NoError(t, err)
+	assert.True(t, response.Success)
+	assert.True(t, strings.HasPrefix(response.Message, "Dry run"))
+	assert.Empty(t, *cleaned)
+
+	plan := response.Results[0].Plan
+	require.NotNil(t, plan)
+	assert.Equal(t, int64(500), plan.EstimatedRows)
+	assert.Equal(t, int64(64000), plan.EstimatedBytes)
+	var actions []string
+	for _, step := range plan.Steps {
+		actions = append(actions, step.Action)
+		assert.NotContains(t, step.Sql, "db-secret")
+		assert.NotContains(t, step.Sql, "jdbc-secret")
+	}
+	assert.Equal(t, []string{
+		planActionResolveSource, planActionSourceQuery, planActionCreateDatabase, planActionCreateResource,
+		planActionCreateTable, planActionCreateTable, planActionInsert, planActionCleanup, planActionReplace,
+	}, actions)
+	assert.Contains(t, plan.Steps[1].Sql, "`amount`")
+	// 源查询包含读取窗口
+	assert.Contains(t, plan.Steps[1].Sql, "WHERE amount > ?")
+	assert.Contains(t, plan.Steps[1].Sql, "LIMIT 100")
+	assert.Contains(t, plan.Steps[1].Description, "[10]")
+	assert.Contains(t, plan.Steps[len(plan.Steps)-1].Sql, "REPLACE WITH TABLE")
+}
+
+func TestPlanSqlWithTargetTable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	doris := mocks.NewMockIDorisService(ctrl)
+
+	query := "SELECT id FROM src"
+	doris.EXPECT().ExecuteSQL("SELECT * FROM ("+query+") sub LIMIT 0").
+		Return(sqlTable(t, []string{"id"}), nil, nil)
+	doris.EXPECT().ExecuteSQL("EXPLAIN "+query).Return(sqlRows(t, "cardinality=7"), nil, nil)
+	doris.EXPECT().GetDBName().Return("job_db")
+
+	s := &SqlExecutionService{dorisService: doris}
+	plan, err := s.planSql(query, "result")
+	require.NoError(t, err)
+
+	assert.Equal(t, "doris->table", plan.Path)
+	require.Len(t, plan.Steps, 2)
+	assert.Equal(t, planActionCreateTable, plan.Steps[0].Action)
+	assert.Contains(t, plan.Steps[0].Sql, "CREATE TABLE IF NOT EXISTS job_db.result")
+	assert.Equal(t, planActionInsert, plan.Steps[1].Action)
+	assert.Equal(t, int64(7), plan.EstimatedRows)
+	assert.Equal(t, []*pb.PlanObject{{Kind: planObjectDorisTable, Name: "job_db.result"}}, plan.Objects)
+}
+
+func TestPlanSqlOtherStatement(t *testing.T) {
+	s := &SqlExecutionService{dorisService: mocks.NewMockIDorisService(gomock.NewController(t))}
+	plan, err := s.planSql("DROP TABLE t", "")
+	require.NoError(t, err)
+	assert.Equal(t, planActionExecute, plan.Steps[0].Action)
+	assert.Len(t, plan.Warnings, 1)
+	assert.Equal(t, int64(-1), plan.EstimatedRows)
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"data-service/common"
+	"data-service/config"
+	"data-service/database"
+	"data-service/generated/datasource"
+	"data-service/log"
+	"data-service/oss"
+	"data-service/utils"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+)
+
+// ExportStreamingService 按导出文件格式从OSS流式读取Doris导出分片并返回 Arrow 批次
+type ExportStreamingService struct {
+	ossClient     oss.ClientInterface
+	dorisService  IDorisService
+	csvStream     *CSVStreamingService
+	parquetStream *ParquetStreamingService
+}
+
+func NewExportStreamingService(chunkService *ChunkService, ossClient oss.ClientInterface, dorisService IDorisService) *ExportStreamingService {
+	return &ExportStreamingService{
+		ossClient:     ossClient,
+		dorisService:  dorisService,
+		csvStream:     NewCSVStreamingService(chunkService, ossClient, dorisService),
+		parquetStream: NewParquetStreamingService(chunkService, ossClient),
+	}
+}
+
+// StreamExportFromOSS 根据导出清单的格式选择对应的读取方式，默认parquet，只读取清单中的分片
+func (s *ExportStreamingService) StreamExportFromOSS(manifest *common.JobExportManifest, tableName string, columns []string, stream datasource.DataSourceService_ReadDataSourceStreamingServer) error {
+	if manifest == nil {
+		return fmt.Errorf("export manifest cannot be nil")
+	}
+	switch manifest.Format {
+	case common.FILE_FORMAT_CSV:
+		return s.csvStream.StreamCSVFileFromOSS(manifest, tableName, columns, stream)
+	case common.FILE_FORMAT_ORC:
+		return s.StreamORCFileFromOSS(manifest, columns, stream)
+	case common.FILE_FORMAT_PARQUET, "":
+		return s.parquetStream.StreamParquetFileFromOSS(manifest, tableName, columns, stream)
+	default:
+		return fmt.Errorf("unsupported export file format: %s", manifest.Format)
+	}
+}
+
+// StreamORCFileFromOSS 通过 Doris S3 表函数逐个读取 ORC 分片，Go 侧不直接解析 ORC 文件
+func (s *ExportStreamingService) StreamORCFileFromOSS(manifest *common.JobExportManifest, columns []string, stream datasource.DataSourceService_ReadDataSourceStreamingServer) error {
+	jobInstanceId := manifest.JobInstanceId
+	keys := manifest.Parts
+	if len(keys) == 0 {
+		return fmt.Errorf("no orc parts found for export %s in bucket %s", manifest.ExportId(), manifest.Bucket)
+	}
+
+	conf := config.GetConfigMap()
+	sqlGenerator := &database.SQLGenerator{}
+	var totalRecords int64
+	var chunkID int64
+	for i, key := range keys {
+		if stream.Context().Err() != nil {
+			return fmt.Errorf("stream connection lost: %v", stream.Context().Err())
+		}
+		log.Logger.Infof("Processing ORC part %d/%d: %s", i+1, len(keys), key)
+
+		querySQL := sqlGenerator.BuildS3FileQuerySQL(key, columns, common.FILE_FORMAT_ORC, conf)
+		n, err := s.streamQueryRows(querySQL, stream, &chunkID)
+		if err != nil {
+			return fmt.Errorf("failed to process part %s: %v", key, err)
+		}
+		totalRecords += n
+	}
+
+	log.Logger.Infof("Successfully streamed all ORC parts for jobInstanceId %s, total records: %d", jobInstanceId, totalRecords)
+	return nil
+}
+
+// streamQueryRows 执行查询并按批次将结果转换为 Arrow 记录发送
+func (s *ExportStreamingService) streamQueryRows(querySQL string, stream datasource.DataSourceService_ReadDataSourceStreamingServer, chunkID *int64) (int64, error) {
+	rows, done, err := s.dorisService.ExecuteSQL(querySQL)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute sql: %v", err)
+	}
+	if done != nil {
+		defer done()
+	} else {
+		defer rows.Close()
+	}
+
+	schema, err := rowsArrowSchema(rows)
+	if err != nil {
+		return 0, err
+	}
+	return scanRowsToArrow(rows, schema, queryBatchRows(config.GetConfigMap()), func(rec arrow.Record) error {
+		if err := sendArrowRecord(stream, rec, *chunkID); err != nil {
+			return fmt.Errorf("failed to send record: %v", err)
+		}
+		*chunkID++
+		return nil
+	})
+}
+
+// queryBatchRows 查询结果转换为 Arrow 记录时每批的行数
+func queryBatchRows(conf *config.DataServiceConf) int {
+	if conf.StreamConfig.ParquetBatchSize > 0 {
+		return conf.StreamConfig.ParquetBatchSize
+	}
+	return conf.Dbms.StreamDataSize
+}
+
+// rowsArrowSchema 按查询结果的列类型生成 Arrow Schema，无法识别的类型使用字符串
+func rowsArrowSchema(rows *sql.Rows) (*arrow.Schema, error) {
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get column types: %v", err)
+	}
+	fields := make([]arrow.Field, 0, len(columnTypes))
+	for _, ct := range columnTypes {
+		arrowType, err := common.ConvertDorisTypeToArrowType(strings.ToUpper(ct.DatabaseTypeName()))
+		if err != nil {
+			log.Logger.Warnf("Failed to convert column type for %s: %v, using string type", ct.Name(), err)
+			arrowType = arrow.BinaryTypes.String
+		}
+		fields = append(fields, arrow.Field{Name: ct.Name(), Type: arrowType, Nullable: true})
+	}
+	return arrow.NewSchema(fields, nil), nil
+}
+
+// scanRowsToArrow 逐行读取查询结果，每 batchSize 行组成一条 Arrow 记录交给 emit，返回读取的行数
+func scanRowsToArrow(rows *sql.Rows, schema *arrow.Schema, batchSize int, emit func(arrow.Record) error) (int64, error) {
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), schema)
+	defer builder.Release()
+
+	var total int64
+	pending := 0
+	flush := func() error {
+		rec := builder.NewRecord()
+		defer rec.Release()
+		if err := emit(rec); err != nil {
+			return err
+		}
+		total += rec.NumRows()
+		pending = 0
+		return nil
+	}
+
+	fields := schema.Fields()
+	values := make([]interface{}, len(fields))
+	valuePtrs := make([]interface{}, len(fields))
+	for i := range values {
+		valuePtrs[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(valuePtrs...); err != nil {
+			return total, fmt.Errorf("failed to scan row: %v", err)
+		}
+		for i, v := range values {
+			if err := utils.AppendValueToBuilder(builder.Field(i), v); err != nil {
+				return total, fmt.Errorf("failed to append value for column %s: %v", fields[i].Name, err)
+			}
+		}
+		pending++
+		if pending >= batchSize {
+			if err := flush(); err != nil {
+				return total, err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return total, fmt.Errorf("row iteration error: %v", err)
+	}
+	if pending > 0 {
+		if err := flush(); err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
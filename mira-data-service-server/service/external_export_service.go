@@ -0,0 +1,256 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"data-service/common"
+	"data-service/config"
+	"data-service/database"
+	ds "data-service/generated/datasource"
+	"data-service/log"
+	"data-service/oss"
+	"data-service/utils"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const manifestFileName = "manifest.json"
+
+// ExportManifest 外部导出的清单文件内容
+type ExportManifest struct {
+	JobInstanceId string                    `json:"jobInstanceId"`
+	Source        string                    `json:"source"`
+	Format        string                    `json:"format"`
+	CreatedAt     string                    `json:"createdAt"`
+	TotalRows     int64                     `json:"totalRows"`
+	TotalSize     int64                     `json:"totalSize"`
+	Files         []ExportManifestFileEntry `json:"files"`
+}
+
+// ExportManifestFileEntry 清单中的单个导出文件
+type ExportManifestFileEntry struct {
+	ObjectName string `json:"objectName"`
+	Size       int64  `json:"size"`
+	RowCount   int64  `json:"rowCount"`
+	ETag       string `json:"etag"` // 对象存储的 ETag，分片上传的文件不是内容的 MD5
+}
+
+// ExternalExportService 将Doris表导出到合作方的S3兼容对象存储
+type ExternalExportService struct {
+	dorisService IDorisService
+	conf         *config.DataServiceConf
+	newOSSClient func(cred config.ExportCredentialConfig) (oss.ClientInterface, error)
+}
+
+func NewExternalExportService(dorisService IDorisService) *ExternalExportService {
+	return &ExternalExportService{
+		dorisService: dorisService,
+		conf:         config.GetConfigMap(),
+		newOSSClient: func(cred config.ExportCredentialConfig) (oss.ClientInterface, error) {
+			return oss.NewMinIOClient(cred.Endpoint, cred.AccessKey, cred.SecretKey, cred.UseSSL)
+		},
+	}
+}
+
+// Export 按请求中的 target 导出数据，可选生成 manifest 并返回预签名下载地址
+func (s *ExternalExportService) Export(request *ds.ExportCsvFileFromDorisRequest) (*ds.ExportCsvFileFromDorisResponse, error) {
+	if request == nil || request.Target == nil {
+		return nil, fmt.Errorf("export target cannot be nil")
+	}
+	if request.DbName == "" || request.TableName == "" {
+		return nil, fmt.Errorf("export failed: DbName and TableName are required")
+	}
+	if request.JobInstanceId == "" {
+		return nil, fmt.Errorf("export failed: JobInstanceId is empty")
+	}
+	target := request.Target
+	if target.BucketName == "" {
+		return nil, fmt.Errorf("export failed: target bucketName is empty")
+	}
+	cred, ok := s.conf.ExportCredentials[target.CredentialRef]
+	if !ok || target.CredentialRef == "" {
+		return nil, fmt.Errorf("export failed: unknown credentialRef %q", target.CredentialRef)
+	}
+	format, err := common.ParseExportFileFormat(request.FileFormat)
+	if err != nil {
+		return nil, fmt.Errorf("export failed: %v", err)
+	}
+	if err := common.ValidateExportCompressType(request.CompressType, format); err != nil {
+		return nil, fmt.Errorf("export failed: %v", err)
+	}
+
+	s3Target := s.buildS3Target(target, cred)
+	ossClient, err := s.newOSSClient(cred)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create target oss client: %v", err)
+	}
+
+	// 1. 导出到外部对象存储，Doris 按 max_file_size 切分文件。
+	// 每次导出（含重试）写入 <jobInstanceId>/<label>/ 子目录，同一作业重复导出时不会列出之前的文件
+	sqlGenerator := &database.SQLGenerator{}
+	log.Logger.Infof("Exporting %s.%s to external bucket %s, credentialRef: %s", request.DbName, request.TableName, target.BucketName, target.CredentialRef)
+	maxRetries := 5
+	var jobPath string
+	err = utils.WithRetry(maxRetries, time.Second, func() error {
+		label, err := common.GenerateRandomString(common.SUFFIX_RANDOM_LENGTH)
+		if err != nil {
+			return fmt.Errorf("failed to generate export label: %v", err)
+		}
+		exportRequest := proto.Clone(request).(*ds.ExportCsvFileFromDorisRequest)
+		exportRequest.JobInstanceId = common.ExportJobPath(request.JobInstanceId, label)
+		jobPath = s3Target.JobPath(exportRequest.JobInstanceId)
+		_, _, err = s.dorisService.ExecuteSQL(sqlGenerator.BuildOutfileToTargetSQL(exportRequest, s3Target))
+		return err
+	}, utils.IsRetryableNetErr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute export SQL after %d retries: %v", maxRetries+1, err)
+	}
+
+	// 2. 列出本次导出的文件
+	ctx := context.Background()
+	keys, err := ossClient.ListObjects(ctx, target.BucketName, jobPath+"/", true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exported files: %v", err)
+	}
+	var parts []string
+	for _, key := range keys {
+		if path.Base(key) != manifestFileName {
+			parts = append(parts, key)
+		}
+	}
+	sort.Strings(parts)
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("no exported files found in %s/%s", target.BucketName, jobPath)
+	}
+
+	response := &ds.ExportCsvFileFromDorisResponse{
+		BucketName:    target.BucketName,
+		JobInstanceId: request.JobInstanceId,
+		FileFormat:    string(format),
+	}
+	for _, key := range parts {
+		response.Files = append(response.Files, &ds.ExportedFile{ObjectName: key})
+	}
+
+	// 3. 生成 manifest：统计每个文件的大小、行数和校验和
+	if target.WithManifest {
+		manifestObject, err := s.writeManifest(ctx, ossClient, s3Target, jobPath, request, format, response.Files)
+		if err != nil {
+			return nil, err
+		}
+		response.ManifestObject = manifestObject
+	}
+
+	// 4. 生成预签名下载地址
+	if target.Presign {
+		expiry := time.Duration(target.PresignExpirySeconds) * time.Second
+		for _, f := range response.Files {
+			url, err := ossClient.PresignedGetObject(target.BucketName, f.ObjectName, expiry)
+			if err != nil {
+				return nil, fmt.Errorf("failed to presign %s: %v", f.ObjectName, err)
+			}
+			f.PresignedUrl = url
+		}
+		if response.ManifestObject != "" {
+			url, err := ossClient.PresignedGetObject(target.BucketName, response.ManifestObject, expiry)
+			if err != nil {
+				return nil, fmt.Errorf("failed to presign manifest: %v", err)
+			}
+			response.ManifestUrl = url
+		}
+	}
+
+	log.Logger.Infof("Successfully exported %d %s files from %s.%s to %s/%s",
+		len(response.Files), format, request.DbName, request.TableName, target.BucketName, jobPath)
+	return response, nil
+}
+
+func (s *ExternalExportService) buildS3Target(target *ds.ExportTarget, cred config.ExportCredentialConfig) *database.S3Target {
+	scheme := "http"
+	if cred.UseSSL {
+		scheme = "https"
+	}
+	s3Target := &database.S3Target{
+		Endpoint:    fmt.Sprintf("%s://%s", scheme, cred.Endpoint),
+		Region:      cred.Region,
+		AccessKey:   cred.AccessKey,
+		SecretKey:   cred.SecretKey,
+		Bucket:      target.BucketName,
+		Prefix:      target.Prefix,
+		MaxFileSize: target.MaxFileSize,
+	}
+	s3Target.ApplyExportConfig(s.conf.DorisConfig)
+	return s3Target
+}
+
+// writeManifest 统计导出文件信息并写入本次导出目录下的 manifest.json，返回 manifest 对象名。
+// 文件由 Doris 直接写入对象存储，大小和 ETag 从对象元数据读取，不重新下载文件
+func (s *ExternalExportService) writeManifest(ctx context.Context, ossClient oss.ClientInterface, target *database.S3Target, jobPath string,
+	request *ds.ExportCsvFileFromDorisRequest, format common.FileFormat, files []*ds.ExportedFile) (string, error) {
+	sqlGenerator := &database.SQLGenerator{}
+	manifest := ExportManifest{
+		JobInstanceId: request.JobInstanceId,
+		Source:        fmt.Sprintf("%s.%s", request.DbName, request.TableName),
+		Format:        string(format),
+		CreatedAt:     time.Now().UTC().Format(time.RFC3339),
+	}
+	for _, f := range files {
+		info, err := ossClient.StatObject(ctx, target.Bucket, f.ObjectName)
+		if err != nil {
+			return "", fmt.Errorf("failed to stat %s: %v", f.ObjectName, err)
+		}
+		etag := strings.Trim(info.ETag, `"`)
+		rowCount, err := s.countRows(sqlGenerator.BuildS3CountSQL(target, f.ObjectName, request))
+		if err != nil {
+			return "", fmt.Errorf("failed to count rows of %s: %v", f.ObjectName, err)
+		}
+		f.Size, f.Checksum, f.RowCount = info.Size, etag, rowCount
+		manifest.TotalRows += rowCount
+		manifest.TotalSize += info.Size
+		manifest.Files = append(manifest.Files, ExportManifestFileEntry{
+			ObjectName: f.ObjectName,
+			Size:       info.Size,
+			RowCount:   rowCount,
+			ETag:       etag,
+		})
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal manifest: %v", err)
+	}
+	manifestObject := jobPath + "/" + manifestFileName
+	if _, err := ossClient.PutObject(ctx, target.Bucket, manifestObject, bytes.NewReader(data), int64(len(data)),
+		&oss.PutOptions{ContentType: "application/json"}); err != nil {
+		return "", fmt.Errorf("failed to upload manifest: %v", err)
+	}
+	log.Logger.Infof("Uploaded export manifest %s/%s, files: %d, total rows: %d", target.Bucket, manifestObject, len(files), manifest.TotalRows)
+	return manifestObject, nil
+}
+
+// countRows 通过 Doris S3 表函数统计导出文件行数
+func (s *ExternalExportService) countRows(countSQL string) (int64, error) {
+	rows, done, err := s.dorisService.ExecuteSQL(countSQL)
+	if err != nil {
+		return 0, err
+	}
+	if done != nil {
+		defer done()
+	} else {
+		defer rows.Close()
+	}
+	var count int64
+	if rows.Next() {
+		if err := rows.Scan(&count); err != nil {
+			return 0, err
+		}
+	}
+	return count, rows.Err()
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"data-service/config"
+	ds "data-service/generated/datasource"
+	"data-service/mocks"
+	"data-service/oss"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalExportRepeatListsOnlyItsOwnFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const bucket = "partner"
+	client := newMemoryOSSClient()
+	// 之前导出残留的文件
+	client.put(bucket, "out/job-1/export_stale_0.csv", []byte("stale"))
+
+	// 模拟 Doris 将本次导出写入 OUTFILE 指定的目录
+	outfilePath := regexp.MustCompile(`s3://` + bucket + `/(\S+)/export_"`)
+	doris := mocks.NewMockIDorisService(ctrl)
+	exports := 0
+	doris.EXPECT().ExecuteSQL(gomock.Any()).Times(2).DoAndReturn(func(query string, args ...interface{}) (*sql.Rows, func(), error) {
+		m := outfilePath.FindStringSubmatch(query)
+		require.NotNil(t, m, query)
+		client.put(bucket, fmt.Sprintf("%s/export_%d_0.csv", m[1], exports), []byte("a,b"))
+		exports++
+		return nil, nil, nil
+	})
+
+	s := &ExternalExportService{
+		dorisService: doris,
+		conf: &config.DataServiceConf{ExportCredentials: map[string]config.ExportCredentialConfig{
+			"partner": {Endpoint: "s3.partner.example", AccessKey: "ak", SecretKey: "sk"},
+		}},
+		newOSSClient: func(cred config.ExportCredentialConfig) (oss.ClientInterface, error) {
+			return client, nil
+		},
+	}
+	request := &ds.ExportCsvFileFromDorisRequest{
+		DbName:        "db",
+		TableName:     "orders",
+		JobInstanceId: "job-1",
+		FileFormat:    "csv",
+		Target:        &ds.ExportTarget{BucketName: bucket, CredentialRef: "partner", Prefix: "out"},
+	}
+
+	var seen []string
+	for i := 0; i < 2; i++ {
+		response, err := s.Export(request)
+		require.NoError(t, err)
+		require.Len(t, response.Files, 1)
+		object := response.Files[0].ObjectName
+		assert.Regexp(t, fmt.Sprintf(`^out/job-1/[^/]+/export_%d_0\.csv$`, i), object)
+		assert.NotContains(t, seen, object)
+		seen = append(seen, object)
+	}
+}
@@ -4,36 +4,158 @@ import (
 	"context"
 	"data-service/common"
 	"data-service/config"
+	"data-service/database/lock"
 	pb "data-service/generated/datasource"
 	"data-service/log"
 	"fmt"
 	"strings"
 	"sync"
+
+	"github.com/google/uuid"
 )
 
+// defaultImportConcurrency 未配置时并发导入的目标数
+const defaultImportConcurrency = 4
+
 // ImportService 数据导入服务
 type ImportService struct {
+	newDorisService   func(dbName string) (IDorisService, error)
+	createCleanupTask func(dbName string)
+	newPlanner        func(doris IDorisService) *dryRunPlanner
+	locker            lock.Locker // 按库+表串行化集群内的导入
+	concurrency       int         // 并发导入的目标数
+	batchSize         int         // 自增主键分批导入时每批的条数
 }
 
-var importLocks sync.Map
+// importProgressFunc 接收目标导入进度，并发调用
+type importProgressFunc func(progress *pb.ImportDataProgress)
 
 // NewImportService 创建数据导入服务实例
 func NewImportService() *ImportService {
-	return &ImportService{}
+	conf := config.GetConfigMap().DorisConfig
+	return &ImportService{
+		newDorisService:   func(dbName string) (IDorisService, error) { return NewDorisService(dbName) },
+		createCleanupTask: createImportCleanupTask,
+		newPlanner:        func(doris IDorisService) *dryRunPlanner { return newDryRunPlanner(doris, config.GetConfigMap()) },
+		locker:            lock.Default(),
+		concurrency:       conf.ImportConcurrency,
+		batchSize:         conf.ImportBatchSize,
+	}
 }
 
 // ImportData 执行数据导入
 func (s *ImportService) ImportData(ctx context.Context, request *pb.ImportDataRequest) (*pb.ImportDataResponse, error) {
+	return s.importTargets(ctx, request, nil), nil
+}
+
+// ImportDataStream 执行数据导入，逐个目标推送导入进度，最后推送汇总结果
+func (s *ImportService) ImportDataStream(request *pb.ImportDataRequest, stream pb.DataSourceService_ImportDataStreamServer) error {
+	var (
+		mu      sync.Mutex
+		sendErr error
+	)
+	send := func(progress *pb.ImportDataProgress) {
+		mu.Lock()
+		defer mu.Unlock()
+		if sendErr != nil {
+			return
+		}
+		// 客户端断开后导入继续执行，保证原子模式能完成替换或回滚
+		if sendErr = stream.Send(progress); sendErr != nil {
+			log.Logger.Warnf("Failed to send import progress: %v", sendErr)
+		}
+	}
+
+	response := s.importTargets(stream.Context(), request, send)
+	send(&pb.ImportDataProgress{TargetIndex: -1, Summary: response})
+	return sendErr
+}
+
+// importTargets 按并发上限导入所有目标，原子模式下全部成功才替换目标表
+func (s *ImportService) importTargets(ctx context.Context, request *pb.ImportDataRequest, progress importProgressFunc) *pb.ImportDataResponse {
+	if progress == nil {
+		progress = func(*pb.ImportDataProgress) {}
+	}
+	targets := request.GetTargets()
 	response := &pb.ImportDataResponse{
 		Success: true,
 		Message: "Import completed",
-		Results: make([]*pb.ImportResult, 0, len(request.Targets)),
+		Results: make([]*pb.ImportResult, len(targets)),
+	}
+	if len(targets) == 0 {
+		s.setFinalMessage(response)
+		return response
 	}
 
-	for _, target := range request.Targets {
-		result := s.processImportTarget(ctx, target)
-		response.Results = append(response.Results, result)
+	var staged []string
+	if request.GetAtomic() {
+		var err error
+		if staged, err = stagingTableNames(targets); err != nil {
+			for i, target := range targets {
+				response.Results[i] = newImportResult(target)
+				response.Results[i].ErrorMessage = err.Error()
+				progress(importProgress(i, target, pb.ImportStage_IMPORT_STAGE_FAILED, response.Results[i]))
+			}
+			response.Success = false
+			s.setFinalMessage(response)
+			return response
+		}
+	}
 
+	if request.GetDryRun() {
+		s.planTargets(targets, staged, response, progress)
+		return response
+	}
+
+	workers := int(request.GetMaxConcurrency())
+	if workers <= 0 {
+		workers = s.concurrency
+	}
+	if workers <= 0 {
+		workers = defaultImportConcurrency
+	}
+	if workers > len(targets) {
+		workers = len(targets)
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				target := targets[i]
+				progress(importProgress(i, target, pb.ImportStage_IMPORT_STAGE_IMPORTING, nil))
+
+				tableName := target.TargetTableName
+				if staged != nil {
+					tableName = staged[i]
+				}
+				result := s.processImportTarget(ctx, target, tableName, staged == nil)
+				response.Results[i] = result
+
+				stage := pb.ImportStage_IMPORT_STAGE_SUCCEEDED
+				if !result.Success {
+					stage = pb.ImportStage_IMPORT_STAGE_FAILED
+				} else if staged != nil {
+					stage = pb.ImportStage_IMPORT_STAGE_STAGED
+				}
+				progress(importProgress(i, target, stage, result))
+			}
+		}()
+	}
+	for i := range targets {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	if staged != nil {
+		s.finishAtomicImport(ctx, targets, staged, response.Results, progress)
+	}
+
+	for _, result := range response.Results {
 		// 如果有任何失败，更新整体状态
 		if !result.Success {
 			response.Success = false
@@ -43,16 +165,266 @@ func (s *ImportService) ImportData(ctx context.Context, request *pb.ImportDataRe
 	// 设置最终消息
 	s.setFinalMessage(response)
 
-	return response, nil
+	return response
+}
+
+// planTargets 试运行：为每个目标生成执行计划，不加锁、不建库建表、不导入
+func (s *ImportService) planTargets(targets []*pb.ImportTarget, staged []string, response *pb.ImportDataResponse, progress importProgressFunc) {
+	dorisService, err := s.newDorisService("")
+	for i, target := range targets {
+		result := newImportResult(target)
+		response.Results[i] = result
+		tableName := target.TargetTableName
+		if staged != nil {
+			tableName = staged[i]
+		}
+
+		stage := pb.ImportStage_IMPORT_STAGE_FAILED
+		if err != nil {
+			result.ErrorMessage = fmt.Sprintf("failed to create doris service: %v", err)
+		} else if plan, planErr := s.planTarget(dorisService, target, tableName); planErr != nil {
+			result.ErrorMessage = planErr.Error()
+		} else {
+			result.Success = true
+			result.SourceTableName = target.External.AssetName
+			result.TargetTableName = target.TargetTableName
+			result.Plan = plan
+			stage = pb.ImportStage_IMPORT_STAGE_SUCCEEDED
+		}
+		if !result.Success {
+			response.Success = false
+		}
+		progress(importProgress(i, target, stage, result))
+	}
+
+	s.setFinalMessage(response)
+	response.Message = "Dry run: " + response.Message
+}
+
+// planTarget 生成单个目标导入 tableName 的执行计划，tableName 与目标表不同时为原子导入的临时表
+func (s *ImportService) planTarget(dorisService IDorisService, target *pb.ImportTarget, tableName string) (*pb.ExecutionPlan, error) {
+	plan := newExecutionPlan("external->doris")
+	jobInstanceId := target.DbName + "_" + planRandomSuffix
+	if err := s.newPlanner(dorisService).planImportTarget(plan, target, tableName, jobInstanceId); err != nil {
+		return nil, err
+	}
+	if tableName != target.TargetTableName {
+		exists, err := dorisTableExists(dorisService, target.DbName, target.TargetTableName)
+		if err != nil {
+			addPlanWarning(plan, "failed to check table %s.%s: %v", target.DbName, target.TargetTableName, err)
+		}
+		addPlanStep(plan, planActionReplace, fmt.Sprintf("replace %s.%s with staging table %s after all targets are imported",
+			target.DbName, target.TargetTableName, tableName), replaceTableSQL(target.DbName, target.TargetTableName, tableName, exists))
+	}
+	return plan, nil
+}
+
+// stagingTableNames 为原子导入的每个目标生成临时表名，同一目标表出现多次时返回错误
+func stagingTableNames(targets []*pb.ImportTarget) ([]string, error) {
+	names := make([]string, len(targets))
+	seen := make(map[string]bool, len(targets))
+	for i, target := range targets {
+		key := target.DbName + "." + target.TargetTableName
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate target table %s in atomic import", key)
+		}
+		seen[key] = true
+		suffix, err := common.GenerateRandomString(8)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate staging table name: %v", err)
+		}
+		names[i] = fmt.Sprintf("%s_staging_%s", target.TargetTableName, strings.ToLower(suffix))
+	}
+	return names, nil
+}
+
+// finishAtomicImport 全部目标导入临时表成功后逐个替换目标表，否则删除所有临时表。
+// 替换使用 swap=true，原表换到临时表名下保留，全部替换成功后才删除；
+// 任一目标替换失败时把已替换的目标换回原表，所有目标回到导入前的状态
+func (s *ImportService) finishAtomicImport(ctx context.Context, targets []*pb.ImportTarget, staged []string, results []*pb.ImportResult, progress importProgressFunc) {
+	allStaged := true
+	for _, result := range results {
+		if !result.Success {
+			allStaged = false
+			break
+		}
+	}
+	if !allStaged {
+		s.rollbackAtomicImport(targets, staged, nil, results, progress, "rolled back: other targets failed to import")
+		return
+	}
+
+	// existed[i] 记录替换前目标表是否存在，决定回退时换回还是改回临时表名
+	existed := make([]bool, 0, len(targets))
+	for i, target := range targets {
+		exists, err := s.replaceTable(ctx, target.DbName, target.TargetTableName, staged[i])
+		if err != nil {
+			log.Logger.Errorf("Failed to replace table %s.%s with %s: %v", target.DbName, target.TargetTableName, staged[i], err)
+			// 换回失败时临时表名下仍是原表，保留不删，由人工恢复
+			keep := make([]bool, len(targets))
+			for j := len(existed) - 1; j >= 0; j-- {
+				if restoreErr := s.restoreTable(ctx, targets[j].DbName, targets[j].TargetTableName, staged[j], existed[j]); restoreErr != nil {
+					log.Logger.Errorf("Failed to restore table %s.%s from %s: %v", targets[j].DbName, targets[j].TargetTableName, staged[j], restoreErr)
+					results[j].ErrorMessage = fmt.Sprintf("failed to restore original table from %s after replace failure: %v", staged[j], restoreErr)
+					keep[j] = true
+				}
+			}
+			results[i].Success = false
+			results[i].ErrorMessage = err.Error()
+			progress(importProgress(i, target, pb.ImportStage_IMPORT_STAGE_FAILED, results[i]))
+			s.rollbackAtomicImport(targets, staged, keep, results, progress, "rolled back: other targets failed to replace")
+			return
+		}
+		existed = append(existed, exists)
+	}
+
+	for i, target := range targets {
+		// 替换后临时表名下是原表，目标表原先不存在时临时表已改名，无需删除
+		if existed[i] {
+			s.dropStagingTable(target.DbName, staged[i])
+		}
+		results[i].TargetTableName = target.TargetTableName
+		s.createCleanupTask(target.DbName)
+		progress(importProgress(i, target, pb.ImportStage_IMPORT_STAGE_SUCCEEDED, results[i]))
+	}
+}
+
+// rollbackAtomicImport 删除 keep 未标记的临时表，把仍标记为成功的目标改为已回退
+func (s *ImportService) rollbackAtomicImport(targets []*pb.ImportTarget, staged []string, keep []bool, results []*pb.ImportResult, progress importProgressFunc, reason string) {
+	for i, target := range targets {
+		if keep == nil || !keep[i] {
+			s.dropStagingTable(target.DbName, staged[i])
+		}
+		if results[i].Success {
+			results[i].Success = false
+			if results[i].ErrorMessage == "" {
+				results[i].ErrorMessage = reason
+			}
+			progress(importProgress(i, target, pb.ImportStage_IMPORT_STAGE_ROLLED_BACK, results[i]))
+		}
+	}
+}
+
+// replaceTable 用临时表替换目标表，目标表不存在时直接重命名临时表，返回替换前目标表是否存在
+func (s *ImportService) replaceTable(ctx context.Context, dbName, tableName, stagingTable string) (bool, error) {
+	lease, err := s.acquireImportLock(ctx, dbName, tableName)
+	if err != nil {
+		return false, err
+	}
+	defer releaseImportLock(lease)
+
+	dorisService, err := s.newDorisService(dbName)
+	if err != nil {
+		return false, fmt.Errorf("failed to create doris service: %v", err)
+	}
+	exists, err := dorisTableExists(dorisService, dbName, tableName)
+	if err != nil {
+		return false, fmt.Errorf("failed to check table %s.%s: %v", dbName, tableName, err)
+	}
+
+	replaceSQL := replaceTableSQL(dbName, tableName, stagingTable, exists)
+	if err := lease.Check(ctx); err != nil {
+		return false, fmt.Errorf("import lock of %s.%s is no longer held before replacing table: %v", dbName, tableName, err)
+	}
+	if _, err := dorisService.ExecuteUpdate(replaceSQL); err != nil {
+		return false, fmt.Errorf("failed to replace table %s.%s: %v", dbName, tableName, err)
+	}
+	log.Logger.Infof("Replaced table %s.%s with staging table %s", dbName, tableName, stagingTable)
+	return exists, nil
+}
+
+// restoreTable 撤销 replaceTable：目标表原先存在时再次交换换回原表，否则把目标表改回临时表名
+func (s *ImportService) restoreTable(ctx context.Context, dbName, tableName, stagingTable string, existed bool) error {
+	lease, err := s.acquireImportLock(ctx, dbName, tableName)
+	if err != nil {
+		return err
+	}
+	defer releaseImportLock(lease)
+
+	dorisService, err := s.newDorisService(dbName)
+	if err != nil {
+		return fmt.Errorf("failed to create doris service: %v", err)
+	}
+	restoreSQL := fmt.Sprintf("ALTER TABLE `%s`.`%s` RENAME `%s`", dbName, tableName, stagingTable)
+	if existed {
+		restoreSQL = replaceTableSQL(dbName, tableName, stagingTable, true)
+	}
+	if err := lease.Check(ctx); err != nil {
+		return fmt.Errorf("import lock of %s.%s is no longer held before restoring table: %v", dbName, tableName, err)
+	}
+	if _, err := dorisService.ExecuteUpdate(restoreSQL); err != nil {
+		return fmt.Errorf("failed to restore table %s.%s: %v", dbName, tableName, err)
+	}
+	log.Logger.Infof("Restored table %s.%s from %s", dbName, tableName, stagingTable)
+	return nil
+}
+
+// replaceTableSQL 构建用临时表替换目标表的SQL，目标表不存在时为重命名
+func replaceTableSQL(dbName, tableName, stagingTable string, exists bool) string {
+	if exists {
+		// swap=true：两表互换名称，原表保留在临时表名下，回退时再交换一次
+		return fmt.Sprintf("ALTER TABLE `%s`.`%s` REPLACE WITH TABLE `%s` PROPERTIES('swap' = 'true')", dbName, tableName, stagingTable)
+	}
+	return fmt.Sprintf("ALTER TABLE `%s`.`%s` RENAME `%s`", dbName, stagingTable, tableName)
+}
+
+// dorisTableExists 判断表是否存在
+func dorisTableExists(dorisService IDorisService, dbName, tableName string) (bool, error) {
+	rows, done, err := dorisService.ExecuteSQL(fmt.Sprintf("SHOW TABLES FROM `%s` LIKE '%s'", dbName, tableName))
+	if err != nil {
+		return false, err
+	}
+	if done != nil {
+		defer done()
+	} else {
+		defer rows.Close()
+	}
+	exists := rows.Next()
+	return exists, rows.Err()
+}
+
+// dropStagingTable 删除原子导入的临时表，失败只记录日志
+func (s *ImportService) dropStagingTable(dbName, stagingTable string) {
+	dorisService, err := s.newDorisService(dbName)
+	if err == nil {
+		err = dorisService.DropTable(dbName, stagingTable)
+	}
+	if err != nil {
+		log.Logger.Warnf("Failed to drop staging table %s.%s: %v", dbName, stagingTable, err)
+	}
 }
 
-// processImportTarget 处理单个导入目标
-func (s *ImportService) processImportTarget(ctx context.Context, target *pb.ImportTarget) *pb.ImportResult {
-	result := &pb.ImportResult{
-		SourceDatabase: target.External.AssetName,
+func newImportResult(target *pb.ImportTarget) *pb.ImportResult {
+	return &pb.ImportResult{
+		SourceDatabase: target.GetExternal().GetAssetName(),
 		TargetDatabase: target.DbName,
 		Success:        false,
 	}
+}
+
+func importProgress(index int, target *pb.ImportTarget, stage pb.ImportStage, result *pb.ImportResult) *pb.ImportDataProgress {
+	return &pb.ImportDataProgress{
+		TargetIndex:     int32(index),
+		TargetDatabase:  target.DbName,
+		TargetTableName: target.TargetTableName,
+		Stage:           stage,
+		Result:          result,
+	}
+}
+
+// processImportTarget 处理单个导入目标，数据写入 tableName；cleanup 为 false 时由调用方创建清理任务
+func (s *ImportService) processImportTarget(ctx context.Context, target *pb.ImportTarget, tableName string, cleanup bool) *pb.ImportResult {
+	result := newImportResult(target)
+	if err := ctx.Err(); err != nil {
+		result.ErrorMessage = fmt.Sprintf("import cancelled: %v", err)
+		return result
+	}
+
+	tolerance, err := common.NewErrorTolerance(target.MaxErrorRatio, target.MaxErrorRows)
+	if err != nil {
+		result.ErrorMessage = err.Error()
+		return result
+	}
 
 	// 1. 创建数据库
 	if err := s.ensureDatabase(target.DbName); err != nil {
@@ -60,15 +432,21 @@ func (s *ImportService) processImportTarget(ctx context.Context, target *pb.Impo
 		return result
 	}
 
-	// 2. 导入数据
-	tableName, affectedRows, err := s.importDataToDoris(target)
+	// 2. 导入数据，被过滤的行无论成功失败都随结果返回
+	tableName, affectedRows, rejects, err := s.importDataToDoris(ctx, target, tableName, tolerance)
+	if rejects != nil {
+		result.RejectedRows = rejects.Rows
+		result.RejectsObject = rejects.Object
+	}
 	if err != nil {
 		result.ErrorMessage = err.Error()
 		return result
 	}
 
 	// 3. 创建清理任务
-	s.createCleanupTask(target.DbName)
+	if cleanup {
+		s.createCleanupTask(target.DbName)
+	}
 
 	// 设置成功结果
 	result.Success = true
@@ -81,7 +459,7 @@ func (s *ImportService) processImportTarget(ctx context.Context, target *pb.Impo
 
 // ensureDatabase 确保数据库存在
 func (s *ImportService) ensureDatabase(dbName string) error {
-	dbService, err := NewDorisService("")
+	dbService, err := s.newDorisService("")
 	if err != nil {
 		return fmt.Errorf("failed to create db service: %v", err)
 	}
@@ -94,30 +472,42 @@ func (s *ImportService) ensureDatabase(dbName string) error {
 	return nil
 }
 
-// importDataToDoris 将数据导入到 Doris
-func (s *ImportService) importDataToDoris(target *pb.ImportTarget) (string, int64, error) {
+// importDataToDoris 将数据导入到 Doris 的 tableName 表，设置了容错阈值时返回被过滤的行
+func (s *ImportService) importDataToDoris(ctx context.Context, target *pb.ImportTarget, tableName string, tolerance common.ErrorTolerance) (_ string, _ int64, rejects *common.RejectSummary, err error) {
 	log.Logger.Infof("importDataToDoris params - DbName: %s, TargetTableName: %s, Columns: %v, External: %+v, Keys: %+v",
-		target.DbName, target.TargetTableName, target.Columns, target.External, target.Keys)
+		target.DbName, tableName, target.Columns, target.External, target.Keys)
 
-	// 并发防重：按库+表序列化导入
-	unlock := lockImport(fmt.Sprintf("%s.%s", target.DbName, target.TargetTableName))
-	defer unlock()
+	// 并发防重：按库+表在集群内序列化导入
+	lease, err := s.acquireImportLock(ctx, target.DbName, tableName)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	defer releaseImportLock(lease)
 
-	dorisService, err := NewDorisService(target.DbName)
+	dorisService, err := s.newDorisService(target.DbName)
 	if err != nil {
-		return "", 0, fmt.Errorf("failed to create doris service: %v", err)
+		return "", 0, nil, fmt.Errorf("failed to create doris service: %v", err)
+	}
+	if tolerance.Enabled() {
+		dorisService.SetErrorTolerance(tolerance, fmt.Sprintf("import_%s_%s", target.DbName, uuid.NewString()))
+		defer func() { rejects = dorisService.SaveRejects() }()
+	}
+	if target.Window != nil {
+		dorisService.SetSourceWindow(target.Window)
 	}
 
 	randomSuffix, err := common.GenerateRandomString(8)
 	if err != nil {
-		return "", 0, fmt.Errorf("failed to generate random suffix: %v", err)
+		return "", 0, nil, fmt.Errorf("failed to generate random suffix: %v", err)
 	}
 	jobInstanceId := target.DbName + "_" + randomSuffix
+	defer TrackActiveJob(target.DbName)()
+	defer TrackActiveJob(jobInstanceId)()
 
-	// 优先使用 ImportTarget.keys 中的“自增主键”定义
-	if pk, ok := getAutoIncrementPrimaryKey(target); ok {
-		// 从配置文件读取分批大小，如果未配置则使用默认值 5000
-		batchSize := config.GetConfigMap().DorisConfig.ImportBatchSize
+	// 优先使用 ImportTarget.keys 中的“自增主键”定义；设置了读取窗口时按窗口一次性导入
+	if pk, ok := getAutoIncrementPrimaryKey(target); ok && target.Window == nil {
+		// 分批大小未配置时使用默认值 5000
+		batchSize := s.batchSize
 		if batchSize <= 0 {
 			batchSize = 5000
 		}
@@ -126,34 +516,40 @@ func (s *ImportService) importDataToDoris(target *pb.ImportTarget) (string, int6
 			target.External.ChainInfoId,
 			target.External.Alias,
 			jobInstanceId,
-			target.TargetTableName,
+			tableName,
 			target.DbName,
 			pk,
 			batchSize,
 			target.Columns...,
 		)
 		if err != nil {
-			return "", 0, err
+			return "", 0, nil, err
+		}
+		if err := lease.Check(ctx); err != nil {
+			return "", 0, nil, fmt.Errorf("import lock of %s.%s is no longer held after import: %v", target.DbName, tableName, err)
 		}
 		affectedRows, err := s.getTableRowCount(dorisService, tableName)
 		if err != nil {
 			log.Logger.Warnf("Failed to get row count for table %s: %v", tableName, err)
 			affectedRows = 0
 		}
-		return tableName, affectedRows, nil
+		return tableName, affectedRows, nil, nil
 	}
 
-	tableName, err := dorisService.CreateExternalAndInternalTableAndImportData(
+	tableName, err = dorisService.CreateExternalAndInternalTableAndImportData(
 		target.External.AssetName,
 		target.External.ChainInfoId,
 		target.External.Alias,
 		jobInstanceId,
-		target.TargetTableName,
+		tableName,
 		target.DbName,
 		target.Columns...,
 	)
 	if err != nil {
-		return "", 0, fmt.Errorf("failed to create external table from asset: %v", err)
+		return "", 0, nil, fmt.Errorf("failed to create external table from asset: %v", err)
+	}
+	if err := lease.Check(ctx); err != nil {
+		return "", 0, nil, fmt.Errorf("import lock of %s.%s is no longer held after import: %v", target.DbName, tableName, err)
 	}
 
 	// 查询导入的行数
@@ -164,7 +560,7 @@ func (s *ImportService) importDataToDoris(target *pb.ImportTarget) (string, int6
 		affectedRows = 0
 	}
 
-	return tableName, affectedRows, nil
+	return tableName, affectedRows, nil, nil
 }
 
 // getTableRowCount 获取表的行数
@@ -191,8 +587,9 @@ func (s *ImportService) getTableRowCount(dorisService IDorisService, tableName s
 	return 0, fmt.Errorf("no result returned from count query")
 }
 
-// createCleanupTask 创建清理任务
-func (s *ImportService) createCleanupTask(dbName string) {
+// createImportCleanupTask 创建清理任务并登记库的生命周期
+func createImportCleanupTask(dbName string) {
+	RegisterTableTTL(dbName, "", dbName)
 	cleanupService := NewCleanupTaskService()
 	cleanupTask, err := cleanupService.GetOrCreateCleanupTask(dbName, "doris_table")
 	if err != nil {
@@ -250,9 +647,19 @@ func getAutoIncrementPrimaryKey(target *pb.ImportTarget) (string, bool) {
 	return "", false
 }
 
-func lockImport(key string) func() {
-	muIface, _ := importLocks.LoadOrStore(key, &sync.Mutex{})
-	mu := muIface.(*sync.Mutex)
-	mu.Lock()
-	return func() { mu.Unlock() }
+// acquireImportLock 获取库+表的导入锁，其他副本或协程正在导入同一张表时等待
+func (s *ImportService) acquireImportLock(ctx context.Context, dbName, tableName string) (*lock.Lease, error) {
+	key := fmt.Sprintf("import:%s.%s", dbName, tableName)
+	lease, err := s.locker.Acquire(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	log.Logger.Infof("Acquired import lock %s, fencing token %d", key, lease.Token)
+	return lease, nil
+}
+
+func releaseImportLock(lease *lock.Lease) {
+	if err := lease.Release(); err != nil {
+		log.Logger.Warnf("Failed to release import lock %s: %v", lease.Key, err)
+	}
 }
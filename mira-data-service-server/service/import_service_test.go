@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"data-service/database/lock"
+	pb "data-service/generated/datasource"
+	"data-service/mocks"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// recordingImportStream 记录推送的导入进度
+type recordingImportStream struct {
+	grpc.ServerStream
+	mu       sync.Mutex
+	progress []*pb.ImportDataProgress
+}
+
+func (s *recordingImportStream) Send(progress *pb.ImportDataProgress) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.progress = append(s.progress, progress)
+	return nil
+}
+
+func (s *recordingImportStream) Context() context.Context {
+	return context.Background()
+}
+
+func newTestImportService(doris IDorisService) (*ImportService, *[]string) {
+	var (
+		mu      sync.Mutex
+		cleaned []string
+	)
+	return &ImportService{
+		newDorisService: func(string) (IDorisService, error) { return doris, nil },
+		createCleanupTask: func(dbName string) {
+			mu.Lock()
+			defer mu.Unlock()
+			cleaned = append(cleaned, dbName)
+		},
+		locker:      lock.NewLocalLocker(lock.Options{}),
+		concurrency: 2,
+	}, &cleaned
+}
+
+func importTarget(table string) *pb.ImportTarget {
+	return &pb.ImportTarget{
+		External:        &pb.ExternalDataSource{AssetName: "asset_" + table, ChainInfoId: "chain1"},
+		TargetTableName: table,
+		DbName:          "job_db",
+	}
+}
+
+// sqlRows 构造查询结果，无列值时返回空结果
+func sqlRows(t *testing.T, values ...string) *sql.Rows {
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	result := sqlmock.NewRows([]string{"value"})
+	for _, v := range values {
+		result.AddRow(v)
+	}
+	sqlMock.ExpectQuery("SELECT").WillReturnRows(result)
+	rows, err := db.Query("SELECT")
+	require.NoError(t, err)
+	return rows
+}
+
+func TestImportData_ImportsTargetsConcurrently(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	doris := mocks.NewMockIDorisService(ctrl)
+
+	// 两个目标都开始导入后才返回，串行执行时会超时失败
+	var started sync.WaitGroup
+	started.Add(2)
+	allStarted := make(chan struct{})
+	go func() { started.Wait(); close(allStarted) }()
+
+	doris.EXPECT().EnsureDorisDatabaseExists("job_db").Return(nil).Times(2)
+	doris.EXPECT().CreateExternalAndInternalTableAndImportData(gomock.Any(), "chain1", "", gomock.Any(), gomock.Any(), "job_db").
+		DoAndReturn(func(assetName, chainInfoId, alias, jobInstanceId, tableName, dbName string, columns ...string) (string, error) {
+			started.Done()
+			select {
+			case <-allStarted:
+			case <-time.After(2 * time.Second):
+				return "", errors.New("targets were not imported concurrently")
+			}
+			if tableName == "t2" {
+				return "", errors.New("source unreachable")
+			}
+			return tableName, nil
+		}).Times(2)
+	doris.EXPECT().ExecuteSQL("SELECT COUNT(*) FROM t1").Return(nil, nil, errors.New("count unavailable"))
+
+	s, cleaned := newTestImportService(doris)
+	resp, err := s.ImportData(context.Background(), &pb.ImportDataRequest{Targets: []*pb.ImportTarget{importTarget("t1"), importTarget("t2")}})
+	require.NoError(t, err)
+
+	require.Len(t, resp.Results, 2)
+	assert.True(t, resp.Results[0].Success)
+	assert.Equal(t, "t1", resp.Results[0].TargetTableName)
+	assert.False(t, resp.Results[1].Success)
+	assert.Contains(t, resp.Results[1].ErrorMessage, "source unreachable")
+	assert.False(t, resp.Success)
+	assert.Equal(t, "Import completed with some failures", resp.Message)
+	assert.Equal(t, []string{"job_db"}, *cleaned)
+}
+
+func TestImportDataStream_AtomicRollsBackOnFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	doris := mocks.NewMockIDorisService(ctrl)
+
+	var (
+		mu      sync.Mutex
+		staging []string
+	)
+	doris.EXPECT().EnsureDorisDatabaseExists("job_db").Return(nil).Times(2)
+	doris.EXPECT().CreateExternalAndInternalTableAndImportData(gomock.Any(), "chain1", "", gomock.Any(), gomock.Any(), "job_db").
+		DoAndReturn(func(assetName, chainInfoId, alias, jobInstanceId, tableName, dbName string, columns ...string) (string, error) {
+			mu.Lock()
+			staging = append(staging, tableName)
+			mu.Unlock()
+			if strings.HasPrefix(tableName, "t2_staging_") {
+				return "", errors.New("source unreachable")
+			}
+			return tableName, nil
+		}).Times(2)
+	doris.EXPECT().ExecuteSQL(gomock.Any()).Return(nil, nil, errors.New("count unavailable")).AnyTimes()
+	var dropped []string
+	doris.EXPECT().DropTable("job_db", gomock.Any()).DoAndReturn(func(dbName, tableName string) error {
+		dropped = append(dropped, tableName)
+		return nil
+	}).Times(2)
+	// 任一目标失败时不替换目标表
+	doris.EXPECT().ExecuteUpdate(gomock.Any()).Times(0)
+
+	s, cleaned := newTestImportService(doris)
+	stream := &recordingImportStream{}
+	require.NoError(t, s.ImportDataStream(&pb.ImportDataRequest{
+		Targets: []*pb.ImportTarget{importTarget("t1"), importTarget("t2")},
+		Atomic:  true,
+	}, stream))
+
+	assert.ElementsMatch(t, staging, dropped)
+	assert.Empty(t, *cleaned)
+
+	stages := map[int32][]pb.ImportStage{}
+	for _, p := range stream.progress[:len(stream.progress)-1] {
+		stages[p.TargetIndex] = append(stages[p.TargetIndex], p.Stage)
+	}
+	assert.Equal(t, []pb.ImportStage{pb.ImportStage_IMPORT_STAGE_IMPORTING, pb.ImportStage_IMPORT_STAGE_STAGED, pb.ImportStage_IMPORT_STAGE_ROLLED_BACK}, stages[0])
+	assert.Equal(t, []pb.ImportStage{pb.ImportStage_IMPORT_STAGE_IMPORTING, pb.ImportStage_IMPORT_STAGE_FAILED}, stages[1])
+
+	summary := stream.progress[len(stream.progress)-1].Summary
+	require.NotNil(t, summary)
+	assert.False(t, summary.Success)
+	assert.Equal(t, "Import failed for all targets", summary.Message)
+	assert.Contains(t, summary.Results[0].ErrorMessage, "rolled back")
+}
+
+func TestImportData_AtomicReplacesTargets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	doris := mocks.NewMockIDorisService(ctrl)
+
+	doris.EXPECT().EnsureDorisDatabaseExists("job_db").Return(nil).Times(2)
+	doris.EXPECT().CreateExternalAndInternalTableAndImportData(gomock.Any(), "chain1", "", gomock.Any(), gomock.Any(), "job_db").
+		DoAndReturn(func(assetName, chainInfoId, alias, jobInstanceId, tableName, dbName string, columns ...string) (string, error) {
+			return tableName, nil
+		}).Times(2)
+	doris.EXPECT().ExecuteSQL(gomock.Any()).DoAndReturn(func(query string, args ...interface{}) (*sql.Rows, func(), error) {
+		switch query {
+		case "SHOW TABLES FROM `job_db` LIKE 'existing'":
+			return sqlRows(t, "existing"), nil, nil
+		case "SHOW TABLES FROM `job_db` LIKE 'fresh'":
+			return sqlRows(t), nil, nil
+		}
+		return nil, nil, errors.New("count unavailable")
+	}).AnyTimes()
+	var updates []string
+	doris.EXPECT().ExecuteUpdate(gomock.Any()).DoAndReturn(func(query string, args ...interface{}) (int64, error) {
+		updates = append(updates, query)
+		return 0, nil
+	}).Times(2)
+	// 原表交换到临时表名下，全部替换成功后才删除
+	var dropped []string
+	doris.EXPECT().DropTable("job_db", gomock.Any()).DoAndReturn(func(dbName, tableName string) error {
+		dropped = append(dropped, tableName)
+		return nil
+	}).Times(1)
+
+	s, cleaned := newTestImportService(doris)
+	resp, err := s.ImportData(context.Background(), &pb.ImportDataRequest{
+		Targets:        []*pb.ImportTarget{importTarget("existing"), importTarget("fresh")},
+		Atomic:         true,
+		MaxConcurrency: 1,
+	})
+	require.NoError(t, err)
+
+	assert.True(t, resp.Success)
+	assert.Equal(t, "existing", resp.Results[0].TargetTableName)
+	assert.Equal(t, "fresh", resp.Results[1].TargetTableName)
+	require.Len(t, updates, 2)
+	assert.Regexp(t, "^ALTER TABLE `job_db`.`existing` REPLACE WITH TABLE `existing_staging_[a-z0-9]+` PROPERTIES\\('swap' = 'true'\\)$", updates[0])
+	assert.Regexp(t, "^ALTER TABLE `job_db`.`fresh_staging_[a-z0-9]+` RENAME `fresh`$", updates[1])
+	require.Len(t, dropped, 1)
+	assert.Regexp(t, "^existing_staging_[a-z0-9]+$", dropped[0])
+	assert.Equal(t, []string{"job_db", "job_db"}, *cleaned)
+}
+
+func TestImportData_AtomicSwapsBackWhenReplaceFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	doris := mocks.NewMockIDorisService(ctrl)
+
+	doris.EXPECT().EnsureDorisDatabaseExists("job_db").Return(nil).Times(3)
+	doris.EXPECT().CreateExternalAndInternalTableAndImportData(gomock.Any(), "chain1", "", gomock.Any(), gomock.Any(), "job_db").
+		DoAndReturn(func(assetName, chainInfoId, alias, jobInstanceId, tableName, dbName string, columns ...string) (string, error) {
+			return tableName, nil
+		}).Times(3)
+	doris.EXPECT().ExecuteSQL(gomock.Any()).DoAndReturn(func(query string, args ...interface{}) (*sql.Rows, func(), error) {
+		switch query {
+		case "SHOW TABLES FROM `job_db` LIKE 'existing'":
+			return sqlRows(t, "existing"), nil, nil
+		case "SHOW TABLES FROM `job_db` LIKE 'fresh'", "SHOW TABLES FROM `job_db` LIKE 'broken'":
+			return sqlRows(t), nil, nil
+		}
+		return nil, nil, errors.New("count unavailable")
+	}).AnyTimes()
+	var updates []string
+	doris.EXPECT().ExecuteUpdate(gomock.Any()).DoAndReturn(func(query string, args ...interface{}) (int64, error) {
+		updates = append(updates, query)
+		if strings.Contains(query, "RENAME `broken`") {
+			return 0, errors.New("rename failed")
+		}
+		return 0, nil
+	}).Times(5)
+	var dropped []string
+	doris.EXPECT().DropTable("job_db", gomock.Any()).DoAndReturn(func(dbName, tableName string) error {
+		dropped = append(dropped, tableName)
+		return nil
+	}).Times(3)
+
+	s, cleaned := newTestImportService(doris)
+	resp, err := s.ImportData(context.Background(), &pb.ImportDataRequest{
+		Targets:        []*pb.ImportTarget{importTarget("existing"), importTarget("fresh"), importTarget("broken")},
+		Atomic:         true,
+		MaxConcurrency: 1,
+	})
+	require.NoError(t, err)
+
+	assert.False(t, resp.Success)
+	require.Len(t, updates, 5)
+	// 失败后按相反顺序撤销：fresh 改回临时表名，existing 再交换一次换回原表
+	assert.Regexp(t, "^ALTER TABLE `job_db`.`fresh` RENAME `fresh_staging_[a-z0-9]+`$", updates[3])
+	assert.Regexp(t, "^ALTER TABLE `job_db`.`existing` REPLACE WITH TABLE `existing_staging_[a-z0-9]+` PROPERTIES\\('swap' = 'true'\\)$", updates[4])
+	assert.Len(t, dropped, 3)
+	assert.Empty(t, *cleaned)
+	for _, result := range resp.Results {
+		assert.False(t, result.Success)
+	}
+	assert.Contains(t, resp.Results[0].ErrorMessage, "rolled back")
+	assert.Contains(t, resp.Results[2].ErrorMessage, "rename failed")
+}
+
+func TestImportData_AtomicRejectsDuplicateTargets(t *testing.T) {
+	s, _ := newTestImportService(nil)
+	resp, err := s.ImportData(context.Background(), &pb.ImportDataRequest{
+		Targets: []*pb.ImportTarget{importTarget("t1"), importTarget("t1")},
+		Atomic:  true,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	for _, result := range resp.Results {
+		assert.Contains(t, result.ErrorMessage, "duplicate target table")
+	}
+}
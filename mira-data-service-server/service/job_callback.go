@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"data-service/database/gorm"
+	"data-service/database/gorm/models"
+	"data-service/database/gorm/repositories"
+	pb "data-service/generated/datasource"
+)
+
+// retryPodSuffix 重试 Pod 名称后缀，见 retryPod
+var retryPodSuffix = regexp.MustCompile(`-retry-[0-9]+$`)
+
+// jobCallbackRetention 未持久化时内存中保留回调的时长
+const jobCallbackRetention = 24 * time.Hour
+
+// JobCallbackStore 作业回调存储，由 JobCallbackRepository 实现
+type JobCallbackStore interface {
+	Create(record *models.JobCallback) error
+	FindLatestByJobID(jobID string) (*models.JobCallback, error)
+}
+
+// JobCallbackHub 按作业ID分发 Spark 作业完成回调：回调先持久化，再通知等待该作业的调用方
+type JobCallbackHub struct {
+	store   JobCallbackStore // 为空时只在内存中保留每个作业最近一次回调
+	mu      sync.Mutex
+	waiters map[string]map[chan *pb.JobCallback]struct{}
+	latest  map[string]*pb.JobCallback
+}
+
+var (
+	jobCallbackHub     *JobCallbackHub
+	jobCallbackHubOnce sync.Once
+)
+
+// GetJobCallbackHub 获取全局回调分发器，数据库可用时持久化回调
+func GetJobCallbackHub() *JobCallbackHub {
+	jobCallbackHubOnce.Do(func() {
+		var store JobCallbackStore
+		if db := gorm.GetGormDB(); db != nil {
+			store = repositories.NewJobCallbackRepository(db)
+		}
+		jobCallbackHub = NewJobCallbackHub(store)
+	})
+	return jobCallbackHub
+}
+
+func NewJobCallbackHub(store JobCallbackStore) *JobCallbackHub {
+	return &JobCallbackHub{
+		store:   store,
+		waiters: make(map[string]map[chan *pb.JobCallback]struct{}),
+		latest:  make(map[string]*pb.JobCallback),
+	}
+}
+
+// JobIdFromPodName 由 Pod 名称得到作业ID，重试 Pod 去掉 -retry-<n> 后缀
+func JobIdFromPodName(podName string) string {
+	return retryPodSuffix.ReplaceAllString(podName, "")
+}
+
+// Publish 保存回调并通知该作业的所有等待者，保存失败时不通知
+func (h *JobCallbackHub) Publish(callback *pb.JobCallback, podName string) error {
+	if callback.JobId == "" {
+		return fmt.Errorf("job callback has no job id")
+	}
+	if callback.ReceivedAt == 0 {
+		callback.ReceivedAt = time.Now().UnixMilli()
+	}
+	if h.store != nil {
+		if err := h.store.Create(jobCallbackToRecord(callback, podName)); err != nil {
+			return fmt.Errorf("failed to save callback of job %s: %v", callback.JobId, err)
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.store == nil {
+		h.latest[callback.JobId] = callback
+		h.pruneLocked(time.Now().Add(-jobCallbackRetention))
+	}
+	for ch := range h.waiters[callback.JobId] {
+		// 每个等待者只接收一次，通道带缓冲不会阻塞
+		select {
+		case ch <- callback:
+		default:
+		}
+	}
+	delete(h.waiters, callback.JobId)
+	return nil
+}
+
+// pruneLocked 删除 before 之前收到的内存回调，调用方持有 h.mu
+func (h *JobCallbackHub) pruneLocked(before time.Time) {
+	cutoff := before.UnixMilli()
+	for jobId, callback := range h.latest {
+		if callback.ReceivedAt < cutoff {
+			delete(h.latest, jobId)
+		}
+	}
+}
+
+// Latest 返回作业最近一次回调，未收到时返回 nil
+func (h *JobCallbackHub) Latest(jobId string) (*pb.JobCallback, error) {
+	if h.store == nil {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		return h.latest[jobId], nil
+	}
+	record, err := h.store.FindLatestByJobID(jobId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find callback of job %s: %v", jobId, err)
+	}
+	if record == nil {
+		return nil, nil
+	}
+	return jobCallbackFromRecord(record), nil
+}
+
+// Wait 等待作业在 since 之后的完成回调，已收到过时直接返回，超过 timeout 或 ctx 结束时返回错误。
+// since 为当前尝试的开始时间，之前尝试上报的回调不会被当作本次结果
+func (h *JobCallbackHub) Wait(ctx context.Context, jobId string, since time.Time, timeout time.Duration) (*pb.JobCallback, error) {
+	// 先注册再查询已有回调，避免两者之间到达的回调被漏掉
+	ch := make(chan *pb.JobCallback, 1)
+	h.mu.Lock()
+	if h.waiters[jobId] == nil {
+		h.waiters[jobId] = make(map[chan *pb.JobCallback]struct{})
+	}
+	h.waiters[jobId][ch] = struct{}{}
+	h.mu.Unlock()
+	defer h.removeWaiter(jobId, ch)
+
+	callback, err := h.Latest(jobId)
+	if err != nil {
+		return nil, err
+	}
+	if callback != nil && !callbackBefore(callback, since) {
+		return callback, nil
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case callback := <-ch:
+		if callbackBefore(callback, since) {
+			return nil, fmt.Errorf("callback of job %s was received before the current attempt", jobId)
+		}
+		return callback, nil
+	case <-timer.C:
+		return nil, fmt.Errorf("timed out waiting for callback of job %s after %s", jobId, timeout)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// callbackBefore 回调是否在 since 之前收到，since 为零值时不过滤
+func callbackBefore(callback *pb.JobCallback, since time.Time) bool {
+	return !since.IsZero() && callback.ReceivedAt < since.UnixMilli()
+}
+
+func (h *JobCallbackHub) removeWaiter(jobId string, ch chan *pb.JobCallback) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if waiters, ok := h.waiters[jobId]; ok {
+		delete(waiters, ch)
+		if len(waiters) == 0 {
+			delete(h.waiters, jobId)
+		}
+	}
+}
+
+// NewJobCallback 由回调内容创建 JobCallback，data 序列化为 JSON
+func NewJobCallback(jobId string, status pb.JobStatus, data map[string]interface{}, errorMessage string) *pb.JobCallback {
+	callback := &pb.JobCallback{
+		JobId:      jobId,
+		Status:     status,
+		Error:      errorMessage,
+		ReceivedAt: time.Now().UnixMilli(),
+	}
+	if len(data) > 0 {
+		if encoded, err := json.Marshal(data); err == nil {
+			callback.Data = string(encoded)
+		}
+	}
+	return callback
+}
+
+func jobCallbackToRecord(callback *pb.JobCallback, podName string) *models.JobCallback {
+	return &models.JobCallback{
+		JobID:        callback.JobId,
+		PodName:      podName,
+		Status:       callback.Status.String(),
+		Payload:      callback.Data,
+		ErrorMessage: callback.Error,
+		ReceivedAt:   time.UnixMilli(callback.ReceivedAt),
+	}
+}
+
+func jobCallbackFromRecord(record *models.JobCallback) *pb.JobCallback {
+	return &pb.JobCallback{
+		JobId:      record.JobID,
+		Status:     parseJobStatus(record.Status),
+		Data:       record.Payload,
+		Error:      record.ErrorMessage,
+		ReceivedAt: record.ReceivedAt.UnixMilli(),
+	}
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"data-service/database/gorm/models"
+	pb "data-service/generated/datasource"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// memoryJobCallbacks 内存回调存储
+type memoryJobCallbacks struct {
+	mu      sync.Mutex
+	records []models.JobCallback
+}
+
+func (m *memoryJobCallbacks) Create(record *models.JobCallback) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	record.ID = uint(len(m.records) + 1)
+	m.records = append(m.records, *record)
+	return nil
+}
+
+func (m *memoryJobCallbacks) FindLatestByJobID(jobID string) (*models.JobCallback, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := len(m.records) - 1; i >= 0; i-- {
+		if m.records[i].JobID == jobID {
+			record := m.records[i]
+			return &record, nil
+		}
+	}
+	return nil, nil
+}
+
+func TestJobIdFromPodName(t *testing.T) {
+	assert.Equal(t, "spark-job-1", JobIdFromPodName("spark-job-1"))
+	assert.Equal(t, "spark-job-1", JobIdFromPodName("spark-job-1-retry-2"))
+	assert.Equal(t, "spark-job-retry", JobIdFromPodName("spark-job-retry"))
+}
+
+func TestJobCallbackHub_RoutesCallbackToJobWaiters(t *testing.T) {
+	hub := NewJobCallbackHub(&memoryJobCallbacks{})
+
+	results := make(chan *pb.JobCallback, 2)
+	errs := make(chan error, 1)
+	var started sync.WaitGroup
+	started.Add(2)
+	go func() {
+		started.Done()
+		callback, err := hub.Wait(context.Background(), "job-a", time.Time{}, time.Second)
+		require.NoError(t, err)
+		results <- callback
+	}()
+	go func() {
+		started.Done()
+		_, err := hub.Wait(context.Background(), "job-b", time.Time{}, 100*time.Millisecond)
+		errs <- err
+	}()
+	started.Wait()
+	time.Sleep(10 * time.Millisecond)
+
+	require.NoError(t, hub.Publish(NewJobCallback("job-a", pb.JobStatus_JOB_STATUS_SUCCEEDED, map[string]interface{}{"tableName": "t1"}, ""), "job-a"))
+
+	select {
+	case callback := <-results:
+		assert.Equal(t, "job-a", callback.JobId)
+		assert.JSONEq(t, `{"tableName":"t1"}`, callback.Data)
+	case <-time.After(time.Second):
+		t.Fatal("waiter of job-a did not receive callback")
+	}
+	// 其他作业的等待者收不到该回调，超时返回
+	assert.ErrorContains(t, <-errs, "timed out")
+
+	hub.mu.Lock()
+	assert.Empty(t, hub.waiters)
+	hub.mu.Unlock()
+}
+
+func TestJobCallbackHub_PersistedCallbackSurvivesRestart(t *testing.T) {
+	store := &memoryJobCallbacks{}
+	require.NoError(t, NewJobCallbackHub(store).Publish(NewJobCallback("job-c", pb.JobStatus_JOB_STATUS_FAILED, nil, "oom"), "job-c-retry-1"))
+	assert.Equal(t, "job-c-retry-1", store.records[0].PodName)
+
+	restarted := NewJobCallbackHub(store)
+	callback, err := restarted.Wait(context.Background(), "job-c", time.Time{}, time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_FAILED, callback.Status)
+	assert.Equal(t, "oom", callback.Error)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = restarted.Wait(ctx, "job-missing", time.Time{}, time.Second)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestJobCallbackHub_IgnoresCallbackOfEarlierAttempt(t *testing.T) {
+	hub := NewJobCallbackHub(&memoryJobCallbacks{})
+	stale := NewJobCallback("job-d", pb.JobStatus_JOB_STATUS_FAILED, nil, "oom")
+	stale.ReceivedAt = time.Now().Add(-time.Minute).UnixMilli()
+	require.NoError(t, hub.Publish(stale, "job-d"))
+
+	retryStarted := time.Now().Add(-time.Second)
+	_, err := hub.Wait(context.Background(), "job-d", retryStarted, 50*time.Millisecond)
+	assert.ErrorContains(t, err, "timed out")
+
+	require.NoError(t, hub.Publish(NewJobCallback("job-d", pb.JobStatus_JOB_STATUS_SUCCEEDED, nil, ""), "job-d-retry-1"))
+	callback, err := hub.Wait(context.Background(), "job-d", retryStarted, 50*time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_SUCCEEDED, callback.Status)
+}
+
+func TestJobCallbackHub_PrunesInMemoryCallbacks(t *testing.T) {
+	hub := NewJobCallbackHub(nil)
+	old := NewJobCallback("job-e", pb.JobStatus_JOB_STATUS_SUCCEEDED, nil, "")
+	old.ReceivedAt = time.Now().Add(-2 * jobCallbackRetention).UnixMilli()
+	require.NoError(t, hub.Publish(old, "job-e"))
+	require.NoError(t, hub.Publish(NewJobCallback("job-f", pb.JobStatus_JOB_STATUS_SUCCEEDED, nil, ""), "job-f"))
+
+	callback, err := hub.Latest("job-e")
+	require.NoError(t, err)
+	assert.Nil(t, callback)
+	callback, err = hub.Latest("job-f")
+	require.NoError(t, err)
+	assert.NotNil(t, callback)
+}
+
+func TestMonitorJobUsesFailedCallback(t *testing.T) {
+	jobId := "spark-job-cb1"
+	s, client, history := newTestK8sService(sparkJobPod(jobId, corev1.PodSucceeded))
+	setPodPhase(t, client, jobId, corev1.PodSucceeded)
+	s.callbacks = NewJobCallbackHub(&memoryJobCallbacks{})
+	s.callbackWait = time.Second
+	s.recordSubmittedJob(jobId, "req-cb1", "", batchBackendSpark, &pb.SparkConfig{}, 0)
+	require.NoError(t, s.callbacks.Publish(NewJobCallback(jobId, pb.JobStatus_JOB_STATUS_FAILED, nil, "write target table failed"), jobId))
+
+	s.monitorJob(jobId, nil)
+
+	record, err := history.FindByJobID(jobId)
+	require.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_FAILED.String(), record.Status)
+	assert.Equal(t, "write target table failed", record.ErrorMessage)
+
+	status, err := s.GetJobStatus(context.Background(), &pb.JobStatusRequest{JobId: jobId})
+	require.NoError(t, err)
+	require.NotNil(t, status.Callback)
+	assert.Equal(t, "write target table failed", status.Callback.Error)
+}
+
+func TestGetJobStatusFallsBackToCallback(t *testing.T) {
+	jobId := "spark-job-cb2"
+	s, _, _ := newTestK8sService()
+	s.history = nil
+	s.callbacks = NewJobCallbackHub(&memoryJobCallbacks{})
+
+	_, err := s.GetJobStatus(context.Background(), &pb.JobStatusRequest{JobId: jobId})
+	assert.Error(t, err)
+
+	require.NoError(t, s.callbacks.Publish(NewJobCallback(jobId, pb.JobStatus_JOB_STATUS_SUCCEEDED, map[string]interface{}{"dbName": "d1"}, ""), jobId))
+	status, err := s.GetJobStatus(context.Background(), &pb.JobStatusRequest{JobId: jobId})
+	require.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_SUCCEEDED, status.Status)
+	assert.JSONEq(t, `{"dbName":"d1"}`, status.Callback.Data)
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"data-service/common"
+	"data-service/log"
+	"data-service/oss"
+)
+
+// buildJobExportManifest 列出本次导出所在子目录生成分片清单。子目录带随机标签，只包含本次导出的文件，
+// 不需要 SELECT ... INTO OUTFILE 的结果集
+func buildJobExportManifest(ctx context.Context, ossClient oss.ClientInterface, jobInstanceId, label, bucket string,
+	format common.FileFormat) (*common.JobExportManifest, error) {
+	manifest := &common.JobExportManifest{
+		JobInstanceId: jobInstanceId,
+		Label:         label,
+		Bucket:        bucket,
+		Format:        format,
+	}
+	parts, err := listExportPartsByPrefix(ctx, ossClient, bucket, manifest.Dir()+"/")
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("export %s produced no files under %s/", manifest.ExportId(), manifest.Dir())
+	}
+	manifest.Parts = parts
+	log.Logger.Infof("Export %s produced %d parts", manifest.ExportId(), len(manifest.Parts))
+	return manifest, nil
+}
+
+// listExportPartsByPrefix 列出前缀下的导出分片，按导出实例和分片序号排序
+func listExportPartsByPrefix(ctx context.Context, ossClient oss.ClientInterface, bucket, keyPrefix string) ([]string, error) {
+	keys, err := ossClient.ListObjects(ctx, bucket, keyPrefix, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list export parts with prefix %s: %v", keyPrefix, err)
+	}
+	type item struct {
+		key      string
+		instance string
+		index    int
+	}
+	var parts []item
+	for _, key := range keys {
+		index, ok := exportPartIndex(key)
+		if !ok {
+			continue
+		}
+		parts = append(parts, item{key: key, instance: key[:strings.LastIndex(key, "_")], index: index})
+	}
+	sort.Slice(parts, func(i, j int) bool {
+		if parts[i].instance != parts[j].instance {
+			return parts[i].instance < parts[j].instance
+		}
+		return parts[i].index < parts[j].index
+	})
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		out = append(out, p.key)
+	}
+	return out, nil
+}
+
+// exportPartIndex 解析分片序号，Doris 导出文件名形如 export_<实例ID>_<序号>.<扩展名>
+func exportPartIndex(key string) (int, bool) {
+	name := key[strings.LastIndex(key, "/")+1:]
+	if dot := strings.Index(name, "."); dot >= 0 {
+		name = name[:dot]
+	}
+	underscore := strings.LastIndex(name, "_")
+	if underscore < 0 {
+		return 0, false
+	}
+	index, err := strconv.Atoi(name[underscore+1:])
+	if err != nil {
+		return 0, false
+	}
+	return index, true
+}
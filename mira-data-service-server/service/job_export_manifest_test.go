@@ -0,0 +1,211 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"data-service/common"
+	ds "data-service/generated/datasource"
+	"data-service/oss"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/apache/arrow/go/v15/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// memoryOSSClient 内存对象存储，仅实现导出读取用到的方法
+type memoryOSSClient struct {
+	oss.ClientInterface
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func newMemoryOSSClient() *memoryOSSClient {
+	return &memoryOSSClient{objects: make(map[string][]byte)}
+}
+
+func (c *memoryOSSClient) put(bucket, key string, data []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.objects[bucket+"/"+key] = data
+}
+
+func (c *memoryOSSClient) ListObjects(ctx context.Context, bucketName, prefix string, recursive bool) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var keys []string
+	for k := range c.objects {
+		if key, ok := strings.CutPrefix(k, bucketName+"/"); ok && strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (c *memoryOSSClient) GetObject(ctx context.Context, bucketName, objectName string, opts *oss.GetOptions) (io.ReadCloser, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, ok := c.objects[bucketName+"/"+objectName]
+	if !ok {
+		return nil, fmt.Errorf("object %s/%s not found", bucketName, objectName)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// recordingStream 记录发送的 Arrow 批次
+type recordingStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*ds.ArrowResponse
+}
+
+func (s *recordingStream) Send(resp *ds.ArrowResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func (s *recordingStream) Context() context.Context {
+	return s.ctx
+}
+
+// parquetPart 生成一个 worker 列全为 worker 的 parquet 分片
+func parquetPart(t *testing.T, worker int64, rows int) []byte {
+	schema := arrow.NewSchema([]arrow.Field{{Name: "worker", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	b := array.NewInt64Builder(memory.NewGoAllocator())
+	defer b.Release()
+	for i := 0; i < rows; i++ {
+		b.Append(worker)
+	}
+	col := b.NewArray()
+	defer col.Release()
+	rec := array.NewRecord(schema, []arrow.Array{col}, int64(rows))
+	defer rec.Release()
+
+	var buf bytes.Buffer
+	w, err := pqarrow.NewFileWriter(schema, &buf, nil, pqarrow.DefaultWriterProps())
+	require.NoError(t, err)
+	require.NoError(t, w.Write(rec))
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+// workersOf 解析收到的批次，返回各 worker 值对应的行数
+func workersOf(t *testing.T, responses []*ds.ArrowResponse) map[int64]int {
+	counts := make(map[int64]int)
+	for _, resp := range responses {
+		r, err := ipc.NewReader(bytes.NewReader(resp.ArrowBatch))
+		require.NoError(t, err)
+		for r.Next() {
+			col := r.Record().Column(0).(*array.Int64)
+			for i := 0; i < col.Len(); i++ {
+				counts[col.Value(i)]++
+			}
+		}
+		r.Release()
+	}
+	return counts
+}
+
+func TestExportPartIndex(t *testing.T) {
+	index, ok := exportPartIndex("job_3fa9c2e1/0a1b2c3d/export_6555cd33e7447c1-baa9568b5c4eb0ac_12.csv.gz")
+	assert.True(t, ok)
+	assert.Equal(t, 12, index)
+	_, ok = exportPartIndex("job_3fa9c2e1/0a1b2c3d/manifest.json")
+	assert.False(t, ok)
+}
+
+func TestBuildJobExportManifest_ListsOnlyItsOwnDirectory(t *testing.T) {
+	bucket := common.BATCH_DATA_BUCKET_NAME
+	client := newMemoryOSSClient()
+	for _, key := range []string{
+		"job_3fa9c2e1/export_legacy_0.parquet",
+		"job_3fa9c2e1/ffffffff/export_abc_0.parquet",
+		"job_3fa9c2e1/0a1b2c3d/export_def_0.parquet",
+		"job_3fa9c2e1/0a1b2c3d/export_abc_10.parquet",
+		"job_3fa9c2e1/0a1b2c3d/export_abc_2.parquet",
+		"job_3fa9c2e1/0a1b2c3d/_SUCCESS",
+	} {
+		client.put(bucket, key, nil)
+	}
+
+	manifest, err := buildJobExportManifest(context.Background(), client, "job_3fa9c2e1", "0a1b2c3d", bucket, common.FILE_FORMAT_PARQUET)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"job_3fa9c2e1/0a1b2c3d/export_abc_2.parquet",
+		"job_3fa9c2e1/0a1b2c3d/export_abc_10.parquet",
+		"job_3fa9c2e1/0a1b2c3d/export_def_0.parquet",
+	}, manifest.Parts)
+
+	_, err = buildJobExportManifest(context.Background(), client, "job_3fa9c2e1", "00000000", bucket, common.FILE_FORMAT_PARQUET)
+	assert.Error(t, err, "export without files should fail")
+}
+
+// TestConcurrentExportsOfSameJobAreIsolated 同一作业并发导出同一张表时，每个读取只返回自己导出的数据
+func TestConcurrentExportsOfSameJobAreIsolated(t *testing.T) {
+	const (
+		workers       = 6
+		partsPerLabel = 3
+		rowsPerPart   = 50
+		jobInstanceId = "orders_3fa9c2e1"
+		bucket        = common.BATCH_DATA_BUCKET_NAME
+	)
+	client := newMemoryOSSClient()
+	// 旧布局下直接位于作业目录的分片不应被读取
+	client.put(bucket, jobInstanceId+"/export_legacy_0.parquet", parquetPart(t, -1, rowsPerPart))
+
+	exportStream := NewExportStreamingService(nil, client, nil)
+	exportStream.parquetStream.dataDir = t.TempDir()
+	exportStream.parquetStream.batchSize = 1024
+
+	var exported, done sync.WaitGroup
+	exported.Add(workers)
+	errs := make(chan error, workers)
+	responses := make([][]*ds.ArrowResponse, workers)
+	for w := 0; w < workers; w++ {
+		part := parquetPart(t, int64(w), rowsPerPart)
+		done.Add(1)
+		go func(w int) {
+			defer done.Done()
+			label := fmt.Sprintf("%08x", 0xa0000000+w)
+			for i := 0; i < partsPerLabel; i++ {
+				client.put(bucket, fmt.Sprintf("%s/export_%d_%d.parquet", common.ExportJobPath(jobInstanceId, label), w, i), part)
+			}
+			// 所有导出完成后再读取，保证对象存储中同时存在全部导出
+			exported.Done()
+			exported.Wait()
+
+			manifest, err := buildJobExportManifest(context.Background(), client, jobInstanceId, label, bucket, common.FILE_FORMAT_PARQUET)
+			if err != nil {
+				errs <- err
+				return
+			}
+			stream := &recordingStream{ctx: context.Background()}
+			if err := exportStream.StreamExportFromOSS(manifest, "orders", nil, stream); err != nil {
+				errs <- err
+				return
+			}
+			responses[w] = stream.responses
+		}(w)
+	}
+	done.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	for w := 0; w < workers; w++ {
+		assert.Equal(t, map[int64]int{int64(w): partsPerLabel * rowsPerPart}, workersOf(t, responses[w]), "worker %d read foreign rows", w)
+	}
+}
@@ -7,23 +7,24 @@ import (
 	"data-service/common"
 	"data-service/config"
 	"data-service/database"
+	"data-service/database/gorm"
+	"data-service/database/gorm/repositories"
 	pb "data-service/generated/datasource"
 	log "data-service/log"
 	"data-service/oss"
 	"data-service/utils"
 	"database/sql"
-	"encoding/base64"
 	"encoding/csv"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"reflect"
 	"regexp"
-	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/apache/arrow/go/v15/arrow"
@@ -35,11 +36,20 @@ import (
 
 type JobResultService interface {
 	PushJobResultToExternalDB(ctx context.Context, req *pb.PushJobResultRequest) (*pb.PushJobResultResponse, error)
+	GetResultDeliveryStatus(ctx context.Context, req *pb.GetResultDeliveryStatusRequest) (*pb.GetResultDeliveryStatusResponse, error)
 }
 
 // DefaultJobResultService 是 JobResultService 的默认实现
 type DefaultJobResultService struct {
-	// 可以添加依赖，如 logger、db 等
+	conf         config.ResultDeliveryConfig
+	credentials  map[string]config.ExportCredentialConfig
+	deliveries   ResultDeliveryStore // 为空时不记录投递状态
+	httpClient   *http.Client
+	maxRetries   int
+	retryDelay   time.Duration
+	newOSSClient func(cred config.ExportCredentialConfig) (oss.ClientInterface, error)
+	openResult   func(req *pb.PushJobResultRequest) (io.ReadCloser, error)
+	inflight     sync.Map // 本实例正在投递的幂等键
 }
 
 // fileReadCloser 自定义 ReadCloser，在关闭时删除临时文件
@@ -63,7 +73,33 @@ func (f *fileReadCloser) Close() error {
 
 // NewJobResultService 创建 JobResultService 实例
 func NewJobResultService() JobResultService {
-	return &DefaultJobResultService{}
+	conf := config.GetConfigMap()
+	deliveryConf := conf.ResultDelivery
+	timeout := time.Duration(deliveryConf.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	retryDelay := time.Duration(deliveryConf.RetryDelaySeconds) * time.Second
+	if retryDelay <= 0 {
+		retryDelay = 2 * time.Second
+	}
+	svc := &DefaultJobResultService{
+		conf:        deliveryConf,
+		credentials: conf.ExportCredentials,
+		httpClient:  &http.Client{Timeout: timeout},
+		maxRetries:  deliveryConf.MaxRetries,
+		retryDelay:  retryDelay,
+		newOSSClient: func(cred config.ExportCredentialConfig) (oss.ClientInterface, error) {
+			return oss.NewMinIOClient(cred.Endpoint, cred.AccessKey, cred.SecretKey, cred.UseSSL)
+		},
+		openResult: func(req *pb.PushJobResultRequest) (io.ReadCloser, error) {
+			return getResultContentReader(req.IsEncrypted, req.PubKey, resultObjectName(req))
+		},
+	}
+	if db := gorm.GetGormDB(); db != nil {
+		svc.deliveries = repositories.NewResultDeliveryRepository(db)
+	}
+	return svc
 }
 
 // PushJobResultToExternalDB 实现
@@ -87,6 +123,36 @@ func (s *DefaultJobResultService) PushJobResultToExternalDB(ctx context.Context,
 	// 	return nil, err
 	// }
 
+	sink, err := s.resolveResultSink(req)
+	if err != nil {
+		log.Logger.Errorf("Failed to resolve result sink: %v", err)
+		return nil, err
+	}
+	if sink == nil {
+		log.Logger.Infof("存储到MINIO，跳过数据同步，直接返回成功, jobInstanceId: %s", req.JobInstanceId)
+		return &pb.PushJobResultResponse{
+			Success: true,
+			Message: "success",
+		}, nil
+	}
+
+	if err := s.deliverResult(ctx, req, sink); err != nil {
+		return nil, err
+	}
+
+	return &pb.PushJobResultResponse{
+		Success: true,
+		Message: "success",
+	}, nil
+}
+
+// resolveResultSink 请求指定了 Kafka/S3/webhook 时投递到对应目标，否则使用 mira-gateway 配置的结果数据库
+// 结果存储类型为 MinIO 时返回 nil，表示无需投递
+func (s *DefaultJobResultService) resolveResultSink(req *pb.PushJobResultRequest) (ResultSink, error) {
+	if req.GetSink().GetType() != pb.ResultSinkType_RESULT_SINK_TYPE_DB {
+		return s.buildRequestedSink(req)
+	}
+
 	// 查询外部存储配置
 	storageInfo, err := clients.GetMiraGateway().GetResultStorageConfig(&mirapb.GetResultStorageConfigRequest{
 		JobInstanceId: req.JobInstanceId,
@@ -106,11 +172,7 @@ func (s *DefaultJobResultService) PushJobResultToExternalDB(ctx context.Context,
 		storageInfo.GetResultStorageConfig().GetUser())
 
 	if storageInfo.GetResultStorageConfig().GetResultStorageType() == int32(pb.DataSourceType_DATA_SOURCE_TYPE_UNKNOWN) {
-		log.Logger.Infof("存储到MINIO，跳过数据同步，直接返回成功, jobInstanceId: %s", req.JobInstanceId)
-		return &pb.PushJobResultResponse{
-			Success: true,
-			Message: "success",
-		}, nil
+		return nil, nil
 	}
 
 	var tlsConfig *pb.DatasourceTlsConfig
@@ -126,28 +188,30 @@ func (s *DefaultJobResultService) PushJobResultToExternalDB(ctx context.Context,
 	}
 
 	// 计算结果存储类型 0:minio 1:mysql 2:TIDB, 3:TDSQL, 4:Kingbase 5:VastBase 6: GBase
-	err = syncResultToDB(storageInfo.GetResultStorageConfig().GetResultStorageType(), &types.CalculationResultStorage{
-		Host:     storageInfo.GetResultStorageConfig().GetHost(),
-		Port:     int(storageInfo.GetResultStorageConfig().GetPort()),
-		User:     storageInfo.GetResultStorageConfig().GetUser(),
-		Password: storageInfo.GetResultStorageConfig().GetPassword(),
-		DB:       storageInfo.GetResultStorageConfig().GetDb(),
-	}, req, tlsConfig)
-	if err != nil {
-		log.Logger.Errorf("syncResultToDB Failed, err: %v", err)
-		return nil, err
-	}
-
-	return &pb.PushJobResultResponse{
-		Success: true,
-		Message: "success",
+	return &dbResultSink{
+		dbType: storageInfo.GetResultStorageConfig().GetResultStorageType(),
+		storage: &types.CalculationResultStorage{
+			Host:     storageInfo.GetResultStorageConfig().GetHost(),
+			Port:     int(storageInfo.GetResultStorageConfig().GetPort()),
+			User:     storageInfo.GetResultStorageConfig().GetUser(),
+			Password: storageInfo.GetResultStorageConfig().GetPassword(),
+			DB:       storageInfo.GetResultStorageConfig().GetDb(),
+		},
+		tlsConfig: tlsConfig,
 	}, nil
 }
 
-func syncResultToDB(dbType int32, storageInfo *types.CalculationResultStorage, req *pb.PushJobResultRequest, tlsConfig *pb.DatasourceTlsConfig) error {
-	// 1.获取CSV文件流
+// resultObjectName 计算结果在 RESULT_BUCKET_NAME 中的对象名
+func resultObjectName(req *pb.PushJobResultRequest) string {
 	dataId := strings.TrimPrefix(req.DataId, "tee_")
-	objectName := fmt.Sprintf("%s/%s/%s/%s", req.ChainInfoId, req.JobInstanceId, req.PartyId, dataId)
+	return fmt.Sprintf("%s/%s/%s/%s", req.ChainInfoId, req.JobInstanceId, req.PartyId, dataId)
+}
+
+// syncResultToDB 把结果写入结果数据库中以作业实例ID命名的表，clearExisting 时先清空表中已有的行，
+// 用于重试上一次写入了部分数据的投递
+func syncResultToDB(dbType int32, storageInfo *types.CalculationResultStorage, req *pb.PushJobResultRequest, tlsConfig *pb.DatasourceTlsConfig, clearExisting bool) error {
+	// 1.获取CSV文件流
+	objectName := resultObjectName(req)
 	log.Logger.Infof("getResultContent | objectName: %s", objectName)
 
 	// 使用流式读取
@@ -158,6 +222,14 @@ func syncResultToDB(dbType int32, storageInfo *types.CalculationResultStorage, r
 	}
 	// defer csvReader.Close()
 
+	// 结果元数据中声明的列类型，未声明的列按全部数据行推断，推断需先完整读一遍结果
+	declaredTypes := loadDeclaredColumnTypes(objectName)
+	csvReader, inferredTypes, err := inferResultCSV(csvReader, common.DATA_DIR)
+	if err != nil {
+		log.Logger.Errorf("inferResultCSV Failed, err: %v", err)
+		return err
+	}
+
 	// 建立数据库连接
 	connInfo := &pb.ConnectionInfo{
 		Host:      storageInfo.Host,
@@ -185,8 +257,31 @@ func syncResultToDB(dbType int32, storageInfo *types.CalculationResultStorage, r
 
 	// 流式处理CSV数据
 	var headers []string
+	var columnTypes []arrow.DataType
 	isFirstBatch := true
 
+	// 目标库支持原生批量导入时在后台导入，不支持时回退到 INSERT
+	var pipe *database.BulkLoadPipe
+	loader, useBulkLoad := database.AsBulkLoader(dbStrategy)
+	insertRows := func(dataRows [][]string) error {
+		if useBulkLoad {
+			if pipe == nil {
+				pipe = database.StartBulkLoad(context.Background(), loader, tableName, headers)
+			}
+			values, err := convertResultRows(headers, columnTypes, dataRows)
+			if err != nil {
+				return err
+			}
+			err = pipe.Write(values)
+			if !errors.Is(err, database.ErrBulkLoadUnavailable) {
+				return err
+			}
+			log.Logger.Infof("syncResultToDB | bulk load unavailable for %s, fall back to INSERT", tableName)
+			useBulkLoad = false
+		}
+		return batchInsertData(db, tableName, headers, columnTypes, dataRows, dbType)
+	}
+
 	err = processCSVStreaming(csvReader, func(records [][]string) error {
 		if len(records) == 0 {
 			return nil
@@ -207,29 +302,48 @@ func syncResultToDB(dbType int32, storageInfo *types.CalculationResultStorage, r
 
 			// 创建表结构
 			columns, _ := generateStructModel(originHeaders, headers)
-			schema := convertColumnsToArrowSchema(columns)
+			columnTypes, err = resultColumnTypes(headers, columns, declaredTypes, inferredTypes)
+			if err != nil {
+				return fmt.Errorf("resolve result column types failed: %v", err)
+			}
+			schema := convertColumnsToArrowSchema(columns, columnTypes)
 			err = utils.WithRetry(3, 200*time.Millisecond, func() error {
 				return dbStrategy.CreateTemporaryTableIfNotExists(tableName, schema)
 			}, utils.IsRetryableNetErr)
 			if err != nil {
 				return fmt.Errorf("CreateTemporaryTableIfNotExists Failed after retry, err: %v", err)
 			}
+			if clearExisting {
+				if _, err := db.Exec("DELETE FROM " + tableName); err != nil {
+					return fmt.Errorf("failed to clear rows of previous attempt from %s: %v", tableName, err)
+				}
+			}
 
 			isFirstBatch = false
 
 			// 跳过表头，处理数据行
 			if len(records) > 1 {
 				dataRows := records[1:]
-				return batchInsertData(db, tableName, headers, dataRows, dbType)
+				return insertRows(dataRows)
 			}
 
 			return nil
 		}
 
 		// 批量插入数据
-		return batchInsertData(db, tableName, headers, records, dbType)
+		return insertRows(records)
 	})
 
+	if pipe != nil {
+		// 读取或转换失败时中止导入，已导入的数据随事务回滚
+		count, loadErr := pipe.Close(err)
+		if err == nil && loadErr != nil && !errors.Is(loadErr, database.ErrBulkLoadUnavailable) {
+			err = fmt.Errorf("bulk load into %s failed: %v", tableName, loadErr)
+		} else if err == nil && loadErr == nil {
+			log.Logger.Infof("syncResultToDB | bulk loaded %d rows into %s", count, tableName)
+		}
+	}
+
 	if err != nil {
 		log.Logger.Errorf("processCSVStreaming Failed, err: %v", err)
 		return err
@@ -239,163 +353,6 @@ func syncResultToDB(dbType int32, storageInfo *types.CalculationResultStorage, r
 	return nil
 }
 
-// TransformSoftwareFormat 将多个JSON字符串合并并转换格式
-// 输入格式: 详见https://www.tapd.cn/51081496/markdown_wikis/show/#1151081496001001856
-// 输出格式: [{"column1":1,"column2":2},{"column1":1,"column2":2}]
-func TransformSoftwareFormat(allResults []string) (string, error) {
-	r, columnsIdx, err := dealRows(allResults)
-	if err != nil {
-		return "", fmt.Errorf("TransformSoftwareFormat | deal row failed: %v", err)
-	}
-
-	result, err := json.Marshal(r)
-	if err != nil {
-		return "", fmt.Errorf("TransformSoftwareFormat | marshal result failed: %v", err)
-	}
-
-	// 解析所有输入的JSON字符串
-	var columnMap = make(map[string][]interface{})
-	maxLen := 0
-	// 兼容空结果
-	if checkResultIsEmpty(string(result)) {
-		return "", fmt.Errorf("TransformSoftwareFormat | result is empty")
-	}
-
-	var resultMap map[string][]interface{}
-	if err := json.Unmarshal([]byte(result), &resultMap); err != nil {
-		return "", fmt.Errorf("TransformSoftwareFormat | unmarshal input JSON failed: %v", err)
-	}
-
-	// 合并所有列数据
-	for col, values := range resultMap {
-		columnMap[col] = values
-		if len(values) > maxLen {
-			maxLen = len(values)
-		}
-	}
-
-	// 兼容空结果
-	if len(columnMap) == 0 {
-		return "", nil
-	}
-
-	// 构建转换后的结果
-	// [{"column1":1,"column2":2},{"column1":1,"column2":2}]
-	var transformedResults []string
-	for i := 0; i < maxLen; i++ {
-		row := "{"
-		// 按排序后的列名顺序构建 row
-		for _, col := range columnsIdx {
-			values := columnMap[col]
-			if i < len(values) {
-				value, _ := json.Marshal(values[i])
-				row += fmt.Sprintf("\"%s\":%s,", col, string(value))
-			}
-		}
-		row = strings.TrimSuffix(row, ",")
-		row += "}"
-		transformedResults = append(transformedResults, row)
-	}
-
-	// 将结果转换回JSON字符串
-	return "[" + strings.Join(transformedResults, ",") + "]", nil
-}
-
-func TransformHardwareFormat(allResults []string) (string, error) {
-	var finalResult []map[string]interface{}
-
-	for _, result := range allResults {
-		if result == "" {
-			continue
-		}
-
-		var inputMap map[string]interface{}
-		if err := json.Unmarshal([]byte(result), &inputMap); err != nil {
-			// 如果不是对象，尝试按原来的方式解析为数组
-			var arrayResult []map[string]interface{}
-			if err := json.Unmarshal([]byte(result), &arrayResult); err != nil {
-				return "", fmt.Errorf("TransformHardware | unmarshal input JSON failed: %v", err)
-			}
-			finalResult = append(finalResult, arrayResult...)
-			continue
-		}
-
-		// 检测是否为列式数据（所有值都是数组）
-		isColumnFormat := true
-		columnLengths := make(map[string]int)
-
-		for key, value := range inputMap {
-			if arrayValue, isArray := value.([]interface{}); isArray {
-				columnLengths[key] = len(arrayValue)
-			} else {
-				isColumnFormat = false
-				break
-			}
-		}
-
-		// 如果是列式数据，转换为行式数据
-		if isColumnFormat && len(columnLengths) > 0 {
-			// 找出最大长度
-			maxLength := 0
-			for _, length := range columnLengths {
-				if length > maxLength {
-					maxLength = length
-				}
-			}
-
-			// 创建行式数据
-			for i := 0; i < maxLength; i++ {
-				row := make(map[string]interface{})
-
-				for key, value := range inputMap {
-					if arrayValue, isArray := value.([]interface{}); isArray && i < len(arrayValue) {
-						row[key] = arrayValue[i]
-					}
-				}
-
-				if len(row) > 0 {
-					finalResult = append(finalResult, row)
-				}
-			}
-		} else {
-			// 原有的处理逻辑
-			for key, value := range inputMap {
-				// 支持直接数组
-				if arrayValue, isArray := value.([]interface{}); isArray {
-					for _, item := range arrayValue {
-						switch v := item.(type) {
-						case map[string]interface{}:
-							finalResult = append(finalResult, map[string]interface{}{key: v})
-						default:
-							finalResult = append(finalResult, map[string]interface{}{key: v})
-						}
-					}
-					continue
-				}
-				// 兼容旧格式：字符串形式的JSON数组
-				if strValue, ok := value.(string); ok && strings.HasPrefix(strValue, "[") && strings.HasSuffix(strValue, "]") {
-					var innerArray []interface{}
-					if err := json.Unmarshal([]byte(strValue), &innerArray); err != nil {
-						continue
-					}
-					for _, innerObj := range innerArray {
-						finalResult = append(finalResult, map[string]interface{}{key: innerObj})
-					}
-				}
-			}
-		}
-	}
-
-	if len(finalResult) == 0 {
-		return "[]", nil
-	}
-	resultJSON, err := json.Marshal(finalResult)
-	if err != nil {
-		return "", fmt.Errorf("TransformHardware | marshal output JSON failed: %v", err)
-	}
-	return string(resultJSON), nil
-}
-
 func getResultContent(isEncrypted bool, pubKey string, objectName string) (string, error) {
 	factory := oss.NewOSSFactory(config.GetConfigMap())
 	client, err := factory.NewOSSClient()
@@ -499,14 +456,15 @@ func generateStructModel(originHeaders, headers []string) ([]string, error) {
 }
 
 // 将columns转换为Arrow Schema
-func convertColumnsToArrowSchema(columns []string) *arrow.Schema {
+func convertColumnsToArrowSchema(columns []string, columnTypes []arrow.DataType) *arrow.Schema {
 	fields := make([]arrow.Field, len(columns))
 
 	for i, columnName := range columns {
-		log.Logger.Infof("convertColumnsToArrowSchema | columnName: %s", columnName)
+		log.Logger.Infof("convertColumnsToArrowSchema | columnName: %s, type: %s", columnName, columnTypes[i])
 		fields[i] = arrow.Field{
-			Name: columnName,
-			Type: arrow.BinaryTypes.LargeString, // 所有字段类型都设为string
+			Name:     columnName,
+			Type:     columnTypes[i],
+			Nullable: true,
 		}
 	}
 
@@ -514,7 +472,7 @@ func convertColumnsToArrowSchema(columns []string) *arrow.Schema {
 }
 
 // 批量插入数据
-func batchInsertData(db *sql.DB, tableName string, headers []string, dataRows [][]string, dbType int32) error {
+func batchInsertData(db *sql.DB, tableName string, headers []string, columnTypes []arrow.DataType, dataRows [][]string, dbType int32) error {
 	batchSize := 1000
 
 	for i := 0; i < len(dataRows); i += batchSize {
@@ -526,10 +484,13 @@ func batchInsertData(db *sql.DB, tableName string, headers []string, dataRows []
 		batch := dataRows[i:end]
 
 		// 生成INSERT SQL
-		insertSQL, args := generateInsertSQL(tableName, headers, batch, dbType)
+		insertSQL, args, err := generateInsertSQL(tableName, headers, columnTypes, batch, dbType)
+		if err != nil {
+			return fmt.Errorf("failed to convert batch %d-%d: %v", i, end-1, err)
+		}
 
 		// 执行插入
-		_, err := db.Exec(insertSQL, args...)
+		_, err = db.Exec(insertSQL, args...)
 		if err != nil {
 			return fmt.Errorf("failed to insert batch %d-%d: %v", i, end-1, err)
 		}
@@ -540,15 +501,44 @@ func batchInsertData(db *sql.DB, tableName string, headers []string, dataRows []
 	return nil
 }
 
-// 生成INSERT SQL语句
-func generateInsertSQL(tableName string, headers []string, dataRows [][]string, dbType int32) (string, []interface{}) {
+// convertResultRows 按列类型转换数据行，供批量导入使用
+func convertResultRows(headers []string, columnTypes []arrow.DataType, dataRows [][]string) ([][]interface{}, error) {
+	rows := make([][]interface{}, len(dataRows))
+	for i, row := range dataRows {
+		values := make([]interface{}, len(row))
+		for j, value := range row {
+			var columnType arrow.DataType
+			if j < len(columnTypes) {
+				columnType = columnTypes[j]
+			}
+			arg, err := convertResultValue(value, columnType)
+			if err != nil {
+				return nil, fmt.Errorf("column %s: %v", headers[j], err)
+			}
+			values[j] = arg
+		}
+		rows[i] = values
+	}
+	return rows, nil
+}
+
+// 生成INSERT SQL语句，按列类型转换参数值
+func generateInsertSQL(tableName string, headers []string, columnTypes []arrow.DataType, dataRows [][]string, dbType int32) (string, []interface{}, error) {
 	var placeholders []string
 	var args []interface{}
 	paramIndex := 1 // 用于KingBase的参数索引
 
 	for _, row := range dataRows {
 		var rowPlaceholders []string
-		for _, value := range row {
+		for j, value := range row {
+			var columnType arrow.DataType
+			if j < len(columnTypes) {
+				columnType = columnTypes[j]
+			}
+			arg, err := convertResultValue(value, columnType)
+			if err != nil {
+				return "", nil, fmt.Errorf("column %s: %v", headers[j], err)
+			}
 			// 根据数据库类型使用不同的占位符
 			if dbType == 4 || dbType == 5 { // KingBase, VastBase
 				rowPlaceholders = append(rowPlaceholders, fmt.Sprintf("$%d", paramIndex))
@@ -556,7 +546,7 @@ func generateInsertSQL(tableName string, headers []string, dataRows [][]string,
 			} else { // MySQL, TiDB, TDSQL等
 				rowPlaceholders = append(rowPlaceholders, "?")
 			}
-			args = append(args, value)
+			args = append(args, arg)
 		}
 		placeholders = append(placeholders, fmt.Sprintf("(%s)", strings.Join(rowPlaceholders, ", ")))
 	}
@@ -590,13 +580,13 @@ func generateInsertSQL(tableName string, headers []string, dataRows [][]string,
 
 	insertSQL := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", tableName, columns, values)
 
-	return insertSQL, args
+	return insertSQL, args, nil
 }
 
 func ProcessFileFormat(result string) (string, error) {
 	// 兼容空结果
 	if strings.TrimSpace(result) == "" {
-		return encodeContent("[]"), nil
+		return utils.EncodeResultContent("[]"), nil
 	}
 
 	var csvContent string
@@ -610,71 +600,7 @@ func ProcessFileFormat(result string) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("processResultFormat | transform to csv failed. err: %v", err)
 	}
-	return encodeContent(string(csvContent)), nil
-}
-
-func encodeContent(content string) string {
-	return base64.StdEncoding.EncodeToString([]byte(content))
-}
-
-// 2025.02.24 变更：软件执行引擎将参与方信息、表信息写入minIO, 解析后展示给用户以区分同名属性
-//
-//	输入：{"id": {
-//		"value": [1, 2, 3, 4],
-//		"party_id": "party_id_1",
-//		"asset_name": "asset_name_1"
-//		}], "id2"...}
-//
-// 输出：{"party_id_1.asset_name_1.id":[1, 2, 3, 4], "party_id_2.asset_name_2.id2":[1, 2, 3, 4]}
-func dealRows(rows []string) (map[string][]interface{}, []string, error) {
-	type rowInfo struct {
-		Value      []interface{} `json:"value"`
-		PartyId    string        `json:"party_id"`
-		AssetName  string        `json:"asset_name"`
-		PartyName  string        `json:"party_name"`
-		ItemIdx    int           `json:"item_idx"`
-		ColumnName string        `json:"column"`
-	}
-	res := make(map[string][]interface{})
-	indexArray := make([]rowInfo, 0, len(rows))
-	for _, row := range rows {
-		rowInfoMap := make(map[string]rowInfo)
-		err := json.Unmarshal([]byte(row), &rowInfoMap)
-		if err != nil {
-			return nil, nil, fmt.Errorf("error unmarshalling err: %v, row: %s", err, row)
-		}
-
-		for k, v := range rowInfoMap {
-			if v.PartyName == "" || v.AssetName == "" {
-				res[k] = v.Value
-				indexArray = append(indexArray, rowInfo{ColumnName: k, ItemIdx: v.ItemIdx})
-			} else {
-				res[v.PartyName+"."+v.AssetName+"."+k] = v.Value
-				indexArray = append(indexArray, rowInfo{
-					ColumnName: v.PartyName + "." + v.AssetName + "." + k,
-					ItemIdx:    v.ItemIdx,
-				})
-			}
-		}
-	}
-
-	sort.Slice(indexArray, func(i, j int) bool {
-		return indexArray[i].ItemIdx < indexArray[j].ItemIdx
-	})
-
-	columns := make([]string, 0, len(indexArray))
-	for _, v := range indexArray {
-		columns = append(columns, v.ColumnName)
-	}
-
-	return res, columns, nil
-}
-
-// 结果为空时也应该有表头之类的数据， 但各个执行引擎实现可能不一致， 这里对空结果做兼容
-func checkResultIsEmpty(result string) bool {
-	return strings.TrimSpace(result) == "" ||
-		strings.TrimSpace(result) == "[]" ||
-		strings.TrimSpace(result) == "{}"
+	return utils.EncodeResultContent(string(csvContent)), nil
 }
 
 func getResultContentReader(isEncrypted bool, pubKey string, objectName string) (io.ReadCloser, error) {
@@ -744,38 +670,24 @@ func getResultContentReader(isEncrypted bool, pubKey string, objectName string)
 		return nil, fmt.Errorf("failed to download after retries: %v", downloadErr)
 	}
 
-	if isEncrypted {
-		// 对于加密文件，读取并解密
-		content, err := os.ReadFile(localFilePath)
-		if err != nil {
-			os.Remove(localFilePath)
-			return nil, fmt.Errorf("failed to read encrypted content: %v", err)
-		}
-
-		// 解密完成后立即删除本地文件
-		os.Remove(localFilePath)
-
-		plainText, err := decryptContent(string(content), pubKey)
-		if err != nil {
-			return nil, err
-		}
-
-		// 返回解密后内容的Reader
-		return io.NopCloser(strings.NewReader(plainText)), nil
-	}
-
-	// 非加密文件：重新打开文件用于流式读取
+	// 重新打开文件用于流式读取
 	localFile, err := os.Open(localFilePath)
 	if err != nil {
 		os.Remove(localFilePath)
 		return nil, fmt.Errorf("failed to open local file for reading: %v", err)
 	}
-
-	// 返回自定义的 ReadCloser，关闭时自动删除临时文件
-	return &fileReadCloser{
+	fileReader := &fileReadCloser{
 		File:     localFile,
 		filePath: localFilePath,
-	}, nil
+	}
+
+	if isEncrypted {
+		// 分块数字信封逐块解密，关闭时删除临时文件
+		return newResultDecryptReader(fileReader, pubKey)
+	}
+
+	// 返回自定义的 ReadCloser，关闭时自动删除临时文件
+	return fileReader, nil
 }
 
 func processCSVStreaming(reader io.ReadCloser, processor func([][]string) error) error {
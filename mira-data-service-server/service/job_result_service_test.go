@@ -1,86 +1,130 @@
 package service
 
 import (
-	"encoding/json"
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
 	"testing"
 
+	"github.com/apache/arrow/go/v15/arrow"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-func TestTransformHardwareFormat(t *testing.T) {
+func TestInferResultColumnType(t *testing.T) {
 	tests := []struct {
-		name     string
-		inputs   []string
-		expected string
-		wantErr  bool
+		name   string
+		values []string
+		want   arrow.DataType
 	}{
-		{
-			name:     "空输入",
-			inputs:   []string{""},
-			expected: "[]",
-			wantErr:  false,
-		},
-		{
-			name:     "单个对象带JSON数组字符串",
-			inputs:   []string{`{"t":[{"k":20,"v":400},{"k":19,"v":361},{"k":2,"v":4},{"k":1,"v":1}]}`},
-			expected: `[{"t":{"k":20,"v":400}},{"t":{"k":19,"v":361}},{"t":{"k":2,"v":4}},{"t":{"k":1,"v":1}}]`,
-			wantErr:  false,
-		},
-		{
-			name:     "多个字段的对象",
-			inputs:   []string{`{"id": ["1", "2", "3"], "username": ["Admin", "test1", "test3"], "values": [{"k":20,"v":400},{"k":19,"v":361},{"k":2,"v":4}]}`},
-			expected: `[{"id":"1","username":"Admin","values":{"k":20,"v":400}},{"id":"2","username":"test1","values":{"k":19,"v":361}},{"id":"3","username":"test3","values":{"k":2,"v":4}}]`,
-			wantErr:  false,
-		},
-		{
-			name:     "原始数组格式",
-			inputs:   []string{`[{"id":"1","val":"test"},{"id":"2","val":"test2"}]`},
-			expected: `[{"id":"1","val":"test"},{"id":"2","val":"test2"}]`,
-			wantErr:  false,
-		},
-		{
-			name:     "无效JSON",
-			inputs:   []string{`{invalid json}`},
-			expected: "",
-			wantErr:  true,
-		},
-		{
-			name:     "对象中的非JSON字符串",
-			inputs:   []string{`{"t":"not a json array"}`},
-			expected: "[]",
-			wantErr:  false,
-		},
-		{
-			name:     "多个输入",
-			inputs:   []string{`{"t":[{"k":1,"v":1}]}`, `{"t":[{"k":2,"v":4}]}`},
-			expected: `[{"t":{"k":1,"v":1}},{"t":{"k":2,"v":4}}]`,
-			wantErr:  false,
-		},
+		{"整数", []string{"1", "-20", "", "300"}, arrow.PrimitiveTypes.Int64},
+		{"前导零保留为字符串", []string{"007", "123"}, arrow.BinaryTypes.LargeString},
+		{"小数", []string{"1.5", "2", "-3.125"}, &arrow.Decimal128Type{Precision: 38, Scale: 3}},
+		{"科学计数法", []string{"1e5", "2.5"}, arrow.PrimitiveTypes.Float64},
+		{"超出int64", []string{"12345678901234567890"}, &arrow.Decimal128Type{Precision: 38, Scale: 0}},
+		{"日期", []string{"2024-01-31", "2024-02-29"}, arrow.FixedWidthTypes.Date32},
+		{"时间戳", []string{"2024-01-31 10:00:00", "2024-02-01"}, arrow.FixedWidthTypes.Timestamp_us},
+		{"布尔", []string{"true", "FALSE"}, arrow.FixedWidthTypes.Boolean},
+		{"混合", []string{"1", "abc"}, arrow.BinaryTypes.LargeString},
+		{"全为空", []string{"", ""}, arrow.BinaryTypes.LargeString},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := TransformHardwareFormat(tt.inputs)
+			assert.True(t, arrow.TypeEqual(tt.want, inferResultColumnType(tt.values)), "got %s", inferResultColumnType(tt.values))
+		})
+	}
+}
 
-			// 检查错误
-			if tt.wantErr {
-				assert.Error(t, err)
-				return
-			}
-			assert.NoError(t, err)
+func TestResultColumnTypes_DeclaredTypesTakePrecedence(t *testing.T) {
+	declared, err := parseResultMetadata([]byte(`{"columns":[{"name":"amount","type":"DECIMAL(18,2)"},{"name":"code","type":"string"}]}`))
+	require.NoError(t, err)
 
-			// 为了比较JSON，我们需要标准化格式（去除空格差异等）
-			var expectedObj interface{}
-			var resultObj interface{}
+	headers := []string{"sum(x) as amount", "code", "cnt"}
+	columns := []string{"amount", "code", "cnt"}
+	inferred := []arrow.DataType{arrow.PrimitiveTypes.Int64, arrow.PrimitiveTypes.Int64, arrow.PrimitiveTypes.Int64}
+	types, err := resultColumnTypes(headers, columns, declared, inferred)
+	require.NoError(t, err)
+	assert.True(t, arrow.TypeEqual(&arrow.Decimal128Type{Precision: 18, Scale: 2}, types[0]))
+	assert.True(t, arrow.TypeEqual(arrow.BinaryTypes.LargeString, types[1]))
+	assert.True(t, arrow.TypeEqual(arrow.PrimitiveTypes.Int64, types[2]))
 
-			err = json.Unmarshal([]byte(tt.expected), &expectedObj)
-			assert.NoError(t, err, "预期结果应该是有效的JSON")
+	_, err = resultColumnTypes(headers, columns, map[string]string{"cnt": "money"}, inferred)
+	assert.Error(t, err)
+}
 
-			err = json.Unmarshal([]byte(result), &resultObj)
-			assert.NoError(t, err, "函数返回结果应该是有效的JSON")
+func TestInferResultCSV_UsesAllRows(t *testing.T) {
+	// 前面的行都是整数，最后一行才出现字符串，按全部数据行推断应退回字符串
+	var b strings.Builder
+	b.WriteString("id,code\n")
+	for i := 0; i < 5000; i++ {
+		fmt.Fprintf(&b, "%d,%d\n", i, i)
+	}
+	b.WriteString("5000,A-1\n")
+	content := b.String()
 
-			// 比较标准化后的JSON
-			assert.Equal(t, expectedObj, resultObj, "转换结果与预期不符")
-		})
+	plain, types, err := inferResultCSV(io.NopCloser(strings.NewReader(content)), t.TempDir())
+	require.NoError(t, err)
+	defer plain.Close()
+	assert.True(t, arrow.TypeEqual(arrow.PrimitiveTypes.Int64, types[0]), "got %s", types[0])
+	assert.True(t, arrow.TypeEqual(arrow.BinaryTypes.LargeString, types[1]), "got %s", types[1])
+
+	// 返回的明文可以完整重读
+	again, err := io.ReadAll(plain)
+	require.NoError(t, err)
+	assert.Equal(t, content, string(again))
+}
+
+func TestGenerateInsertSQL_TypedValues(t *testing.T) {
+	headers := []string{"id", "score", "day", "name"}
+	columnTypes := []arrow.DataType{
+		arrow.PrimitiveTypes.Int64,
+		arrow.PrimitiveTypes.Float64,
+		arrow.FixedWidthTypes.Timestamp_us,
+		arrow.BinaryTypes.LargeString,
+	}
+	query, args, err := generateInsertSQL("t", headers, columnTypes, [][]string{
+		{"1", "0.5", "2024-01-31T10:00:00+08:00", "a"},
+		{"2", "", "2024-02-01", ""},
+	}, 4)
+	require.NoError(t, err)
+	assert.Equal(t, `INSERT INTO t ("id", "score", "day", "name") VALUES ($1, $2, $3, $4), ($5, $6, $7, $8)`, query)
+	assert.Equal(t, []interface{}{int64(1), 0.5, "2024-01-31 02:00:00", "a", int64(2), nil, "2024-02-01 00:00:00", ""}, args)
+
+	_, _, err = generateInsertSQL("t", headers, columnTypes, [][]string{{"x", "1", "2024-01-01", "a"}}, 1)
+	assert.Error(t, err)
+}
+
+func TestEnvelopeDecryptReader(t *testing.T) {
+	content := "{\"kek\":\"k1\",\"cipherText\":\"c0\"}\n\n{\"cipherText\":\"c1\"}\n{\"kek\":\"k2\",\"cipherText\":\"c2\"}"
+	src := bufio.NewReader(strings.NewReader(content))
+	envelope, err := isEnvelopeEncrypted(src)
+	require.NoError(t, err)
+	require.True(t, envelope)
+
+	var keks []string
+	reader := &envelopeDecryptReader{
+		src: src,
+		decrypt: func(kek, cipherText string) (string, error) {
+			keks = append(keks, kek)
+			return "plain-" + cipherText + ";", nil
+		},
+	}
+	plain, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, "plain-c0;plain-c1;plain-c2;", string(plain))
+	assert.Equal(t, []string{"k1", "k1", "k2"}, keks)
+
+	legacy, err := isEnvelopeEncrypted(bufio.NewReader(strings.NewReader("  04abcdef")))
+	require.NoError(t, err)
+	assert.False(t, legacy)
+
+	failing := &envelopeDecryptReader{
+		src: bufio.NewReader(strings.NewReader(content)),
+		decrypt: func(kek, cipherText string) (string, error) {
+			return "", fmt.Errorf("bad kek")
+		},
 	}
+	_, err = io.ReadAll(failing)
+	assert.ErrorContains(t, err, "chunk 0")
 }
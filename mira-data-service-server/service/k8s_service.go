@@ -8,6 +8,8 @@ import (
 
 	"data-service/common"
 	"data-service/config"
+	"data-service/database/gorm"
+	"data-service/database/gorm/repositories"
 	pb "data-service/generated/datasource"
 
 	"data-service/utils"
@@ -22,12 +24,24 @@ import (
 type K8sService interface {
 	SubmitBatchJob(ctx context.Context, request *pb.BatchReadRequest) (*pb.BatchResponse, error)
 	GetJobStatus(ctx context.Context, request *pb.JobStatusRequest) (*pb.BatchResponse, error)
+	CancelBatchJob(ctx context.Context, request *pb.CancelBatchJobRequest) (*pb.BatchResponse, error)
+	GetBatchJobLogs(request *pb.GetBatchJobLogsRequest, stream pb.DataSourceService_GetBatchJobLogsServer) error
+	ListBatchJobHistory(ctx context.Context, request *pb.ListBatchJobHistoryRequest) (*pb.ListBatchJobHistoryResponse, error)
 }
 
 type k8sService struct {
-	logger      *zap.SugaredLogger
-	k8sClient   *kubernetes.Clientset
-	redisClient *RedisClient
+	logger       *zap.SugaredLogger
+	k8sClient    kubernetes.Interface
+	redisClient  *RedisClient
+	namespace    string
+	history      BatchJobHistoryStore // 为空时不记录作业历史，取消状态也只能通过删除 Pod 体现
+	cleanPod     bool                 // 作业结束后删除 Pod
+	pollInterval time.Duration        // 后台监控 Pod 状态的间隔
+	callbacks    *JobCallbackHub      // 为空时不关联作业完成回调
+	callbackWait time.Duration        // Pod 成功结束后等待回调的时长
+
+	executorConfig config.BatchExecutorConfig
+	dorisExecutor  *dorisBatchExecutor // 为空时所有作业使用 Spark 执行
 }
 
 func NewK8sService(logger *zap.SugaredLogger) (K8sService, error) {
@@ -48,11 +62,26 @@ func NewK8sService(logger *zap.SugaredLogger) (K8sService, error) {
 		return nil, fmt.Errorf("failed to create Redis client: %v", err)
 	}
 
-	return &k8sService{
-		logger:      logger,
-		k8sClient:   clientset,
-		redisClient: redisClient,
-	}, nil
+	conf := config.GetConfigMap()
+	svc := &k8sService{
+		logger:       logger,
+		k8sClient:    clientset,
+		redisClient:  redisClient,
+		namespace:    conf.SparkPodConfig.Namespace,
+		cleanPod:     conf.CleanupConfig.Enable,
+		pollInterval: 10 * time.Second,
+		callbacks:    GetJobCallbackHub(),
+		callbackWait: time.Duration(conf.JobCallback.WaitTimeoutSeconds) * time.Second,
+
+		executorConfig: conf.BatchExecutor,
+	}
+	svc.dorisExecutor = newDorisBatchExecutor(svc, func() (IDorisService, error) {
+		return NewDorisService(common.MIRA_TMP_TASK_DB)
+	})
+	if db := gorm.GetGormDB(); db != nil {
+		svc.history = repositories.NewBatchJobHistoryRepository(db)
+	}
+	return svc, nil
 }
 
 func (s *k8sService) logPodInfo(pod *corev1.Pod) {
@@ -165,7 +194,7 @@ func (s *k8sService) SubmitBatchJob(ctx context.Context, request *pb.BatchReadRe
 	if tableInfo != nil {
 		s.logger.Infof("Table info retrieved: TableName=%s, RecordCount=%d, RecordSize=%d bytes (%.2f MB)",
 			tableInfo.GetTableName(),
-			tableInfo.GetRecordCount(),
+			tableInfo.GetRecordCount64(),
 			tableInfo.GetRecordSize(),
 			float64(tableInfo.GetRecordSize())/(1024*1024))
 	} else {
@@ -175,7 +204,7 @@ func (s *k8sService) SubmitBatchJob(ctx context.Context, request *pb.BatchReadRe
 	// 根据表信息调整Spark配置
 	adjustedSparkConfig := AdjustSparkConfigByTableInfo(tableInfo, request.SparkConfig)
 	s.logger.Infof("Adjusted Spark config based on table info: RecordCount=%d, RecordSize=%d, MaxExecutors=%d, ExecutorMemoryMB=%d",
-		tableInfo.GetRecordCount(), tableInfo.GetRecordSize(),
+		tableInfo.GetRecordCount64(), tableInfo.GetRecordSize(),
 		adjustedSparkConfig.GetDynamicAllocationMaxExecutors(), adjustedSparkConfig.GetExecutorMemoryMB())
 
 	// 根据操作类型构建 SparkDBConnInfo
@@ -245,6 +274,9 @@ func (s *k8sService) SubmitBatchJob(ctx context.Context, request *pb.BatchReadRe
 		sparkConnInfo.Mode = pb.OperationMode_OPERATION_MODE_JOIN
 
 	case *pb.BatchReadRequest_AddHashColumn:
+		if _, err := utils.NormalizeHashAlgorithm(op.AddHashColumn.HashAlgorithm); err != nil {
+			return nil, err
+		}
 		sparkConnInfo.TempTable = op.AddHashColumn.TempTable
 		sparkConnInfo.JoinColumns = op.AddHashColumn.JoinColumns // 这里复用 JoinColumns 存储哈希列
 		sparkConnInfo.Mode = pb.OperationMode_OPERATION_MODE_ADD_HASH_COLUMN
@@ -268,93 +300,179 @@ func (s *k8sService) SubmitBatchJob(ctx context.Context, request *pb.BatchReadRe
 		return nil, fmt.Errorf("不支持的操作类型")
 	}
 
-	// 创建 Spark Pod
-	podName := "spark-job-" + request.RequestId
-	pod, err := utils.CreateSparkPod(s.k8sClient, podName, sparkConnInfo, adjustedSparkConfig, connInfo, tableInfo.GetRecordCount())
+	// 按配置和数据量选择执行后端，作业ID与后端无关
+	job := &BatchJob{
+		JobId:       "spark-job-" + request.RequestId,
+		Request:     request,
+		Spec:        sparkConnInfo,
+		ConnInfo:    connInfo,
+		SparkConfig: adjustedSparkConfig,
+		TableInfo:   tableInfo,
+	}
+	executor, err := s.selectBatchExecutor(job)
 	if err != nil {
-		s.logger.Errorf("Failed to create Pod: %v", err)
 		return nil, err
 	}
-
-	// 打印 Pod 信息
-	s.logPodInfo(pod)
-
-	cleanPod := conf.CleanupConfig.Enable
-
-	if cleanPod {
-		s.logger.Infof("Starting Pod cleanup for %s", podName)
-		go s.monitorJob(podName)
+	s.logger.Infof("Batch job %s will be executed by %s", job.JobId, executor.Name())
+	if err := executor.Submit(ctx, job); err != nil {
+		return nil, err
 	}
 
 	// 返回初始状态
 	return &pb.BatchResponse{
-		Status: pb.JobStatus_JOB_STATUS_PENDING,
-		JobId:  podName,
+		Status:  pb.JobStatus_JOB_STATUS_PENDING,
+		JobId:   job.JobId,
+		Attempt: 1,
 	}, nil
 }
 
-// 修改GetJobStatus函数，尝试从Redis获取信息
+// GetJobStatus 查询作业状态，并附带作业最近一次完成回调
 func (s *k8sService) GetJobStatus(ctx context.Context, request *pb.JobStatusRequest) (*pb.BatchResponse, error) {
-	podName := request.GetJobId() // podName
-	s.logger.Debugf("查询作业状态: %s", podName)
+	jobId := request.GetJobId() // 首个 podName
+	s.logger.Debugf("查询作业状态: %s", jobId)
+
+	resp, err := s.jobStatus(ctx, jobId)
+	callback := s.latestCallback(jobId)
+	if err != nil {
+		if callback == nil {
+			return nil, err
+		}
+		// Pod、Redis 和作业历史中都没有数据时以回调为准
+		resp = &pb.BatchResponse{Status: callback.Status, Error: callback.Error, JobId: jobId}
+	}
+	resp.Callback = callback
+	return resp, nil
+}
 
-	// 先检查Pod状态
-	pod, err := s.findJobPod(ctx, podName)
+// latestCallback 作业最近一次完成回调，未收到或查询失败时返回 nil
+func (s *k8sService) latestCallback(jobId string) *pb.JobCallback {
+	if s.callbacks == nil {
+		return nil
+	}
+	callback, err := s.callbacks.Latest(jobId)
+	if err != nil {
+		s.logger.Warnf("Failed to get callback of job %s: %v", jobId, err)
+		return nil
+	}
+	return callback
+}
+
+// waitCallback Pod 成功结束后等待该 Pod 创建后上报的作业完成回调，超时或未启用时返回 nil
+func (s *k8sService) waitCallback(jobId string, pod *corev1.Pod) *pb.JobCallback {
+	if s.callbacks == nil || s.callbackWait <= 0 {
+		return nil
+	}
+	callback, err := s.callbacks.Wait(context.Background(), jobId, pod.CreationTimestamp.Time, s.callbackWait)
+	if err != nil {
+		s.logger.Warnf("No callback received for job %s: %v", jobId, err)
+		return nil
+	}
+	return callback
+}
+
+// jobStatus 依次从 Pod 状态、Redis 和作业历史获取作业状态
+func (s *k8sService) jobStatus(ctx context.Context, jobId string) (*pb.BatchResponse, error) {
+
+	if s.jobCancelled(jobId) {
+		return &pb.BatchResponse{Status: pb.JobStatus_JOB_STATUS_CANCELLED, JobId: jobId}, nil
+	}
+
+	// 在 Doris 中执行的作业没有 Pod，结束一段时间后同样从 Redis 和作业历史获取
+	if resp, ok := s.dorisExecutor.status(ctx, jobId); ok {
+		return resp, nil
+	}
+
+	// 先检查Pod状态，存在重试时取最近一次尝试
+	pod, err := s.findJobPod(ctx, jobId)
 	if err != nil {
 		// 如果Pod不存在，检查Redis中是否有结果
-		resultStr, err := s.redisClient.Get(ctx, podName).Result()
-		if err == nil && resultStr != "" {
+		if resultStr, ok := s.jobResult(ctx, jobId); ok {
 			// Redis中有数据，说明任务已经成功完成
 			return &pb.BatchResponse{
 				Status: pb.JobStatus_JOB_STATUS_SUCCEEDED,
 				Data:   resultStr,
+				JobId:  jobId,
+			}, nil
+		}
+
+		// Pod 已被清理时从作业历史获取最终状态
+		if record := s.findHistory(jobId); record != nil {
+			return &pb.BatchResponse{
+				Status:  parseJobStatus(record.Status),
+				Mode:    record.Mode,
+				Error:   record.ErrorMessage,
+				JobId:   jobId,
+				Attempt: int32(record.Attempt),
 			}, nil
 		}
 
-		// Redis中也没有数据，返回错误
+		// Redis和作业历史中都没有数据，返回错误
 		s.logger.Errorf("获取Pod失败且Redis中无数据: %v", err)
 		return nil, err
 	}
+	attempt := podAttempt(pod)
 
 	// 根据Pod状态处理
 	switch pod.Status.Phase {
 	case corev1.PodSucceeded:
 		// Pod成功，从Redis获取详细数据
-		resultStr, err := s.redisClient.Get(ctx, podName).Result()
-		if err == nil && resultStr != "" {
-			return &pb.BatchResponse{
-				Status: pb.JobStatus_JOB_STATUS_SUCCEEDED,
-				Data:   resultStr,
-			}, nil
-		}
-
-		// Redis没有数据，返回成功但无数据
+		resultStr, _ := s.jobResult(ctx, jobId)
 		return &pb.BatchResponse{
-			Status: pb.JobStatus_JOB_STATUS_SUCCEEDED,
+			Status:  pb.JobStatus_JOB_STATUS_SUCCEEDED,
+			Data:    resultStr,
+			JobId:   jobId,
+			Attempt: attempt,
 		}, nil
 
 	case corev1.PodFailed:
+		// 还有重试机会时作业仍在进行中
+		if record := s.findHistory(jobId); record != nil && int(attempt) <= record.MaxRetries {
+			return &pb.BatchResponse{
+				Status:  pb.JobStatus_JOB_STATUS_PENDING,
+				Error:   getPodFailureReason(pod),
+				JobId:   jobId,
+				Attempt: attempt,
+			}, nil
+		}
 		// Pod失败，返回失败状态
 		errorMsg := getPodFailureReason(pod)
 		return &pb.BatchResponse{
-			Status: pb.JobStatus_JOB_STATUS_FAILED,
-			Error:  errorMsg,
+			Status:  pb.JobStatus_JOB_STATUS_FAILED,
+			Error:   errorMsg,
+			JobId:   jobId,
+			Attempt: attempt,
 		}, nil
 
 	case corev1.PodRunning:
 		// Pod运行中，返回运行状态
 		return &pb.BatchResponse{
-			Status: pb.JobStatus_JOB_STATUS_RUNNING,
+			Status:  pb.JobStatus_JOB_STATUS_RUNNING,
+			JobId:   jobId,
+			Attempt: attempt,
 		}, nil
 
 	default:
 		// 其他状态，返回待处理状态
 		return &pb.BatchResponse{
-			Status: pb.JobStatus_JOB_STATUS_PENDING,
+			Status:  pb.JobStatus_JOB_STATUS_PENDING,
+			JobId:   jobId,
+			Attempt: attempt,
 		}, nil
 	}
 }
 
+// jobResult 从Redis获取作业结果
+func (s *k8sService) jobResult(ctx context.Context, jobId string) (string, bool) {
+	if s.redisClient == nil {
+		return "", false
+	}
+	resultStr, err := s.redisClient.Get(ctx, jobId).Result()
+	if err != nil || resultStr == "" {
+		return "", false
+	}
+	return resultStr, true
+}
+
 // 获取 Pod 失败原因
 func getPodFailureReason(pod *corev1.Pod) string {
 	for _, condition := range pod.Status.Conditions {
@@ -373,18 +491,51 @@ func getPodFailureReason(pod *corev1.Pod) string {
 	return "Unknown failure reason"
 }
 
-func (s *k8sService) findJobPod(ctx context.Context, podName string) (*corev1.Pod, error) {
-	return s.k8sClient.CoreV1().Pods(config.GetConfigMap().SparkPodConfig.Namespace).Get(ctx, podName, metav1.GetOptions{})
+// findJobPod 查找作业最近一次尝试的 Pod，没有作业标签的旧 Pod 按名称查找
+func (s *k8sService) findJobPod(ctx context.Context, jobId string) (*corev1.Pod, error) {
+	pods, err := s.listJobPods(ctx, jobId)
+	if err != nil {
+		return nil, err
+	}
+	var latest *corev1.Pod
+	for i := range pods {
+		if latest == nil || podAttempt(&pods[i]) > podAttempt(latest) {
+			latest = &pods[i]
+		}
+	}
+	if latest != nil {
+		return latest, nil
+	}
+	return s.k8sClient.CoreV1().Pods(s.namespace).Get(ctx, jobId, metav1.GetOptions{})
+}
+
+// listJobPods 列出作业所有尝试的 Pod
+func (s *k8sService) listJobPods(ctx context.Context, jobId string) ([]corev1.Pod, error) {
+	pods, err := s.k8sClient.CoreV1().Pods(s.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: utils.SparkJobLabel + "=" + jobId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods of job %s: %v", jobId, err)
+	}
+	return pods.Items, nil
 }
 
-// 后台监控作业状态，清理已完成的Pod
-func (s *k8sService) monitorJob(podName string) {
-	ticker := time.NewTicker(10 * time.Second)
+// 后台监控作业状态，失败时按重试策略重新创建Pod，作业结束后清理Pod
+func (s *k8sService) monitorJob(jobId string, policy *pb.SparkRetryPolicy) {
+	ticker := time.NewTicker(s.pollInterval)
 	defer ticker.Stop()
 
+	podName := jobId
+	attempt := 1
+	running := false
 	for range ticker.C {
+		if s.jobCancelled(jobId) {
+			s.logger.Infof("Job %s was cancelled, stop monitoring", jobId)
+			return
+		}
+
 		// Get Pod status
-		pod, err := s.k8sClient.CoreV1().Pods(config.GetConfigMap().SparkPodConfig.Namespace).Get(context.Background(), podName, metav1.GetOptions{})
+		pod, err := s.k8sClient.CoreV1().Pods(s.namespace).Get(context.Background(), podName, metav1.GetOptions{})
 		if err != nil {
 			s.logger.Errorf("Failed to get Pod status: %v", err)
 			return
@@ -393,15 +544,53 @@ func (s *k8sService) monitorJob(podName string) {
 		switch pod.Status.Phase {
 		case corev1.PodRunning:
 			s.logger.Debugf("Pod %s is running", podName)
+			if !running {
+				running = true
+				s.updateHistory(jobId, map[string]interface{}{"status": pb.JobStatus_JOB_STATUS_RUNNING.String()})
+			}
 		case corev1.PodSucceeded:
 			s.logger.Infof("Pod %s completed successfully", podName)
-			time.Sleep(10 * time.Second)
-			// Delete successfully completed Pod
-			s.deletePod(podName)
+			// 作业可能以成功退出但通过回调上报失败
+			if callback := s.waitCallback(jobId, pod); callback != nil && callback.Status == pb.JobStatus_JOB_STATUS_FAILED {
+				s.logger.Errorf("Job %s reported failure by callback: %s", jobId, callback.Error)
+				s.finishHistory(jobId, pb.JobStatus_JOB_STATUS_FAILED, callback.Error)
+			} else {
+				s.finishHistory(jobId, pb.JobStatus_JOB_STATUS_SUCCEEDED, "")
+			}
+			if s.cleanPod {
+				time.Sleep(10 * time.Second)
+				// Delete successfully completed Pod
+				s.deletePod(jobId)
+			}
 			return
 		case corev1.PodFailed:
-			s.logger.Errorf("Pod %s failed: %s", podName, getPodFailureReason(pod))
-			s.deletePod(podName)
+			reason := getPodFailureReason(pod)
+			s.logger.Errorf("Pod %s failed: %s", podName, reason)
+			if attempt <= int(policy.GetMaxRetries()) {
+				if !s.waitRetryBackoff(jobId, retryBackoff(policy, attempt)) {
+					return
+				}
+				retryPod, err := s.retryPod(pod, jobId, attempt+1)
+				if err == nil {
+					attempt++
+					podName = retryPod.Name
+					running = false
+					s.logger.Infof("Retrying job %s with Pod %s (attempt %d/%d)", jobId, podName, attempt, policy.GetMaxRetries()+1)
+					s.updateHistory(jobId, map[string]interface{}{
+						"status":        pb.JobStatus_JOB_STATUS_PENDING.String(),
+						"attempt":       attempt,
+						"pod_name":      podName,
+						"error_message": reason,
+					})
+					continue
+				}
+				s.logger.Errorf("Failed to retry job %s: %v", jobId, err)
+				reason = fmt.Sprintf("%s; retry failed: %v", reason, err)
+			}
+			s.finishHistory(jobId, pb.JobStatus_JOB_STATUS_FAILED, reason)
+			if s.cleanPod {
+				s.deletePod(jobId)
+			}
 			return
 		}
 	}
@@ -412,7 +601,7 @@ func (s *k8sService) deletePod(podName string) {
 	s.logger.Infof("Deleting Pods starting with %s", podName)
 
 	// Get all Pods
-	pods, err := s.k8sClient.CoreV1().Pods(config.GetConfigMap().SparkPodConfig.Namespace).List(
+	pods, err := s.k8sClient.CoreV1().Pods(s.namespace).List(
 		context.Background(),
 		metav1.ListOptions{},
 	)
@@ -424,7 +613,7 @@ func (s *k8sService) deletePod(podName string) {
 	// Delete Pods starting with podName
 	for _, pod := range pods.Items {
 		if strings.HasPrefix(pod.Name, podName) {
-			err := s.k8sClient.CoreV1().Pods(config.GetConfigMap().SparkPodConfig.Namespace).Delete(
+			err := s.k8sClient.CoreV1().Pods(s.namespace).Delete(
 				context.Background(),
 				pod.Name,
 				metav1.DeleteOptions{},
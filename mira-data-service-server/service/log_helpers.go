@@ -3,6 +3,7 @@ package service
 import (
 	"data-service/log"
 	"data-service/utils"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
@@ -53,7 +54,9 @@ func checkStreamloaderOutput(out string) error {
 	reTotalRows := regexp.MustCompile(`"TotalRows"\s*:\s*(\d+)`)
 
 	status, message := "", ""
-	if m := reStatus.FindStringSubmatch(out); len(m) > 1 {
+	if v, ok := topLevelJSONString(out, "Status"); ok {
+		status = v
+	} else if m := reStatus.FindStringSubmatch(out); len(m) > 1 {
 		status = m[1]
 	}
 	if m := reMessage.FindStringSubmatch(out); len(m) > 1 {
@@ -105,3 +108,47 @@ func checkStreamloaderOutput(out string) error {
 	}
 	return fmt.Errorf("doris-streamloader not success: status=%s, message=%s, output=%s", status, message, out)
 }
+
+// streamloaderFilteredRows 解析 doris-streamloader 输出中被过滤的行数和总行数，缺失时为 0
+func streamloaderFilteredRows(out string) (filtered, total int64) {
+	reFilteredRows := regexp.MustCompile(`"FilteredRows"\s*:\s*(\d+)`)
+	reTotalRows := regexp.MustCompile(`"TotalRows"\s*:\s*(\d+)`)
+	if m := reFilteredRows.FindStringSubmatch(out); len(m) > 1 {
+		fmt.Sscanf(m[1], "%d", &filtered)
+	}
+	if m := reTotalRows.FindStringSubmatch(out); len(m) > 1 {
+		fmt.Sscanf(m[1], "%d", &total)
+	}
+	return filtered, total
+}
+
+// topLevelJSONString 读取输出中第一个 JSON 对象最外层的字符串字段，重复字段取第一个
+func topLevelJSONString(out, key string) (string, bool) {
+	start := strings.Index(out, "{")
+	if start < 0 {
+		return "", false
+	}
+	dec := json.NewDecoder(strings.NewReader(out[start:]))
+	if _, err := dec.Token(); err != nil {
+		return "", false
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", false
+		}
+		if name, _ := tok.(string); name == key {
+			var v interface{}
+			if err := dec.Decode(&v); err != nil {
+				return "", false
+			}
+			str, ok := v.(string)
+			return str, ok
+		}
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return "", false
+		}
+	}
+	return "", false
+}
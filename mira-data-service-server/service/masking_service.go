@@ -0,0 +1,498 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"data-service/common"
+	"data-service/config"
+	"data-service/database/gorm"
+	"data-service/database/gorm/models"
+	"data-service/database/gorm/repositories"
+	pb "data-service/generated/datasource"
+	"data-service/log"
+	"data-service/utils"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"google.golang.org/grpc"
+)
+
+const (
+	maskingPolicySourceConfig = "config"
+	maskingPolicySourceDB     = "db"
+	maskingAllAssets          = "*"
+)
+
+// MaskingPolicy 生效的列脱敏策略
+type MaskingPolicy struct {
+	ID         uint
+	Source     string
+	Asset      string
+	Column     string
+	Method     common.MaskingMethod
+	KeepPrefix int
+	KeepSuffix int
+	MaskChar   rune
+	Algorithm  string
+	Salt       string
+}
+
+// MaskingEngine 按资产和列匹配脱敏策略，并对 Arrow 批次执行脱敏
+type MaskingEngine struct {
+	mu       sync.RWMutex
+	enabled  bool
+	tokenKey []byte
+	salt     string
+	base     []MaskingPolicy
+	policies []MaskingPolicy
+	loadedAt time.Time
+	refresh  time.Duration
+	loader   func() ([]models.MaskingPolicy, error)
+}
+
+var (
+	maskingEngine     *MaskingEngine
+	maskingEngineOnce sync.Once
+)
+
+// GetMaskingEngine 获取全局脱敏引擎，策略来自配置文件和数据库
+func GetMaskingEngine() *MaskingEngine {
+	maskingEngineOnce.Do(func() {
+		var loader func() ([]models.MaskingPolicy, error)
+		if db := gorm.GetGormDB(); db != nil {
+			loader = repositories.NewMaskingPolicyRepository(db).FindEnabled
+		}
+		maskingEngine = NewMaskingEngine(config.GetConfigMap().MaskingConfig, loader)
+	})
+	return maskingEngine
+}
+
+// NewMaskingEngine 创建脱敏引擎，loader 为空时只使用配置文件中的策略
+func NewMaskingEngine(conf config.MaskingConfig, loader func() ([]models.MaskingPolicy, error)) *MaskingEngine {
+	refresh := time.Duration(conf.RefreshInterval) * time.Second
+	if refresh <= 0 {
+		refresh = time.Minute
+	}
+	e := &MaskingEngine{
+		enabled:  conf.Enabled,
+		tokenKey: []byte(conf.TokenKey),
+		salt:     conf.Salt,
+		refresh:  refresh,
+		loader:   loader,
+	}
+	for _, p := range conf.Policies {
+		policy, err := newMaskingPolicy(0, maskingPolicySourceConfig, p.Asset, p.Column, p.Method, p.KeepPrefix, p.KeepSuffix, p.MaskChar, p.Algorithm, p.Salt)
+		if err != nil {
+			log.Logger.Warnf("Skip invalid masking policy %s.%s: %v", p.Asset, p.Column, err)
+			continue
+		}
+		e.base = append(e.base, policy)
+	}
+	e.policies = e.base
+	return e
+}
+
+func newMaskingPolicy(id uint, source, asset, column, method string, keepPrefix, keepSuffix int, maskChar, algorithm, salt string) (MaskingPolicy, error) {
+	m := common.MaskingMethod(strings.ToLower(method))
+	if !m.IsValid() {
+		return MaskingPolicy{}, fmt.Errorf("unsupported masking method: %s", method)
+	}
+	if column == "" {
+		return MaskingPolicy{}, fmt.Errorf("masking column is empty")
+	}
+	if asset == "" {
+		asset = maskingAllAssets
+	}
+	mc := '*'
+	if r := []rune(maskChar); len(r) > 0 {
+		mc = r[0]
+	}
+	return MaskingPolicy{
+		ID:         id,
+		Source:     source,
+		Asset:      asset,
+		Column:     column,
+		Method:     m,
+		KeepPrefix: keepPrefix,
+		KeepSuffix: keepSuffix,
+		MaskChar:   mc,
+		Algorithm:  algorithm,
+		Salt:       salt,
+	}, nil
+}
+
+// Enabled 是否启用脱敏
+func (e *MaskingEngine) Enabled() bool {
+	return e != nil && e.enabled
+}
+
+// reloadIfNeeded 定期从数据库刷新策略，刷新失败时沿用上一次的策略
+func (e *MaskingEngine) reloadIfNeeded() {
+	if e.loader == nil {
+		return
+	}
+	e.mu.RLock()
+	fresh := time.Since(e.loadedAt) < e.refresh
+	e.mu.RUnlock()
+	if fresh {
+		return
+	}
+
+	rows, err := e.loader()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.loadedAt = time.Now()
+	if err != nil {
+		log.Logger.Warnf("Failed to load masking policies from db: %v", err)
+		return
+	}
+	policies := append([]MaskingPolicy{}, e.base...)
+	for _, row := range rows {
+		policy, err := newMaskingPolicy(row.ID, maskingPolicySourceDB, row.Asset, row.ColumnName, row.Method, row.KeepPrefix, row.KeepSuffix, row.MaskChar, row.Algorithm, row.Salt)
+		if err != nil {
+			log.Logger.Warnf("Skip invalid masking policy %d: %v", row.ID, err)
+			continue
+		}
+		policies = append(policies, policy)
+	}
+	e.policies = policies
+}
+
+// PoliciesFor 返回资产适用的列策略（key 为小写列名），资产专属策略优先于 * 策略，数据库策略优先于配置文件
+func (e *MaskingEngine) PoliciesFor(asset string) map[string]MaskingPolicy {
+	return e.PoliciesForAssets([]string{asset})
+}
+
+// PoliciesForAssets 返回多个资产适用的列策略，用于读取多张表的 SQL
+func (e *MaskingEngine) PoliciesForAssets(assets []string) map[string]MaskingPolicy {
+	if !e.Enabled() {
+		return nil
+	}
+	e.reloadIfNeeded()
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	result := make(map[string]MaskingPolicy)
+	// 先应用通配策略，再由资产专属策略覆盖
+	for _, wildcard := range []bool{true, false} {
+		for _, p := range e.policies {
+			if (p.Asset == maskingAllAssets) != wildcard {
+				continue
+			}
+			if !wildcard && !containsFold(assets, p.Asset) {
+				continue
+			}
+			result[strings.ToLower(p.Column)] = p
+		}
+	}
+	return result
+}
+
+func containsFold(values []string, target string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// MaskRecord 按策略对记录脱敏，applied 记录每列脱敏的行数；置空策略保留原列类型，其余策略输出字符串列
+func (e *MaskingEngine) MaskRecord(rec arrow.Record, policies map[string]MaskingPolicy, applied map[string]int64) (arrow.Record, error) {
+	mem := memory.NewGoAllocator()
+	schema := rec.Schema()
+	fields := make([]arrow.Field, 0, len(schema.Fields()))
+	cols := make([]arrow.Array, 0, len(schema.Fields()))
+	defer func() {
+		for _, c := range cols {
+			c.Release()
+		}
+	}()
+
+	for i, field := range schema.Fields() {
+		col := rec.Column(i)
+		policy, ok := policies[strings.ToLower(field.Name)]
+		if !ok {
+			col.Retain()
+			fields = append(fields, field)
+			cols = append(cols, col)
+			continue
+		}
+
+		masked, err := e.maskArray(mem, col, policy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to mask column %s: %v", field.Name, err)
+		}
+		fields = append(fields, arrow.Field{Name: field.Name, Type: masked.DataType(), Nullable: true, Metadata: field.Metadata})
+		cols = append(cols, masked)
+		if applied != nil {
+			applied[field.Name] += int64(col.Len())
+		}
+	}
+
+	return array.NewRecord(arrow.NewSchema(fields, nil), cols, rec.NumRows()), nil
+}
+
+func (e *MaskingEngine) maskArray(mem memory.Allocator, col arrow.Array, policy MaskingPolicy) (arrow.Array, error) {
+	if policy.Method == common.MaskingMethodNull {
+		return array.MakeArrayOfNull(mem, col.DataType(), col.Len()), nil
+	}
+
+	b := array.NewStringBuilder(mem)
+	defer b.Release()
+	b.Reserve(col.Len())
+	for j := 0; j < col.Len(); j++ {
+		if col.IsNull(j) {
+			b.AppendNull()
+			continue
+		}
+		v, err := e.maskValue(policy, col.ValueStr(j))
+		if err != nil {
+			return nil, err
+		}
+		b.Append(v)
+	}
+	return b.NewArray(), nil
+}
+
+func (e *MaskingEngine) maskValue(policy MaskingPolicy, value string) (string, error) {
+	switch policy.Method {
+	case common.MaskingMethodRedact:
+		return utils.RedactedValue, nil
+	case common.MaskingMethodPartial:
+		return utils.MaskPartial(value, policy.KeepPrefix, policy.KeepSuffix, policy.MaskChar), nil
+	case common.MaskingMethodHash:
+		salt := policy.Salt
+		if salt == "" {
+			salt = e.salt
+		}
+		return utils.HashValue(value, salt, policy.Algorithm)
+	case common.MaskingMethodTokenize:
+		return utils.TokenizeFormatPreserving(value, e.tokenKey), nil
+	default:
+		return "", fmt.Errorf("unsupported masking method: %s", policy.Method)
+	}
+}
+
+// batchMasker 单次RPC内的批次脱敏器，结束时写入审计记录
+type batchMasker struct {
+	engine   *MaskingEngine
+	rpc      string
+	asset    string
+	policies map[string]MaskingPolicy
+	mu       sync.Mutex
+	applied  map[string]int64
+}
+
+// maskIPC 解码 Arrow IPC 数据，脱敏后重新编码；空数据和 EOF 标志原样返回
+func (m *batchMasker) maskIPC(data []byte) ([]byte, error) {
+	if len(data) == 0 || string(data) == "EOF" {
+		return data, nil
+	}
+	reader, err := ipc.NewReader(bytes.NewReader(data), ipc.WithAllocator(memory.NewGoAllocator()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode arrow batch for masking: %v", err)
+	}
+	defer reader.Release()
+
+	var buf bytes.Buffer
+	var writer *ipc.Writer
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for reader.Next() {
+		masked, err := m.engine.MaskRecord(reader.Record(), m.policies, m.applied)
+		if err != nil {
+			return nil, err
+		}
+		if writer == nil {
+			writer = ipc.NewWriter(&buf, ipc.WithSchema(masked.Schema()))
+		}
+		err = writer.Write(masked)
+		masked.Release()
+		if err != nil {
+			return nil, fmt.Errorf("failed to write masked arrow record: %v", err)
+		}
+	}
+	if err := reader.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read arrow batch for masking: %v", err)
+	}
+	if writer == nil {
+		// 只有 schema 没有数据的批次：按脱敏后的 schema 输出空批次
+		writer = ipc.NewWriter(&buf, ipc.WithSchema(maskedSchema(reader.Schema(), m.policies)))
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close arrow writer: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// finish 记录本次RPC应用的脱敏策略
+func (m *batchMasker) finish() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var records []models.MaskingAuditRecord
+	for column, rows := range m.applied {
+		p := m.policies[strings.ToLower(column)]
+		log.Logger.Infof("Masking policy applied, rpc: %s, asset: %s, column: %s, method: %s, source: %s, policyId: %d, rows: %d",
+			m.rpc, m.asset, column, p.Method, p.Source, p.ID, rows)
+		records = append(records, models.MaskingAuditRecord{
+			Rpc:        m.rpc,
+			Asset:      m.asset,
+			ColumnName: column,
+			PolicyID:   p.ID,
+			Source:     p.Source,
+			Method:     string(p.Method),
+			RowsMasked: rows,
+		})
+	}
+	if db := gorm.GetGormDB(); db != nil && len(records) > 0 {
+		if err := repositories.NewMaskingPolicyRepository(db).CreateAuditRecords(records); err != nil {
+			log.Logger.Warnf("Failed to save masking audit records: %v", err)
+		}
+	}
+}
+
+func maskedSchema(schema *arrow.Schema, policies map[string]MaskingPolicy) *arrow.Schema {
+	fields := make([]arrow.Field, 0, len(schema.Fields()))
+	for _, f := range schema.Fields() {
+		if p, ok := policies[strings.ToLower(f.Name)]; ok {
+			f.Nullable = true
+			if p.Method != common.MaskingMethodNull {
+				f.Type = arrow.BinaryTypes.String
+			}
+		}
+		fields = append(fields, f)
+	}
+	return arrow.NewSchema(fields, nil)
+}
+
+func newBatchMasker(rpc string, assets ...string) *batchMasker {
+	engine := GetMaskingEngine()
+	policies := engine.PoliciesForAssets(assets)
+	if len(policies) == 0 {
+		return nil
+	}
+	return &batchMasker{
+		engine:   engine,
+		rpc:      rpc,
+		asset:    strings.Join(assets, ","),
+		policies: policies,
+		applied:  make(map[string]int64),
+	}
+}
+
+// maskedArrowStream 发送前对 Arrow 批次脱敏的流包装
+type maskedArrowStream struct {
+	grpc.ServerStreamingServer[pb.ArrowResponse]
+	masker *batchMasker
+}
+
+func (s *maskedArrowStream) Send(resp *pb.ArrowResponse) error {
+	// 试运行只返回执行计划，没有数据需要脱敏
+	if resp.Plan != nil {
+		return s.ServerStreamingServer.Send(resp)
+	}
+	data, err := s.masker.maskIPC(resp.ArrowBatch)
+	if err != nil {
+		return err
+	}
+	return s.ServerStreamingServer.Send(&pb.ArrowResponse{ArrowBatch: data})
+}
+
+// maskedSqlStream 发送前对 SQL 查询结果批次脱敏的流包装
+type maskedSqlStream struct {
+	grpc.ServerStreamingServer[pb.ExecuteSqlResponse]
+	masker *batchMasker
+}
+
+func (s *maskedSqlStream) Send(resp *pb.ExecuteSqlResponse) error {
+	batch, ok := resp.Result.(*pb.ExecuteSqlResponse_ArrowBatch)
+	if !ok {
+		return s.ServerStreamingServer.Send(resp)
+	}
+	data, err := s.masker.maskIPC(batch.ArrowBatch)
+	if err != nil {
+		return err
+	}
+	return s.ServerStreamingServer.Send(&pb.ExecuteSqlResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+		Result:  &pb.ExecuteSqlResponse_ArrowBatch{ArrowBatch: data},
+	})
+}
+
+// WrapArrowStreamWithMasking 为读取流挂载脱敏，返回的 finish 需在RPC结束时调用以写入审计记录
+func WrapArrowStreamWithMasking(rpc, asset string, stream grpc.ServerStreamingServer[pb.ArrowResponse]) (grpc.ServerStreamingServer[pb.ArrowResponse], func()) {
+	masker := newBatchMasker(rpc, asset)
+	if masker == nil {
+		return stream, func() {}
+	}
+	return &maskedArrowStream{ServerStreamingServer: stream, masker: masker}, masker.finish
+}
+
+// WrapSqlStreamWithMasking 为SQL查询结果流挂载脱敏，SQL 结果按 * 策略和 SQL 读取的各表对应的策略匹配。
+// 结果按输出列名脱敏，SQL 以别名或表达式输出脱敏列时返回错误，见 checkMaskedColumnAliases；
+// 写入语句或 writesTable 为 true（结果写入目标表）时引用脱敏列返回错误，见 checkMaskedColumnWrites
+func WrapSqlStreamWithMasking(rpc, dbName, query string, writesTable bool, stream grpc.ServerStreamingServer[pb.ExecuteSqlResponse]) (grpc.ServerStreamingServer[pb.ExecuteSqlResponse], func(), error) {
+	masker := newBatchMasker(rpc, SqlMaskingAssets(dbName, query)...)
+	if masker == nil {
+		return stream, func() {}, nil
+	}
+	if err := checkMaskedColumnAliases(query, masker.policies); err != nil {
+		return nil, nil, err
+	}
+	if err := checkMaskedColumnWrites(query, masker.policies, writesTable); err != nil {
+		return nil, nil, err
+	}
+	return &maskedSqlStream{ServerStreamingServer: stream, masker: masker}, masker.finish, nil
+}
+
+// SqlMaskingAssets 返回 SQL 读取的表对应的资产标识：表名和 库名.表名，未带库名的表使用 dbName
+func SqlMaskingAssets(dbName, query string) []string {
+	var assets []string
+	seen := make(map[string]bool)
+	add := func(asset string) {
+		if asset != "" && !seen[strings.ToLower(asset)] {
+			seen[strings.ToLower(asset)] = true
+			assets = append(assets, asset)
+		}
+	}
+	for _, table := range referencedTables(query) {
+		if i := strings.LastIndex(table, "."); i >= 0 {
+			add(table[i+1:])
+			add(table)
+			continue
+		}
+		add(table)
+		if dbName != "" {
+			add(dbName + "." + table)
+		}
+	}
+	return assets
+}
+
+type dataSourceRequest interface {
+	GetExternal() *pb.ExternalDataSource
+	GetInternal() *pb.InternalDataSource
+	GetDoris() *pb.DorisDataSource
+}
+
+// MaskingAssetOf 返回读取请求对应的资产标识：外部数据源为资产名，内部和Doris数据源为表名
+func MaskingAssetOf(request dataSourceRequest) string {
+	switch {
+	case request.GetExternal() != nil:
+		return request.GetExternal().AssetName
+	case request.GetInternal() != nil:
+		return request.GetInternal().TableName
+	case request.GetDoris() != nil:
+		return request.GetDoris().TableName
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"bytes"
+	"testing"
+
+	"data-service/config"
+	"data-service/utils"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMaskingEngine() *MaskingEngine {
+	return NewMaskingEngine(config.MaskingConfig{
+		Enabled: true,
+		Policies: []config.MaskingPolicyConfig{
+			{Asset: "*", Column: "phone", Method: "redact"},
+			{Asset: "users", Column: "Phone", Method: "partial", KeepPrefix: 3, KeepSuffix: 2},
+			{Asset: "users", Column: "id_card", Method: "null"},
+			{Asset: "users", Column: "bad", Method: "unknown"},
+		},
+	}, nil)
+}
+
+func TestMaskingEngine_PoliciesFor(t *testing.T) {
+	engine := newTestMaskingEngine()
+
+	users := engine.PoliciesFor("USERS")
+	require.Len(t, users, 2)
+	assert.Equal(t, "partial", string(users["phone"].Method), "asset policy overrides wildcard policy")
+	assert.Equal(t, "null", string(users["id_card"].Method))
+
+	orders := engine.PoliciesFor("orders")
+	require.Len(t, orders, 1)
+	assert.Equal(t, "redact", string(orders["phone"].Method))
+
+	disabled := NewMaskingEngine(config.MaskingConfig{Policies: []config.MaskingPolicyConfig{{Column: "phone", Method: "redact"}}}, nil)
+	assert.Nil(t, disabled.PoliciesFor("users"))
+}
+
+// maskingTestRecord 生成 id、phone、id_card 三列的记录
+func maskingTestRecord() arrow.Record {
+	mem := memory.NewGoAllocator()
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "PHONE", Type: arrow.BinaryTypes.String},
+		{Name: "id_card", Type: arrow.BinaryTypes.String},
+	}, nil)
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+	b.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2}, nil)
+	b.Field(1).(*array.StringBuilder).AppendValues([]string{"13812345678", ""}, []bool{true, false})
+	b.Field(2).(*array.StringBuilder).AppendValues([]string{"110101199001011234", "110101199001015678"}, nil)
+	return b.NewRecord()
+}
+
+func TestMaskingEngine_MaskRecord(t *testing.T) {
+	engine := newTestMaskingEngine()
+	rec := maskingTestRecord()
+	defer rec.Release()
+
+	applied := map[string]int64{}
+	masked, err := engine.MaskRecord(rec, engine.PoliciesFor("users"), applied)
+	require.NoError(t, err)
+	defer masked.Release()
+
+	assert.Equal(t, int64(1), masked.Column(0).(*array.Int64).Value(0))
+	phone := masked.Column(1).(*array.String)
+	assert.Equal(t, "138******78", phone.Value(0))
+	assert.True(t, phone.IsNull(1))
+	idCard := masked.Column(2)
+	assert.Equal(t, arrow.BinaryTypes.String, idCard.DataType())
+	assert.Equal(t, 2, idCard.NullN())
+	assert.Equal(t, map[string]int64{"PHONE": 2, "id_card": 2}, applied)
+
+	redacted, err := engine.MaskRecord(rec, engine.PoliciesFor("orders"), nil)
+	require.NoError(t, err)
+	defer redacted.Release()
+	assert.Equal(t, utils.RedactedValue, redacted.Column(1).(*array.String).Value(0))
+}
+
+func TestBatchMasker_MaskIPC(t *testing.T) {
+	engine := newTestMaskingEngine()
+	masker := &batchMasker{engine: engine, rpc: "Read", asset: "users", policies: engine.PoliciesFor("users"), applied: map[string]int64{}}
+
+	rec := maskingTestRecord()
+	defer rec.Release()
+	var buf bytes.Buffer
+	w := ipc.NewWriter(&buf, ipc.WithSchema(rec.Schema()))
+	require.NoError(t, w.Write(rec))
+	require.NoError(t, w.Close())
+
+	data, err := masker.maskIPC(buf.Bytes())
+	require.NoError(t, err)
+	r, err := ipc.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	defer r.Release()
+	require.True(t, r.Next())
+	assert.Equal(t, "138******78", r.Record().Column(1).(*array.String).Value(0))
+	assert.Equal(t, int64(2), masker.applied["PHONE"])
+
+	// EOF 标志和空数据原样返回
+	eof, err := masker.maskIPC([]byte("EOF"))
+	require.NoError(t, err)
+	assert.Equal(t, "EOF", string(eof))
+	empty, err := masker.maskIPC(nil)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
+func TestCheckMaskedColumnAliases(t *testing.T) {
+	masked := newTestMaskingEngine().PoliciesFor("users")
+	tests := []struct {
+		name    string
+		sql     string
+		wantErr bool
+	}{
+		{"普通列", "SELECT id, phone FROM users", false},
+		{"表名限定与同名别名", "SELECT u.`phone` AS PHONE, u.id FROM users u WHERE phone LIKE '138%' ORDER BY phone", false},
+		{"星号", "SELECT * FROM users", false},
+		{"未引用脱敏列", "SELECT id, name AS n, 'phone' AS p FROM users -- phone AS p", false},
+		{"表名与脱敏列同名", "SELECT phone.id FROM phone", false},
+		{"别名", "SELECT phone AS p FROM users", true},
+		{"隐式别名", "SELECT id, phone p FROM users", true},
+		{"反引号别名", "SELECT `phone` `p` FROM users", true},
+		{"表达式", "SELECT concat(phone, '') FROM users", true},
+		{"运算", "SELECT id_card || '' AS x FROM users", true},
+		{"子查询改名", "SELECT p FROM (SELECT phone AS p FROM users) t", true},
+		{"标量子查询", "SELECT (SELECT phone FROM users LIMIT 1) AS x", true},
+		{"集合运算", "SELECT name FROM users UNION ALL SELECT phone FROM users", true},
+		{"CTE 列名", "WITH t(p) AS (SELECT phone FROM users) SELECT p FROM t", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkMaskedColumnAliases(tt.sql, masked)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+	assert.NoError(t, checkMaskedColumnAliases("SELECT phone AS p FROM users", nil))
+}
+
+func TestSqlMaskingAssets(t *testing.T) {
+	assets := SqlMaskingAssets("db1", "SELECT u.phone FROM users u JOIN db2.`orders` AS o ON u.id = o.uid, "+
+		"(SELECT id FROM items) x, unnest(arr) WHERE u.id IN (SELECT uid FROM `blacklist`)")
+	assert.Equal(t, []string{"users", "db1.users", "orders", "db2.orders", "items", "db1.items", "blacklist", "db1.blacklist"}, assets)
+
+	// SQL 读取的表的专属策略生效，不再只匹配库名
+	engine := newTestMaskingEngine()
+	policies := engine.PoliciesForAssets(SqlMaskingAssets("db1", "SELECT id_card FROM db1.users"))
+	assert.Equal(t, "null", string(policies["id_card"].Method))
+	assert.Equal(t, "partial", string(policies["phone"].Method))
+}
+
+func TestCheckMaskedColumnWrites(t *testing.T) {
+	masked := newTestMaskingEngine().PoliciesFor("users")
+	tests := []struct {
+		name        string
+		sql         string
+		writesTable bool
+		wantErr     bool
+	}{
+		{"查询", "SELECT id, phone FROM users", false, false},
+		{"写入未引用脱敏列", "INSERT INTO t (id) SELECT id FROM users", false, false},
+		{"写入常量", "INSERT INTO t (id, name) VALUES (1, 'phone')", false, false},
+		{"列名列表改名写入", "INSERT INTO t (other_col) SELECT phone FROM users", false, true},
+		{"同名写入", "INSERT INTO t SELECT phone FROM users", false, true},
+		{"星号写入", "INSERT INTO t SELECT * FROM users", false, true},
+		{"CTAS 改名", "CREATE TABLE t (c) AS SELECT id_card FROM users", false, true},
+		{"CTAS 星号", "CREATE TABLE t AS SELECT u.* FROM users u", false, true},
+		{"UPDATE 复制", "UPDATE users SET name = phone", false, true},
+		{"导出文件", "SELECT phone FROM users INTO OUTFILE 's3://b/p'", false, true},
+		{"写入目标表", "SELECT phone FROM users", true, true},
+		{"写入目标表不含脱敏列", "SELECT id, COUNT(*) FROM users GROUP BY id", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkMaskedColumnWrites(tt.sql, masked, tt.writesTable)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+	assert.NoError(t, checkMaskedColumnWrites("INSERT INTO t SELECT phone FROM users", nil, false))
+}
@@ -0,0 +1,321 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SQL 结果按输出列名匹配脱敏策略，别名或表达式会让脱敏列以其他列名输出。
+// 这里在执行前检查 SQL：引用了脱敏列的查询，脱敏列只能作为普通列出现在 SELECT 列表中
+// （可带表名限定，别名只能与列名相同），不允许出现在表达式、改名别名、CTE 列名列表和集合运算中。
+// 过滤、排序、分组中引用脱敏列不影响输出，不做限制。
+// 写入表或文件的语句不经过结果脱敏，引用脱敏列或以 * 读取时直接拒绝，见 checkMaskedColumnWrites。
+
+// sqlToken SQL 词法单元，quoted 表示反引号标识符
+type sqlToken struct {
+	text   string
+	word   bool
+	quoted bool
+}
+
+func (t sqlToken) is(keyword string) bool {
+	return t.word && !t.quoted && strings.EqualFold(t.text, keyword)
+}
+
+func (t sqlToken) isPunct(p string) bool {
+	return !t.word && t.text == p
+}
+
+// selectListEnd 在 SELECT 列表顶层出现时结束列表的关键字
+var selectListEnd = map[string]bool{
+	"FROM": true, "WHERE": true, "GROUP": true, "HAVING": true, "ORDER": true, "LIMIT": true,
+	"INTO": true, "UNION": true, "INTERSECT": true, "EXCEPT": true, "MINUS": true, "WINDOW": true,
+}
+
+// checkMaskedColumnAliases 检查 SQL 是否以别名或表达式输出脱敏列，masked 的 key 为小写列名
+func checkMaskedColumnAliases(query string, masked map[string]MaskingPolicy) error {
+	if len(masked) == 0 {
+		return nil
+	}
+	tokens := tokenizeSQL(query)
+	column, referenced := firstMaskedReference(tokens, masked)
+	if !referenced {
+		return nil
+	}
+
+	for i, t := range tokens {
+		switch {
+		case t.is("UNION") || t.is("INTERSECT") || t.is("EXCEPT") || t.is("MINUS"):
+			return fmt.Errorf("masked column %s cannot be used with %s", column, strings.ToUpper(t.text))
+		case t.is("WITH") && i+2 < len(tokens) && tokens[i+1].word && tokens[i+2].isPunct("("):
+			return fmt.Errorf("masked column %s cannot be used with CTE column lists", column)
+		case t.is("SELECT"):
+			for _, item := range selectItems(tokens[i+1:]) {
+				if err := checkSelectItem(item, masked); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// writeStatementKeywords 把查询结果写入表或文件的语句关键字
+var writeStatementKeywords = map[string]bool{
+	"INSERT": true, "REPLACE": true, "UPSERT": true, "MERGE": true, "CREATE": true, "UPDATE": true, "OUTFILE": true,
+}
+
+// checkMaskedColumnWrites 写入语句（INSERT ... SELECT、CTAS、UPDATE、INTO OUTFILE）或写入目标表的查询
+// 会把原始数据写到脱敏策略之外，引用脱敏列或使用 * 时返回错误
+func checkMaskedColumnWrites(query string, masked map[string]MaskingPolicy, writesTable bool) error {
+	if len(masked) == 0 {
+		return nil
+	}
+	tokens := tokenizeSQL(query)
+	statement := ""
+	for i, t := range tokens {
+		if t.word && !t.quoted && writeStatementKeywords[strings.ToUpper(t.text)] &&
+			(i == 0 || t.is("OUTFILE")) {
+			statement = strings.ToUpper(t.text)
+			break
+		}
+	}
+	if statement == "" && !writesTable {
+		return nil
+	}
+	if statement == "" {
+		statement = "target table"
+	}
+	if column, ok := firstMaskedReference(tokens, masked); ok {
+		return fmt.Errorf("masked column %s cannot be written by %s", column, statement)
+	}
+	for i, t := range tokens {
+		if t.is("SELECT") {
+			for _, item := range selectItems(tokens[i+1:]) {
+				if len(item) > 0 && item[len(item)-1].isPunct("*") {
+					return fmt.Errorf("SELECT * cannot be written by %s when masking policies apply", statement)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// tableRefEnd 表引用之后不作为别名的关键字
+var tableRefEnd = map[string]bool{
+	"WHERE": true, "GROUP": true, "HAVING": true, "ORDER": true, "LIMIT": true, "ON": true, "USING": true,
+	"JOIN": true, "INNER": true, "LEFT": true, "RIGHT": true, "FULL": true, "CROSS": true, "NATURAL": true,
+	"UNION": true, "INTERSECT": true, "EXCEPT": true, "MINUS": true, "INTO": true, "WINDOW": true, "TABLESAMPLE": true,
+	"PARTITION": true, "TABLET": true, "LATERAL": true, "OUTER": true, "SET": true, "VALUES": true, "SELECT": true,
+}
+
+// referencedTables 返回 SQL 在 FROM 和 JOIN 之后读取的表名，带库名时为 db.table；子查询和表函数跳过
+func referencedTables(query string) []string {
+	tokens := tokenizeSQL(query)
+	var tables []string
+	for i := 0; i < len(tokens); i++ {
+		if !tokens[i].is("FROM") && !tokens[i].is("JOIN") {
+			continue
+		}
+		for j := i + 1; j < len(tokens); {
+			name, next := tableRefName(tokens, j)
+			if name != "" {
+				tables = append(tables, name)
+			}
+			// 跳过别名
+			if next < len(tokens) && tokens[next].is("AS") {
+				next++
+			}
+			if next < len(tokens) && tokens[next].word && (tokens[next].quoted || !tableRefEnd[strings.ToUpper(tokens[next].text)]) {
+				next++
+			}
+			if name == "" || !tokens[i].is("FROM") || next >= len(tokens) || !tokens[next].isPunct(",") {
+				break
+			}
+			j = next + 1
+		}
+	}
+	return tables
+}
+
+// tableRefName 解析从 i 开始的 [库名.]表名，返回表名和之后的位置；不是表名时返回空
+func tableRefName(tokens []sqlToken, i int) (string, int) {
+	if i >= len(tokens) || !tokens[i].word {
+		return "", i
+	}
+	parts := []string{tokens[i].text}
+	i++
+	for i+1 < len(tokens) && tokens[i].isPunct(".") && tokens[i+1].word {
+		parts = append(parts, tokens[i+1].text)
+		i += 2
+	}
+	if i < len(tokens) && tokens[i].isPunct("(") {
+		return "", i
+	}
+	return strings.Join(parts, "."), i
+}
+
+// firstMaskedReference 返回 SQL 中首个作为列引用出现的脱敏列
+func firstMaskedReference(tokens []sqlToken, masked map[string]MaskingPolicy) (string, bool) {
+	for i, t := range tokens {
+		if isMaskedColumnRef(tokens, i, masked) {
+			return t.text, true
+		}
+	}
+	return "", false
+}
+
+// isMaskedColumnRef 判断第 i 个词是否引用脱敏列：排除表名限定（后跟 .）和函数名（后跟 (）
+func isMaskedColumnRef(tokens []sqlToken, i int, masked map[string]MaskingPolicy) bool {
+	t := tokens[i]
+	if !t.word {
+		return false
+	}
+	if _, ok := masked[strings.ToLower(t.text)]; !ok {
+		return false
+	}
+	if i+1 < len(tokens) && (tokens[i+1].isPunct(".") || (!t.quoted && tokens[i+1].isPunct("("))) {
+		return false
+	}
+	return true
+}
+
+// selectItems 切分 SELECT 之后的列表项，到顶层的 FROM 等关键字或外层右括号为止
+func selectItems(tokens []sqlToken) [][]sqlToken {
+	start := 0
+	for start < len(tokens) && (tokens[start].is("DISTINCT") || tokens[start].is("ALL") || tokens[start].is("DISTINCTROW")) {
+		start++
+	}
+	var items [][]sqlToken
+	depth, begin := 0, start
+	for i := start; i < len(tokens); i++ {
+		t := tokens[i]
+		switch {
+		case t.isPunct("("):
+			depth++
+		case t.isPunct(")"):
+			if depth == 0 {
+				return append(items, tokens[begin:i])
+			}
+			depth--
+		case depth == 0 && t.isPunct(","):
+			items = append(items, tokens[begin:i])
+			begin = i + 1
+		case depth == 0 && t.word && !t.quoted && selectListEnd[strings.ToUpper(t.text)]:
+			return append(items, tokens[begin:i])
+		}
+	}
+	return append(items, tokens[begin:])
+}
+
+// checkSelectItem 含脱敏列的列表项只能是 [限定名.]列名 [[AS] 同名别名]
+func checkSelectItem(item []sqlToken, masked map[string]MaskingPolicy) error {
+	column := ""
+	for i := range item {
+		if isMaskedColumnRef(item, i, masked) {
+			column = item[i].text
+			break
+		}
+	}
+	if column == "" {
+		return nil
+	}
+
+	// 跳过表名限定
+	i := 0
+	for i+1 < len(item) && item[i].word && item[i+1].isPunct(".") {
+		i += 2
+	}
+	if i >= len(item) || !item[i].word || !strings.EqualFold(item[i].text, column) {
+		return fmt.Errorf("masked column %s must be selected as a plain column, not inside an expression", column)
+	}
+	rest := item[i+1:]
+	if len(rest) > 0 && rest[0].is("AS") {
+		rest = rest[1:]
+	}
+	switch {
+	case len(rest) == 0:
+		return nil
+	case len(rest) == 1 && rest[0].word && strings.EqualFold(rest[0].text, column):
+		return nil
+	case len(rest) == 1 && rest[0].word:
+		return fmt.Errorf("masked column %s cannot be aliased as %s", column, rest[0].text)
+	default:
+		return fmt.Errorf("masked column %s must be selected as a plain column, not inside an expression", column)
+	}
+}
+
+// tokenizeSQL 把 SQL 切成词、反引号标识符和单个符号，跳过注释和字符串字面量
+func tokenizeSQL(query string) []sqlToken {
+	var tokens []sqlToken
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '#' || (c == '-' && strings.HasPrefix(query[i:], "--")):
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+		case c == '/' && strings.HasPrefix(query[i:], "/*"):
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				return tokens
+			}
+			i += end + 4
+		case c == '\'' || c == '"':
+			i = skipSQLString(query, i)
+		case c == '`':
+			end := i + 1
+			var b strings.Builder
+			for end < len(query) {
+				if query[end] == '`' {
+					if end+1 < len(query) && query[end+1] == '`' {
+						b.WriteByte('`')
+						end += 2
+						continue
+					}
+					break
+				}
+				b.WriteByte(query[end])
+				end++
+			}
+			tokens = append(tokens, sqlToken{text: b.String(), word: true, quoted: true})
+			i = end + 1
+		case isSQLWordByte(c):
+			end := i
+			for end < len(query) && isSQLWordByte(query[end]) {
+				end++
+			}
+			tokens = append(tokens, sqlToken{text: query[i:end], word: true})
+			i = end
+		default:
+			tokens = append(tokens, sqlToken{text: string(c)})
+			i++
+		}
+	}
+	return tokens
+}
+
+// skipSQLString 跳过从 start 开始的字符串字面量，支持反斜杠转义和重复引号，返回字面量之后的位置
+func skipSQLString(query string, start int) int {
+	quote := query[start]
+	for i := start + 1; i < len(query); i++ {
+		switch query[i] {
+		case '\\':
+			i++
+		case quote:
+			if i+1 < len(query) && query[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(query)
+}
+
+func isSQLWordByte(c byte) bool {
+	return c == '_' || c == '$' || c >= 0x80 ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,776 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"data-service/common"
+	"data-service/config"
+	"data-service/database/gorm"
+	"data-service/database/gorm/repositories"
+	"data-service/log"
+	"data-service/oss"
+)
+
+// 孤儿资源类型
+const (
+	OrphanKindDorisDatabase      = "doris_database"
+	OrphanKindDorisResource      = "doris_resource"
+	OrphanKindDorisExternalTable = "doris_external_table"
+	OrphanKindDorisTlsFile       = "doris_tls_file"
+	OrphanKindMinioExport        = "minio_export"
+	OrphanKindMinioTlsObject     = "minio_tls_object"
+)
+
+const (
+	defaultOrphanInterval    = time.Hour
+	defaultOrphanGracePeriod = 24 * time.Hour
+	dorisTimeLayout          = "2006-01-02 15:04:05"
+)
+
+// orphanKindOrder 删除顺序：先删库内对象和资源，再删库，最后删对象存储
+var orphanKindOrder = []string{
+	OrphanKindDorisTlsFile,
+	OrphanKindDorisExternalTable,
+	OrphanKindDorisResource,
+	OrphanKindDorisDatabase,
+	OrphanKindMinioExport,
+	OrphanKindMinioTlsObject,
+}
+
+// dorisSystemDatabases 永不巡检的 Doris 库
+var dorisSystemDatabases = map[string]bool{
+	"information_schema":    true,
+	"mysql":                 true,
+	"__internal_schema":     true,
+	common.MIRA_TMP_TASK_DB: true,
+}
+
+// orphanJobSuffixPattern 作业实例ID的随机后缀：_ 或 @ 加 common.GenerateRandomString(8) 生成的 8 位小写十六进制
+var orphanJobSuffixPattern = regexp.MustCompile(`[_@][0-9a-f]{8}`)
+
+// orphanUUIDSuffixPattern 以 uuid 为后缀的临时外部表
+var orphanUUIDSuffixPattern = regexp.MustCompile(`_[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// OrphanCandidate 巡检发现的作业临时资源
+type OrphanCandidate struct {
+	Kind          string
+	Name          string
+	Location      string // 所在 Doris 库或 MinIO 桶
+	JobInstanceId string // 从资源名解析出的作业实例ID
+	FirstSeenAt   time.Time
+	Age           time.Duration
+	catalog       string // Doris FILE 的 catalog
+}
+
+// key 巡检记录中的资源名，库内对象带库名
+func (c OrphanCandidate) key() string {
+	if c.Kind == OrphanKindDorisExternalTable || c.Kind == OrphanKindDorisTlsFile {
+		return c.Location + "." + c.Name
+	}
+	return c.Name
+}
+
+// OrphanReconciler 定期盘点失败作业遗留的 Doris 库、JDBC 资源、外部表、TLS 文件和 MinIO 导出文件，
+// 与所有副本上运行中的作业和未完成的清理任务比对后删除超过宽限期的孤儿资源。
+type OrphanReconciler struct {
+	dorisService *DorisService
+	ossClient    oss.ClientInterface
+	taskRepo     *repositories.CleanupTaskRepository
+	ttlRepo      *repositories.TableTTLRepository
+	seenRepo     *repositories.OrphanResourceRepository
+	conf         config.OrphanReconcilerConfig
+	protected    map[string]bool
+	stopCh       chan struct{}
+	stopOnce     sync.Once
+	runMu        sync.Mutex
+}
+
+// StartOrphanReconciler 按配置启动孤儿资源巡检，未启用时返回 nil
+func StartOrphanReconciler(conf config.OrphanReconcilerConfig) *OrphanReconciler {
+	if !conf.Enabled {
+		return nil
+	}
+	reconciler, err := NewOrphanReconciler(conf)
+	if err != nil {
+		log.Logger.Errorf("Orphan reconciler disabled: %v", err)
+		return nil
+	}
+	reconciler.Start()
+	return reconciler
+}
+
+func NewOrphanReconciler(conf config.OrphanReconcilerConfig) (*OrphanReconciler, error) {
+	dorisService, err := NewDorisService(common.MIRA_TMP_TASK_DB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create doris service: %v", err)
+	}
+	ds, ok := dorisService.(*DorisService)
+	if !ok {
+		return nil, fmt.Errorf("unexpected doris service type %T", dorisService)
+	}
+	ossClient, err := oss.NewOSSFactory(config.GetConfigMap()).NewOSSClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OSS client: %v", err)
+	}
+
+	r := &OrphanReconciler{
+		dorisService: ds,
+		ossClient:    ossClient,
+		conf:         conf,
+		protected:    make(map[string]bool, len(conf.ProtectedDatabases)),
+		stopCh:       make(chan struct{}),
+	}
+	for _, db := range conf.ProtectedDatabases {
+		r.protected[db] = true
+	}
+	if db := gorm.GetGormDB(); db != nil {
+		r.taskRepo = repositories.NewCleanupTaskRepository(db)
+		r.ttlRepo = repositories.NewTableTTLRepository(db)
+		r.seenRepo = repositories.NewOrphanResourceRepository(db)
+	}
+	return r, nil
+}
+
+func (r *OrphanReconciler) interval() time.Duration {
+	if r.conf.IntervalMinutes > 0 {
+		return time.Duration(r.conf.IntervalMinutes) * time.Minute
+	}
+	return defaultOrphanInterval
+}
+
+// GracePeriod 配置的宽限期
+func (r *OrphanReconciler) GracePeriod() time.Duration {
+	if r.conf.GracePeriodHours > 0 {
+		return time.Duration(r.conf.GracePeriodHours) * time.Hour
+	}
+	return defaultOrphanGracePeriod
+}
+
+// DryRun 后台巡检是否只记录不删除
+func (r *OrphanReconciler) DryRun() bool {
+	return r.conf.DryRun
+}
+
+// Start 启动定时巡检
+func (r *OrphanReconciler) Start() {
+	log.Logger.Infof("Starting orphan reconciler, interval: %v, grace period: %v, dry run: %v",
+		r.interval(), r.GracePeriod(), r.conf.DryRun)
+	go func() {
+		ticker := time.NewTicker(r.interval())
+		defer ticker.Stop()
+		for {
+			select {
+			case <-r.stopCh:
+				log.Logger.Infof("Orphan reconciler stopped")
+				return
+			case <-ticker.C:
+				if _, err := r.Reconcile(); err != nil {
+					log.Logger.Errorf("Orphan reconcile failed: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// Stop 停止定时巡检
+func (r *OrphanReconciler) Stop() {
+	r.stopOnce.Do(func() { close(r.stopCh) })
+}
+
+// Report 列出超过宽限期的孤儿资源，不执行删除；kinds 为空时列出全部类型
+func (r *OrphanReconciler) Report(grace time.Duration, kinds []string) ([]OrphanCandidate, error) {
+	if grace <= 0 {
+		grace = r.GracePeriod()
+	}
+	orphans, err := r.scan(time.Now(), grace)
+	if err != nil {
+		return nil, err
+	}
+	if len(kinds) == 0 {
+		return orphans, nil
+	}
+	wanted := make(map[string]bool, len(kinds))
+	for _, kind := range kinds {
+		wanted[kind] = true
+	}
+	filtered := orphans[:0]
+	for _, c := range orphans {
+		if wanted[c.Kind] {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered, nil
+}
+
+// Reconcile 执行一轮巡检，dry run 模式下只记录日志，返回孤儿资源列表
+func (r *OrphanReconciler) Reconcile() ([]OrphanCandidate, error) {
+	r.runMu.Lock()
+	defer r.runMu.Unlock()
+
+	start := time.Now()
+	orphans, err := r.scan(start, r.GracePeriod())
+	if err != nil {
+		return nil, err
+	}
+	if r.seenRepo != nil {
+		if _, err := r.seenRepo.DeleteNotSeenSince(start); err != nil {
+			log.Logger.Warnf("Failed to prune orphan resource records: %v", err)
+		}
+	}
+
+	if r.conf.DryRun {
+		for _, c := range orphans {
+			log.Logger.Infof("[dry run] orphan %s %s in %s, job: %s, age: %v", c.Kind, c.Name, c.Location, c.JobInstanceId, c.Age)
+		}
+		log.Logger.Infof("Orphan reconcile finished in dry run mode, found %d orphans, cost: %v", len(orphans), time.Since(start))
+		return orphans, nil
+	}
+
+	var failed int
+	for _, c := range orphans {
+		if err := r.remove(c); err != nil {
+			failed++
+			log.Logger.Warnf("Failed to remove orphan %s %s in %s: %v", c.Kind, c.Name, c.Location, err)
+			continue
+		}
+		log.Logger.Infof("Removed orphan %s %s in %s, job: %s, age: %v", c.Kind, c.Name, c.Location, c.JobInstanceId, c.Age)
+		if r.seenRepo != nil {
+			if err := r.seenRepo.Delete(c.Kind, c.key()); err != nil {
+				log.Logger.Warnf("Failed to delete orphan record %s %s: %v", c.Kind, c.key(), err)
+			}
+		}
+	}
+	log.Logger.Infof("Orphan reconcile finished, removed %d of %d orphans, cost: %v", len(orphans)-failed, len(orphans), time.Since(start))
+	return orphans, nil
+}
+
+// scan 盘点全部临时资源并筛选孤儿
+func (r *OrphanReconciler) scan(now time.Time, grace time.Duration) ([]OrphanCandidate, error) {
+	candidates, err := r.inventory()
+	if err != nil {
+		return nil, err
+	}
+	for i := range candidates {
+		r.resolveAge(&candidates[i], now)
+	}
+
+	owners, err := ClusterActiveJobIds()
+	if err != nil {
+		return nil, err
+	}
+	if r.taskRepo != nil {
+		ids, err := r.taskRepo.FindUnfinishedJobInstanceIDs()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load unfinished cleanup tasks: %v", err)
+		}
+		owners = append(owners, ids...)
+	}
+	return selectOrphans(candidates, owners, grace), nil
+}
+
+// resolveAge 以首次发现时间和资源创建时间中较早者计算存活时长
+func (r *OrphanReconciler) resolveAge(c *OrphanCandidate, now time.Time) {
+	firstSeen := now
+	if r.seenRepo != nil {
+		seen, err := r.seenRepo.Touch(c.Kind, c.key(), now)
+		if err != nil {
+			log.Logger.Warnf("Failed to record orphan candidate %s %s: %v", c.Kind, c.key(), err)
+		}
+		firstSeen = seen
+	}
+	if c.FirstSeenAt.IsZero() || firstSeen.Before(c.FirstSeenAt) {
+		c.FirstSeenAt = firstSeen
+	}
+	c.Age = now.Sub(c.FirstSeenAt)
+}
+
+// selectOrphans 过滤出超过宽限期、不属于运行中作业或未完成清理任务的资源，孤儿库内的对象随库一起删除
+func selectOrphans(candidates []OrphanCandidate, owners []string, grace time.Duration) []OrphanCandidate {
+	orphanDBs := make(map[string]bool)
+	var orphans []OrphanCandidate
+	for _, c := range candidates {
+		if c.Age < grace || ownedByJob(c, owners) {
+			continue
+		}
+		if c.Kind == OrphanKindDorisDatabase {
+			orphanDBs[c.Name] = true
+		}
+		orphans = append(orphans, c)
+	}
+
+	result := orphans[:0]
+	for _, c := range orphans {
+		if (c.Kind == OrphanKindDorisExternalTable || c.Kind == OrphanKindDorisTlsFile) && orphanDBs[c.Location] {
+			continue
+		}
+		result = append(result, c)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return orphanKindRank(result[i].Kind) < orphanKindRank(result[j].Kind)
+	})
+	return result
+}
+
+func orphanKindRank(kind string) int {
+	for i, k := range orphanKindOrder {
+		if k == kind {
+			return i
+		}
+	}
+	return len(orphanKindOrder)
+}
+
+// ownedByJob 资源名、所在库或解析出的作业ID与运行中作业或未完成清理任务的作业ID相关联
+func ownedByJob(c OrphanCandidate, owners []string) bool {
+	for _, owner := range owners {
+		if owner == "" {
+			continue
+		}
+		if hasJobPrefix(c.Name, owner) || hasJobPrefix(c.JobInstanceId, owner) || hasJobPrefix(owner, c.JobInstanceId) {
+			return true
+		}
+		if (c.Kind == OrphanKindDorisExternalTable || c.Kind == OrphanKindDorisTlsFile) && c.Location == owner {
+			return true
+		}
+	}
+	return false
+}
+
+// hasJobPrefix name 等于 jobId 或以 jobId 加分隔符开头
+func hasJobPrefix(name, jobId string) bool {
+	if name == "" || jobId == "" || !strings.HasPrefix(name, jobId) {
+		return false
+	}
+	if len(name) == len(jobId) {
+		return true
+	}
+	switch name[len(jobId)] {
+	case '_', '@', '/':
+		return true
+	}
+	return false
+}
+
+// isJobSuffix name[start:end] 是否为作业实例ID的随机后缀。随机后缀可能全是数字，
+// 与日期等业务命名无法区分，此时只认生命周期记录中登记过的作业
+func isJobSuffix(name string, start, end int, recorded map[string]bool) bool {
+	if start == 0 || (end < len(name) && name[end] != '_' && name[end] != '/') {
+		return false
+	}
+	return strings.ContainsAny(name[start+1:end], "abcdef") || recorded[name[:end]]
+}
+
+// orphanJobIdOf 从资源名中解析作业实例ID，即到第一个随机后缀为止的前缀，不含随机后缀时返回空
+func orphanJobIdOf(name string, recorded map[string]bool) string {
+	for _, loc := range orphanJobSuffixPattern.FindAllStringIndex(name, -1) {
+		if isJobSuffix(name, loc[0], loc[1], recorded) {
+			return name[:loc[1]]
+		}
+	}
+	return ""
+}
+
+// isGeneratedJobName 名称本身以随机后缀结尾，即由作业生成
+func isGeneratedJobName(name string, recorded map[string]bool) bool {
+	m := orphanJobSuffixPattern.FindAllStringIndex(name, -1)
+	if len(m) == 0 {
+		return false
+	}
+	last := m[len(m)-1]
+	return last[1] == len(name) && isJobSuffix(name, last[0], last[1], recorded)
+}
+
+// recordedJobs 表生命周期记录中登记过的作业实例ID，未连接元数据库时为空
+func (r *OrphanReconciler) recordedJobs() (map[string]bool, error) {
+	if r.ttlRepo == nil {
+		return nil, nil
+	}
+	ids, err := r.ttlRepo.FindJobInstanceIDs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load table ttl records: %v", err)
+	}
+	recorded := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		recorded[id] = true
+	}
+	return recorded, nil
+}
+
+// inventory 盘点 Doris 与 MinIO 中由作业生成的临时资源
+func (r *OrphanReconciler) inventory() ([]OrphanCandidate, error) {
+	recorded, err := r.recordedJobs()
+	if err != nil {
+		return nil, err
+	}
+	databases, err := r.inventoryDatabases(recorded)
+	if err != nil {
+		return nil, err
+	}
+	candidates := append([]OrphanCandidate{}, databases...)
+
+	resources, err := r.inventoryResources(recorded)
+	if err != nil {
+		return nil, err
+	}
+	candidates = append(candidates, resources...)
+
+	tables, err := r.inventoryExternalTables(recorded)
+	if err != nil {
+		return nil, err
+	}
+	candidates = append(candidates, tables...)
+
+	// TLS FILE 只在临时库和作业库中盘点
+	fileDBs := []string{common.MIRA_TMP_TASK_DB}
+	for _, db := range databases {
+		fileDBs = append(fileDBs, db.Name)
+	}
+	for _, db := range fileDBs {
+		files, err := r.inventoryTlsFiles(db)
+		if err != nil {
+			log.Logger.Warnf("Failed to list FILE entries in %s: %v", db, err)
+			continue
+		}
+		candidates = append(candidates, files...)
+	}
+
+	exports, err := r.inventoryMinioExports(recorded)
+	if err != nil {
+		return nil, err
+	}
+	candidates = append(candidates, exports...)
+
+	tlsObjects, err := r.inventoryMinioTlsObjects()
+	if err != nil {
+		return nil, err
+	}
+	return append(candidates, tlsObjects...), nil
+}
+
+// queryRows 执行查询并以字符串读取全部列
+// parseDorisTime 解析 queryRows 返回的时间列。DSN 使用 parseTime=true&loc=UTC，
+// 驱动返回 time.Time，扫描为字符串后是 RFC3339 格式；未开启 parseTime 时按 UTC 解析原始格式
+func parseDorisTime(value string) (time.Time, bool) {
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, true
+	}
+	if t, err := time.ParseInLocation(dorisTimeLayout, value, time.UTC); err == nil {
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+func (r *OrphanReconciler) queryRows(query string) ([][]string, error) {
+	rows, done, err := r.dorisService.ExecuteSQL(query)
+	if err != nil {
+		return nil, err
+	}
+	if rows == nil {
+		return nil, nil
+	}
+	if done != nil {
+		defer done()
+	} else {
+		defer rows.Close()
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var result [][]string
+	for rows.Next() {
+		values := make([]sql.NullString, len(columns))
+		dest := make([]interface{}, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		row := make([]string, len(columns))
+		for i, v := range values {
+			row[i] = v.String
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
+// inventoryDatabases 名称以随机后缀结尾的库，创建时间取库内最早建表时间
+func (r *OrphanReconciler) inventoryDatabases(recorded map[string]bool) ([]OrphanCandidate, error) {
+	rows, err := r.queryRows("SHOW DATABASES")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list doris databases: %v", err)
+	}
+	createTimes, err := r.queryRows("SELECT TABLE_SCHEMA, MIN(CREATE_TIME) FROM information_schema.tables GROUP BY TABLE_SCHEMA")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query database create time: %v", err)
+	}
+	created := make(map[string]time.Time, len(createTimes))
+	for _, row := range createTimes {
+		if t, ok := parseDorisTime(row[1]); ok {
+			created[row[0]] = t
+		}
+	}
+
+	var candidates []OrphanCandidate
+	for _, row := range rows {
+		name := row[0]
+		if dorisSystemDatabases[name] || r.protected[name] || !isGeneratedJobName(name, recorded) {
+			continue
+		}
+		candidates = append(candidates, OrphanCandidate{
+			Kind:          OrphanKindDorisDatabase,
+			Name:          name,
+			JobInstanceId: name,
+			FirstSeenAt:   created[name],
+		})
+	}
+	return candidates, nil
+}
+
+// inventoryResources 作业创建的 JDBC 资源 <jobInstanceId>_resource
+func (r *OrphanReconciler) inventoryResources(recorded map[string]bool) ([]OrphanCandidate, error) {
+	rows, err := r.queryRows("SHOW RESOURCES")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list doris resources: %v", err)
+	}
+	seen := make(map[string]bool)
+	var candidates []OrphanCandidate
+	for _, row := range rows {
+		name := row[0]
+		if seen[name] || !strings.HasSuffix(name, "_resource") || name == globalResourceName {
+			continue
+		}
+		seen[name] = true
+		jobId := orphanJobIdOf(strings.TrimSuffix(name, "_resource"), recorded)
+		if jobId == "" {
+			continue
+		}
+		candidates = append(candidates, OrphanCandidate{
+			Kind:          OrphanKindDorisResource,
+			Name:          name,
+			JobInstanceId: jobId,
+		})
+	}
+	return candidates, nil
+}
+
+// inventoryExternalTables 作业创建的外部表 <jobInstanceId>_external 及 <table>_<uuid>_external
+func (r *OrphanReconciler) inventoryExternalTables(recorded map[string]bool) ([]OrphanCandidate, error) {
+	rows, err := r.queryRows("SELECT TABLE_SCHEMA, TABLE_NAME, CREATE_TIME FROM information_schema.tables " +
+		"WHERE TABLE_NAME LIKE '%external'")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list external tables: %v", err)
+	}
+	var candidates []OrphanCandidate
+	for _, row := range rows {
+		db, name := row[0], row[1]
+		if !strings.HasSuffix(name, "_external") || r.protected[db] || (dorisSystemDatabases[db] && db != common.MIRA_TMP_TASK_DB) {
+			continue
+		}
+		base := strings.TrimSuffix(name, "_external")
+		jobId := orphanJobIdOf(base, recorded)
+		if jobId == "" && !orphanUUIDSuffixPattern.MatchString(base) {
+			continue
+		}
+		c := OrphanCandidate{
+			Kind:          OrphanKindDorisExternalTable,
+			Name:          name,
+			Location:      db,
+			JobInstanceId: jobId,
+		}
+		if t, ok := parseDorisTime(row[2]); ok {
+			c.FirstSeenAt = t
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates, nil
+}
+
+// inventoryTlsFiles 库内通过 CREATE FILE 上传的 TLS 证书
+func (r *OrphanReconciler) inventoryTlsFiles(dbName string) ([]OrphanCandidate, error) {
+	rows, err := r.queryRows(fmt.Sprintf("SHOW FILE FROM `%s`", dbName))
+	if err != nil {
+		return nil, err
+	}
+	var candidates []OrphanCandidate
+	for _, row := range rows {
+		if len(row) < 4 || !isTlsCertFileName(row[3]) {
+			continue
+		}
+		candidates = append(candidates, OrphanCandidate{
+			Kind:     OrphanKindDorisTlsFile,
+			Name:     row[3],
+			Location: dbName,
+			catalog:  row[2],
+		})
+	}
+	return candidates, nil
+}
+
+// inventoryMinioExports 批量数据桶中 <jobInstanceId>/[<label>/]export_* 导出文件，按作业聚合，时间取最新文件
+func (r *OrphanReconciler) inventoryMinioExports(recorded map[string]bool) ([]OrphanCandidate, error) {
+	objects, err := r.ossClient.ListObjectsWithInfo(context.Background(), common.BATCH_DATA_BUCKET_NAME, "", true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list export objects: %v", err)
+	}
+	byJob := make(map[string]*OrphanCandidate)
+	var jobs []string
+	for _, obj := range objects {
+		parts := strings.SplitN(obj.Key, "/", 2)
+		if len(parts) != 2 || !strings.HasPrefix(path.Base(parts[1]), "export_") || !isGeneratedJobName(parts[0], recorded) {
+			continue
+		}
+		c, ok := byJob[parts[0]]
+		if !ok {
+			c = &OrphanCandidate{
+				Kind:          OrphanKindMinioExport,
+				Name:          parts[0],
+				Location:      common.BATCH_DATA_BUCKET_NAME,
+				JobInstanceId: parts[0],
+			}
+			byJob[parts[0]] = c
+			jobs = append(jobs, parts[0])
+		}
+		if obj.LastModified.After(c.FirstSeenAt) {
+			c.FirstSeenAt = obj.LastModified
+		}
+	}
+	candidates := make([]OrphanCandidate, 0, len(jobs))
+	for _, job := range jobs {
+		candidates = append(candidates, *byJob[job])
+	}
+	return candidates, nil
+}
+
+// inventoryMinioTlsObjects TLS 证书桶中未被任何库的 FILE 或 JDBC 资源引用的证书对象
+func (r *OrphanReconciler) inventoryMinioTlsObjects() ([]OrphanCandidate, error) {
+	objects, err := r.ossClient.ListObjectsWithInfo(context.Background(), common.TLS_CERT_BUCKET_NAME, "", true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tls cert objects: %v", err)
+	}
+	refs, err := r.tlsReferences()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check tls cert references: %v", err)
+	}
+	var candidates []OrphanCandidate
+	for _, obj := range objects {
+		if !isTlsCertFileName(obj.Key) || refs.contains(obj.Key) {
+			continue
+		}
+		candidates = append(candidates, OrphanCandidate{
+			Kind:          OrphanKindMinioTlsObject,
+			Name:          obj.Key,
+			Location:      common.TLS_CERT_BUCKET_NAME,
+			JobInstanceId: tlsRequestIdOf(obj.Key),
+			FirstSeenAt:   obj.LastModified,
+		})
+	}
+	return candidates, nil
+}
+
+// tlsCertReferences 仍在使用的证书：各库 FILE 名称及 JDBC 资源的属性值
+type tlsCertReferences struct {
+	files  map[string]bool
+	values []string
+}
+
+// contains 证书对象是否仍被 FILE 或资源属性引用
+func (t tlsCertReferences) contains(name string) bool {
+	if t.files[name] {
+		return true
+	}
+	for _, v := range t.values {
+		if strings.Contains(v, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// tlsReferences 盘点所有库（系统库除外）中的 FILE 和全部 JDBC 资源属性，任一库查询失败时返回错误，本轮不删除证书
+func (r *OrphanReconciler) tlsReferences() (tlsCertReferences, error) {
+	refs := tlsCertReferences{files: make(map[string]bool)}
+	databases, err := r.queryRows("SHOW DATABASES")
+	if err != nil {
+		return refs, fmt.Errorf("failed to list doris databases: %v", err)
+	}
+	for _, row := range databases {
+		name := row[0]
+		if dorisSystemDatabases[name] && name != common.MIRA_TMP_TASK_DB {
+			continue
+		}
+		files, err := r.queryRows(fmt.Sprintf("SHOW FILE FROM `%s`", name))
+		if err != nil {
+			return refs, fmt.Errorf("failed to list FILE entries in %s: %v", name, err)
+		}
+		for _, f := range files {
+			if len(f) >= 4 {
+				refs.files[f[3]] = true
+			}
+		}
+	}
+
+	resources, err := r.queryRows("SHOW RESOURCES")
+	if err != nil {
+		return refs, fmt.Errorf("failed to list doris resources: %v", err)
+	}
+	for _, row := range resources {
+		if len(row) >= 4 {
+			refs.values = append(refs.values, row[3])
+		}
+	}
+	return refs, nil
+}
+
+// tlsRequestIdOf 证书文件名 <requestId><suffix> 中的 requestId
+func tlsRequestIdOf(name string) string {
+	for _, suffix := range tlsCertFileSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return strings.TrimSuffix(name, suffix)
+		}
+	}
+	return ""
+}
+
+func isTlsCertFileName(name string) bool {
+	for _, suffix := range tlsCertFileSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// remove 删除单个孤儿资源
+func (r *OrphanReconciler) remove(c OrphanCandidate) error {
+	switch c.Kind {
+	case OrphanKindDorisTlsFile:
+		return r.dorisService.dropFileFromDB(c.Location, c.Name, c.catalog)
+	case OrphanKindDorisExternalTable:
+		return r.dorisService.DropTable(c.Location, c.Name)
+	case OrphanKindDorisResource:
+		return r.dorisService.dropResource(c.Name)
+	case OrphanKindDorisDatabase:
+		droppedFiles := r.dorisService.CleanupAllFilesInDatabase(c.Name)
+		if err := r.dorisService.cleanupMinioTlsObjectsByFileNames(droppedFiles); err != nil {
+			log.Logger.Warnf("Failed to cleanup tls cert objects of %s: %v", c.Name, err)
+		}
+		return r.dorisService.DropDatabase(c.Name)
+	case OrphanKindMinioExport:
+		return r.ossClient.DeleteObjectsByJobInstanceId(context.Background(), c.Location, c.Name)
+	case OrphanKindMinioTlsObject:
+		return r.ossClient.DeleteObject(context.Background(), c.Location, c.Name)
+	}
+	return fmt.Errorf("unsupported orphan kind: %s", c.Kind)
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrphanJobIdOf(t *testing.T) {
+	tests := map[string]string{
+		"orders_3fa9c2e1":                   "orders_3fa9c2e1",
+		"orders_3fa9c2e1_7b0d4e2a":          "orders_3fa9c2e1",
+		"orders_3fa9c2e1_users":             "orders_3fa9c2e1",
+		"sales@0a1b2c3d":                    "sales@0a1b2c3d",
+		"logs_20240101":                     "",
+		"orders_3fa9c2e1ff":                 "",
+		"mira_system_global":                "",
+		"_3fa9c2e1":                         "",
+		"report_20240101_3fa9c2e1_internal": "report_20240101_3fa9c2e1",
+	}
+	for name, want := range tests {
+		if got := orphanJobIdOf(name, nil); got != want {
+			t.Errorf("orphanJobIdOf(%q) = %q, want %q", name, got, want)
+		}
+	}
+
+	if !isGeneratedJobName("orders_3fa9c2e1", nil) || isGeneratedJobName("orders_3fa9c2e1_users", nil) || isGeneratedJobName("logs_20240101", nil) {
+		t.Errorf("isGeneratedJobName mismatch")
+	}
+
+	// 全数字的随机后缀只认生命周期记录中登记过的作业
+	recorded := map[string]bool{"orders_12345678": true}
+	if !isGeneratedJobName("orders_12345678", recorded) || isGeneratedJobName("logs_20240101", recorded) {
+		t.Errorf("isGeneratedJobName mismatch with recorded jobs")
+	}
+	if got := orphanJobIdOf("orders_12345678_7b0d4e2a", recorded); got != "orders_12345678" {
+		t.Errorf("orphanJobIdOf with recorded jobs = %q", got)
+	}
+	if got := orphanJobIdOf("orders_12345678_7b0d4e2a", nil); got != "orders_12345678_7b0d4e2a" {
+		t.Errorf("orphanJobIdOf without recorded jobs = %q", got)
+	}
+}
+
+func TestSelectOrphans(t *testing.T) {
+	grace := time.Hour
+	candidates := []OrphanCandidate{
+		{Kind: OrphanKindMinioExport, Name: "orders_3fa9c2e1", JobInstanceId: "orders_3fa9c2e1", Age: 2 * grace},
+		{Kind: OrphanKindDorisDatabase, Name: "orders_3fa9c2e1", JobInstanceId: "orders_3fa9c2e1", Age: 2 * grace},
+		{Kind: OrphanKindDorisExternalTable, Name: "orders_3fa9c2e1_7b0d4e2a_external", Location: "orders_3fa9c2e1", JobInstanceId: "orders_3fa9c2e1", Age: 2 * grace},
+		{Kind: OrphanKindDorisResource, Name: "orders_3fa9c2e1_7b0d4e2a_resource", JobInstanceId: "orders_3fa9c2e1", Age: 2 * grace},
+		// 运行中作业的资源
+		{Kind: OrphanKindDorisDatabase, Name: "users_aa00bb11", JobInstanceId: "users_aa00bb11", Age: 2 * grace},
+		{Kind: OrphanKindDorisResource, Name: "users_aa00bb11_cc22dd33_resource", JobInstanceId: "users_aa00bb11", Age: 2 * grace},
+		// 未超过宽限期
+		{Kind: OrphanKindDorisResource, Name: "items_ee44ff55_resource", JobInstanceId: "items_ee44ff55", Age: grace / 2},
+		{Kind: OrphanKindDorisTlsFile, Name: "req1_ca_cert.pem", Location: "mira_task_tmp", Age: 2 * grace},
+	}
+
+	orphans := selectOrphans(candidates, []string{"users_aa00bb11_cc22dd33"}, grace)
+	var got []string
+	for _, c := range orphans {
+		got = append(got, c.Kind+":"+c.Name)
+	}
+	want := []string{
+		OrphanKindDorisTlsFile + ":req1_ca_cert.pem",
+		OrphanKindDorisResource + ":orders_3fa9c2e1_7b0d4e2a_resource",
+		OrphanKindDorisDatabase + ":orders_3fa9c2e1",
+		OrphanKindMinioExport + ":orders_3fa9c2e1",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("selectOrphans = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("selectOrphans[%d] = %s, want %s", i, got[i], want[i])
+		}
+	}
+}
+
+func TestTlsCertReferences(t *testing.T) {
+	refs := tlsCertReferences{
+		files:  map[string]bool{"req1_ca_cert.pem": true},
+		values: []string{"jdbc:mysql://h:3306/db?trustCertificateKeyStoreUrl=file:///certs/req2_ca_cert.p12"},
+	}
+	if !refs.contains("req1_ca_cert.pem") || !refs.contains("req2_ca_cert.p12") {
+		t.Errorf("referenced tls certs should be kept")
+	}
+	if refs.contains("req3_client_key.pem") {
+		t.Errorf("unreferenced tls cert should be a candidate")
+	}
+
+	if got := tlsRequestIdOf("req3_client_key.pem"); got != "req3" {
+		t.Errorf("tlsRequestIdOf = %q, want req3", got)
+	}
+}
+
+func TestParseDorisTime(t *testing.T) {
+	want := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, value := range []string{"2024-01-02T03:04:05Z", "2024-01-02 03:04:05"} {
+		got, ok := parseDorisTime(value)
+		if !ok || !got.Equal(want) {
+			t.Errorf("parseDorisTime(%q) = %v, %v, want %v", value, got, ok, want)
+		}
+	}
+	if _, ok := parseDorisTime(""); ok {
+		t.Errorf("empty time should not parse")
+	}
+}
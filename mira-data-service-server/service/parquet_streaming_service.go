@@ -7,9 +7,6 @@ import (
 	"io"
 	"os"
 	"path/filepath"
-	"regexp"
-	"sort"
-	"strconv"
 	"time"
 
 	"data-service/common"
@@ -30,22 +27,23 @@ import (
 type ParquetStreamingService struct {
 	chunkService *ChunkService
 	ossClient    oss.ClientInterface
+	dataDir      string // 分片下载的本地目录
+	batchSize    int    // 每批行数，0 时读取配置
 }
 
 func NewParquetStreamingService(chunkService *ChunkService, ossClient oss.ClientInterface) *ParquetStreamingService {
 	return &ParquetStreamingService{
 		chunkService: chunkService,
 		ossClient:    ossClient,
+		dataDir:      common.DATA_DIR,
 	}
 }
 
-// StreamParquetFileFromOSS 从OSS按顺序读取 parquet 分片并流式返回 Arrow 批次
-func (s *ParquetStreamingService) StreamParquetFileFromOSS(tableName, jobInstanceId string, columns []string, stream datasource.DataSourceService_ReadDataSourceStreamingServer) error {
-	bucketName := common.BATCH_DATA_BUCKET_NAME
-	keys, err := s.listAndSortParquetParts(bucketName, jobInstanceId)
-	if err != nil {
-		return fmt.Errorf("failed to list and sort parquet parts: %v", err)
-	}
+// StreamParquetFileFromOSS 按导出清单顺序读取 parquet 分片并流式返回 Arrow 批次
+func (s *ParquetStreamingService) StreamParquetFileFromOSS(manifest *common.JobExportManifest, tableName string, columns []string, stream datasource.DataSourceService_ReadDataSourceStreamingServer) error {
+	jobInstanceId := manifest.JobInstanceId
+	bucketName := manifest.Bucket
+	keys := manifest.Parts
 	if len(keys) == 0 {
 		return fmt.Errorf("no parquet parts found for jobInstanceId %s in bucket %s", jobInstanceId, bucketName)
 	}
@@ -74,7 +72,7 @@ func (s *ParquetStreamingService) StreamParquetFileFromOSS(tableName, jobInstanc
 			10*time.Second,       // 最大延迟
 			func() error {
 				var downloadErr error
-				localFilePath, downloadErr = s.downloadSinglePart(stream.Context(), bucketName, key, tableName, jobInstanceId, i)
+				localFilePath, downloadErr = s.downloadSinglePart(stream.Context(), bucketName, key, tableName, manifest.ExportId(), i)
 				return downloadErr
 			},
 			utils.IsRetryableNetErr, // 只对网络错误重试
@@ -133,42 +131,9 @@ func (s *ParquetStreamingService) StreamParquetFileFromOSS(tableName, jobInstanc
 	return nil
 }
 
-func (s *ParquetStreamingService) listAndSortParquetParts(bucketName, jobInstanceId string) ([]string, error) {
-	prefix := fmt.Sprintf("%s/", jobInstanceId)
-	keys, err := s.ossClient.ListObjects(context.Background(), bucketName, prefix, true)
-	if err != nil {
-		return nil, err
-	}
-	// 匹配类似 ..._0.parquet / ...-0.parquet / ...0.parquet
-	re := regexp.MustCompile(`(\d+)\.parquet$`)
-	type item struct {
-		key   string
-		index int
-	}
-	var parts []item
-	for _, k := range keys {
-		m := re.FindStringSubmatch(k)
-		if len(m) == 0 {
-			continue
-		}
-		n, err := strconv.Atoi(m[1])
-		if err != nil {
-			continue
-		}
-		parts = append(parts, item{key: k, index: n})
-	}
-	sort.Slice(parts, func(i, j int) bool { return parts[i].index < parts[j].index })
-	out := make([]string, 0, len(parts))
-	for _, p := range parts {
-		out = append(out, p.key)
-	}
-	log.Logger.Infof("Found %d parquet parts for jobInstanceId %s", len(out), jobInstanceId)
-	return out, nil
-}
-
-func (s *ParquetStreamingService) downloadSinglePart(ctx context.Context, bucketName, objectName, tableName, jobInstanceId string, partIndex int) (string, error) {
-	tempDir := common.DATA_DIR
-	localFileName := fmt.Sprintf("parquet_part_%s_%s_%d_%d.parquet", tableName, jobInstanceId, partIndex, time.Now().Unix())
+func (s *ParquetStreamingService) downloadSinglePart(ctx context.Context, bucketName, objectName, tableName, exportId string, partIndex int) (string, error) {
+	tempDir := s.dataDir
+	localFileName := fmt.Sprintf("parquet_part_%s_%s_%d_%d.parquet", tableName, exportId, partIndex, time.Now().Unix())
 	localFilePath := filepath.Join(tempDir, localFileName)
 
 	reader, err := s.ossClient.GetObject(ctx, bucketName, objectName, &oss.GetOptions{})
@@ -209,7 +174,10 @@ func (s *ParquetStreamingService) processParquetPart(filePath string, columns []
 	defer table.Release()
 
 	// 逐批（record batch）读取并发送
-	batchSize := config.GetConfigMap().StreamConfig.ParquetBatchSize
+	batchSize := s.batchSize
+	if batchSize == 0 {
+		batchSize = config.GetConfigMap().StreamConfig.ParquetBatchSize
+	}
 	tr := array.NewTableReader(table, int64(batchSize))
 	defer tr.Release()
 
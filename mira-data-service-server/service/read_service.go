@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"data-service/common"
+	"data-service/config"
+	"data-service/database"
 	"data-service/log"
 	"data-service/oss"
 
@@ -20,6 +22,8 @@ type ReadService struct {
 	chunkService  *ChunkService
 	streamService *CSVStreamingService
 	parquetStream *ParquetStreamingService
+	exportStream  *ExportStreamingService
+	conf          *config.DataServiceConf
 }
 
 // NewReadService 创建一个新的ReadService实例
@@ -32,6 +36,7 @@ func NewReadService(ossClient oss.ClientInterface) (*ReadService, error) {
 	chunkService := NewChunkService(ossClient)
 	streamService := NewCSVStreamingService(chunkService, ossClient, dorisService)
 	parquetStream := NewParquetStreamingService(chunkService, ossClient)
+	exportStream := NewExportStreamingService(chunkService, ossClient, dorisService)
 
 	return &ReadService{
 		ossClient:     ossClient,
@@ -39,31 +44,48 @@ func NewReadService(ossClient oss.ClientInterface) (*ReadService, error) {
 		chunkService:  chunkService,
 		streamService: streamService,
 		parquetStream: parquetStream,
+		exportStream:  exportStream,
+		conf:          config.GetConfigMap(),
 	}, nil
 }
 
 // ProcessReadRequest 处理读取请求
 func (s *ReadService) ProcessReadRequest(request *pb.ReadRequest, g grpc.ServerStreamingServer[pb.ArrowResponse]) error {
+	if _, err := database.NewReadWindow(request.Limit, request.Offset, request.Sample); err != nil {
+		return fmt.Errorf("invalid read window: %v", err)
+	}
+
+	// 试运行只返回执行计划
+	if request.GetDryRun() {
+		plan, err := newDryRunPlanner(s.dorisService, s.conf).planRead(request, readJobInstanceId(request, planRandomSuffix))
+		if err != nil {
+			return fmt.Errorf("failed to plan read: %v", err)
+		}
+		return g.Send(&pb.ArrowResponse{Plan: plan})
+	}
+
 	var tableName string
 	randomSuffix, err := common.GenerateRandomString(common.SUFFIX_RANDOM_LENGTH)
 	if err != nil {
 		return fmt.Errorf("failed to generate random suffix: %v", err)
 	}
 
-	var enhancedJobInstanceId string
+	enhancedJobInstanceId := readJobInstanceId(request, randomSuffix)
 	// 将ReadRequest转换为ReadDataSourceStreamingRequest
 	streamingRequest := &pb.ReadDataSourceStreamingRequest{
 		Columns:          request.Columns,
 		SortRules:        request.SortRules,
 		FilterConditions: request.FilterConditions,
+		FileFormat:       request.FileFormat,
+		Limit:            request.Limit,
+		Offset:           request.Offset,
+		Sample:           request.Sample,
 	}
 
-	// 根据数据源类型设置相应的字段和生成jobInstanceId
+	// 根据数据源类型设置相应的字段
 	switch {
 	case request.GetExternal() != nil:
-		enhancedJobInstanceId = request.GetExternal().AssetName + "_" + randomSuffix
-
-		// 导入数据
+		// 导入数据，导入时在 Doris 中按窗口读取外部表，导出时不再重复
 		importService := NewImportService()
 		importResult, err := importService.ImportData(context.Background(), &pb.ImportDataRequest{
 			Targets: []*pb.ImportTarget{
@@ -73,6 +95,7 @@ func (s *ReadService) ProcessReadRequest(request *pb.ReadRequest, g grpc.ServerS
 					TargetTableName: enhancedJobInstanceId + "_" + "internal",
 					Columns:         request.Columns,
 					Keys:            request.Keys,
+					Window:          sourceWindow(request.FilterConditions, request.SortRules, request.Limit, request.Offset, request.Sample),
 				},
 			},
 		})
@@ -83,6 +106,7 @@ func (s *ReadService) ProcessReadRequest(request *pb.ReadRequest, g grpc.ServerS
 		log.Logger.Debugf("importResult: %v", importResult)
 
 		tableName = importResult.Results[0].TargetTableName
+		streamingRequest.Limit, streamingRequest.Offset, streamingRequest.Sample = 0, 0, nil
 		// 后续请求变成Doris数据源
 		streamingRequest.DataSource = &pb.ReadDataSourceStreamingRequest_Doris{
 			Doris: &pb.DorisDataSource{
@@ -92,12 +116,10 @@ func (s *ReadService) ProcessReadRequest(request *pb.ReadRequest, g grpc.ServerS
 		}
 
 	case request.GetInternal() != nil:
-		enhancedJobInstanceId = request.GetInternal().TableName + "_" + randomSuffix
 		streamingRequest.DataSource = &pb.ReadDataSourceStreamingRequest_Internal{
 			Internal: request.GetInternal(),
 		}
 	case request.GetDoris() != nil:
-		enhancedJobInstanceId = request.GetDoris().DbName + "@" + randomSuffix
 		streamingRequest.DataSource = &pb.ReadDataSourceStreamingRequest_Doris{
 			Doris: request.GetDoris(),
 		}
@@ -106,6 +128,7 @@ func (s *ReadService) ProcessReadRequest(request *pb.ReadRequest, g grpc.ServerS
 	}
 
 	streamingRequest.JobInstanceId = enhancedJobInstanceId
+	defer TrackActiveJob(enhancedJobInstanceId)()
 
 	// 5.执行完成后清理资源
 	defer func() {
@@ -136,23 +159,50 @@ func (s *ReadService) ProcessReadRequest(request *pb.ReadRequest, g grpc.ServerS
 	}()
 
 	// 1.从数据源拉取数据到doris并导出到minio
-	// bug 如果相同的任务读同一张表，会出现多读数据，需要加子路径做隔离
-	tableName, err = s.dorisService.ProcessDataSourceAndExport(streamingRequest, enhancedJobInstanceId)
+	// 每次导出写入独立子目录，读取时只读清单中的分片
+	tableName, manifest, err := s.dorisService.ProcessDataSourceAndExport(streamingRequest, enhancedJobInstanceId)
 	if err != nil {
 		return fmt.Errorf("failed to process data source and export: %v", err)
 	}
 	log.Logger.Infof("Successfully exported table %s to MinIO for jobInstanceId: %s", tableName, enhancedJobInstanceId)
 
-	// 2.执行导出parquet文件到minio
-	// 3.从minio流式读取arrow文件
-	if err := s.parquetStream.StreamParquetFileFromOSS(tableName, enhancedJobInstanceId, request.Columns, g); err != nil {
-		log.Logger.Errorf("failed to stream parquet file from OSS: %v", err)
-		return fmt.Errorf("failed to stream parquet file from OSS: %v", err)
+	// 2.执行导出文件到minio（格式由请求指定，默认parquet）
+	// 3.从minio按导出清单流式读取并返回arrow批次
+	if err := s.exportStream.StreamExportFromOSS(manifest, tableName, request.Columns, g); err != nil {
+		log.Logger.Errorf("failed to stream %s file from OSS: %v", manifest.Format, err)
+		return fmt.Errorf("failed to stream %s file from OSS: %v", manifest.Format, err)
 	}
 
 	return nil
 }
 
+// sourceWindow 设置了分页或采样时返回导入外部源的读取窗口，窗口查询连同过滤与排序在源库执行；否则返回 nil
+func sourceWindow(filters []*pb.FilterCondition, sortRules []*pb.SortRule, limit, offset int64, sample *pb.ReadSample) *pb.ReadWindow {
+	if limit == 0 && offset == 0 && sample == nil {
+		return nil
+	}
+	return &pb.ReadWindow{
+		FilterConditions: filters,
+		SortRules:        sortRules,
+		Limit:            limit,
+		Offset:           offset,
+		Sample:           sample,
+	}
+}
+
+// readJobInstanceId 按数据源生成读取任务的 jobInstanceId
+func readJobInstanceId(request *pb.ReadRequest, randomSuffix string) string {
+	switch {
+	case request.GetExternal() != nil:
+		return request.GetExternal().AssetName + "_" + randomSuffix
+	case request.GetInternal() != nil:
+		return request.GetInternal().TableName + "_" + randomSuffix
+	case request.GetDoris() != nil:
+		return request.GetDoris().DbName + "@" + randomSuffix
+	}
+	return ""
+}
+
 // cleanupMinioFiles 清理Minio上的文件
 func (s *ReadService) cleanupMinioFiles(jobInstanceId string) error {
 	// 从批量数据桶中删除相关文件
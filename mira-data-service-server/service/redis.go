@@ -3,7 +3,7 @@ package service
 import (
 	"context"
 	"data-service/config"
-	"strings"
+	"data-service/utils"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -14,56 +14,7 @@ type RedisClient struct {
 }
 
 func NewRedisClient() (*RedisClient, error) {
-	conf := config.GetConfigMap()
-	redisConf := conf.RedisConfig
-
-	var client redis.Cmdable
-
-	switch redisConf.ClusterType {
-	case "sentinel":
-		// 哨兵模式
-		sentinelAddrs := strings.Split(redisConf.Address, ",")
-		client = redis.NewFailoverClient(&redis.FailoverOptions{
-			MasterName:    redisConf.SentinelMasterName,
-			SentinelAddrs: sentinelAddrs,
-			Password:      redisConf.Password,
-			DB:            redisConf.DB,
-			// 连接池配置
-			PoolSize:           10,
-			MinIdleConns:       5,
-			MaxConnAge:         time.Hour,
-			IdleTimeout:        5 * time.Minute,
-			IdleCheckFrequency: time.Minute,
-		})
-	case "cluster":
-		// 集群模式
-		clusterAddrs := strings.Split(redisConf.Address, ",")
-		client = redis.NewClusterClient(&redis.ClusterOptions{
-			Addrs:    clusterAddrs,
-			Password: redisConf.Password,
-			// 连接池配置
-			PoolSize:           10,
-			MinIdleConns:       5,
-			MaxConnAge:         time.Hour,
-			IdleTimeout:        5 * time.Minute,
-			IdleCheckFrequency: time.Minute,
-		})
-	default:
-		// 单机模式
-		client = redis.NewClient(&redis.Options{
-			Addr:     redisConf.Address,
-			Password: redisConf.Password,
-			DB:       redisConf.DB,
-			// 连接池配置
-			PoolSize:           10,
-			MinIdleConns:       5,
-			MaxConnAge:         time.Hour,
-			IdleTimeout:        5 * time.Minute,
-			IdleCheckFrequency: time.Minute,
-		})
-	}
-
-	return &RedisClient{client: client}, nil
+	return &RedisClient{client: utils.NewRedisCmdable(config.GetConfigMap().RedisConfig)}, nil
 }
 
 func (c *RedisClient) HGetAll(ctx context.Context, key string) *redis.StringStringMapCmd {
@@ -74,6 +25,11 @@ func (c *RedisClient) Get(ctx context.Context, key string) *redis.StringCmd {
 	return c.client.Get(ctx, "job_result:"+key)
 }
 
+// Set 写入作业结果，与 Get 使用相同的 job_result: 前缀
+func (c *RedisClient) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd {
+	return c.client.Set(ctx, "job_result:"+key, value, expiration)
+}
+
 // 添加关闭连接的方法
 func (c *RedisClient) Close() error {
 	if closer, ok := c.client.(interface{ Close() error }); ok {
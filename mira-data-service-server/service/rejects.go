@@ -0,0 +1,186 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"data-service/common"
+	"data-service/config"
+	log "data-service/log"
+	"data-service/oss"
+)
+
+// rejectsPrefix 被拒绝行对象的路径前缀，对象名为 rejects/<作业标识>.csv
+const rejectsPrefix = "rejects"
+
+// loadErrorLogPattern Doris 导入错误日志的一行：Reason: <原因>. src line [<原始行>];
+var loadErrorLogPattern = regexp.MustCompile(`^Reason:\s*(.*?)\.?\s*src line \[(.*)\];?\s*$`)
+
+// loadErrorURLPattern Doris 导入失败信息中的错误日志地址
+var loadErrorURLPattern = regexp.MustCompile(`url:\s*(https?://\S+)`)
+
+// loadErrorLogClient 拉取 BE 错误日志使用的客户端
+var loadErrorLogClient = &http.Client{Timeout: time.Minute}
+
+// newRejectsClient 上传被拒绝行对象使用的客户端，测试时替换
+var newRejectsClient = func() (oss.ClientInterface, error) {
+	return oss.NewOSSFactory(config.GetConfigMap()).NewOSSClient()
+}
+
+// RejectRecorder 收集一个作业被拒绝的行及原因，作业结束时以 CSV（reason,row）写入对象存储。
+// Doris 错误日志只保留部分行，被拒绝行数以 Doris 统计为准，对象中的行可能少于该数
+type RejectRecorder struct {
+	mu       sync.Mutex
+	key      string
+	buf      bytes.Buffer
+	writer   *csv.Writer
+	rejected int64
+	recorded int64
+}
+
+// NewRejectRecorder 创建作业的被拒绝行记录器，相同作业标识写入同一对象，重试时覆盖
+func NewRejectRecorder(jobId string) *RejectRecorder {
+	r := &RejectRecorder{key: fmt.Sprintf("%s/%s.csv", rejectsPrefix, jobId)}
+	r.writer = csv.NewWriter(&r.buf)
+	_ = r.writer.Write([]string{"reason", "row"})
+	return r
+}
+
+// RecordRow 记录一条本端转换时被拒绝的行
+func (r *RejectRecorder) RecordRow(reason, row string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rejected++
+	r.record(reason, row)
+}
+
+func (r *RejectRecorder) record(reason, row string) {
+	_ = r.writer.Write([]string{reason, row})
+	r.recorded++
+}
+
+// RecordLoadErrors 记录一次 Doris 导入过滤的行：filtered 计入被拒绝行数，错误日志中的行写入对象；
+// filtered 未知（<=0）时按错误日志的行数计数
+func (r *RejectRecorder) RecordLoadErrors(ctx context.Context, filtered int64, errorURL string) {
+	var rows int64
+	if errorURL != "" {
+		n, err := r.fetchLoadErrorLog(ctx, errorURL)
+		if err != nil {
+			log.Logger.Warnf("Failed to fetch load error log %s: %v", errorURL, err)
+		}
+		rows = n
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if filtered > 0 {
+		r.rejected += filtered
+	} else {
+		r.rejected += rows
+	}
+}
+
+// fetchLoadErrorLog 拉取 Doris 错误日志并逐行记录，返回记录的行数
+func (r *RejectRecorder) fetchLoadErrorLog(ctx context.Context, errorURL string) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, errorURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := loadErrorLogClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return r.recordLoadErrorLog(resp.Body)
+}
+
+// recordLoadErrorLog 解析错误日志，每行拆成原因和原始行
+func (r *RejectRecorder) recordLoadErrorLog(body io.Reader) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	var n int64
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if m := loadErrorLogPattern.FindStringSubmatch(line); m != nil {
+			r.record(m[1], m[2])
+		} else {
+			r.record(line, "")
+		}
+		n++
+	}
+	return n, scanner.Err()
+}
+
+// Rejected 被拒绝的行数
+func (r *RejectRecorder) Rejected() int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.rejected
+}
+
+// Save 把记录的行写入 BATCH_DATA_BUCKET_NAME 桶，没有记录到具体行时不写入
+func (r *RejectRecorder) Save(ctx context.Context) (*common.RejectSummary, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	summary := &common.RejectSummary{Rows: r.rejected}
+	if r.recorded == 0 {
+		return summary, nil
+	}
+	r.writer.Flush()
+	client, err := newRejectsClient()
+	if err != nil {
+		return summary, fmt.Errorf("failed to create OSS client: %v", err)
+	}
+	data := r.buf.Bytes()
+	if _, err := client.PutObject(ctx, common.BATCH_DATA_BUCKET_NAME, r.key, bytes.NewReader(data), int64(len(data)),
+		&oss.PutOptions{ContentType: "text/csv"}); err != nil {
+		return summary, fmt.Errorf("failed to upload rejects object %s: %v", r.key, err)
+	}
+	summary.Object = r.key
+	log.Logger.Infof("Saved %d rejected rows (%d recorded) to %s/%s", r.rejected, r.recorded, common.BATCH_DATA_BUCKET_NAME, r.key)
+	return summary, nil
+}
+
+// saveRejects 保存被拒绝行，失败只记录日志，不影响写入结果；请求已取消时也要保存，不使用请求的 context
+func saveRejects(rejects *RejectRecorder) *common.RejectSummary {
+	summary, err := rejects.Save(context.Background())
+	if err != nil {
+		log.Logger.Warnf("Failed to save rejected rows: %v", err)
+	}
+	return summary
+}
+
+// rejectsError 写入失败时保存已记录的被拒绝行，并在错误中带上对象名
+func rejectsError(rejects *RejectRecorder, err error) error {
+	if rejects == nil {
+		return err
+	}
+	if summary := saveRejects(rejects); summary.Object != "" {
+		return fmt.Errorf("%v, %d rejected rows saved to %s", err, summary.Rows, summary.Object)
+	}
+	return err
+}
+
+// loadErrorURL 从 Doris 导入失败信息中提取错误日志地址
+func loadErrorURL(message string) string {
+	if m := loadErrorURLPattern.FindStringSubmatch(message); m != nil {
+		return strings.TrimRight(m[1], ".,;'\"")
+	}
+	return ""
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"data-service/common"
+	"data-service/mocks"
+	"data-service/oss"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const testLoadErrorLog = "Reason: column_name[age], the length of input is too long than schema. src line [1,tom,abc]; \n" +
+	"Reason: no partition for this tuple. src line [2,jerry,\"x\"]; \n" +
+	"unparsed line\n"
+
+// mockRejectsClient 把被拒绝行对象的上传替换为 mock 客户端，返回上传的内容
+func mockRejectsClient(t *testing.T) *[]byte {
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockClientInterface(ctrl)
+	var uploaded []byte
+	client.EXPECT().PutObject(gomock.Any(), common.BATCH_DATA_BUCKET_NAME, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, reader io.Reader, _ int64, _ interface{}) (interface{}, error) {
+			var err error
+			uploaded, err = io.ReadAll(reader)
+			return nil, err
+		}).AnyTimes()
+
+	orig := newRejectsClient
+	newRejectsClient = func() (oss.ClientInterface, error) { return client, nil }
+	t.Cleanup(func() {
+		newRejectsClient = orig
+		ctrl.Finish()
+	})
+	return &uploaded
+}
+
+func TestRejectRecorder_RecordLoadErrors(t *testing.T) {
+	uploaded := mockRejectsClient(t)
+	errorLog := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testLoadErrorLog))
+	}))
+	defer errorLog.Close()
+
+	rejects := NewRejectRecorder("write_x")
+	// Doris 统计过滤 5 行，错误日志只保留 3 行
+	rejects.RecordLoadErrors(context.Background(), 5, errorLog.URL)
+	assert.Equal(t, int64(5), rejects.Rejected())
+
+	summary, err := rejects.Save(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), summary.Rows)
+	assert.Equal(t, "rejects/write_x.csv", summary.Object)
+	assert.Equal(t, "reason,row\n"+
+		"\"column_name[age], the length of input is too long than schema\",\"1,tom,abc\"\n"+
+		"no partition for this tuple,\"2,jerry,\"\"x\"\"\"\n"+
+		"unparsed line,\n", string(*uploaded))
+}
+
+func TestRejectRecorder_CountsErrorLogWhenFilteredUnknown(t *testing.T) {
+	mockRejectsClient(t)
+	errorLog := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testLoadErrorLog))
+	}))
+	defer errorLog.Close()
+
+	rejects := NewRejectRecorder("insert_x")
+	rejects.RecordLoadErrors(context.Background(), 0, errorLog.URL)
+	assert.Equal(t, int64(3), rejects.Rejected())
+}
+
+func TestRejectRecorder_SaveWithoutRows(t *testing.T) {
+	rejects := NewRejectRecorder("write_x")
+	// 只有行数没有具体行时不上传对象
+	rejects.RecordLoadErrors(context.Background(), 2, "")
+	summary, err := rejects.Save(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &common.RejectSummary{Rows: 2}, summary)
+}
+
+func TestLoadErrorURL(t *testing.T) {
+	msg := "errCode = 2, detailMessage = Insert has too many filtered data 2/10 insert_max_filter_ratio is 0.1. " +
+		"url: http://be:8040/api/_load_error_log?file=__shard_1/error_log_insert_stmt_x"
+	assert.Equal(t, "http://be:8040/api/_load_error_log?file=__shard_1/error_log_insert_stmt_x", loadErrorURL(msg))
+	assert.Equal(t, "", loadErrorURL("failed to execute update SQL: connection refused"))
+}
+
+func TestStagingTableName(t *testing.T) {
+	assert.Equal(t, "`db`.`t_stage_ab12`", stagingTableName("`db`.`t`", "ab12"))
+	assert.Equal(t, "t_stage_ab12", stagingTableName("t", "ab12"))
+	assert.Equal(t, "t_stage_ab12", unquoteTableName("`db`.`t_stage_ab12`"))
+	assert.Equal(t, "t", unquoteTableName("t"))
+}
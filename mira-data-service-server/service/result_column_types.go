@@ -0,0 +1,335 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"data-service/common"
+	"data-service/config"
+	log "data-service/log"
+	"data-service/oss"
+
+	"github.com/apache/arrow/go/v15/arrow"
+)
+
+// resultMetadataSuffix 结果元数据对象后缀，与结果对象同目录，可声明列类型
+const resultMetadataSuffix = ".meta.json"
+
+// 推断出的 DECIMAL 列精度，小数位取样本中的最大值
+const (
+	resultDecimalPrecision = 38
+	resultDecimalMaxScale  = 18
+)
+
+var (
+	resultIntPattern     = regexp.MustCompile(`^[+-]?(0|[1-9][0-9]*)$`)
+	resultDecimalPattern = regexp.MustCompile(`^[+-]?(0|[1-9][0-9]*)\.([0-9]+)$`)
+	resultFloatPattern   = regexp.MustCompile(`^[+-]?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+	declaredDecimalType  = regexp.MustCompile(`^(decimal|numeric)\s*\(\s*(\d+)\s*(,\s*(\d+)\s*)?\)$`)
+	resultTimestampForms = []string{"2006-01-02 15:04:05.999999999", time.RFC3339Nano, "2006-01-02T15:04:05.999999999"}
+)
+
+// resultMetadata 结果元数据，columns 中未声明的列按样本推断类型
+type resultMetadata struct {
+	Columns []struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"columns"`
+}
+
+// loadDeclaredColumnTypes 读取结果元数据中声明的列类型，元数据不存在或无法解析时返回 nil
+func loadDeclaredColumnTypes(objectName string) map[string]string {
+	client, err := oss.NewOSSFactory(config.GetConfigMap()).NewOSSClient()
+	if err != nil {
+		log.Logger.Warnf("Failed to create OSS client for result metadata: %v", err)
+		return nil
+	}
+	reader, err := client.GetObject(context.Background(), common.RESULT_BUCKET_NAME, objectName+resultMetadataSuffix, &oss.GetOptions{})
+	if err != nil {
+		log.Logger.Infof("No result metadata for %s, infer column types: %v", objectName, err)
+		return nil
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		log.Logger.Infof("No result metadata for %s, infer column types: %v", objectName, err)
+		return nil
+	}
+	declared, err := parseResultMetadata(content)
+	if err != nil {
+		log.Logger.Warnf("Ignore invalid result metadata of %s: %v", objectName, err)
+		return nil
+	}
+	return declared
+}
+
+func parseResultMetadata(content []byte) (map[string]string, error) {
+	var meta resultMetadata
+	if err := json.Unmarshal(content, &meta); err != nil {
+		return nil, err
+	}
+	declared := make(map[string]string, len(meta.Columns))
+	for _, column := range meta.Columns {
+		if column.Name != "" && column.Type != "" {
+			declared[column.Name] = column.Type
+		}
+	}
+	return declared, nil
+}
+
+// parseDeclaredColumnType 解析元数据中声明的列类型
+func parseDeclaredColumnType(declared string) (arrow.DataType, error) {
+	t := strings.ToLower(strings.TrimSpace(declared))
+	switch t {
+	case "int", "integer", "bigint", "long", "int64":
+		return arrow.PrimitiveTypes.Int64, nil
+	case "double", "float", "float64", "real":
+		return arrow.PrimitiveTypes.Float64, nil
+	case "decimal", "numeric":
+		return &arrow.Decimal128Type{Precision: resultDecimalPrecision, Scale: 0}, nil
+	case "date":
+		return arrow.FixedWidthTypes.Date32, nil
+	case "timestamp", "datetime":
+		return arrow.FixedWidthTypes.Timestamp_us, nil
+	case "bool", "boolean":
+		return arrow.FixedWidthTypes.Boolean, nil
+	case "string", "varchar", "text":
+		return arrow.BinaryTypes.LargeString, nil
+	}
+	if m := declaredDecimalType.FindStringSubmatch(t); m != nil {
+		precision, _ := strconv.Atoi(m[2])
+		scale := 0
+		if m[4] != "" {
+			scale, _ = strconv.Atoi(m[4])
+		}
+		if precision < 1 || precision > resultDecimalPrecision || scale > precision {
+			return nil, fmt.Errorf("invalid decimal type %q", declared)
+		}
+		return &arrow.Decimal128Type{Precision: int32(precision), Scale: int32(scale)}, nil
+	}
+	return nil, fmt.Errorf("unsupported column type %q", declared)
+}
+
+// resultTypeInference 逐个观察列值推断列类型，空值不参与推断，无法统一时使用字符串
+// 数值不允许前导零，避免编号类字符串丢失前导零
+type resultTypeInference struct {
+	notInt, notDecimal, notFloat, notDate, notTimestamp, notBool bool
+	maxScale, seen                                               int
+}
+
+func (r *resultTypeInference) observe(v string) {
+	if v == "" {
+		return
+	}
+	r.seen++
+	if !r.notInt && (!resultIntPattern.MatchString(v) || !fitsInt64(v)) {
+		r.notInt = true
+	}
+	if !r.notDecimal && !resultIntPattern.MatchString(v) {
+		if m := resultDecimalPattern.FindStringSubmatch(v); m != nil {
+			if len(m[2]) > r.maxScale {
+				r.maxScale = len(m[2])
+			}
+		} else {
+			r.notDecimal = true
+		}
+	}
+	if !r.notFloat && !resultFloatPattern.MatchString(v) {
+		r.notFloat = true
+	}
+	if !r.notDate {
+		if _, err := time.Parse(time.DateOnly, v); err != nil {
+			r.notDate = true
+		}
+	}
+	if !r.notTimestamp {
+		if _, ok := parseResultTimestamp(v); !ok {
+			if _, err := time.Parse(time.DateOnly, v); err != nil {
+				r.notTimestamp = true
+			}
+		}
+	}
+	if !r.notBool && !strings.EqualFold(v, "true") && !strings.EqualFold(v, "false") {
+		r.notBool = true
+	}
+}
+
+// dataType 返回能容纳所有已观察值的类型
+func (r *resultTypeInference) dataType() arrow.DataType {
+	switch {
+	case r.seen == 0:
+		return arrow.BinaryTypes.LargeString
+	case !r.notInt:
+		return arrow.PrimitiveTypes.Int64
+	case !r.notDecimal && r.maxScale <= resultDecimalMaxScale:
+		return &arrow.Decimal128Type{Precision: resultDecimalPrecision, Scale: int32(r.maxScale)}
+	case !r.notFloat:
+		return arrow.PrimitiveTypes.Float64
+	case !r.notDate:
+		return arrow.FixedWidthTypes.Date32
+	case !r.notTimestamp:
+		return arrow.FixedWidthTypes.Timestamp_us
+	case !r.notBool:
+		return arrow.FixedWidthTypes.Boolean
+	default:
+		return arrow.BinaryTypes.LargeString
+	}
+}
+
+// inferResultColumnType 按一组值推断列类型
+func inferResultColumnType(values []string) arrow.DataType {
+	var r resultTypeInference
+	for _, v := range values {
+		r.observe(v)
+	}
+	return r.dataType()
+}
+
+func fitsInt64(v string) bool {
+	_, err := strconv.ParseInt(v, 10, 64)
+	return err == nil
+}
+
+func parseResultTimestamp(v string) (time.Time, bool) {
+	for _, layout := range resultTimestampForms {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// resultColumnTypes 确定结果表各列类型：元数据声明优先（按原始表头或列名匹配），否则使用按全部数据行推断的类型
+func resultColumnTypes(headers, columns []string, declared map[string]string, inferred []arrow.DataType) ([]arrow.DataType, error) {
+	types := make([]arrow.DataType, len(columns))
+	for i, column := range columns {
+		decl, ok := declared[headers[i]]
+		if !ok {
+			decl, ok = declared[column]
+		}
+		if ok {
+			t, err := parseDeclaredColumnType(decl)
+			if err != nil {
+				return nil, fmt.Errorf("column %s: %v", column, err)
+			}
+			types[i] = t
+			continue
+		}
+		types[i] = arrow.BinaryTypes.LargeString
+		if i < len(inferred) {
+			types[i] = inferred[i]
+		}
+	}
+	return types, nil
+}
+
+// convertResultValue 把 CSV 值转换为列类型对应的插入参数，非字符串列的空值写入 NULL
+func convertResultValue(v string, t arrow.DataType) (interface{}, error) {
+	if t == nil || t.ID() == arrow.LARGE_STRING || t.ID() == arrow.STRING {
+		return v, nil
+	}
+	if v == "" {
+		return nil, nil
+	}
+	switch t.ID() {
+	case arrow.INT64:
+		return strconv.ParseInt(v, 10, 64)
+	case arrow.FLOAT64:
+		return strconv.ParseFloat(v, 64)
+	case arrow.BOOL:
+		return strconv.ParseBool(v)
+	case arrow.DECIMAL128:
+		if !resultIntPattern.MatchString(v) && !resultDecimalPattern.MatchString(v) {
+			return nil, fmt.Errorf("invalid decimal %q", v)
+		}
+		return v, nil
+	case arrow.DATE32:
+		if _, err := time.Parse(time.DateOnly, v); err != nil {
+			return nil, fmt.Errorf("invalid date %q", v)
+		}
+		return v, nil
+	case arrow.TIMESTAMP:
+		ts, ok := parseResultTimestamp(v)
+		if !ok {
+			d, err := time.Parse(time.DateOnly, v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid timestamp %q", v)
+			}
+			ts = d
+		}
+		return ts.UTC().Format("2006-01-02 15:04:05.999999"), nil
+	default:
+		return v, nil
+	}
+}
+
+// inferResultCSV 先完整读一遍结果 CSV，按全部数据行推断各列类型，返回可再次读取的明文 CSV。
+// 本地明文文件直接回到开头重读；解密流写入 spoolDir 下的临时文件，避免再次调用解密接口
+func inferResultCSV(reader io.ReadCloser, spoolDir string) (io.ReadCloser, []arrow.DataType, error) {
+	if file, ok := reader.(*fileReadCloser); ok {
+		types, err := inferCSVColumnTypes(file)
+		if err == nil {
+			_, err = file.Seek(0, io.SeekStart)
+		}
+		if err != nil {
+			file.Close()
+			return nil, nil, err
+		}
+		return file, types, nil
+	}
+
+	defer reader.Close()
+	if err := os.MkdirAll(spoolDir, 0755); err != nil {
+		return nil, nil, fmt.Errorf("failed to create data directory: %v", err)
+	}
+	spool, err := os.CreateTemp(spoolDir, "result_plain_*.csv")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create result spool file: %v", err)
+	}
+	plain := &fileReadCloser{File: spool, filePath: spool.Name()}
+	types, err := inferCSVColumnTypes(io.TeeReader(reader, spool))
+	if err == nil {
+		_, err = spool.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		plain.Close()
+		return nil, nil, err
+	}
+	return plain, types, nil
+}
+
+// inferCSVColumnTypes 跳过表头，按全部数据行推断各列类型
+func inferCSVColumnTypes(r io.Reader) ([]arrow.DataType, error) {
+	csvReader := csv.NewReader(r)
+	csvReader.ReuseRecord = true
+	var inferences []resultTypeInference
+	for first := true; ; first = false {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV record: %v", err)
+		}
+		if first {
+			inferences = make([]resultTypeInference, len(record))
+			continue
+		}
+		for i, v := range record {
+			inferences[i].observe(v)
+		}
+	}
+	types := make([]arrow.DataType, len(inferences))
+	for i := range inferences {
+		types[i] = inferences[i].dataType()
+	}
+	return types, nil
+}
@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"data-service/database/gorm/models"
+	pb "data-service/generated/datasource"
+	log "data-service/log"
+	"data-service/utils"
+)
+
+// ResultDeliveryStore 计算结果投递状态存储
+type ResultDeliveryStore interface {
+	Create(record *models.ResultDelivery) error
+	FindByIdempotencyKey(key string) (*models.ResultDelivery, error)
+	ClaimDelivery(key, owner string, lease time.Duration, doneStatus, claimedStatus string) (bool, error)
+	RenewDelivery(key, owner string, lease time.Duration) (bool, error)
+	FinishDelivery(key, owner string, updates map[string]interface{}) error
+	FindByJob(jobInstanceID, partyID string) ([]models.ResultDelivery, error)
+}
+
+// 投递租约：投递期间定期续期，实例异常退出后租约过期，其他实例可重新领取
+const (
+	resultDeliveryLease = 2 * time.Minute
+	resultDeliveryRenew = 30 * time.Second
+)
+
+// errDeliveryInProgress 同一幂等键正由其他请求投递
+var errDeliveryInProgress = errors.New("result delivery is in progress")
+
+// resultIdempotencyKey 同一作业参与方投递到同一目标时幂等键相同
+func resultIdempotencyKey(req *pb.PushJobResultRequest, sink ResultSink) string {
+	sum := sha256.Sum256([]byte(sink.Type().String() + "|" + sink.Target()))
+	return fmt.Sprintf("%s:%s:%s", req.GetJobInstanceId(), req.GetPartyId(), hex.EncodeToString(sum[:6]))
+}
+
+// isRetryableDeliveryErr 除 permanentDeliveryError 外的投递错误均重试
+func isRetryableDeliveryErr(err error) bool {
+	var permanent *permanentDeliveryError
+	return !errors.As(err, &permanent)
+}
+
+// buildRequestedSink 根据请求中的投递目标创建 ResultSink
+func (s *DefaultJobResultService) buildRequestedSink(req *pb.PushJobResultRequest) (ResultSink, error) {
+	sink := req.GetSink()
+	switch sink.GetType() {
+	case pb.ResultSinkType_RESULT_SINK_TYPE_KAFKA:
+		if sink.GetTopic() == "" {
+			return nil, fmt.Errorf("kafka sink requires topic")
+		}
+		if s.conf.KafkaRestProxy == "" {
+			return nil, fmt.Errorf("kafka sink is not available: result_delivery.kafka_rest_proxy is not configured")
+		}
+		return &kafkaResultSink{client: s.httpClient, proxyURL: s.conf.KafkaRestProxy, topic: sink.GetTopic()}, nil
+	case pb.ResultSinkType_RESULT_SINK_TYPE_S3:
+		if sink.GetBucketName() == "" {
+			return nil, fmt.Errorf("s3 sink requires bucketName")
+		}
+		cred, ok := s.credentials[sink.GetCredentialRef()]
+		if !ok || sink.GetCredentialRef() == "" {
+			return nil, fmt.Errorf("s3 sink: unknown credentialRef %q", sink.GetCredentialRef())
+		}
+		client, err := s.newOSSClient(cred)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create s3 client: %v", err)
+		}
+		return &s3ResultSink{
+			client:     client,
+			bucketName: sink.GetBucketName(),
+			objectName: s3ResultObjectName(sink.GetObjectPrefix(), req),
+		}, nil
+	case pb.ResultSinkType_RESULT_SINK_TYPE_WEBHOOK:
+		u, err := url.Parse(sink.GetUrl())
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("webhook sink requires an http(s) url, got %q", sink.GetUrl())
+		}
+		if !webhookHostAllowed(u, s.conf.WebhookAllowedHosts) {
+			return nil, fmt.Errorf("webhook host %s is not in result_delivery.webhook_allowed_hosts", u.Host)
+		}
+		// 不跟随重定向，避免被重定向到允许列表之外的地址
+		client := *s.httpClient
+		client.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+		return &webhookResultSink{client: &client, url: sink.GetUrl(), secret: s.conf.WebhookSecret}, nil
+	default:
+		return nil, fmt.Errorf("unsupported result sink type: %s", sink.GetType())
+	}
+}
+
+// deliverResult 按幂等键投递结果：已成功的投递直接返回；发送前先领取投递租约，
+// 同一幂等键同时只有一个请求在投递，其余返回 errDeliveryInProgress
+func (s *DefaultJobResultService) deliverResult(ctx context.Context, req *pb.PushJobResultRequest, sink ResultSink) error {
+	key := resultIdempotencyKey(req, sink)
+	if _, loaded := s.inflight.LoadOrStore(key, struct{}{}); loaded {
+		return fmt.Errorf("%w: key=%s", errDeliveryInProgress, key)
+	}
+	defer s.inflight.Delete(key)
+
+	owner := cleanupLeaseOwner()
+	attempts, done, err := s.claimDelivery(key, owner, req, sink)
+	if err != nil {
+		return err
+	}
+	if done {
+		log.Logger.Infof("Result already delivered, skip: key=%s, target=%s", key, sink.Target())
+		return nil
+	}
+
+	// 续期失败说明租约已被其他实例领取，取消本次投递
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go s.renewDeliveryLease(ctx, cancel, key, owner)
+
+	result := &jobResult{
+		Request:        req,
+		IdempotencyKey: key,
+		open: func() (io.ReadCloser, error) {
+			return s.openResult(req)
+		},
+	}
+	log.Logger.Infof("Delivering job result: key=%s, type=%s, target=%s", key, sink.Type(), sink.Target())
+	err = utils.WithRetryCtx(ctx, s.maxRetries, s.retryDelay, 30*time.Second, func() error {
+		attempts++
+		result.Attempt = attempts
+		return sink.Deliver(ctx, result)
+	}, isRetryableDeliveryErr)
+
+	updates := map[string]interface{}{"attempts": attempts}
+	if err != nil {
+		log.Logger.Errorf("Failed to deliver job result %s after %d attempts: %v", key, attempts, err)
+		updates["status"] = pb.ResultDeliveryStatus_RESULT_DELIVERY_STATUS_FAILED.String()
+		updates["error_message"] = err.Error()
+	} else {
+		updates["status"] = pb.ResultDeliveryStatus_RESULT_DELIVERY_STATUS_SUCCEEDED.String()
+		updates["error_message"] = ""
+		updates["delivered_at"] = time.Now()
+	}
+	if s.deliveries != nil {
+		if updateErr := s.deliveries.FinishDelivery(key, owner, updates); updateErr != nil {
+			log.Logger.Warnf("Failed to update result delivery %s: %v", key, updateErr)
+		}
+	}
+	return err
+}
+
+// claimDelivery 创建或领取投递记录，返回已尝试次数；记录已成功时 done 为 true，
+// 其他实例持有未过期的租约时返回 errDeliveryInProgress。存储不可用时只记录日志，不阻断投递
+func (s *DefaultJobResultService) claimDelivery(key, owner string, req *pb.PushJobResultRequest, sink ResultSink) (int, bool, error) {
+	if s.deliveries == nil {
+		return 0, false, nil
+	}
+	succeeded := pb.ResultDeliveryStatus_RESULT_DELIVERY_STATUS_SUCCEEDED.String()
+	delivering := pb.ResultDeliveryStatus_RESULT_DELIVERY_STATUS_DELIVERING.String()
+
+	record, err := s.deliveries.FindByIdempotencyKey(key)
+	if err != nil {
+		log.Logger.Warnf("Failed to query result delivery %s: %v", key, err)
+		return 0, false, nil
+	}
+	if record == nil {
+		expires := time.Now().Add(resultDeliveryLease)
+		err := s.deliveries.Create(&models.ResultDelivery{
+			IdempotencyKey: key,
+			JobInstanceID:  req.GetJobInstanceId(),
+			PartyID:        req.GetPartyId(),
+			SinkType:       sink.Type().String(),
+			Target:         sink.Target(),
+			Status:         delivering,
+			LeaseOwner:     owner,
+			LeaseExpires:   &expires,
+		})
+		if err == nil {
+			return 0, false, nil
+		}
+		// 并发创建时唯一索引冲突，重新读取后按已有记录领取
+		log.Logger.Infof("Failed to create result delivery %s, claim existing record: %v", key, err)
+		if record, err = s.deliveries.FindByIdempotencyKey(key); err != nil || record == nil {
+			log.Logger.Warnf("Failed to query result delivery %s: %v", key, err)
+			return 0, false, nil
+		}
+	}
+	if record.Status == succeeded {
+		return record.Attempts, true, nil
+	}
+
+	claimed, err := s.deliveries.ClaimDelivery(key, owner, resultDeliveryLease, succeeded, delivering)
+	if err != nil {
+		log.Logger.Warnf("Failed to claim result delivery %s: %v", key, err)
+		return record.Attempts, false, nil
+	}
+	if !claimed {
+		// 领取失败时记录可能刚被其他实例投递成功
+		if latest, err := s.deliveries.FindByIdempotencyKey(key); err == nil && latest != nil && latest.Status == succeeded {
+			return latest.Attempts, true, nil
+		}
+		return 0, false, fmt.Errorf("%w: key=%s, owner=%s", errDeliveryInProgress, key, record.LeaseOwner)
+	}
+	return record.Attempts, false, nil
+}
+
+// renewDeliveryLease 定期续期投递租约，租约丢失时调用 cancel 中止投递
+func (s *DefaultJobResultService) renewDeliveryLease(ctx context.Context, cancel context.CancelFunc, key, owner string) {
+	if s.deliveries == nil {
+		return
+	}
+	ticker := time.NewTicker(resultDeliveryRenew)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			renewed, err := s.deliveries.RenewDelivery(key, owner, resultDeliveryLease)
+			if err != nil {
+				log.Logger.Warnf("Failed to renew result delivery lease %s: %v", key, err)
+				continue
+			}
+			if !renewed {
+				log.Logger.Errorf("Lost result delivery lease %s, cancel delivery", key)
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// GetResultDeliveryStatus 查询作业结果的投递状态
+func (s *DefaultJobResultService) GetResultDeliveryStatus(ctx context.Context, req *pb.GetResultDeliveryStatusRequest) (*pb.GetResultDeliveryStatusResponse, error) {
+	if req.GetJobInstanceId() == "" {
+		return nil, fmt.Errorf("jobInstanceId is required")
+	}
+	if s.deliveries == nil {
+		return nil, fmt.Errorf("result delivery store is not available")
+	}
+	records, err := s.deliveries.FindByJob(req.GetJobInstanceId(), req.GetPartyId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query result delivery: %v", err)
+	}
+	response := &pb.GetResultDeliveryStatusResponse{
+		Records: make([]*pb.ResultDeliveryRecord, 0, len(records)),
+	}
+	for i := range records {
+		response.Records = append(response.Records, deliveryToProto(&records[i]))
+	}
+	return response, nil
+}
+
+func deliveryToProto(record *models.ResultDelivery) *pb.ResultDeliveryRecord {
+	out := &pb.ResultDeliveryRecord{
+		JobInstanceId:  record.JobInstanceID,
+		PartyId:        record.PartyID,
+		SinkType:       pb.ResultSinkType(pb.ResultSinkType_value[record.SinkType]),
+		Target:         record.Target,
+		IdempotencyKey: record.IdempotencyKey,
+		Status:         pb.ResultDeliveryStatus(pb.ResultDeliveryStatus_value[record.Status]),
+		Attempts:       int32(record.Attempts),
+		Error:          record.ErrorMessage,
+		UpdatedAt:      record.UpdatedAt.UnixMilli(),
+	}
+	if record.DeliveredAt != nil {
+		out.DeliveredAt = record.DeliveredAt.UnixMilli()
+	}
+	return out
+}
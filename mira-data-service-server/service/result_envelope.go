@@ -0,0 +1,167 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"data-service/utils"
+
+	mirapb "chainweaver.org.cn/chainweaver/mira/mira-ida-access-service/pb/mirapb"
+)
+
+// resultEnvelopeChunk 分块数字信封加密结果中的一块。
+//
+// 分块格式（isEncrypted 结果的生产方按此写入结果对象）：
+//   - 对象为 JSON Lines，每行一个 {"kek": "...", "cipherText": "..."}，空行忽略
+//   - 每块是对一段明文独立做 SM2SM4 数字信封加密的结果，kek 为 SM2 加密的 SM4 密钥，
+//     可直接交给 IDA DecByKeK（DataEnvelopAlgoType_SM2SM4DataEnvelope）解密
+//   - kek 只需出现在第一块，后续块省略时沿用上一块的 kek，出现新 kek 时切换
+//   - 各块明文按顺序拼接即为完整 CSV，块边界不必落在行边界上
+//
+// 首个非空白字符不是 '{' 时按旧格式处理：整个对象是一段 SM2 密文，一次 Decrypt 解密
+type resultEnvelopeChunk struct {
+	Kek        string `json:"kek,omitempty"`
+	CipherText string `json:"cipherText"`
+}
+
+// envelopeDecryptFunc 解密一块数字信封密文
+type envelopeDecryptFunc func(kek, cipherText string) (string, error)
+
+// envelopeDecryptReader 逐块解密数字信封密文，内存中只保留当前块的明文
+type envelopeDecryptReader struct {
+	src     *bufio.Reader
+	closer  io.Closer
+	decrypt envelopeDecryptFunc
+	kek     string
+	chunk   int
+	buf     []byte
+	err     error
+}
+
+func (r *envelopeDecryptReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.err = r.next()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// next 读取并解密下一块，忽略空行
+func (r *envelopeDecryptReader) next() error {
+	line, err := r.src.ReadBytes('\n')
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("failed to read envelope chunk %d: %v", r.chunk, err)
+	}
+	if len(bytes.TrimSpace(line)) == 0 {
+		if err == io.EOF {
+			return io.EOF
+		}
+		return nil
+	}
+
+	var chunk resultEnvelopeChunk
+	if jsonErr := json.Unmarshal(line, &chunk); jsonErr != nil {
+		return fmt.Errorf("invalid envelope chunk %d: %v", r.chunk, jsonErr)
+	}
+	if chunk.Kek != "" {
+		r.kek = chunk.Kek
+	}
+	if r.kek == "" {
+		return fmt.Errorf("envelope chunk %d has no kek", r.chunk)
+	}
+	plainText, decErr := r.decrypt(r.kek, chunk.CipherText)
+	if decErr != nil {
+		return fmt.Errorf("failed to decrypt envelope chunk %d: %v", r.chunk, decErr)
+	}
+	r.chunk++
+	r.buf = []byte(plainText)
+	return err
+}
+
+func (r *envelopeDecryptReader) Close() error {
+	if r.closer != nil {
+		return r.closer.Close()
+	}
+	return nil
+}
+
+// isEnvelopeEncrypted 判断密文是否为分块数字信封格式（首个非空白字符为 '{'），不消耗数据
+func isEnvelopeEncrypted(src *bufio.Reader) (bool, error) {
+	first, err := peekFirstNonSpace(src)
+	if err == io.EOF {
+		return false, nil
+	}
+	return first == '{', err
+}
+
+// peekFirstNonSpace 返回首个非空白字符，不消耗数据，内容为空时返回 io.EOF
+func peekFirstNonSpace(src *bufio.Reader) (byte, error) {
+	for n := 1; ; n++ {
+		peek, err := src.Peek(n)
+		if len(peek) < n {
+			return 0, err
+		}
+		switch peek[n-1] {
+		case ' ', '\t', '\r', '\n':
+			continue
+		default:
+			return peek[n-1], nil
+		}
+	}
+}
+
+// newResultDecryptReader 解密结果内容：分块数字信封逐块调用 DecByKeK 流式解密，
+// 其余按整体 SM2 密文解密
+func newResultDecryptReader(src io.ReadCloser, pubKey string) (io.ReadCloser, error) {
+	buffered := bufio.NewReaderSize(src, 64*1024)
+	envelope, err := isEnvelopeEncrypted(buffered)
+	if err != nil {
+		src.Close()
+		return nil, fmt.Errorf("failed to read encrypted content: %v", err)
+	}
+	if envelope {
+		return &envelopeDecryptReader{
+			src:    buffered,
+			closer: src,
+			decrypt: func(kek, cipherText string) (string, error) {
+				return decryptEnvelopeChunk(kek, cipherText, pubKey)
+			},
+		}, nil
+	}
+
+	content, err := io.ReadAll(buffered)
+	src.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read encrypted content: %v", err)
+	}
+	plainText, err := decryptContent(string(content), pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader([]byte(plainText))), nil
+}
+
+// decryptEnvelopeChunk 通过 IDA 数字信封接口解密一块密文
+func decryptEnvelopeChunk(kek, cipherText, pubKey string) (string, error) {
+	resp, err := utils.GetIDAService().Client.DecByKeK(context.Background(), &mirapb.DataEnvelopeDecryptRequest{
+		CipherText:          cipherText,
+		DataEnvelopAlgoType: mirapb.DataEnvelopAlgoType_SM2SM4DataEnvelope,
+		PubKey:              pubKey,
+		Kek:                 kek,
+	})
+	if err != nil {
+		return "", fmt.Errorf("DecByKeK failed: %v", err)
+	}
+	if resp.GetCode() != 0 {
+		return "", fmt.Errorf("DecByKeK failed: code=%d, msg=%s", resp.GetCode(), resp.GetMsg())
+	}
+	return resp.GetPlainText(), nil
+}
@@ -0,0 +1,350 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	pb "data-service/generated/datasource"
+	"data-service/oss"
+
+	"chainweaver.org.cn/chainweaver/mira/mira-common/types"
+)
+
+// kafkaRecordsPerRequest 每次向 Kafka REST Proxy 提交的消息数
+const kafkaRecordsPerRequest = 500
+
+// ResultSink 计算结果的投递目标
+type ResultSink interface {
+	// Type 投递类型
+	Type() pb.ResultSinkType
+	// Target 投递位置描述，记录在投递状态中，不含凭证
+	Target() string
+	// Deliver 投递一次结果，返回 permanentDeliveryError 时不再重试
+	Deliver(ctx context.Context, result *jobResult) error
+}
+
+// jobResult 待投递的计算结果
+type jobResult struct {
+	Request        *pb.PushJobResultRequest
+	IdempotencyKey string
+	// Attempt 本次是第几次尝试，包含此前请求中的尝试
+	Attempt int
+	// open 打开解密后的 CSV 结果，每次尝试重新打开
+	open func() (io.ReadCloser, error)
+}
+
+// permanentDeliveryError 重试无法恢复的投递错误
+type permanentDeliveryError struct {
+	err error
+}
+
+func (e *permanentDeliveryError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentDeliveryError) Unwrap() error {
+	return e.err
+}
+
+// webhookHostAllowed 判断 webhook 地址是否在允许列表中：条目为主机名、主机名:端口，或 *.example.com 形式的子域名通配
+func webhookHostAllowed(u *url.URL, allowed []string) bool {
+	host := strings.ToLower(u.Hostname())
+	for _, entry := range allowed {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if h, port, err := net.SplitHostPort(entry); err == nil {
+			if port != u.Port() {
+				continue
+			}
+			entry = h
+		}
+		if suffix, ok := strings.CutPrefix(entry, "*."); ok {
+			if strings.HasSuffix(host, "."+suffix) {
+				return true
+			}
+			continue
+		}
+		if host == entry {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDeliveryResponse 检查 HTTP 投递响应，408/429/5xx 可重试，其余非 2xx 不重试
+func checkDeliveryResponse(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	err := fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	if resp.StatusCode == http.StatusRequestTimeout || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		return err
+	}
+	return &permanentDeliveryError{err: err}
+}
+
+// dbResultSink 写入 mira-gateway 配置的结果数据库，表名为作业实例ID
+type dbResultSink struct {
+	dbType    int32
+	storage   *types.CalculationResultStorage
+	tlsConfig *pb.DatasourceTlsConfig
+}
+
+func (d *dbResultSink) Type() pb.ResultSinkType {
+	return pb.ResultSinkType_RESULT_SINK_TYPE_DB
+}
+
+func (d *dbResultSink) Target() string {
+	return fmt.Sprintf("db(%d)://%s:%d/%s", d.dbType, d.storage.Host, d.storage.Port, d.storage.DB)
+}
+
+// Deliver 失败时可能已插入部分数据，重试时先清空上一次写入的行再导入，避免重复
+func (d *dbResultSink) Deliver(ctx context.Context, result *jobResult) error {
+	return syncResultToDB(d.dbType, d.storage, result.Request, d.tlsConfig, result.Attempt > 1)
+}
+
+// kafkaResultSink 经 Kafka REST Proxy（v2 API）把结果逐行写入 topic
+// 消息 key 为 <幂等键>:<行号>，各行按 key 分散到不同分区，消费方可按 key 去重
+type kafkaResultSink struct {
+	client   *http.Client
+	proxyURL string
+	topic    string
+}
+
+// kafkaResultRecord 一行结果对应的消息
+type kafkaResultRecord struct {
+	Key   string             `json:"key"`
+	Value kafkaResultMessage `json:"value"`
+}
+
+type kafkaResultMessage struct {
+	JobInstanceId string            `json:"jobInstanceId"`
+	PartyId       string            `json:"partyId"`
+	Seq           int64             `json:"seq"`
+	Row           map[string]string `json:"row"`
+}
+
+func (k *kafkaResultSink) Type() pb.ResultSinkType {
+	return pb.ResultSinkType_RESULT_SINK_TYPE_KAFKA
+}
+
+func (k *kafkaResultSink) Target() string {
+	return "kafka://" + k.topic
+}
+
+func (k *kafkaResultSink) Deliver(ctx context.Context, result *jobResult) error {
+	reader, err := result.open()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	csvReader := csv.NewReader(reader)
+	headers, err := csvReader.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return &permanentDeliveryError{err: fmt.Errorf("failed to read CSV header: %v", err)}
+	}
+
+	var seq int64
+	batch := make([]kafkaResultRecord, 0, kafkaRecordsPerRequest)
+	for {
+		row, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return &permanentDeliveryError{err: fmt.Errorf("failed to read CSV record: %v", err)}
+		}
+		values := make(map[string]string, len(headers))
+		for i, header := range headers {
+			if i < len(row) {
+				values[header] = row[i]
+			}
+		}
+		batch = append(batch, kafkaResultRecord{
+			Key: fmt.Sprintf("%s:%d", result.IdempotencyKey, seq),
+			Value: kafkaResultMessage{
+				JobInstanceId: result.Request.GetJobInstanceId(),
+				PartyId:       result.Request.GetPartyId(),
+				Seq:           seq,
+				Row:           values,
+			},
+		})
+		seq++
+		if len(batch) >= kafkaRecordsPerRequest {
+			if err := k.produce(ctx, batch); err != nil {
+				return err
+			}
+			batch = batch[:0]
+		}
+	}
+	if len(batch) > 0 {
+		return k.produce(ctx, batch)
+	}
+	return nil
+}
+
+// produce 提交一批消息，REST Proxy 返回的单条消息错误同样视为失败
+func (k *kafkaResultSink) produce(ctx context.Context, records []kafkaResultRecord) error {
+	body, err := json.Marshal(map[string]interface{}{"records": records})
+	if err != nil {
+		return &permanentDeliveryError{err: fmt.Errorf("failed to marshal kafka records: %v", err)}
+	}
+	endpoint := strings.TrimSuffix(k.proxyURL, "/") + "/topics/" + url.PathEscape(k.topic)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return &permanentDeliveryError{err: err}
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to produce to kafka topic %s: %v", k.topic, err)
+	}
+	defer resp.Body.Close()
+	if err := checkDeliveryResponse(resp); err != nil {
+		return fmt.Errorf("failed to produce to kafka topic %s: %w", k.topic, err)
+	}
+
+	var produced struct {
+		Offsets []struct {
+			ErrorCode *int   `json:"error_code"`
+			Error     string `json:"error"`
+		} `json:"offsets"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&produced); err != nil {
+		return fmt.Errorf("failed to decode kafka produce response: %v", err)
+	}
+	for _, offset := range produced.Offsets {
+		if offset.ErrorCode != nil {
+			return fmt.Errorf("failed to produce to kafka topic %s: error_code=%d %s", k.topic, *offset.ErrorCode, offset.Error)
+		}
+	}
+	return nil
+}
+
+// s3ResultSink 上传结果到合作方的 S3 兼容对象存储，对象名固定，重试时覆盖
+type s3ResultSink struct {
+	client     oss.ClientInterface
+	bucketName string
+	objectName string
+}
+
+func (o *s3ResultSink) Type() pb.ResultSinkType {
+	return pb.ResultSinkType_RESULT_SINK_TYPE_S3
+}
+
+func (o *s3ResultSink) Target() string {
+	return fmt.Sprintf("s3://%s/%s", o.bucketName, o.objectName)
+}
+
+func (o *s3ResultSink) Deliver(ctx context.Context, result *jobResult) error {
+	reader, err := result.open()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	_, err = o.client.PutObject(ctx, o.bucketName, o.objectName, reader, -1, &oss.PutOptions{
+		ContentType: "text/csv",
+		Metadata: map[string]string{
+			"idempotency-key": result.IdempotencyKey,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload result to %s: %v", o.Target(), err)
+	}
+	return nil
+}
+
+// s3ResultObjectName 合作方桶中的结果对象名：<prefix>/<jobInstanceId>/<partyId>/<dataId>.csv
+func s3ResultObjectName(prefix string, req *pb.PushJobResultRequest) string {
+	dataId := strings.TrimPrefix(req.GetDataId(), "tee_")
+	return strings.TrimPrefix(path.Join(prefix, req.GetJobInstanceId(), req.GetPartyId(), dataId+".csv"), "/")
+}
+
+// webhookResultSink 以 CSV 请求体流式 POST 结果，配置了密钥时附带 HMAC-SHA256 签名。
+// 地址须在 webhook_allowed_hosts 中，且不跟随重定向
+type webhookResultSink struct {
+	client *http.Client
+	url    string
+	secret string
+}
+
+func (w *webhookResultSink) Type() pb.ResultSinkType {
+	return pb.ResultSinkType_RESULT_SINK_TYPE_WEBHOOK
+}
+
+// Target 去掉查询参数，避免记录回调地址中的令牌
+func (w *webhookResultSink) Target() string {
+	u, err := url.Parse(w.url)
+	if err != nil {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host + u.Path
+}
+
+// Deliver 签名需要在发送请求头前算出，配置了密钥时先读一遍结果计算签名，再重新打开结果流式发送
+func (w *webhookResultSink) Deliver(ctx context.Context, result *jobResult) error {
+	var signature string
+	if w.secret != "" {
+		reader, err := result.open()
+		if err != nil {
+			return err
+		}
+		mac := hmac.New(sha256.New, []byte(w.secret))
+		_, err = io.Copy(mac, reader)
+		reader.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read result content: %v", err)
+		}
+		signature = "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	reader, err := result.open()
+	if err != nil {
+		return err
+	}
+	// 请求体由 client.Do 关闭
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, reader)
+	if err != nil {
+		reader.Close()
+		return &permanentDeliveryError{err: err}
+	}
+	req.Header.Set("Content-Type", "text/csv")
+	req.Header.Set("Idempotency-Key", result.IdempotencyKey)
+	req.Header.Set("X-Mira-Job-Instance-Id", result.Request.GetJobInstanceId())
+	req.Header.Set("X-Mira-Party-Id", result.Request.GetPartyId())
+	if signature != "" {
+		req.Header.Set("X-Mira-Signature", signature)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook %s: %v", w.Target(), err)
+	}
+	defer resp.Body.Close()
+	if err := checkDeliveryResponse(resp); err != nil {
+		return fmt.Errorf("failed to call webhook %s: %w", w.Target(), err)
+	}
+	return nil
+}
@@ -0,0 +1,387 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"data-service/config"
+	"data-service/database/gorm/models"
+	pb "data-service/generated/datasource"
+	"data-service/oss"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testResultCSV = "id,name\n1,alice\n2,bob\n3,carol\n"
+
+// memoryResultDeliveries 内存投递状态存储
+type memoryResultDeliveries struct {
+	mu      sync.Mutex
+	records map[string]models.ResultDelivery
+}
+
+func newMemoryResultDeliveries() *memoryResultDeliveries {
+	return &memoryResultDeliveries{records: make(map[string]models.ResultDelivery)}
+}
+
+func (d *memoryResultDeliveries) Create(record *models.ResultDelivery) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.records[record.IdempotencyKey]; ok {
+		return fmt.Errorf("duplicate idempotency key %s", record.IdempotencyKey)
+	}
+	d.records[record.IdempotencyKey] = *record
+	return nil
+}
+
+func (d *memoryResultDeliveries) FindByIdempotencyKey(key string) (*models.ResultDelivery, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	record, ok := d.records[key]
+	if !ok {
+		return nil, nil
+	}
+	return &record, nil
+}
+
+func (d *memoryResultDeliveries) ClaimDelivery(key, owner string, lease time.Duration, doneStatus, claimedStatus string) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	record, ok := d.records[key]
+	now := time.Now()
+	if !ok || record.Status == doneStatus ||
+		(record.LeaseExpires != nil && record.LeaseExpires.After(now) && record.LeaseOwner != owner) {
+		return false, nil
+	}
+	expires := now.Add(lease)
+	record.Status, record.LeaseOwner, record.LeaseExpires = claimedStatus, owner, &expires
+	d.records[key] = record
+	return true, nil
+}
+
+func (d *memoryResultDeliveries) RenewDelivery(key, owner string, lease time.Duration) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	record, ok := d.records[key]
+	if !ok || record.LeaseOwner != owner {
+		return false, nil
+	}
+	expires := time.Now().Add(lease)
+	record.LeaseExpires = &expires
+	d.records[key] = record
+	return true, nil
+}
+
+func (d *memoryResultDeliveries) FinishDelivery(key, owner string, updates map[string]interface{}) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	record := d.records[key]
+	if record.LeaseOwner != owner {
+		return nil
+	}
+	record.LeaseOwner, record.LeaseExpires = "", nil
+	for k, v := range updates {
+		switch k {
+		case "status":
+			record.Status = v.(string)
+		case "attempts":
+			record.Attempts = v.(int)
+		case "error_message":
+			record.ErrorMessage = v.(string)
+		case "delivered_at":
+			t := v.(time.Time)
+			record.DeliveredAt = &t
+		}
+	}
+	d.records[key] = record
+	return nil
+}
+
+func (d *memoryResultDeliveries) FindByJob(jobInstanceID, partyID string) ([]models.ResultDelivery, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var records []models.ResultDelivery
+	for _, record := range d.records {
+		if record.JobInstanceID == jobInstanceID && (partyID == "" || record.PartyID == partyID) {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+func (c *memoryOSSClient) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts *oss.PutOptions) (interface{}, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	c.put(bucketName, objectName, data)
+	return nil, nil
+}
+
+func newTestJobResultService(conf config.ResultDeliveryConfig) *DefaultJobResultService {
+	return &DefaultJobResultService{
+		conf:       conf,
+		deliveries: newMemoryResultDeliveries(),
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+		maxRetries: 2,
+		retryDelay: time.Millisecond,
+		openResult: func(req *pb.PushJobResultRequest) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(testResultCSV)), nil
+		},
+	}
+}
+
+func testPushRequest(sink *pb.ResultSink) *pb.PushJobResultRequest {
+	return &pb.PushJobResultRequest{
+		JobInstanceId: "job-1",
+		ChainInfoId:   "chain-1",
+		PartyId:       "party-a",
+		DataId:        "tee_result-1",
+		Sink:          sink,
+	}
+}
+
+func TestDeliverResult_WebhookRetriesAndIsIdempotent(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, testResultCSV, string(body))
+		assert.Equal(t, "text/csv", r.Header.Get("Content-Type"))
+		assert.True(t, strings.HasPrefix(r.Header.Get("Idempotency-Key"), "job-1:party-a:"))
+		assert.Equal(t, "job-1", r.Header.Get("X-Mira-Job-Instance-Id"))
+
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write(body)
+		assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), r.Header.Get("X-Mira-Signature"))
+
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	svc := newTestJobResultService(config.ResultDeliveryConfig{WebhookSecret: "secret", WebhookAllowedHosts: []string{"127.0.0.1"}})
+	req := testPushRequest(&pb.ResultSink{Type: pb.ResultSinkType_RESULT_SINK_TYPE_WEBHOOK, Url: server.URL + "/hook?token=abc"})
+	sink, err := svc.resolveResultSink(req)
+	require.NoError(t, err)
+	assert.Equal(t, server.URL+"/hook", sink.Target())
+
+	require.NoError(t, svc.deliverResult(context.Background(), req, sink))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	// 已成功的投递不再重复调用
+	require.NoError(t, svc.deliverResult(context.Background(), req, sink))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	resp, err := svc.GetResultDeliveryStatus(context.Background(), &pb.GetResultDeliveryStatusRequest{JobInstanceId: "job-1"})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 1)
+	record := resp.Records[0]
+	assert.Equal(t, pb.ResultDeliveryStatus_RESULT_DELIVERY_STATUS_SUCCEEDED, record.Status)
+	assert.Equal(t, pb.ResultSinkType_RESULT_SINK_TYPE_WEBHOOK, record.SinkType)
+	assert.Equal(t, int32(2), record.Attempts)
+	assert.NotZero(t, record.DeliveredAt)
+}
+
+func TestDeliverResult_PermanentFailureNotRetried(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		http.Error(w, "bad payload", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	svc := newTestJobResultService(config.ResultDeliveryConfig{WebhookAllowedHosts: []string{"127.0.0.1"}})
+	req := testPushRequest(&pb.ResultSink{Type: pb.ResultSinkType_RESULT_SINK_TYPE_WEBHOOK, Url: server.URL})
+	sink, err := svc.resolveResultSink(req)
+	require.NoError(t, err)
+
+	err = svc.deliverResult(context.Background(), req, sink)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bad payload")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	record, _ := svc.deliveries.FindByIdempotencyKey(resultIdempotencyKey(req, sink))
+	require.NotNil(t, record)
+	assert.Equal(t, pb.ResultDeliveryStatus_RESULT_DELIVERY_STATUS_FAILED.String(), record.Status)
+	assert.Equal(t, 1, record.Attempts)
+}
+
+func TestDeliverResult_KafkaRestProxy(t *testing.T) {
+	var produced []kafkaResultRecord
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/topics/partner-results", r.URL.Path)
+		assert.Equal(t, "application/vnd.kafka.json.v2+json", r.Header.Get("Content-Type"))
+		var body struct {
+			Records []kafkaResultRecord `json:"records"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		produced = append(produced, body.Records...)
+		w.Header().Set("Content-Type", "application/vnd.kafka.v2+json")
+		w.Write([]byte(`{"offsets":[{"partition":0,"offset":1,"error_code":null,"error":null}]}`))
+	}))
+	defer server.Close()
+
+	svc := newTestJobResultService(config.ResultDeliveryConfig{KafkaRestProxy: server.URL})
+	req := testPushRequest(&pb.ResultSink{Type: pb.ResultSinkType_RESULT_SINK_TYPE_KAFKA, Topic: "partner-results"})
+	sink, err := svc.resolveResultSink(req)
+	require.NoError(t, err)
+	require.NoError(t, svc.deliverResult(context.Background(), req, sink))
+
+	require.Len(t, produced, 3)
+	for i, record := range produced {
+		assert.Equal(t, fmt.Sprintf("%s:%d", resultIdempotencyKey(req, sink), i), record.Key)
+		assert.Equal(t, int64(i), record.Value.Seq)
+		assert.Equal(t, "party-a", record.Value.PartyId)
+	}
+	assert.Equal(t, map[string]string{"id": "2", "name": "bob"}, produced[1].Value.Row)
+}
+
+func TestDeliverResult_S3(t *testing.T) {
+	client := newMemoryOSSClient()
+	svc := newTestJobResultService(config.ResultDeliveryConfig{})
+	svc.credentials = map[string]config.ExportCredentialConfig{"partner": {Endpoint: "partner-minio:9000"}}
+	svc.newOSSClient = func(cred config.ExportCredentialConfig) (oss.ClientInterface, error) {
+		assert.Equal(t, "partner-minio:9000", cred.Endpoint)
+		return client, nil
+	}
+
+	req := testPushRequest(&pb.ResultSink{
+		Type:          pb.ResultSinkType_RESULT_SINK_TYPE_S3,
+		BucketName:    "partner-bucket",
+		ObjectPrefix:  "mira",
+		CredentialRef: "partner",
+	})
+	sink, err := svc.resolveResultSink(req)
+	require.NoError(t, err)
+	assert.Equal(t, "s3://partner-bucket/mira/job-1/party-a/result-1.csv", sink.Target())
+	require.NoError(t, svc.deliverResult(context.Background(), req, sink))
+
+	reader, err := client.GetObject(context.Background(), "partner-bucket", "mira/job-1/party-a/result-1.csv", nil)
+	require.NoError(t, err)
+	data, _ := io.ReadAll(reader)
+	assert.Equal(t, testResultCSV, string(data))
+
+	_, err = svc.resolveResultSink(testPushRequest(&pb.ResultSink{Type: pb.ResultSinkType_RESULT_SINK_TYPE_S3, BucketName: "b", CredentialRef: "missing"}))
+	assert.Error(t, err)
+}
+
+func TestWebhookHostAllowed(t *testing.T) {
+	allowed := []string{"hooks.partner.com", "*.example.org", "10.0.0.5:8443"}
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{"https://hooks.partner.com/result", true},
+		{"https://HOOKS.partner.com:9443/result", true},
+		{"https://a.b.example.org/result", true},
+		{"https://example.org/result", false},
+		{"https://10.0.0.5:8443/result", true},
+		{"https://10.0.0.5/result", false},
+		{"http://169.254.169.254/latest/meta-data", false},
+		{"http://hooks.partner.com.evil.com/", false},
+	}
+	for _, tt := range tests {
+		u, err := url.Parse(tt.url)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, webhookHostAllowed(u, allowed), tt.url)
+	}
+	u, _ := url.Parse("https://hooks.partner.com/")
+	assert.False(t, webhookHostAllowed(u, nil))
+
+	svc := newTestJobResultService(config.ResultDeliveryConfig{})
+	_, err := svc.resolveResultSink(testPushRequest(&pb.ResultSink{Type: pb.ResultSinkType_RESULT_SINK_TYPE_WEBHOOK, Url: "http://169.254.169.254/"}))
+	assert.ErrorContains(t, err, "webhook_allowed_hosts")
+}
+
+func TestDeliverResult_WebhookDoesNotFollowRedirects(t *testing.T) {
+	var redirected int32
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&redirected, 1)
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+
+	svc := newTestJobResultService(config.ResultDeliveryConfig{WebhookAllowedHosts: []string{"127.0.0.1"}})
+	req := testPushRequest(&pb.ResultSink{Type: pb.ResultSinkType_RESULT_SINK_TYPE_WEBHOOK, Url: server.URL})
+	sink, err := svc.resolveResultSink(req)
+	require.NoError(t, err)
+	assert.Error(t, svc.deliverResult(context.Background(), req, sink))
+	assert.Zero(t, atomic.LoadInt32(&redirected))
+}
+
+// blockingResultSink 投递时阻塞到 release 关闭，记录投递次数
+type blockingResultSink struct {
+	started chan struct{}
+	release chan struct{}
+	calls   int32
+}
+
+func (b *blockingResultSink) Type() pb.ResultSinkType {
+	return pb.ResultSinkType_RESULT_SINK_TYPE_WEBHOOK
+}
+
+func (b *blockingResultSink) Target() string {
+	return "blocking"
+}
+
+func (b *blockingResultSink) Deliver(ctx context.Context, result *jobResult) error {
+	atomic.AddInt32(&b.calls, 1)
+	close(b.started)
+	<-b.release
+	return nil
+}
+
+func TestDeliverResult_ConcurrentDeliveryIsClaimedOnce(t *testing.T) {
+	sink := &blockingResultSink{started: make(chan struct{}), release: make(chan struct{})}
+	first := newTestJobResultService(config.ResultDeliveryConfig{})
+	// 另一个实例共享同一投递状态存储
+	second := newTestJobResultService(config.ResultDeliveryConfig{})
+	second.deliveries = first.deliveries
+	req := testPushRequest(nil)
+
+	done := make(chan error, 1)
+	go func() { done <- first.deliverResult(context.Background(), req, sink) }()
+	<-sink.started
+
+	assert.ErrorIs(t, first.deliverResult(context.Background(), req, sink), errDeliveryInProgress)
+	assert.ErrorIs(t, second.deliverResult(context.Background(), req, sink), errDeliveryInProgress)
+
+	close(sink.release)
+	require.NoError(t, <-done)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&sink.calls))
+
+	// 投递成功后再次推送直接返回
+	require.NoError(t, second.deliverResult(context.Background(), req, sink))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&sink.calls))
+
+	// 租约过期的投递可被其他实例重新领取
+	key := resultIdempotencyKey(req, sink)
+	store := first.deliveries.(*memoryResultDeliveries)
+	expired := time.Now().Add(-time.Second)
+	store.records[key] = models.ResultDelivery{IdempotencyKey: key, Status: pb.ResultDeliveryStatus_RESULT_DELIVERY_STATUS_DELIVERING.String(),
+		LeaseOwner: "crashed", LeaseExpires: &expired, Attempts: 1}
+	attempts, delivered, err := second.claimDelivery(key, "second", req, sink)
+	require.NoError(t, err)
+	assert.False(t, delivered)
+	assert.Equal(t, 1, attempts)
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	pb "data-service/generated/datasource"
+	"data-service/utils"
+
+	"github.com/iancoleman/orderedmap"
+)
+
+// JobResultQuery 计算结果查询参数
+type JobResultQuery struct {
+	ChainInfoId   string
+	JobInstanceId string
+	PartyId       string
+	DataId        string
+	IsEncrypted   bool
+	PubKey        string
+}
+
+// JobResultRows 按行读取计算结果
+// CSV 结果边读边解密逐行返回；JSON 结果（软件/硬件执行引擎格式）需整体转换后再逐行返回
+type JobResultRows struct {
+	columns []string
+	next    func() ([]string, error)
+	closer  io.Closer
+}
+
+// OpenJobResult 打开参与方的计算结果，isEncrypted 时解密
+func OpenJobResult(query *JobResultQuery) (*JobResultRows, error) {
+	objectName := resultObjectName(&pb.PushJobResultRequest{
+		ChainInfoId:   query.ChainInfoId,
+		JobInstanceId: query.JobInstanceId,
+		PartyId:       query.PartyId,
+		DataId:        query.DataId,
+	})
+	reader, err := getResultContentReader(query.IsEncrypted, query.PubKey, objectName)
+	if err != nil {
+		return nil, err
+	}
+	return NewJobResultRows(reader)
+}
+
+// NewJobResultRows 从明文结果内容创建 JobResultRows，首个非空白字符为 '[' 或 '{' 时按 JSON 结果解析，否则按 CSV
+func NewJobResultRows(src io.ReadCloser) (*JobResultRows, error) {
+	buffered := bufio.NewReader(src)
+	first, err := peekFirstNonSpace(buffered)
+	if err == io.EOF {
+		src.Close()
+		return &JobResultRows{next: func() ([]string, error) { return nil, io.EOF }}, nil
+	}
+	if err != nil {
+		src.Close()
+		return nil, fmt.Errorf("failed to read result content: %v", err)
+	}
+
+	if first == '[' || first == '{' {
+		content, err := io.ReadAll(buffered)
+		src.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read result content: %v", err)
+		}
+		return jsonResultRows(string(content))
+	}
+
+	csvReader := csv.NewReader(buffered)
+	columns, err := csvReader.Read()
+	if err != nil {
+		src.Close()
+		return nil, fmt.Errorf("failed to read CSV header: %v", err)
+	}
+	return &JobResultRows{
+		columns: columns,
+		next:    csvReader.Read,
+		closer:  src,
+	}, nil
+}
+
+// jsonResultRows 按软件格式转换 JSON 结果，失败时按硬件格式转换，列顺序取第一行的字段顺序
+func jsonResultRows(content string) (*JobResultRows, error) {
+	merged, err := utils.TransformSoftwareFormat([]string{content})
+	if err != nil {
+		merged, err = utils.TransformHardwareFormat([]string{content})
+		if err != nil {
+			return nil, fmt.Errorf("failed to transform result: %v", err)
+		}
+	}
+
+	var records []*orderedmap.OrderedMap
+	if merged != "" {
+		if err := json.Unmarshal([]byte(merged), &records); err != nil {
+			return nil, fmt.Errorf("failed to parse transformed result: %v", err)
+		}
+	}
+	var columns []string
+	if len(records) > 0 {
+		columns = records[0].Keys()
+	}
+
+	i := 0
+	return &JobResultRows{
+		columns: columns,
+		next: func() ([]string, error) {
+			if i >= len(records) {
+				return nil, io.EOF
+			}
+			row := make([]string, len(columns))
+			for j, column := range columns {
+				if value, ok := records[i].Get(column); ok {
+					row[j] = formatResultValue(value)
+				}
+			}
+			i++
+			return row, nil
+		},
+	}, nil
+}
+
+// formatResultValue 标量按原样输出，数值不使用科学计数法，嵌套对象和数组输出为 JSON
+func formatResultValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(encoded)
+	}
+}
+
+// Columns 结果列名
+func (r *JobResultRows) Columns() []string {
+	return r.columns
+}
+
+// Next 返回下一行，结束时返回 io.EOF
+func (r *JobResultRows) Next() ([]string, error) {
+	return r.next()
+}
+
+func (r *JobResultRows) Close() error {
+	if r.closer != nil {
+		return r.closer.Close()
+	}
+	return nil
+}
+
+// CopyJobResultRows 跳过 offset 行后写出至多 limit 行（limit<=0 时不限制），
+// 返回写出的行数以及之后是否还有数据
+func CopyJobResultRows(rows *JobResultRows, w utils.ResultRowWriter, offset, limit int64) (int64, bool, error) {
+	if err := w.WriteHeader(rows.Columns()); err != nil {
+		return 0, false, err
+	}
+	for skipped := int64(0); skipped < offset; skipped++ {
+		if _, err := rows.Next(); err == io.EOF {
+			return 0, false, w.Close()
+		} else if err != nil {
+			return 0, false, err
+		}
+	}
+
+	var written int64
+	for limit <= 0 || written < limit {
+		row, err := rows.Next()
+		if err == io.EOF {
+			return written, false, w.Close()
+		}
+		if err != nil {
+			return written, false, err
+		}
+		if err := w.WriteRow(row); err != nil {
+			return written, false, err
+		}
+		written++
+	}
+
+	_, err := rows.Next()
+	hasMore := err == nil
+	if err != nil && err != io.EOF {
+		return written, false, err
+	}
+	return written, hasMore, w.Close()
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"data-service/common"
+	"data-service/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openTestRows(t *testing.T, content string) *JobResultRows {
+	rows, err := NewJobResultRows(io.NopCloser(strings.NewReader(content)))
+	require.NoError(t, err)
+	return rows
+}
+
+func copyTestRows(t *testing.T, rows *JobResultRows, offset, limit int64) (string, int64, bool) {
+	var buf bytes.Buffer
+	writer, err := utils.NewResultRowWriter(common.FILE_FORMAT_CSV, &buf)
+	require.NoError(t, err)
+	written, hasMore, err := CopyJobResultRows(rows, writer, offset, limit)
+	require.NoError(t, err)
+	return buf.String(), written, hasMore
+}
+
+func TestNewJobResultRows_CSV(t *testing.T) {
+	rows := openTestRows(t, "id,name\n1,a\n2,b\n")
+	defer rows.Close()
+
+	assert.Equal(t, []string{"id", "name"}, rows.Columns())
+	row, err := rows.Next()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "a"}, row)
+}
+
+func TestNewJobResultRows_SoftwareJSON(t *testing.T) {
+	rows := openTestRows(t, ` [{"id":"1","score":1.5},{"id":"2","score":100000000}]`)
+	defer rows.Close()
+
+	out, written, hasMore := copyTestRows(t, rows, 0, 0)
+	assert.Equal(t, "id,score\n1,1.5\n2,100000000\n", out)
+	assert.Equal(t, int64(2), written)
+	assert.False(t, hasMore)
+}
+
+func TestNewJobResultRows_Empty(t *testing.T) {
+	rows := openTestRows(t, "  \n")
+	assert.Empty(t, rows.Columns())
+	_, err := rows.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestCopyJobResultRows_Pagination(t *testing.T) {
+	content := "id\n1\n2\n3\n4\n5\n"
+
+	out, written, hasMore := copyTestRows(t, openTestRows(t, content), 1, 2)
+	assert.Equal(t, "id\n2\n3\n", out)
+	assert.Equal(t, int64(2), written)
+	assert.True(t, hasMore)
+
+	out, written, hasMore = copyTestRows(t, openTestRows(t, content), 3, 2)
+	assert.Equal(t, "id\n4\n5\n", out)
+	assert.Equal(t, int64(2), written)
+	assert.False(t, hasMore)
+
+	out, written, hasMore = copyTestRows(t, openTestRows(t, content), 10, 2)
+	assert.Equal(t, "id\n", out)
+	assert.Equal(t, int64(0), written)
+	assert.False(t, hasMore)
+}
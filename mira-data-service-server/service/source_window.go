@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"data-service/config"
+	"data-service/database"
+	ds "data-service/generated/datasource"
+	"data-service/log"
+	"data-service/utils"
+
+	"github.com/google/uuid"
+)
+
+// sourceWindowQuery 以源库方言构建读取窗口查询，过滤、采样、排序与分页都在源库执行；
+// 未设置分页或采样时返回空查询，由 Doris 读取 JDBC 外部表导入
+func sourceWindowQuery(strategy database.DatabaseStrategy, tableName string, columns []string, window *ds.ReadWindow) (string, []interface{}, error) {
+	w, err := database.NewReadWindow(window.GetLimit(), window.GetOffset(), window.GetSample())
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid read window: %v", err)
+	}
+	if w == nil {
+		return "", nil, nil
+	}
+	filterNames, filterOperators, filterValues := splitFilterConditions(window.GetFilterConditions())
+	return strategy.BuildWithConditionQuery(tableName, columns, filterNames, filterOperators, filterValues, window.GetSortRules(), w)
+}
+
+// importSourceWindow 在源库执行读取窗口查询，结果以 Stream Load 写入 dbName.tableName，返回导入的行数。
+// 只有窗口内的行离开源库；设置了容错阈值时被过滤的行记录到 s.rejects
+func (s *DorisService) importSourceWindow(reqId, assetName, chainInfoId, alias, dbName, tableName string, columns []string) (int64, error) {
+	connInfo, err := utils.GetDatasourceByAssetName(reqId, assetName, chainInfoId, alias)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get datasource of asset %s: %v", assetName, err)
+	}
+	dbType := utils.ConvertDataSourceType(connInfo.Dbtype)
+	strategy, err := database.DatabaseFactory(dbType, connInfo)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create database strategy: %v", err)
+	}
+	if err := strategy.ConnectToDBWithPass(connInfo); err != nil {
+		return 0, fmt.Errorf("failed to connect to source database: %v", err)
+	}
+	// Doris 使用全局连接池，不关闭
+	if dbType != ds.DataSourceType_DATA_SOURCE_TYPE_DORIS {
+		defer strategy.Close()
+	}
+
+	query, args, err := sourceWindowQuery(strategy, connInfo.TableName, columns, s.sourceWindow)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build source window query: %v", err)
+	}
+	if query == "" {
+		return 0, fmt.Errorf("read window of %s has no limit, offset or sample", assetName)
+	}
+	log.Logger.Infof("Reading window of asset %s from source: %s", assetName, query)
+	rows, err := database.GetDB(strategy).Query(query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query source window: %v", err)
+	}
+	defer rows.Close()
+
+	schema, err := rowsArrowSchema(rows)
+	if err != nil {
+		return 0, err
+	}
+	if dbName == "" {
+		dbName = s.GetDBName()
+	}
+	conf := config.GetConfigMap()
+	label := streamLoadLabel("import_window", dbName, tableName, uuid.NewString())
+	writer := NewStreamLoadWriter(context.Background(), NewStreamLoadClient(conf), dbName, tableName, schema, label)
+	if s.tolerance.Enabled() {
+		writer.SetErrorTolerance(s.tolerance, s.rejects)
+	}
+	if _, err := scanRowsToArrow(rows, schema, queryBatchRows(conf), writer.Write); err != nil {
+		writer.Abort()
+		return 0, err
+	}
+	summary, err := writer.Commit()
+	if err != nil {
+		writer.Abort()
+		return 0, err
+	}
+	log.Logger.Infof("Stream loaded read window of asset %s into %s.%s, label: %s, loaded: %d, filtered: %d",
+		assetName, dbName, tableName, summary.Label, summary.LoadedRows, summary.FilteredRows)
+	return summary.LoadedRows, nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"testing"
+
+	"data-service/database"
+	pb "data-service/generated/datasource"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceWindowQuery(t *testing.T) {
+	window := &pb.ReadWindow{
+		FilterConditions: []*pb.FilterCondition{{
+			FieldName:  "amount",
+			Operator:   pb.FilterOperator_GREATER_THAN,
+			FieldValue: &pb.FilterValue{IntValue: 10},
+		}},
+		SortRules: []*pb.SortRule{{FieldName: "id"}},
+		Limit:     100,
+		Offset:    20,
+		Sample:    &pb.ReadSample{Percent: 5, Seed: 42},
+	}
+
+	// 分页与采样在源库方言的查询中执行
+	query, args, err := sourceWindowQuery(&database.MySQLStrategy{}, "orders", []string{"id", "amount"}, window)
+	require.NoError(t, err)
+	assert.Contains(t, query, "FROM orders")
+	assert.Contains(t, query, "amount > ?")
+	assert.Contains(t, query, "RAND(42) < 0.05")
+	assert.Contains(t, query, "LIMIT 100 OFFSET 20")
+	assert.Len(t, args, 1)
+
+	query, _, err = sourceWindowQuery(&database.KingbaseStrategy{}, "orders", []string{"id", "amount"}, window)
+	require.NoError(t, err)
+	assert.Contains(t, query, "TABLESAMPLE BERNOULLI (5) REPEATABLE (42)")
+	assert.Contains(t, query, "LIMIT 100 OFFSET 20")
+
+	// 按行数采样
+	window.Sample = &pb.ReadSample{Rows: 50, Seed: 7}
+	query, _, err = sourceWindowQuery(&database.MySQLStrategy{}, "orders", nil, window)
+	require.NoError(t, err)
+	assert.Contains(t, query, "ORDER BY RAND(7) LIMIT 50")
+
+	// 只有过滤与排序时由 Doris 读取外部表导入
+	query, _, err = sourceWindowQuery(&database.MySQLStrategy{}, "orders", nil, &pb.ReadWindow{SortRules: window.SortRules})
+	require.NoError(t, err)
+	assert.Empty(t, query)
+
+	_, _, err = sourceWindowQuery(&database.MySQLStrategy{}, "orders", nil, &pb.ReadWindow{Limit: -1})
+	assert.ErrorContains(t, err, "invalid read window")
+}
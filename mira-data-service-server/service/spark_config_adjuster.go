@@ -8,7 +8,9 @@ import (
 // AdjustSparkConfigByTableInfo 根据表信息调整SparkConfig参数
 func AdjustSparkConfigByTableInfo(tableInfo *pb.TableInfoResponse, baseConfig *pb.SparkConfig) *pb.SparkConfig {
 	if tableInfo == nil {
-		return getDefaultSparkConfig()
+		config := getDefaultSparkConfig()
+		config.RetryPolicy = baseConfig.GetRetryPolicy()
+		return config
 	}
 
 	// 获取配置
@@ -17,16 +19,16 @@ func AdjustSparkConfigByTableInfo(tableInfo *pb.TableInfoResponse, baseConfig *p
 
 	// 根据记录数量选择合适的配置
 	var selectedConfig *config.SparkExecutionConfig
-	recordCount := tableInfo.GetRecordCount()
+	recordCount := tableInfo.GetRecordCount64()
 
 	// 按数据量级别选择配置
-	if int64(recordCount) <= executionConfigs.SmallData.RecordCountThreshold {
+	if recordCount <= executionConfigs.SmallData.RecordCountThreshold {
 		selectedConfig = &executionConfigs.SmallData
-	} else if int64(recordCount) <= executionConfigs.MediumData.RecordCountThreshold {
+	} else if recordCount <= executionConfigs.MediumData.RecordCountThreshold {
 		selectedConfig = &executionConfigs.MediumData
-	} else if int64(recordCount) <= executionConfigs.LargeData.RecordCountThreshold {
+	} else if recordCount <= executionConfigs.LargeData.RecordCountThreshold {
 		selectedConfig = &executionConfigs.LargeData
-	} else if int64(recordCount) <= executionConfigs.XLargeData.RecordCountThreshold {
+	} else if recordCount <= executionConfigs.XLargeData.RecordCountThreshold {
 		selectedConfig = &executionConfigs.XLargeData
 	} else {
 		// 超过最大配置，使用最大配置
@@ -44,6 +46,8 @@ func AdjustSparkConfigByTableInfo(tableInfo *pb.TableInfoResponse, baseConfig *p
 		DriverCores:                   selectedConfig.DriverCores,
 		Parallelism:                   selectedConfig.Parallelism,
 		NumPartitions:                 selectedConfig.NumPartitions,
+		// 重试策略沿用请求中的配置
+		RetryPolicy: baseConfig.GetRetryPolicy(),
 	}
 
 	return config
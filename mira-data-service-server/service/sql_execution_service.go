@@ -15,7 +15,6 @@ import (
 	"github.com/apache/arrow/go/v15/arrow/array"
 	"github.com/apache/arrow/go/v15/arrow/ipc"
 	"github.com/apache/arrow/go/v15/arrow/memory"
-	"github.com/google/uuid"
 	"google.golang.org/grpc"
 )
 
@@ -258,27 +257,10 @@ func (s *SqlExecutionService) convertDorisColumnTypeToArrowType(colType *sql.Col
 
 // ExecuteSqlWithTableOutput 执行SQL并将结果写入目标表
 func (s *SqlExecutionService) ExecuteSqlWithTableOutput(sql, targetTableName string, stream grpc.ServerStreamingServer[pb.ExecuteSqlResponse]) error {
-	// 1. 先获取列信息：拼接 LIMIT 1
-	limitedSQL := fmt.Sprintf("SELECT * FROM (%s) sub LIMIT 0", sql)
-	rows, done, err := s.dorisService.ExecuteSQL(limitedSQL)
-	if err != nil {
-		return fmt.Errorf("failed to execute SQL: %v", err)
-	}
-	if done != nil {
-		defer done()
-	} else {
-		defer rows.Close()
-	}
-
-	// 2. 获取列信息
-	columns, err := rows.Columns()
+	// 1-2. 获取列信息
+	columns, columnTypes, err := s.queryColumns(sql)
 	if err != nil {
-		return fmt.Errorf("failed to get columns: %v", err)
-	}
-
-	columnTypes, err := rows.ColumnTypes()
-	if err != nil {
-		return fmt.Errorf("failed to get column types: %v", err)
+		return err
 	}
 
 	dbName := s.dorisService.GetDBName()
@@ -292,16 +274,7 @@ func (s *SqlExecutionService) ExecuteSqlWithTableOutput(sql, targetTableName str
 	}
 
 	// 4. 执行查询结果并插入到目标表
-	quotedColumns := make([]string, len(columns))
-	for i, col := range columns {
-		quotedColumns[i] = fmt.Sprintf("`%s`", col)
-	}
-	InsertSQL := fmt.Sprintf(`
-		INSERT INTO %s (%s) 
-		SELECT %s FROM (%s) sub
-	`, targetTableName, strings.Join(quotedColumns, ", "), strings.Join(quotedColumns, ", "), sql)
-
-	totalRows, err := s.dorisService.ExecuteUpdate(InsertSQL)
+	totalRows, err := s.dorisService.ExecuteUpdate(targetInsertSQL(targetTableName, columns, sql))
 	if err != nil {
 		return fmt.Errorf("failed to insert into target table: %v", err)
 	}
@@ -320,15 +293,117 @@ func (s *SqlExecutionService) ExecuteSqlWithTableOutput(sql, targetTableName str
 	return stream.Send(response)
 }
 
+// targetInsertSQL 构建将查询结果写入目标表的 INSERT ... SELECT
+func targetInsertSQL(targetTableName string, columns []string, sql string) string {
+	quotedColumns := make([]string, len(columns))
+	for i, col := range columns {
+		quotedColumns[i] = fmt.Sprintf("`%s`", col)
+	}
+	return fmt.Sprintf(`
+		INSERT INTO %s (%s) 
+		SELECT %s FROM (%s) sub
+	`, targetTableName, strings.Join(quotedColumns, ", "), strings.Join(quotedColumns, ", "), sql)
+}
+
+// queryColumns 以 LIMIT 0 执行查询，只获取结果的列名和类型
+func (s *SqlExecutionService) queryColumns(sql string) ([]string, []*sql.ColumnType, error) {
+	limitedSQL := fmt.Sprintf("SELECT * FROM (%s) sub LIMIT 0", sql)
+	rows, done, err := s.dorisService.ExecuteSQL(limitedSQL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to execute SQL: %v", err)
+	}
+	if done != nil {
+		defer done()
+	} else {
+		defer rows.Close()
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get columns: %v", err)
+	}
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get column types: %v", err)
+	}
+	return columns, columnTypes, nil
+}
+
+// DryRunSql 试运行：返回执行计划和 Doris EXPLAIN，不执行语句、不建表
+func (s *SqlExecutionService) DryRunSql(sql, targetTableName string, stream grpc.ServerStreamingServer[pb.ExecuteSqlResponse]) error {
+	plan, err := s.planSql(sql, targetTableName)
+	if err != nil {
+		return err
+	}
+	return stream.Send(&pb.ExecuteSqlResponse{
+		Success: true,
+		Message: "Dry run: statement not executed",
+		Plan:    plan,
+	})
+}
+
+// planSql 生成 SQL 的执行计划，SELECT 和 INSERT 附带 EXPLAIN，有目标表时附带建表和写入语句
+func (s *SqlExecutionService) planSql(sql, targetTableName string) (*pb.ExecutionPlan, error) {
+	planner := newDryRunPlanner(s.dorisService, nil)
+	sqlUpper := strings.ToUpper(strings.TrimSpace(sql))
+
+	if targetTableName == "" {
+		plan := newExecutionPlan("doris")
+		var step *pb.PlanStep
+		switch {
+		case strings.HasPrefix(sqlUpper, "SELECT"):
+			step = addPlanStep(plan, planActionQuery, "execute the query and stream the result as Arrow batches", sql)
+		case strings.HasPrefix(sqlUpper, "INSERT"):
+			step = addPlanStep(plan, planActionExecute, "execute the statement and return affected rows", sql)
+		default:
+			addPlanStep(plan, planActionExecute, "execute the statement and return affected rows", sql)
+			addPlanWarning(plan, "EXPLAIN is only available for SELECT and INSERT statements")
+			return plan, nil
+		}
+		explain, err := planner.explain(sql)
+		if err != nil {
+			return nil, fmt.Errorf("failed to explain sql: %v", err)
+		}
+		step.Explain = explain
+		plan.EstimatedRows = explainCardinality(explain)
+		return plan, nil
+	}
+
+	// 目标表尚不存在，EXPLAIN 查询本身
+	plan := newExecutionPlan("doris->table")
+	columns, columnTypes, err := s.queryColumns(sql)
+	if err != nil {
+		return nil, err
+	}
+	explain, err := planner.explain(sql)
+	if err != nil {
+		return nil, fmt.Errorf("failed to explain sql: %v", err)
+	}
+	dbName := s.dorisService.GetDBName()
+	addPlanStep(plan, planActionCreateTable, fmt.Sprintf("create table %s.%s with an auto-increment primary key", dbName, targetTableName),
+		targetTableSQL(dbName, targetTableName, autoIncrementKeyName(), columns, columnTypes))
+	addPlanObject(plan, planObjectDorisTable, dbName+"."+targetTableName)
+	step := addPlanStep(plan, planActionInsert, fmt.Sprintf("insert the query result into %s.%s", dbName, targetTableName),
+		targetInsertSQL(targetTableName, columns, sql))
+	step.Explain = explain
+	plan.EstimatedRows = explainCardinality(explain)
+	return plan, nil
+}
+
 // createTargetTable 创建目标表
 func (s *SqlExecutionService) createTargetTable(dbName string, tableName string, columns []string, columnTypes []*sql.ColumnType) error {
-	var columnDefs []string
+	_, err := s.dorisService.ExecuteUpdate(targetTableSQL(dbName, tableName, autoIncrementKeyName(), columns, columnTypes))
+	if err != nil {
+		return fmt.Errorf("failed to create target table: %v", err)
+	}
 
-	// 使用UUID生成5位随机字符串作为主键字段名的一部分
-	uuidStr := uuid.New().String()
-	// 取UUID的前5位字符（去掉连字符）
-	randomSuffix := strings.ReplaceAll(uuidStr[:8], "-", "")[:5]
-	primaryKeyFieldName := fmt.Sprintf("pk_%s", randomSuffix)
+	return nil
+}
+
+// targetTableSQL 构建目标表建表SQL，另加自增主键 primaryKeyFieldName
+func targetTableSQL(dbName string, tableName string, primaryKeyFieldName string, columns []string, columnTypes []*sql.ColumnType) string {
+	var columnDefs []string
 	primaryKeyField := fmt.Sprintf("`%s` BIGINT NOT NULL AUTO_INCREMENT", primaryKeyFieldName)
 	columnDefs = append(columnDefs, primaryKeyField)
 
@@ -339,7 +414,7 @@ func (s *SqlExecutionService) createTargetTable(dbName string, tableName string,
 		columnDefs = append(columnDefs, columnDef)
 	}
 
-	createTableSQL := fmt.Sprintf(`
+	return fmt.Sprintf(`
 		CREATE TABLE IF NOT EXISTS %s.%s (
 			%s
 		)
@@ -350,13 +425,6 @@ func (s *SqlExecutionService) createTargetTable(dbName string, tableName string,
 			"replication_num" = "1"
 		)
 	`, dbName, tableName, strings.Join(columnDefs, ",\n\t\t"), primaryKeyFieldName, primaryKeyFieldName)
-
-	_, err := s.dorisService.ExecuteUpdate(createTableSQL)
-	if err != nil {
-		return fmt.Errorf("failed to create target table: %v", err)
-	}
-
-	return nil
 }
 
 // insertBatchToTable 批量插入数据到目标表
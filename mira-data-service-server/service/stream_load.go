@@ -0,0 +1,493 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"data-service/common"
+	"data-service/config"
+	log "data-service/log"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+	"github.com/apache/arrow/go/v15/arrow/util"
+)
+
+// Stream Load 返回的导入状态
+const (
+	streamLoadStatusSuccess        = "Success"
+	streamLoadStatusPublishTimeout = "Publish Timeout"
+	streamLoadStatusLabelExists    = "Label Already Exists"
+)
+
+// label 已存在时原导入任务的状态
+const (
+	streamLoadJobFinished     = "FINISHED"
+	streamLoadJobVisible      = "VISIBLE"
+	streamLoadJobPrecommitted = "PRECOMMITTED"
+)
+
+// 两阶段提交的操作
+const (
+	streamLoadTxnCommit = "commit"
+	streamLoadTxnAbort  = "abort"
+)
+
+// streamLoadMaxRedirects FE 转发到 BE 的最大跳转次数
+const streamLoadMaxRedirects = 3
+
+var errStreamLoadAborted = errors.New("stream load aborted")
+
+// StreamLoadResult Doris Stream Load 返回的导入结果
+type StreamLoadResult struct {
+	TxnID                int64  `json:"TxnId"`
+	Label                string `json:"Label"`
+	TwoPhaseCommit       string `json:"TwoPhaseCommit"`
+	Status               string `json:"Status"`
+	ExistingJobStatus    string `json:"ExistingJobStatus"`
+	Message              string `json:"Message"`
+	NumberTotalRows      int64  `json:"NumberTotalRows"`
+	NumberLoadedRows     int64  `json:"NumberLoadedRows"`
+	NumberFilteredRows   int64  `json:"NumberFilteredRows"`
+	NumberUnselectedRows int64  `json:"NumberUnselectedRows"`
+	LoadBytes            int64  `json:"LoadBytes"`
+	LoadTimeMs           int64  `json:"LoadTimeMs"`
+	ErrorURL             string `json:"ErrorURL"`
+}
+
+// Succeeded 导入是否成功（两阶段提交时为预提交成功）
+func (r *StreamLoadResult) Succeeded() bool {
+	return r.Status == streamLoadStatusSuccess || r.Status == streamLoadStatusPublishTimeout
+}
+
+// Deduplicated 相同 label 的导入已经生效，本次请求被去重
+func (r *StreamLoadResult) Deduplicated() bool {
+	return r.Status == streamLoadStatusLabelExists &&
+		(r.ExistingJobStatus == streamLoadJobFinished || r.ExistingJobStatus == streamLoadJobVisible)
+}
+
+// Precommitted 相同 label 的导入已预提交但未提交，通常是上次写入在提交前中断
+func (r *StreamLoadResult) Precommitted() bool {
+	return r.Status == streamLoadStatusLabelExists && r.ExistingJobStatus == streamLoadJobPrecommitted
+}
+
+func (r *StreamLoadResult) failure() error {
+	return fmt.Errorf("stream load %s failed: status=%s, existing=%s, message=%s, errorURL=%s",
+		r.Label, r.Status, r.ExistingJobStatus, r.Message, r.ErrorURL)
+}
+
+// StreamLoadOptions 单次 Stream Load 的参数
+type StreamLoadOptions struct {
+	Label           string
+	Format          string // arrow/csv
+	Columns         []string
+	ColumnSeparator string
+	TwoPhaseCommit  bool
+	MaxFilterRatio  float64 // 允许过滤行的比例，0 时任一行不合格即失败
+}
+
+// StreamLoadClient 通过 FE HTTP 接口执行 Stream Load，请求体以分块传输发送，不落本地文件
+type StreamLoadClient struct {
+	baseURL        string
+	user           string
+	password       string
+	timeoutSeconds int
+	batchBytes     int64
+	httpClient     *http.Client
+}
+
+// NewStreamLoadClient 按 doris 和 stream_load 配置创建客户端
+func NewStreamLoadClient(conf *config.DataServiceConf) *StreamLoadClient {
+	port := conf.StreamLoad.HTTPPort
+	if port <= 0 {
+		port = 8030
+	}
+	batchMB := conf.StreamLoad.BatchMB
+	if batchMB <= 0 {
+		batchMB = 1024
+	}
+	return newStreamLoadClient(fmt.Sprintf("http://%s:%d", conf.DorisConfig.Address, port),
+		conf.DorisConfig.User, conf.DorisConfig.Password, conf.StreamLoad.TimeoutSeconds, int64(batchMB)<<20)
+}
+
+func newStreamLoadClient(baseURL, user, password string, timeoutSeconds int, batchBytes int64) *StreamLoadClient {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// FE 在收到请求头后即返回跳转，等待 100-continue 保证请求体只发送给 BE
+	transport.ExpectContinueTimeout = 30 * time.Second
+	return &StreamLoadClient{
+		baseURL:        strings.TrimRight(baseURL, "/"),
+		user:           user,
+		password:       password,
+		timeoutSeconds: timeoutSeconds,
+		batchBytes:     batchBytes,
+		httpClient: &http.Client{
+			Transport: transport,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// streamLoadLabel 由导入来源生成确定的 label，相同来源的重试使用相同 label，Doris 据此去重
+func streamLoadLabel(prefix string, parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return prefix + "_" + hex.EncodeToString(sum[:16])
+}
+
+// loadBody 记录请求体是否已被读取，不关闭底层数据源，FE 跳转后可继续发送给 BE
+type loadBody struct {
+	r    io.Reader
+	read int64
+}
+
+func (b *loadBody) Read(p []byte) (int, error) {
+	n, err := b.r.Read(p)
+	b.read += int64(n)
+	return n, err
+}
+
+// Load 执行一次 Stream Load，body 以分块传输发送
+func (c *StreamLoadClient) Load(ctx context.Context, dbName, tableName string, opts StreamLoadOptions, body io.Reader) (*StreamLoadResult, error) {
+	header := http.Header{}
+	header.Set("Expect", "100-continue")
+	header.Set("label", opts.Label)
+	header.Set("format", opts.Format)
+	if len(opts.Columns) > 0 {
+		header.Set("columns", strings.Join(opts.Columns, ","))
+	}
+	if opts.ColumnSeparator != "" {
+		header.Set("column_separator", opts.ColumnSeparator)
+	}
+	if opts.TwoPhaseCommit {
+		header.Set("two_phase_commit", "true")
+	}
+	if opts.MaxFilterRatio > 0 {
+		header.Set("max_filter_ratio", strconv.FormatFloat(opts.MaxFilterRatio, 'f', -1, 64))
+	}
+	if c.timeoutSeconds > 0 {
+		header.Set("timeout", strconv.Itoa(c.timeoutSeconds))
+	}
+
+	data, err := c.do(ctx, fmt.Sprintf("%s/api/%s/%s/_stream_load", c.baseURL, dbName, tableName), header, &loadBody{r: body})
+	if err != nil {
+		return nil, err
+	}
+	result := &StreamLoadResult{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, fmt.Errorf("failed to parse stream load result: %v, body: %s", err, string(data))
+	}
+	if result.Label == "" {
+		result.Label = opts.Label
+	}
+	log.Logger.Infof("Stream load %s into %s.%s: status=%s, loaded=%d, filtered=%d, unselected=%d, bytes=%d, cost=%dms",
+		result.Label, dbName, tableName, result.Status, result.NumberLoadedRows, result.NumberFilteredRows,
+		result.NumberUnselectedRows, result.LoadBytes, result.LoadTimeMs)
+	return result, nil
+}
+
+// Commit 提交 label 对应的预提交导入
+func (c *StreamLoadClient) Commit(ctx context.Context, dbName, label string) error {
+	return c.finishTxn(ctx, dbName, label, streamLoadTxnCommit)
+}
+
+// Abort 中止 label 对应的预提交导入
+func (c *StreamLoadClient) Abort(ctx context.Context, dbName, label string) error {
+	return c.finishTxn(ctx, dbName, label, streamLoadTxnAbort)
+}
+
+func (c *StreamLoadClient) finishTxn(ctx context.Context, dbName, label, operation string) error {
+	header := http.Header{}
+	header.Set("label", label)
+	header.Set("txn_operation", operation)
+	data, err := c.do(ctx, fmt.Sprintf("%s/api/%s/_stream_load_2pc", c.baseURL, dbName), header, nil)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		Status string `json:"status"`
+		Msg    string `json:"msg"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return fmt.Errorf("failed to parse stream load %s result: %v, body: %s", operation, err, string(data))
+	}
+	if !strings.EqualFold(result.Status, streamLoadStatusSuccess) {
+		return fmt.Errorf("stream load %s %s failed: status=%s, msg=%s", operation, label, result.Status, result.Msg)
+	}
+	log.Logger.Infof("Stream load %s %s: %s", operation, label, result.Msg)
+	return nil
+}
+
+// do 发送 PUT 请求并跟随 FE 到 BE 的跳转，返回响应体
+func (c *StreamLoadClient) do(ctx context.Context, url string, header http.Header, body *loadBody) ([]byte, error) {
+	for i := 0; i <= streamLoadMaxRedirects; i++ {
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = body
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stream load request: %v", err)
+		}
+		req.Header = header.Clone()
+		req.SetBasicAuth(c.user, c.password)
+		if body != nil {
+			req.ContentLength = -1
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("stream load request to %s failed: %v", url, err)
+		}
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read stream load response: %v", err)
+		}
+
+		switch resp.StatusCode {
+		case http.StatusTemporaryRedirect, http.StatusPermanentRedirect, http.StatusFound, http.StatusMovedPermanently:
+			location := resp.Header.Get("Location")
+			if location == "" {
+				return nil, fmt.Errorf("stream load redirect from %s without location", url)
+			}
+			if body != nil && body.read > 0 {
+				return nil, fmt.Errorf("stream load body was sent to %s before redirect", url)
+			}
+			url = location
+			continue
+		case http.StatusOK:
+			return data, nil
+		default:
+			return nil, fmt.Errorf("stream load request to %s returned %d: %s", url, resp.StatusCode, string(data))
+		}
+	}
+	return nil, fmt.Errorf("stream load exceeded %d redirects", streamLoadMaxRedirects)
+}
+
+// StreamLoadSummary 一次写入各批导入结果的合计，按 label 去重的批次计入 LoadedRows
+type StreamLoadSummary struct {
+	Label            string
+	Batches          int
+	Deduplicated     int   // 按 label 去重跳过的批次数
+	DeduplicatedRows int64 // 去重批次本次发送的行数，这些行已由之前的导入写入
+	TotalRows        int64
+	LoadedRows       int64
+	FilteredRows     int64
+	UnselectedRows   int64
+}
+
+// streamLoadBatch 正在发送的一批数据
+type streamLoadBatch struct {
+	label    string
+	pw       *io.PipeWriter
+	writer   *ipc.Writer
+	bytes    int64 // 按 Arrow 缓冲区大小累计，拆批位置与编码无关，重试时批次划分一致
+	rows     int64 // 本批写入的行数，含提前返回后丢弃的行
+	skip     bool  // 服务端已提前返回结果（如 label 已存在），本批剩余数据丢弃
+	replayed bool  // label 已有导入（已生效或已预提交），结果中没有行数，批次结束时按 rows 计入
+	accepted bool
+	done     chan struct{}
+	result   *StreamLoadResult
+	err      error
+}
+
+// StreamLoadWriter 以 Arrow 格式把记录写入 Doris：数据量超过 batch_mb 时拆成多批，
+// 每批以两阶段提交预提交，Commit 时统一提交，失败时 Abort 中止已预提交的批次
+type StreamLoadWriter struct {
+	ctx       context.Context
+	client    *StreamLoadClient
+	dbName    string
+	tableName string
+	schema    *arrow.Schema
+	columns   []string
+	seq       int
+	batch     *streamLoadBatch
+	labels    []string // 已预提交、待提交的 label
+	summary   StreamLoadSummary
+	tolerance common.ErrorTolerance
+	rejects   *RejectRecorder // 为空时不记录被过滤的行
+}
+
+// NewStreamLoadWriter 创建写入器，第 n 批的 label 为 <label>_<n>
+func NewStreamLoadWriter(ctx context.Context, client *StreamLoadClient, dbName, tableName string, schema *arrow.Schema, label string) *StreamLoadWriter {
+	columns := make([]string, schema.NumFields())
+	for i, field := range schema.Fields() {
+		columns[i] = field.Name
+	}
+	return &StreamLoadWriter{
+		ctx:       ctx,
+		client:    client,
+		dbName:    dbName,
+		tableName: tableName,
+		schema:    schema,
+		columns:   columns,
+		summary:   StreamLoadSummary{Label: label},
+	}
+}
+
+// SetErrorTolerance 设置容错阈值，被过滤的行记录到 rejects
+func (w *StreamLoadWriter) SetErrorTolerance(tolerance common.ErrorTolerance, rejects *RejectRecorder) {
+	w.tolerance = tolerance
+	w.rejects = rejects
+}
+
+func (w *StreamLoadWriter) startBatch() {
+	w.seq++
+	pr, pw := io.Pipe()
+	b := &streamLoadBatch{
+		label:  fmt.Sprintf("%s_%d", w.summary.Label, w.seq),
+		pw:     pw,
+		writer: ipc.NewWriter(pw, ipc.WithSchema(w.schema)),
+		done:   make(chan struct{}),
+	}
+	opts := StreamLoadOptions{Label: b.label, Format: "arrow", Columns: w.columns, TwoPhaseCommit: true,
+		MaxFilterRatio: w.tolerance.FilterRatio()}
+	go func() {
+		defer close(b.done)
+		b.result, b.err = w.client.Load(w.ctx, w.dbName, w.tableName, opts, pr)
+		// 服务端未读完请求体就返回时，让写入端退出
+		pr.Close()
+	}()
+	w.batch = b
+}
+
+// Write 写入一条记录，达到批次大小时结束当前批次
+func (w *StreamLoadWriter) Write(record arrow.Record) error {
+	if w.batch == nil {
+		w.startBatch()
+	}
+	b := w.batch
+	if !b.skip {
+		if err := b.writer.Write(record); err != nil {
+			// 服务端已提前返回，按结果判断本批是否可以跳过
+			<-b.done
+			if err := w.accept(b); err != nil {
+				return err
+			}
+			b.skip = true
+		}
+	}
+	b.bytes += int64(util.TotalRecordSize(record))
+	b.rows += record.NumRows()
+	if b.bytes >= w.client.batchBytes {
+		return w.flush()
+	}
+	return nil
+}
+
+// flush 结束当前批次并等待导入结果
+func (w *StreamLoadWriter) flush() error {
+	b := w.batch
+	w.batch = nil
+	if !b.skip {
+		if err := b.writer.Close(); err != nil {
+			log.Logger.Debugf("Stream load %s body closed early: %v", b.label, err)
+		}
+		b.pw.Close()
+	}
+	<-b.done
+	if err := w.accept(b); err != nil {
+		return err
+	}
+	if b.replayed {
+		w.summary.TotalRows += b.rows
+		w.summary.LoadedRows += b.rows
+		if r := b.result; r.Deduplicated() {
+			w.summary.DeduplicatedRows += b.rows
+		}
+	}
+	return nil
+}
+
+// accept 记录一批的导入结果，预提交成功的批次等待统一提交
+func (w *StreamLoadWriter) accept(b *streamLoadBatch) error {
+	if b.accepted {
+		return nil
+	}
+	b.accepted = true
+	if b.err != nil {
+		return fmt.Errorf("stream load %s failed: %v", b.label, b.err)
+	}
+	r := b.result
+	if w.rejects != nil && (r.NumberFilteredRows > 0 || r.ErrorURL != "") {
+		w.rejects.RecordLoadErrors(w.ctx, r.NumberFilteredRows, r.ErrorURL)
+	}
+	switch {
+	case r.Succeeded():
+		w.labels = append(w.labels, b.label)
+		w.summary.TotalRows += r.NumberTotalRows
+		w.summary.LoadedRows += r.NumberLoadedRows
+		w.summary.FilteredRows += r.NumberFilteredRows
+		w.summary.UnselectedRows += r.NumberUnselectedRows
+		// 行数阈值由本端校验，超出时本批已预提交，随 Abort 中止
+		if w.tolerance.ExceedsRows(w.summary.FilteredRows) {
+			return fmt.Errorf("stream load %s: %d rows filtered, exceeds max error rows %d",
+				b.label, w.summary.FilteredRows, w.tolerance.MaxErrorRows)
+		}
+	case r.Deduplicated():
+		// 批次划分与上次一致，本批的行已由上次导入写入，批次结束时按本次发送的行数计入
+		log.Logger.Infof("Stream load %s already finished, skip duplicated batch", b.label)
+		w.summary.Deduplicated++
+		b.replayed = true
+	case r.Precommitted():
+		// 上次写入预提交后中断，数据相同，随本次写入一并提交
+		log.Logger.Infof("Stream load %s already precommitted, commit with current write", b.label)
+		w.labels = append(w.labels, b.label)
+		b.replayed = true
+	default:
+		return r.failure()
+	}
+	return nil
+}
+
+// Commit 结束最后一批，各批合计的过滤行未超出阈值时逐批提交所有预提交的批次。
+// 各批分别提交，不是整体原子的：中途失败时已提交的批次保留，剩余批次由 Abort 中止。
+// 每批至少提交一次，调用方以相同 label 重试时已提交的批次按 label 去重，重试最终收敛为完整写入一次
+func (w *StreamLoadWriter) Commit() (*StreamLoadSummary, error) {
+	if w.batch != nil {
+		if err := w.flush(); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.tolerance.Check(w.summary.FilteredRows, w.summary.TotalRows); err != nil {
+		return nil, fmt.Errorf("stream load %s: %v", w.summary.Label, err)
+	}
+	for i, label := range w.labels {
+		if err := w.client.Commit(w.ctx, w.dbName, label); err != nil {
+			// 已提交的批次无法回滚，剩余批次交给 Abort；重试时已提交的批次按 label 去重
+			w.labels = w.labels[i:]
+			return nil, err
+		}
+	}
+	w.labels = nil
+	w.summary.Batches = w.seq
+	return &w.summary, nil
+}
+
+// Abort 中止正在发送的批次和所有未提交的批次
+func (w *StreamLoadWriter) Abort() {
+	if b := w.batch; b != nil {
+		w.batch = nil
+		b.pw.CloseWithError(errStreamLoadAborted)
+		<-b.done
+		if err := w.accept(b); err != nil {
+			log.Logger.Debugf("Aborted stream load batch %s: %v", b.label, err)
+		}
+	}
+	for _, label := range w.labels {
+		if err := w.client.Abort(context.Background(), w.dbName, label); err != nil {
+			log.Logger.Warnf("Failed to abort stream load %s: %v", label, err)
+		}
+	}
+	w.labels = nil
+}
@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"data-service/common"
+	"data-service/generated/datasource"
+	"data-service/mocks"
+
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// fakeDoris FE 把 Stream Load 跳转到 BE，BE 解析 Arrow 请求体并按 label 返回结果
+type fakeDoris struct {
+	mu       sync.Mutex
+	fe, be   *httptest.Server
+	existing map[string]string // label -> 已有任务状态
+	fail     map[string]bool   // 导入失败的 label
+	loaded   map[string]int64  // 预提交的 label -> 行数
+	filtered map[string]int64  // label -> 被过滤的行数
+	ratios   map[string]string // label -> 请求的 max_filter_ratio
+	txnOps   []string          // 两阶段提交操作，格式 <operation>:<label>
+}
+
+func newFakeDoris(t *testing.T) *fakeDoris {
+	d := &fakeDoris{existing: map[string]string{}, fail: map[string]bool{}, loaded: map[string]int64{},
+		filtered: map[string]int64{}, ratios: map[string]string{}}
+	d.be = httptest.NewServer(http.HandlerFunc(d.handleLoad))
+	d.fe = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/_stream_load_2pc") {
+			d.mu.Lock()
+			d.txnOps = append(d.txnOps, r.Header.Get("txn_operation")+":"+r.Header.Get("label"))
+			d.mu.Unlock()
+			_, _ = w.Write([]byte(`{"status":"Success","msg":"ok"}`))
+			return
+		}
+		http.Redirect(w, r, d.be.URL+r.URL.Path, http.StatusTemporaryRedirect)
+	}))
+	t.Cleanup(func() {
+		d.fe.Close()
+		d.be.Close()
+	})
+	return d
+}
+
+func (d *fakeDoris) handleLoad(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/api/_load_error_log" {
+		_, _ = w.Write([]byte("Reason: bad value. src line [" + r.URL.Query().Get("file") + "]; \n"))
+		return
+	}
+	label := r.Header.Get("label")
+	user, _, _ := r.BasicAuth()
+	if user != "root" || r.Header.Get("format") != "arrow" || r.Header.Get("two_phase_commit") != "true" ||
+		r.Header.Get("columns") != "id,name,age" {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	d.mu.Lock()
+	existing, fail, filtered := d.existing[label], d.fail[label], d.filtered[label]
+	d.ratios[label] = r.Header.Get("max_filter_ratio")
+	d.mu.Unlock()
+	result := StreamLoadResult{Label: label, TxnID: 1}
+	switch {
+	case existing != "":
+		result.Status, result.ExistingJobStatus = streamLoadStatusLabelExists, existing
+	case fail:
+		result.Status, result.Message = "Fail", "too many filtered rows"
+	default:
+		reader, err := ipc.NewReader(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var rows int64
+		for reader.Next() {
+			rows += reader.Record().NumRows()
+		}
+		reader.Release()
+		d.mu.Lock()
+		d.loaded[label] = rows
+		d.mu.Unlock()
+		result.Status, result.TwoPhaseCommit = streamLoadStatusSuccess, "true"
+		result.NumberTotalRows, result.NumberLoadedRows, result.NumberFilteredRows = rows, rows-filtered, filtered
+		if filtered > 0 {
+			result.ErrorURL = d.be.URL + "/api/_load_error_log?file=" + label
+		}
+	}
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+func (d *fakeDoris) client(batchBytes int64) *StreamLoadClient {
+	return newStreamLoadClient(d.fe.URL, "root", "", 60, batchBytes)
+}
+
+// writeTestRecords 写入 n 条测试记录，每条 3 行
+func writeTestRecords(t *testing.T, w *StreamLoadWriter, n int) error {
+	for i := 0; i < n; i++ {
+		reader, err := ipc.NewReader(strings.NewReader(string(createTestArrowData())))
+		assert.NoError(t, err)
+		for reader.Next() {
+			if err := w.Write(reader.Record()); err != nil {
+				reader.Release()
+				return err
+			}
+		}
+		reader.Release()
+	}
+	return nil
+}
+
+func TestStreamLoadLabel(t *testing.T) {
+	a := streamLoadLabel("write", "db", "t", "req-1")
+	assert.Equal(t, a, streamLoadLabel("write", "db", "t", "req-1"))
+	assert.NotEqual(t, a, streamLoadLabel("write", "db", "t", "req-2"))
+	assert.True(t, strings.HasPrefix(a, "write_"))
+	assert.Len(t, a, len("write_")+32)
+}
+
+func TestStreamLoadWriter_MultiBatchTwoPhaseCommit(t *testing.T) {
+	d := newFakeDoris(t)
+	w := NewStreamLoadWriter(context.Background(), d.client(1), "db", "t", createTestSchema(), "write_x")
+
+	assert.NoError(t, writeTestRecords(t, w, 3))
+	// 预提交后提交前数据不可见
+	assert.Empty(t, d.txnOps)
+
+	summary, err := w.Commit()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, summary.Batches)
+	assert.Equal(t, int64(9), summary.LoadedRows)
+	assert.Equal(t, []string{"commit:write_x_1", "commit:write_x_2", "commit:write_x_3"}, d.txnOps)
+}
+
+func TestStreamLoadWriter_RetryDeduplicatesByLabel(t *testing.T) {
+	d := newFakeDoris(t)
+	d.existing["write_x_1"] = streamLoadJobVisible
+	d.existing["write_x_2"] = streamLoadJobPrecommitted
+	w := NewStreamLoadWriter(context.Background(), d.client(1), "db", "t", createTestSchema(), "write_x")
+
+	assert.NoError(t, writeTestRecords(t, w, 3))
+	summary, err := w.Commit()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, summary.Deduplicated)
+	assert.Equal(t, int64(3), summary.DeduplicatedRows)
+	// 去重和已预提交批次的行按本次发送的行数计入
+	assert.Equal(t, int64(9), summary.LoadedRows)
+	assert.Equal(t, []string{"commit:write_x_2", "commit:write_x_3"}, d.txnOps)
+}
+
+func TestStreamLoadWriter_FailureAbortsPrecommitted(t *testing.T) {
+	d := newFakeDoris(t)
+	d.fail["write_x_2"] = true
+	w := NewStreamLoadWriter(context.Background(), d.client(1), "db", "t", createTestSchema(), "write_x")
+
+	err := writeTestRecords(t, w, 3)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "too many filtered rows")
+	w.Abort()
+	assert.Equal(t, []string{"abort:write_x_1"}, d.txnOps)
+}
+
+func TestStreamLoadWriter_ErrorTolerance(t *testing.T) {
+	uploaded := mockRejectsClient(t)
+	d := newFakeDoris(t)
+	d.filtered["write_x_1"] = 1
+	d.filtered["write_x_2"] = 1
+	w := NewStreamLoadWriter(context.Background(), d.client(1), "db", "t", createTestSchema(), "write_x")
+	rejects := NewRejectRecorder("write_x")
+	w.SetErrorTolerance(common.ErrorTolerance{MaxErrorRatio: 0.5}, rejects)
+
+	assert.NoError(t, writeTestRecords(t, w, 2))
+	summary, err := w.Commit()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), summary.LoadedRows)
+	assert.Equal(t, int64(2), summary.FilteredRows)
+	assert.Equal(t, "0.5", d.ratios["write_x_1"])
+	assert.Equal(t, []string{"commit:write_x_1", "commit:write_x_2"}, d.txnOps)
+
+	saved, err := rejects.Save(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), saved.Rows)
+	assert.Equal(t, "reason,row\nbad value,write_x_1\nbad value,write_x_2\n", string(*uploaded))
+}
+
+func TestStreamLoadWriter_MaxErrorRowsAborts(t *testing.T) {
+	mockRejectsClient(t)
+	d := newFakeDoris(t)
+	d.filtered["write_x_1"] = 1
+	d.filtered["write_x_2"] = 1
+	w := NewStreamLoadWriter(context.Background(), d.client(1), "db", "t", createTestSchema(), "write_x")
+	w.SetErrorTolerance(common.ErrorTolerance{MaxErrorRows: 1}, NewRejectRecorder("write_x"))
+
+	err := writeTestRecords(t, w, 3)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeds max error rows 1")
+	w.Abort()
+	// 只限制行数时由 Doris 放行全部过滤行，超出的批次已预提交，一并中止
+	assert.Equal(t, "1", d.ratios["write_x_1"])
+	assert.Equal(t, []string{"abort:write_x_1", "abort:write_x_2"}, d.txnOps)
+}
+
+func TestStreamLoadWriter_FilteredWithoutTolerance(t *testing.T) {
+	d := newFakeDoris(t)
+	d.filtered["write_x_1"] = 1
+	w := NewStreamLoadWriter(context.Background(), d.client(1<<20), "db", "t", createTestSchema(), "write_x")
+
+	assert.NoError(t, writeTestRecords(t, w, 1))
+	_, err := w.Commit()
+	assert.Error(t, err)
+	assert.Empty(t, d.ratios["write_x_1"])
+	w.Abort()
+	assert.Equal(t, []string{"abort:write_x_1"}, d.txnOps)
+}
+
+// recordingWriteStream 按顺序返回写入请求并记录响应
+type recordingWriteStream struct {
+	grpc.ServerStream
+	requests []*datasource.WriteRequest
+	response *datasource.WriteResponse
+}
+
+func (s *recordingWriteStream) Recv() (*datasource.WriteRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *recordingWriteStream) SendAndClose(resp *datasource.WriteResponse) error {
+	s.response = resp
+	return nil
+}
+
+func (s *recordingWriteStream) Context() context.Context {
+	return context.Background()
+}
+
+func TestDataWriteService_ProcessWriteStreamLoad(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDorisService := mocks.NewMockIDorisService(ctrl)
+	mockDorisService.EXPECT().EnsureDorisDatabaseExists("test_db").Return(nil)
+	mockDorisService.EXPECT().ExecuteUpdate(gomock.Any()).Return(int64(0), nil)
+
+	d := newFakeDoris(t)
+	service := &DataWriteService{dorisService: mockDorisService, streamLoad: d.client(1 << 20)}
+	service.initOnce.Do(func() {})
+
+	arrowData := createTestArrowData()
+	stream := &recordingWriteStream{requests: []*datasource.WriteRequest{
+		{DbName: "test_db", TableName: "test_table", RequestId: "req-1", ArrowBatch: arrowData},
+		{ArrowBatch: arrowData},
+	}}
+	assert.NoError(t, service.ProcessWriteStream(stream))
+
+	label := streamLoadLabel("write", "test_db", "test_table", "req-1")
+	assert.True(t, stream.response.Success)
+	assert.Equal(t, int64(6), stream.response.LoadedRows)
+	assert.Equal(t, label, stream.response.Label)
+	assert.Equal(t, []string{"commit:" + label + "_1"}, d.txnOps)
+	assert.Equal(t, int64(6), d.loaded[label+"_1"])
+}
+
+func TestDataWriteService_ProcessWriteStreamLoadRejects(t *testing.T) {
+	mockRejectsClient(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDorisService := mocks.NewMockIDorisService(ctrl)
+	mockDorisService.EXPECT().EnsureDorisDatabaseExists("test_db").Return(nil)
+	mockDorisService.EXPECT().ExecuteUpdate(gomock.Any()).Return(int64(0), nil)
+
+	d := newFakeDoris(t)
+	label := streamLoadLabel("write", "test_db", "test_table", "req-1")
+	d.filtered[label+"_1"] = 2
+	service := &DataWriteService{dorisService: mockDorisService, streamLoad: d.client(1 << 20)}
+	service.initOnce.Do(func() {})
+
+	stream := &recordingWriteStream{requests: []*datasource.WriteRequest{
+		{DbName: "test_db", TableName: "test_table", RequestId: "req-1", ArrowBatch: createTestArrowData(), MaxErrorRows: 2},
+	}}
+	assert.NoError(t, service.ProcessWriteStream(stream))
+
+	assert.True(t, stream.response.Success)
+	assert.Equal(t, int64(1), stream.response.LoadedRows)
+	assert.Equal(t, int64(2), stream.response.RejectedRows)
+	assert.Equal(t, "rejects/"+label+".csv", stream.response.RejectsObject)
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"data-service/config"
+	"data-service/database"
+	"data-service/database/gorm"
+	"data-service/database/gorm/models"
+	"data-service/database/gorm/repositories"
+	pb "data-service/generated/datasource"
+	"data-service/log"
+	"data-service/utils"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	defaultProfileTopK          = 10
+	defaultProfileLengthBuckets = 10
+	defaultProfileCacheTTL      = 24 * time.Hour
+)
+
+// TableProfileService 数据质量画像服务，统计下推到外部数据源或 Doris 执行
+type TableProfileService struct {
+	repo *repositories.TableProfileRepository
+	conf config.ProfileConfig
+}
+
+func NewTableProfileService() *TableProfileService {
+	s := &TableProfileService{conf: config.GetConfigMap().ProfileConfig}
+	if db := gorm.GetGormDB(); db != nil {
+		s.repo = repositories.NewTableProfileRepository(db)
+	}
+	return s
+}
+
+// Profile 计算表的列画像，同一资产版本和统计参数命中缓存时直接返回
+func (s *TableProfileService) Profile(request *pb.ProfileTableRequest) (*pb.ProfileTableResponse, error) {
+	if request.SamplePercent < 0 || request.SamplePercent > 100 {
+		return nil, fmt.Errorf("samplePercent must be between 0 and 100, got %v", request.SamplePercent)
+	}
+	connInfo, asset, err := s.resolveSource(request)
+	if err != nil {
+		return nil, err
+	}
+
+	dbType := utils.ConvertDataSourceType(connInfo.Dbtype)
+	if dbType == pb.DataSourceType_DATA_SOURCE_TYPE_UNKNOWN {
+		return nil, fmt.Errorf("invalid database type: %d", connInfo.Dbtype)
+	}
+	dbStrategy, err := database.DatabaseFactory(dbType, connInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create database strategy: %v", err)
+	}
+	if err := dbStrategy.ConnectToDBWithPass(connInfo); err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %v", err)
+	}
+	// Doris 使用全局连接池，不关闭
+	if dbType != pb.DataSourceType_DATA_SOURCE_TYPE_DORIS {
+		defer dbStrategy.Close()
+	}
+
+	tableInfo, err := dbStrategy.GetTableInfo(connInfo.DbName, connInfo.TableName, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get table info: %v", err)
+	}
+	columns, err := selectProfileColumns(tableInfo.Columns, request.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	assetVersion := request.AssetVersion
+	if assetVersion == "" {
+		assetVersion = tableVersionOf(tableInfo)
+	}
+	topK := int(request.TopK)
+	if topK <= 0 {
+		topK = s.conf.DefaultTopK
+	}
+	if topK <= 0 {
+		topK = defaultProfileTopK
+	}
+	samplePercent := request.SamplePercent
+	if samplePercent == 100 {
+		samplePercent = 0
+	}
+	cacheKey := profileCacheKey(asset, columns, samplePercent, request.ApproximateDistinct, topK)
+
+	if s.repo != nil && !request.ForceRefresh {
+		if cached := s.loadCached(cacheKey, assetVersion); cached != nil {
+			log.Logger.Infof("Table profile cache hit for %s, version: %s", asset, assetVersion)
+			return cached, nil
+		}
+	}
+
+	lengthBuckets := s.conf.LengthBuckets
+	if lengthBuckets <= 0 {
+		lengthBuckets = defaultProfileLengthBuckets
+	}
+	dialect := database.ProfileDialectOf(dbStrategy)
+	profiler := &database.TableProfiler{
+		DB:                  database.GetDB(dbStrategy),
+		Dialect:             dialect,
+		Table:               dialect.QualifiedTable(connInfo.DbName, connInfo.TableName),
+		SamplePercent:       samplePercent,
+		SampleSeed:          rand.Int63n(math.MaxInt32),
+		ApproximateDistinct: request.ApproximateDistinct,
+		TopK:                topK,
+		LengthBuckets:       lengthBuckets,
+	}
+	if profiler.DB == nil {
+		return nil, fmt.Errorf("database connection is not available for %s", asset)
+	}
+
+	start := time.Now()
+	rowCount, profiles, err := profiler.Profile(columns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to profile %s: %v", asset, err)
+	}
+	response := &pb.ProfileTableResponse{
+		TableName:     connInfo.TableName,
+		RowCount:      rowCount,
+		SamplePercent: samplePercent,
+		Columns:       profiles,
+		AssetVersion:  assetVersion,
+		ProfiledAt:    time.Now().UnixMilli(),
+	}
+	log.Logger.Infof("Profiled %s, columns: %d, rows: %d, sample: %v%%, cost: %v",
+		asset, len(profiles), rowCount, samplePercent, time.Since(start))
+
+	if s.repo != nil {
+		s.saveCache(cacheKey, asset, response)
+	}
+	return response, nil
+}
+
+// resolveSource 解析画像数据源：外部资产走资产平台，否则使用 Doris 内部表
+func (s *TableProfileService) resolveSource(request *pb.ProfileTableRequest) (*pb.ConnectionInfo, string, error) {
+	if request.AssetName != "" {
+		connInfo, err := utils.GetDatasourceByAssetName(request.RequestId, request.AssetName, request.ChainInfoId, request.Alias)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to get datasource by asset name: %v", err)
+		}
+		return connInfo, request.AssetName, nil
+	}
+	if request.DbName == "" || request.TableName == "" {
+		return nil, "", fmt.Errorf("either assetName or dbName and tableName are required")
+	}
+	conf := config.GetConfigMap()
+	connInfo := &pb.ConnectionInfo{
+		Host:      conf.DorisConfig.Address,
+		Port:      conf.DorisConfig.Port,
+		User:      conf.DorisConfig.User,
+		DbName:    request.DbName,
+		Password:  conf.DorisConfig.Password,
+		Dbtype:    int32(pb.DataSourceType_DATA_SOURCE_TYPE_DORIS),
+		TableName: request.TableName,
+	}
+	return connInfo, request.DbName + "." + request.TableName, nil
+}
+
+func (s *TableProfileService) loadCached(cacheKey, assetVersion string) *pb.ProfileTableResponse {
+	cache, err := s.repo.FindValid(cacheKey, assetVersion)
+	if err != nil {
+		log.Logger.Warnf("Failed to load table profile cache: %v", err)
+		return nil
+	}
+	if cache == nil {
+		return nil
+	}
+	response := &pb.ProfileTableResponse{}
+	if err := protojson.Unmarshal([]byte(cache.Result), response); err != nil {
+		log.Logger.Warnf("Failed to decode table profile cache %d: %v", cache.ID, err)
+		return nil
+	}
+	response.Cached = true
+	return response
+}
+
+func (s *TableProfileService) saveCache(cacheKey, asset string, response *pb.ProfileTableResponse) {
+	data, err := protojson.Marshal(response)
+	if err != nil {
+		log.Logger.Warnf("Failed to encode table profile of %s: %v", asset, err)
+		return
+	}
+	ttl := defaultProfileCacheTTL
+	if s.conf.CacheTTLSeconds > 0 {
+		ttl = time.Duration(s.conf.CacheTTLSeconds) * time.Second
+	}
+	err = s.repo.Save(&models.TableProfileCache{
+		CacheKey:     cacheKey,
+		Asset:        asset,
+		AssetVersion: response.AssetVersion,
+		Result:       string(data),
+		ExpiresAt:    time.Now().Add(ttl),
+	})
+	if err != nil {
+		log.Logger.Warnf("Failed to save table profile cache of %s: %v", asset, err)
+	}
+}
+
+// selectProfileColumns 按请求筛选需要统计的列，保持请求中的顺序
+func selectProfileColumns(tableColumns []*pb.ColumnItem, requested []string) ([]*pb.ColumnItem, error) {
+	if len(tableColumns) == 0 {
+		return nil, fmt.Errorf("table has no columns")
+	}
+	if len(requested) == 0 {
+		return tableColumns, nil
+	}
+	byName := make(map[string]*pb.ColumnItem, len(tableColumns))
+	for _, col := range tableColumns {
+		byName[strings.ToLower(col.Name)] = col
+	}
+	columns := make([]*pb.ColumnItem, 0, len(requested))
+	for _, name := range requested {
+		col, ok := byName[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("column %s not found", name)
+		}
+		columns = append(columns, col)
+	}
+	return columns, nil
+}
+
+// tableVersionOf 未指定资产版本时，根据表结构、行数和大小生成版本
+func tableVersionOf(tableInfo *pb.TableInfoResponse) string {
+	h := sha256.New()
+	for _, col := range tableInfo.Columns {
+		h.Write([]byte(col.Name + ":" + col.DataType + ";"))
+	}
+	h.Write([]byte(fmt.Sprintf("rows=%d;size=%d", tableInfo.RecordCount64, tableInfo.RecordSize)))
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// profileCacheKey 资产与统计参数的摘要
+func profileCacheKey(asset string, columns []*pb.ColumnItem, samplePercent float64, approximate bool, topK int) string {
+	names := make([]string, 0, len(columns))
+	for _, col := range columns {
+		names = append(names, col.Name)
+	}
+	key := strings.Join([]string{
+		asset,
+		strings.Join(names, ","),
+		strconv.FormatFloat(samplePercent, 'f', -1, 64),
+		strconv.FormatBool(approximate),
+		strconv.Itoa(topK),
+	}, "|")
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,353 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"data-service/common"
+	"data-service/config"
+	"data-service/database/gorm"
+	"data-service/database/gorm/models"
+	"data-service/database/gorm/repositories"
+	"data-service/database/lock"
+	"data-service/log"
+)
+
+const (
+	defaultTableTTL         = 7 * 24 * time.Hour
+	defaultTableTTLInterval = 30 * time.Minute
+	defaultTableTTLBatch    = 100
+	// tableTTLLockKey 多副本部署时同一时刻只有一个副本执行过期删除
+	tableTTLLockKey = "table_ttl_scheduler"
+)
+
+// tableTTLLockWait 等待锁的时长，其他副本正在执行时跳过本轮
+var tableTTLLockWait = 5 * time.Second
+
+// ErrTableTTLNotFound 表或库没有生命周期记录，不是服务创建的或已被删除
+var ErrTableTTLNotFound = errors.New("table ttl not found")
+
+// ErrTableTTLDisabled 表生命周期调度未启用
+var ErrTableTTLDisabled = errors.New("table ttl scheduler is not enabled")
+
+// tableTTLStore 生命周期记录的存取，测试时替换
+type tableTTLStore interface {
+	Register(record *models.TableTTL) (*models.TableTTL, error)
+	FindTableNames(dbName string) ([]string, error)
+	FindExpired(now time.Time, limit int) ([]models.TableTTL, error)
+	UpdateExpiry(dbName, tableName string, expiresAt time.Time) (bool, error)
+	Delete(dbName, tableName string) error
+	DeleteByDb(dbName string) error
+}
+
+// tableTTLDoris 过期删除使用的 Doris 操作，测试时替换
+type tableTTLDoris interface {
+	// ListTables 库内的表及创建时间
+	ListTables(dbName string) (map[string]time.Time, error)
+	DropTable(dbName, tableName string) error
+	// DropJobDatabase 删除作业库及库内的 FILE 和证书对象
+	DropJobDatabase(dbName string) error
+}
+
+// TableTTLScheduler 登记服务创建的表和库的过期时间，定时删除过期的表和库；
+// MIRA_TMP_TASK_DB 中未登记的表按 Doris 建表时间加默认存活时长接管
+type TableTTLScheduler struct {
+	store    tableTTLStore
+	doris    tableTTLDoris
+	locker   lock.Locker
+	conf     config.TableTTLConfig
+	ttl      time.Duration
+	now      func() time.Time
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	runMu    sync.Mutex
+}
+
+// defaultTableTTLScheduler 已启动的调度器，未启用时为 nil，登记和修改生命周期均不生效
+var defaultTableTTLScheduler atomic.Pointer[TableTTLScheduler]
+
+// StartTableTTLScheduler 按配置启动表生命周期调度，未启用时返回 nil
+func StartTableTTLScheduler(conf config.TableTTLConfig, retentionDays int) *TableTTLScheduler {
+	if !conf.Enabled {
+		return nil
+	}
+	scheduler, err := NewTableTTLScheduler(conf, retentionDays)
+	if err != nil {
+		log.Logger.Errorf("Table ttl scheduler disabled: %v", err)
+		return nil
+	}
+	defaultTableTTLScheduler.Store(scheduler)
+	scheduler.Start()
+	return scheduler
+}
+
+func NewTableTTLScheduler(conf config.TableTTLConfig, retentionDays int) (*TableTTLScheduler, error) {
+	db := gorm.GetGormDB()
+	if db == nil {
+		return nil, fmt.Errorf("gorm database not initialized")
+	}
+	dorisService, err := NewDorisService(common.MIRA_TMP_TASK_DB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create doris service: %v", err)
+	}
+	ds, ok := dorisService.(*DorisService)
+	if !ok {
+		return nil, fmt.Errorf("unexpected doris service type %T", dorisService)
+	}
+	return newTableTTLScheduler(repositories.NewTableTTLRepository(db), dorisTableTTL{ds}, lock.Default(), conf, retentionDays), nil
+}
+
+func newTableTTLScheduler(store tableTTLStore, doris tableTTLDoris, locker lock.Locker, conf config.TableTTLConfig, retentionDays int) *TableTTLScheduler {
+	ttl := defaultTableTTL
+	if conf.DefaultTTLHours > 0 {
+		ttl = time.Duration(conf.DefaultTTLHours) * time.Hour
+	} else if retentionDays > 0 {
+		ttl = time.Duration(retentionDays) * 24 * time.Hour
+	}
+	return &TableTTLScheduler{
+		store:  store,
+		doris:  doris,
+		locker: locker,
+		conf:   conf,
+		ttl:    ttl,
+		now:    time.Now,
+		stopCh: make(chan struct{}),
+	}
+}
+
+func (s *TableTTLScheduler) interval() time.Duration {
+	if s.conf.IntervalMinutes > 0 {
+		return time.Duration(s.conf.IntervalMinutes) * time.Minute
+	}
+	return defaultTableTTLInterval
+}
+
+func (s *TableTTLScheduler) batchSize() int {
+	if s.conf.BatchSize > 0 {
+		return s.conf.BatchSize
+	}
+	return defaultTableTTLBatch
+}
+
+// Start 启动定时过期检查
+func (s *TableTTLScheduler) Start() {
+	log.Logger.Infof("Starting table ttl scheduler, interval: %v, default ttl: %v", s.interval(), s.ttl)
+	go func() {
+		ticker := time.NewTicker(s.interval())
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.stopCh:
+				log.Logger.Infof("Table ttl scheduler stopped")
+				return
+			case <-ticker.C:
+				if _, err := s.RunOnce(); err != nil {
+					log.Logger.Errorf("Table ttl expiry failed: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// Stop 停止定时过期检查
+func (s *TableTTLScheduler) Stop() {
+	s.stopOnce.Do(func() { close(s.stopCh) })
+}
+
+// Register 登记表的生命周期，tableName 为空表示整个库；已登记时保留原过期时间
+func (s *TableTTLScheduler) Register(dbName, tableName, jobInstanceId string, createdAt time.Time) (*models.TableTTL, error) {
+	return s.store.Register(&models.TableTTL{
+		DbName:        dbName,
+		Table:         tableName,
+		JobInstanceID: jobInstanceId,
+		CreatedAt:     createdAt,
+		ExpiresAt:     createdAt.Add(s.ttl),
+	})
+}
+
+// UpdateExpiry 修改已登记表的过期时间，未登记时返回 ErrTableTTLNotFound
+func (s *TableTTLScheduler) UpdateExpiry(dbName, tableName string, expiresAt time.Time) error {
+	found, err := s.store.UpdateExpiry(dbName, tableName, expiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to update ttl of %s.%s: %v", dbName, tableName, err)
+	}
+	if !found {
+		return ErrTableTTLNotFound
+	}
+	log.Logger.Infof("Updated ttl of %s.%s, expires at %s", dbName, tableName, expiresAt.Format(time.RFC3339))
+	return nil
+}
+
+// RunOnce 执行一轮过期检查，返回删除的表和库数；其他副本正在执行时跳过
+func (s *TableTTLScheduler) RunOnce() (int, error) {
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), tableTTLLockWait)
+	lease, err := s.locker.Acquire(ctx, tableTTLLockKey)
+	cancel()
+	if err != nil {
+		log.Logger.Debugf("Skip table ttl expiry, lock %s not acquired: %v", tableTTLLockKey, err)
+		return 0, nil
+	}
+	defer func() {
+		if err := lease.Release(); err != nil {
+			log.Logger.Warnf("Failed to release lock %s: %v", tableTTLLockKey, err)
+		}
+	}()
+
+	start := s.now()
+	if err := s.adopt(common.MIRA_TMP_TASK_DB); err != nil {
+		log.Logger.Warnf("Failed to adopt tables in %s: %v", common.MIRA_TMP_TASK_DB, err)
+	}
+	expired, err := s.store.FindExpired(start, s.batchSize())
+	if err != nil {
+		return 0, fmt.Errorf("failed to find expired tables: %v", err)
+	}
+
+	var dropped int
+	for _, record := range expired {
+		if err := lease.Check(context.Background()); err != nil {
+			return dropped, err
+		}
+		if err := s.drop(record); err != nil {
+			log.Logger.Warnf("Failed to drop expired %s: %v", tableTTLName(record.DbName, record.Table), err)
+			continue
+		}
+		dropped++
+		log.Logger.Infof("Dropped expired %s, job: %s, expired at %s", tableTTLName(record.DbName, record.Table),
+			record.JobInstanceID, record.ExpiresAt.Format(time.RFC3339))
+	}
+	if len(expired) > 0 {
+		log.Logger.Infof("Table ttl expiry finished, dropped %d of %d, cost: %v", dropped, len(expired), s.now().Sub(start))
+	}
+	return dropped, nil
+}
+
+// adopt 登记库内未登记的表，过期时间按 Doris 建表时间计算
+func (s *TableTTLScheduler) adopt(dbName string) error {
+	tables, err := s.doris.ListTables(dbName)
+	if err != nil {
+		return err
+	}
+	tracked, err := s.store.FindTableNames(dbName)
+	if err != nil {
+		return err
+	}
+	for _, name := range tracked {
+		delete(tables, name)
+	}
+	for name, createdAt := range tables {
+		if createdAt.IsZero() {
+			createdAt = s.now()
+		}
+		if _, err := s.Register(dbName, name, "", createdAt); err != nil {
+			return fmt.Errorf("failed to register %s.%s: %v", dbName, name, err)
+		}
+	}
+	if len(tables) > 0 {
+		log.Logger.Infof("Adopted %d untracked tables in %s", len(tables), dbName)
+	}
+	return nil
+}
+
+// drop 删除过期的表或库并移除记录
+func (s *TableTTLScheduler) drop(record models.TableTTL) error {
+	if record.Table == "" {
+		if err := s.doris.DropJobDatabase(record.DbName); err != nil {
+			return err
+		}
+		return s.store.DeleteByDb(record.DbName)
+	}
+	if err := s.doris.DropTable(record.DbName, record.Table); err != nil {
+		return err
+	}
+	return s.store.Delete(record.DbName, record.Table)
+}
+
+func tableTTLName(dbName, tableName string) string {
+	if tableName == "" {
+		return "database " + dbName
+	}
+	return "table " + dbName + "." + tableName
+}
+
+// RegisterTableTTL 登记服务创建的表或库，tableName 为空表示整个库；调度未启用时不登记，失败只记录日志
+func RegisterTableTTL(dbName, tableName, jobInstanceId string) {
+	scheduler := defaultTableTTLScheduler.Load()
+	if scheduler == nil {
+		return
+	}
+	record, err := scheduler.Register(dbName, tableName, jobInstanceId, time.Now())
+	if err != nil {
+		log.Logger.Warnf("Failed to register ttl of %s: %v", tableTTLName(dbName, tableName), err)
+		return
+	}
+	log.Logger.Infof("Registered ttl of %s, job: %s, expires at %s", tableTTLName(dbName, tableName),
+		jobInstanceId, record.ExpiresAt.Format(time.RFC3339))
+}
+
+// ForgetTableTTL 库已被删除时移除库及库内表的生命周期记录
+func ForgetTableTTL(dbName string) {
+	scheduler := defaultTableTTLScheduler.Load()
+	if scheduler == nil {
+		return
+	}
+	if err := scheduler.store.DeleteByDb(dbName); err != nil {
+		log.Logger.Warnf("Failed to delete ttl records of database %s: %v", dbName, err)
+	}
+}
+
+// UpdateTableTTL 延长或缩短已登记表或库的生命周期，调度未启用时返回错误
+func UpdateTableTTL(dbName, tableName string, expiresAt time.Time) error {
+	scheduler := defaultTableTTLScheduler.Load()
+	if scheduler == nil {
+		return ErrTableTTLDisabled
+	}
+	return scheduler.UpdateExpiry(dbName, tableName, expiresAt)
+}
+
+// dorisTableTTL 基于 DorisService 的过期删除实现
+type dorisTableTTL struct {
+	ds *DorisService
+}
+
+func (d dorisTableTTL) ListTables(dbName string) (map[string]time.Time, error) {
+	rows, done, err := d.ds.ExecuteSQL("SELECT TABLE_NAME, CREATE_TIME FROM information_schema.tables WHERE TABLE_SCHEMA = ? AND TABLE_TYPE = 'BASE TABLE'", dbName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tables in %s: %v", dbName, err)
+	}
+	if rows == nil {
+		return nil, nil
+	}
+	if done != nil {
+		defer done()
+	} else {
+		defer rows.Close()
+	}
+
+	tables := make(map[string]time.Time)
+	for rows.Next() {
+		var name string
+		// Doris 连接使用 parseTime=true&loc=UTC，CREATE_TIME 由驱动解析为 time.Time
+		var createTime sql.NullTime
+		if err := rows.Scan(&name, &createTime); err != nil {
+			return nil, fmt.Errorf("failed to scan table: %v", err)
+		}
+		tables[name] = createTime.Time
+	}
+	return tables, rows.Err()
+}
+
+func (d dorisTableTTL) DropTable(dbName, tableName string) error {
+	return d.ds.DropTable(dbName, tableName)
+}
+
+func (d dorisTableTTL) DropJobDatabase(dbName string) error {
+	return dropDorisJobDatabase(dbName)
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"data-service/common"
+	"data-service/config"
+	"data-service/database/gorm/models"
+	"data-service/database/lock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeTableTTLStore struct {
+	records map[[2]string]models.TableTTL
+}
+
+func newFakeTableTTLStore() *fakeTableTTLStore {
+	return &fakeTableTTLStore{records: make(map[[2]string]models.TableTTL)}
+}
+
+func (f *fakeTableTTLStore) Register(record *models.TableTTL) (*models.TableTTL, error) {
+	key := [2]string{record.DbName, record.Table}
+	if existing, ok := f.records[key]; ok {
+		return &existing, nil
+	}
+	f.records[key] = *record
+	return record, nil
+}
+
+func (f *fakeTableTTLStore) FindTableNames(dbName string) ([]string, error) {
+	var names []string
+	for key := range f.records {
+		if key[0] == dbName && key[1] != "" {
+			names = append(names, key[1])
+		}
+	}
+	return names, nil
+}
+
+func (f *fakeTableTTLStore) FindExpired(now time.Time, limit int) ([]models.TableTTL, error) {
+	var expired []models.TableTTL
+	for _, record := range f.records {
+		if !record.ExpiresAt.After(now) {
+			expired = append(expired, record)
+		}
+	}
+	sort.Slice(expired, func(i, j int) bool { return expired[i].ExpiresAt.Before(expired[j].ExpiresAt) })
+	if len(expired) > limit {
+		expired = expired[:limit]
+	}
+	return expired, nil
+}
+
+func (f *fakeTableTTLStore) UpdateExpiry(dbName, tableName string, expiresAt time.Time) (bool, error) {
+	key := [2]string{dbName, tableName}
+	record, ok := f.records[key]
+	if !ok {
+		return false, nil
+	}
+	record.ExpiresAt = expiresAt
+	f.records[key] = record
+	return true, nil
+}
+
+func (f *fakeTableTTLStore) Delete(dbName, tableName string) error {
+	delete(f.records, [2]string{dbName, tableName})
+	return nil
+}
+
+func (f *fakeTableTTLStore) DeleteByDb(dbName string) error {
+	for key := range f.records {
+		if key[0] == dbName {
+			delete(f.records, key)
+		}
+	}
+	return nil
+}
+
+type fakeTableTTLDoris struct {
+	tables           map[string]time.Time
+	droppedTables    []string
+	droppedDatabases []string
+	failDrop         map[string]bool
+}
+
+func (f *fakeTableTTLDoris) ListTables(dbName string) (map[string]time.Time, error) {
+	tables := make(map[string]time.Time, len(f.tables))
+	for name, created := range f.tables {
+		tables[name] = created
+	}
+	return tables, nil
+}
+
+func (f *fakeTableTTLDoris) DropTable(dbName, tableName string) error {
+	if f.failDrop[tableName] {
+		return errors.New("drop failed")
+	}
+	f.droppedTables = append(f.droppedTables, dbName+"."+tableName)
+	return nil
+}
+
+func (f *fakeTableTTLDoris) DropJobDatabase(dbName string) error {
+	f.droppedDatabases = append(f.droppedDatabases, dbName)
+	return nil
+}
+
+func newTestTableTTLScheduler(store *fakeTableTTLStore, doris *fakeTableTTLDoris, now time.Time) *TableTTLScheduler {
+	s := newTableTTLScheduler(store, doris, lock.NewLocalLocker(lock.Options{}), config.TableTTLConfig{DefaultTTLHours: 24}, 7)
+	s.now = func() time.Time { return now }
+	return s
+}
+
+func TestNewTableTTLSchedulerDefaultTTL(t *testing.T) {
+	store, doris := newFakeTableTTLStore(), &fakeTableTTLDoris{}
+	assert.Equal(t, 24*time.Hour, newTableTTLScheduler(store, doris, nil, config.TableTTLConfig{DefaultTTLHours: 24}, 7).ttl)
+	assert.Equal(t, 7*24*time.Hour, newTableTTLScheduler(store, doris, nil, config.TableTTLConfig{}, 7).ttl)
+	assert.Equal(t, defaultTableTTL, newTableTTLScheduler(store, doris, nil, config.TableTTLConfig{}, 0).ttl)
+}
+
+func TestTableTTLSchedulerRunOnce(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.Local)
+	store := newFakeTableTTLStore()
+	doris := &fakeTableTTLDoris{tables: map[string]time.Time{
+		"job1_old_internal":   now.Add(-48 * time.Hour),
+		"job2_fresh_internal": now.Add(-time.Hour),
+		"job3_registered":     now.Add(-48 * time.Hour),
+	}}
+	s := newTestTableTTLScheduler(store, doris, now)
+
+	// 已登记的表按登记的过期时间，不按建表时间接管
+	_, err := s.Register(common.MIRA_TMP_TASK_DB, "job3_registered", "job3", now.Add(-time.Hour))
+	assert.NoError(t, err)
+	_, err = s.Register("job4_abcd1234", "", "job4", now.Add(-25*time.Hour))
+	assert.NoError(t, err)
+	_, err = s.Register("job4_abcd1234", "t1", "job4", now.Add(-time.Hour))
+	assert.NoError(t, err)
+
+	dropped, err := s.RunOnce()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, dropped)
+	assert.Equal(t, []string{common.MIRA_TMP_TASK_DB + ".job1_old_internal"}, doris.droppedTables)
+	assert.Equal(t, []string{"job4_abcd1234"}, doris.droppedDatabases)
+
+	// 库删除后库内表的记录一并移除
+	var remaining []string
+	for key := range store.records {
+		remaining = append(remaining, key[0]+"."+key[1])
+	}
+	sort.Strings(remaining)
+	assert.Equal(t, []string{
+		common.MIRA_TMP_TASK_DB + ".job2_fresh_internal",
+		common.MIRA_TMP_TASK_DB + ".job3_registered",
+	}, remaining)
+}
+
+func TestTableTTLSchedulerKeepsRecordOnDropFailure(t *testing.T) {
+	now := time.Now()
+	store := newFakeTableTTLStore()
+	doris := &fakeTableTTLDoris{failDrop: map[string]bool{"broken": true}}
+	s := newTestTableTTLScheduler(store, doris, now)
+	_, _ = s.Register("db", "broken", "job", now.Add(-48*time.Hour))
+
+	dropped, err := s.RunOnce()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, dropped)
+	assert.Contains(t, store.records, [2]string{"db", "broken"})
+}
+
+func TestTableTTLSchedulerSkipsWhenLocked(t *testing.T) {
+	now := time.Now()
+	store := newFakeTableTTLStore()
+	doris := &fakeTableTTLDoris{}
+	s := newTestTableTTLScheduler(store, doris, now)
+	_, _ = s.Register("db", "t", "job", now.Add(-48*time.Hour))
+
+	// 其他副本持有锁时跳过本轮
+	origWait := tableTTLLockWait
+	tableTTLLockWait = 50 * time.Millisecond
+	defer func() { tableTTLLockWait = origWait }()
+	lease, err := s.locker.Acquire(context.Background(), tableTTLLockKey)
+	assert.NoError(t, err)
+	defer lease.Release()
+
+	dropped, err := s.RunOnce()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, dropped)
+	assert.Empty(t, doris.droppedTables)
+}
+
+func TestTableTTLSchedulerUpdateExpiry(t *testing.T) {
+	now := time.Now()
+	store := newFakeTableTTLStore()
+	doris := &fakeTableTTLDoris{}
+	s := newTestTableTTLScheduler(store, doris, now)
+	_, _ = s.Register("db", "t", "job", now)
+
+	assert.ErrorIs(t, s.UpdateExpiry("db", "missing", now), ErrTableTTLNotFound)
+
+	// 缩短生命周期后下一轮即删除
+	assert.NoError(t, s.UpdateExpiry("db", "t", now.Add(-time.Minute)))
+	dropped, err := s.RunOnce()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, dropped)
+	assert.Equal(t, []string{"db.t"}, doris.droppedTables)
+}
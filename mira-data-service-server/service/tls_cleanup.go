@@ -107,8 +107,8 @@ func (s *DorisService) dropFileFromDB(dbName, fileName string, catalog string) e
 	return nil
 }
 
-// CleanupAllFilesInDatabase 删除指定数据库中通过 CREATE FILE 创建的所有文件
-func (s *DorisService) CleanupAllFilesInDatabase(dbName string) {
+// CleanupAllFilesInDatabase 删除指定数据库中通过 CREATE FILE 创建的所有文件，返回已删除的文件名
+func (s *DorisService) CleanupAllFilesInDatabase(dbName string) []string {
 	query := fmt.Sprintf("SHOW FILE FROM %s", dbName)
 	rows, done, err := s.ExecuteSQL(query)
 	if err != nil || rows == nil {
@@ -117,7 +117,7 @@ func (s *DorisService) CleanupAllFilesInDatabase(dbName string) {
 		} else {
 			log.Logger.Infof("No FILE entries found for db=%s", dbName)
 		}
-		return
+		return nil
 	}
 	if done != nil {
 		defer done()
@@ -125,6 +125,7 @@ func (s *DorisService) CleanupAllFilesInDatabase(dbName string) {
 		defer rows.Close()
 	}
 
+	var dropped []string
 	for rows.Next() {
 		var fileId int64
 		var db, catalog, fileName, fileSize, isContent, md5 string
@@ -134,7 +135,45 @@ func (s *DorisService) CleanupAllFilesInDatabase(dbName string) {
 		}
 		if err := s.dropFileFromDB(dbName, fileName, catalog); err != nil {
 			log.Logger.Warnf("Failed to drop FILE '%s' from db=%s (catalog=%s): %v", fileName, dbName, catalog, err)
+			continue
 		}
+		dropped = append(dropped, fileName)
 	}
 	log.Logger.Infof("Finished cleaning up FILE entries for db=%s", dbName)
+	return dropped
+}
+
+// tlsCertFileSuffixes TLS 证书文件名后缀，文件名格式为 <requestId><suffix>
+var tlsCertFileSuffixes = []string{"_ca_cert.pem", "_client_cert.pem", "_client_key.pem", "_client_cert.p12", "_ca_cert.p12"}
+
+// cleanupMinioTlsObjectsByFileNames 根据已删除的 Doris FILE 名删除 MinIO 上同名的 TLS 证书对象
+func (s *DorisService) cleanupMinioTlsObjectsByFileNames(fileNames []string) error {
+	var candidates []string
+	for _, name := range fileNames {
+		for _, suffix := range tlsCertFileSuffixes {
+			if strings.HasSuffix(name, suffix) {
+				candidates = append(candidates, name)
+				break
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	ossClient, err := oss.NewOSSFactory(config.GetConfigMap()).NewOSSClient()
+	if err != nil {
+		return fmt.Errorf("failed to create OSS client for TLS cleanup: %v", err)
+	}
+	ctx := context.Background()
+	for _, name := range candidates {
+		if err := ossClient.DeleteObject(ctx, common.TLS_CERT_BUCKET_NAME, name); err != nil {
+			if strings.Contains(strings.ToLower(err.Error()), "not found") {
+				continue
+			}
+			return fmt.Errorf("failed to delete TLS cert object %s/%s: %v", common.TLS_CERT_BUCKET_NAME, name, err)
+		}
+		log.Logger.Infof("Deleted TLS cert object %s/%s", common.TLS_CERT_BUCKET_NAME, name)
+	}
+	return nil
 }
@@ -17,6 +17,7 @@ import (
 
 	"github.com/apache/arrow/go/v15/arrow"
 	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/decimal128"
 )
 
 // 处理 string 类型的值
@@ -445,6 +446,84 @@ func AppendValueToBuilder(b array.Builder, val interface{}) error {
 	return nil
 }
 
+// ValueRejectFunc 值无法转换为列类型时的回调，reason 为原因
+type ValueRejectFunc func(reason string)
+
+// AppendValueToBuilderWithRejects 与 AppendValueToBuilder 相同，但值无法转换为列类型时不写入默认值，
+// 而是调用 reject 并写入 NULL；reject 为空时与 AppendValueToBuilder 一致
+func AppendValueToBuilderWithRejects(b array.Builder, val interface{}, reject ValueRejectFunc) error {
+	if reject != nil {
+		if reason := unconvertibleReason(b, val); reason != "" {
+			reject(reason)
+			b.AppendNull()
+			return nil
+		}
+	}
+	return AppendValueToBuilder(b, val)
+}
+
+// unconvertibleReason AppendValueToBuilder 会以默认值替换或丢弃 val 时返回原因，nil 按原逻辑写入默认值
+func unconvertibleReason(b array.Builder, val interface{}) string {
+	if val == nil {
+		return ""
+	}
+	var ok bool
+	switch b := b.(type) {
+	case *array.StringBuilder, *array.LargeStringBuilder:
+		switch val.(type) {
+		case []byte, int, int8, int16, int32, int64, string, bool, time.Time:
+			ok = true
+		}
+	case *array.Uint8Builder:
+		_, ok = val.(uint8)
+	case *array.Uint16Builder:
+		_, ok = val.(uint16)
+	case *array.Uint32Builder:
+		_, ok = val.(uint32)
+	case *array.Uint64Builder:
+		_, ok = val.(uint64)
+	case *array.Int8Builder:
+		_, ok = val.(int8)
+	case *array.Int16Builder:
+		_, ok = val.(int16)
+	case *array.Int32Builder:
+		_, ok = val.(int32)
+	case *array.Int64Builder:
+		_, ok = val.(int64)
+	case *array.Float32Builder, *array.Float64Builder:
+		switch val.(type) {
+		case float32, float64:
+			ok = true
+		}
+	case *array.Decimal128Builder:
+		var s string
+		switch v := val.(type) {
+		case string:
+			s, ok = v, true
+		case []byte:
+			s, ok = string(v), true
+		}
+		if ok {
+			dt := b.Type().(*arrow.Decimal128Type)
+			if _, err := decimal128.FromString(normalizeDecimalString(s, dt.Scale), dt.Precision, dt.Scale); err != nil {
+				return fmt.Sprintf("invalid %s value %q: %v", dt, s, err)
+			}
+		}
+	}
+	if !ok {
+		// 整数列接受 int64 后截断转换
+		switch b.(type) {
+		case *array.Uint8Builder, *array.Uint16Builder, *array.Uint32Builder, *array.Uint64Builder,
+			*array.Int8Builder, *array.Int16Builder, *array.Int32Builder:
+			if _, isInt64 := val.(int64); isInt64 {
+				return ""
+			}
+		}
+		return fmt.Sprintf("cannot convert %T value %v to %s", val, val, b.Type())
+	}
+	return ""
+}
+
 func ExtractRowData(record arrow.Record) ([][]interface{}, error) {
 	var rows [][]interface{}
 	numRows := record.NumRows() // 获取行数
@@ -570,3 +570,30 @@ func TestAppendFloat64Value(t *testing.T) {
 		})
 	}
 }
+
+func TestAppendValueToBuilderWithRejects(t *testing.T) {
+	pool := memory.NewGoAllocator()
+	b := array.NewInt32Builder(pool)
+	defer b.Release()
+
+	var reasons []string
+	reject := func(reason string) { reasons = append(reasons, reason) }
+	require.NoError(t, AppendValueToBuilderWithRejects(b, int32(7), reject))
+	require.NoError(t, AppendValueToBuilderWithRejects(b, "abc", reject))
+	// 未设置回调时沿用默认值
+	require.NoError(t, AppendValueToBuilderWithRejects(b, "abc", nil))
+
+	arr := b.NewInt32Array()
+	defer arr.Release()
+	assert.Equal(t, int32(7), arr.Value(0))
+	assert.True(t, arr.IsNull(1))
+	assert.Equal(t, int32(0), arr.Value(2))
+	require.Len(t, reasons, 1)
+	assert.Contains(t, reasons[0], "cannot convert string value abc")
+
+	d := array.NewDecimal128Builder(pool, &arrow.Decimal128Type{Precision: 5, Scale: 2})
+	defer d.Release()
+	require.NoError(t, AppendValueToBuilderWithRejects(d, "12.5", reject))
+	require.NoError(t, AppendValueToBuilderWithRejects(d, "1x", reject))
+	assert.Len(t, reasons, 2)
+}
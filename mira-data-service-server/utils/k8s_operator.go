@@ -26,7 +26,16 @@ import (
 	"k8s.io/client-go/rest"
 )
 
-func CreateSparkPod(clientset *kubernetes.Clientset, podName string, info *pb.SparkDBConnInfo, sparkConfig *pb.SparkConfig, connInfo *pb.ConnectionInfo, recordCount int32) (*v1.Pod, error) {
+const (
+	// SparkJobLabel 作业ID标签，重试产生的Pod沿用首个Pod的名称
+	SparkJobLabel = "data-service/spark-job"
+	// SparkAttemptLabel 尝试次数标签，从1开始
+	SparkAttemptLabel = "data-service/spark-attempt"
+	// SparkContainerName Spark driver 容器名
+	SparkContainerName = "spark-container"
+)
+
+func CreateSparkPod(clientset kubernetes.Interface, podName string, info *pb.SparkDBConnInfo, sparkConfig *pb.SparkConfig, connInfo *pb.ConnectionInfo, recordCount int64) (*v1.Pod, error) {
 	conf := config.GetConfigMap()
 	imageFullName := conf.SparkPodConfig.ImageName + ":" + conf.SparkPodConfig.ImageTag
 	minioEndpoint := fmt.Sprintf("%s:%d", conf.OSSConfig.Host, conf.OSSConfig.Port)
@@ -152,12 +161,16 @@ func CreateSparkPod(clientset *kubernetes.Clientset, podName string, info *pb.Sp
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      podName,
 			Namespace: conf.SparkPodConfig.Namespace,
+			Labels: map[string]string{
+				SparkJobLabel:     podName,
+				SparkAttemptLabel: "1",
+			},
 		},
 		Spec: corev1.PodSpec{
 			ServiceAccountName: conf.SparkPodConfig.AccountName,
 			Containers: []corev1.Container{
 				{
-					Name:            "spark-container",
+					Name:            SparkContainerName,
 					Image:           imageFullName,           // 替换为实际的 Spark 镜像
 					ImagePullPolicy: corev1.PullIfNotPresent, // 镜像拉取策略
 					Args:            allArgs,
@@ -182,7 +195,7 @@ func CreateSparkPod(clientset *kubernetes.Clientset, podName string, info *pb.Sp
 }
 
 // GenerateSparkConfArgs generates the --conf arguments for Spark based on the provided SparkConfig.
-func GenerateSparkConfArgs(sparkConfig *pb.SparkConfig, recordCount int32) []string {
+func GenerateSparkConfArgs(sparkConfig *pb.SparkConfig, recordCount int64) []string {
 	if sparkConfig == nil {
 		return []string{}
 	}
@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"strings"
+	"unicode"
+
+	"github.com/tjfoc/gmsm/sm3"
+)
+
+const RedactedValue = "******"
+
+// MaskPartial 保留前 keepPrefix 个和后 keepSuffix 个字符，其余替换为 maskChar
+//
+// 例如 MaskPartial("13812345678", 3, 4, '*') => "138****5678"
+func MaskPartial(value string, keepPrefix, keepSuffix int, maskChar rune) string {
+	runes := []rune(value)
+	if keepPrefix < 0 {
+		keepPrefix = 0
+	}
+	if keepSuffix < 0 {
+		keepSuffix = 0
+	}
+	// 保留位数不少于总长度时整体掩码，避免原值泄露
+	if keepPrefix+keepSuffix >= len(runes) {
+		return strings.Repeat(string(maskChar), len(runes))
+	}
+	for i := keepPrefix; i < len(runes)-keepSuffix; i++ {
+		runes[i] = maskChar
+	}
+	return string(runes)
+}
+
+// NormalizeHashAlgorithm 校验哈希算法并返回小写名称，空值视为 sha256
+func NormalizeHashAlgorithm(algorithm string) (string, error) {
+	switch strings.ToLower(algorithm) {
+	case "", "sha256":
+		return "sha256", nil
+	case "sm3":
+		return "sm3", nil
+	default:
+		return "", fmt.Errorf("unsupported hash algorithm: %s", algorithm)
+	}
+}
+
+// HashValue 计算加盐哈希，algorithm 支持 sha256（默认）和 sm3，返回十六进制字符串
+func HashValue(value, salt, algorithm string) (string, error) {
+	algorithm, err := NormalizeHashAlgorithm(algorithm)
+	if err != nil {
+		return "", err
+	}
+	var h hash.Hash
+	if algorithm == "sm3" {
+		h = sm3.New()
+	} else {
+		h = sha256.New()
+	}
+	h.Write([]byte(salt))
+	h.Write([]byte(value))
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// TokenizeFormatPreserving 格式保留令牌化：数字替换为数字、字母替换为同大小写字母，其余字符保持不变
+//
+// 相同 key 下相同输入得到相同令牌，可用于关联分析；令牌不可逆。
+func TokenizeFormatPreserving(value string, key []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+	seed := mac.Sum(nil)
+
+	runes := []rune(value)
+	stream := seed
+	for i, r := range runes {
+		// 按需扩展伪随机字节流
+		if i >= len(stream) {
+			mac.Reset()
+			mac.Write(seed)
+			mac.Write([]byte{byte(i >> 8), byte(i)})
+			stream = append(stream, mac.Sum(nil)...)
+		}
+		b := int(stream[i])
+		switch {
+		case r >= '0' && r <= '9':
+			runes[i] = rune('0' + (int(r-'0')+b)%10)
+		case r >= 'a' && r <= 'z':
+			runes[i] = rune('a' + (int(r-'a')+b)%26)
+		case r >= 'A' && r <= 'Z':
+			runes[i] = rune('A' + (int(r-'A')+b)%26)
+		case unicode.IsLetter(r):
+			// 非ASCII字母（如中文）替换为掩码，避免泄露
+			runes[i] = '*'
+		}
+	}
+	return string(runes)
+}
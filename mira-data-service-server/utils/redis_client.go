@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"data-service/config"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// NewRedisCmdable 按部署模式（standalone/sentinel/cluster）创建 Redis 客户端
+func NewRedisCmdable(redisConf config.RedisConfig) redis.Cmdable {
+	switch redisConf.ClusterType {
+	case "sentinel":
+		// 哨兵模式
+		sentinelAddrs := strings.Split(redisConf.Address, ",")
+		return redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:    redisConf.SentinelMasterName,
+			SentinelAddrs: sentinelAddrs,
+			Password:      redisConf.Password,
+			DB:            redisConf.DB,
+			// 连接池配置
+			PoolSize:           10,
+			MinIdleConns:       5,
+			MaxConnAge:         time.Hour,
+			IdleTimeout:        5 * time.Minute,
+			IdleCheckFrequency: time.Minute,
+		})
+	case "cluster":
+		// 集群模式
+		clusterAddrs := strings.Split(redisConf.Address, ",")
+		return redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:    clusterAddrs,
+			Password: redisConf.Password,
+			// 连接池配置
+			PoolSize:           10,
+			MinIdleConns:       5,
+			MaxConnAge:         time.Hour,
+			IdleTimeout:        5 * time.Minute,
+			IdleCheckFrequency: time.Minute,
+		})
+	default:
+		// 单机模式
+		return redis.NewClient(&redis.Options{
+			Addr:     redisConf.Address,
+			Password: redisConf.Password,
+			DB:       redisConf.DB,
+			// 连接池配置
+			PoolSize:           10,
+			MinIdleConns:       5,
+			MaxConnAge:         time.Hour,
+			IdleTimeout:        5 * time.Minute,
+			IdleCheckFrequency: time.Minute,
+		})
+	}
+}
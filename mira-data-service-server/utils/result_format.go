@@ -0,0 +1,235 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TransformSoftwareFormat 将多个JSON字符串合并并转换格式
+// 输入格式: 详见https://www.tapd.cn/51081496/markdown_wikis/show/#1151081496001001856
+// 输出格式: [{"column1":1,"column2":2},{"column1":1,"column2":2}]
+func TransformSoftwareFormat(allResults []string) (string, error) {
+	r, columnsIdx, err := dealRows(allResults)
+	if err != nil {
+		return "", fmt.Errorf("TransformSoftwareFormat | deal row failed: %v", err)
+	}
+
+	result, err := json.Marshal(r)
+	if err != nil {
+		return "", fmt.Errorf("TransformSoftwareFormat | marshal result failed: %v", err)
+	}
+
+	// 解析所有输入的JSON字符串
+	var columnMap = make(map[string][]interface{})
+	maxLen := 0
+	// 兼容空结果
+	if checkResultIsEmpty(string(result)) {
+		return "", fmt.Errorf("TransformSoftwareFormat | result is empty")
+	}
+
+	var resultMap map[string][]interface{}
+	if err := json.Unmarshal([]byte(result), &resultMap); err != nil {
+		return "", fmt.Errorf("TransformSoftwareFormat | unmarshal input JSON failed: %v", err)
+	}
+
+	// 合并所有列数据
+	for col, values := range resultMap {
+		columnMap[col] = values
+		if len(values) > maxLen {
+			maxLen = len(values)
+		}
+	}
+
+	// 兼容空结果
+	if len(columnMap) == 0 {
+		return "", nil
+	}
+
+	// 构建转换后的结果
+	// [{"column1":1,"column2":2},{"column1":1,"column2":2}]
+	var transformedResults []string
+	for i := 0; i < maxLen; i++ {
+		row := "{"
+		// 按排序后的列名顺序构建 row
+		for _, col := range columnsIdx {
+			values := columnMap[col]
+			if i < len(values) {
+				value, _ := json.Marshal(values[i])
+				row += fmt.Sprintf("\"%s\":%s,", col, string(value))
+			}
+		}
+		row = strings.TrimSuffix(row, ",")
+		row += "}"
+		transformedResults = append(transformedResults, row)
+	}
+
+	// 将结果转换回JSON字符串
+	return "[" + strings.Join(transformedResults, ",") + "]", nil
+}
+
+// 背景：目前硬件常用的板卡执行结果是json格式，每个mpc的结果格式可能不尽相同
+// TransformHardwareFormat 将多个JSON字符串合并并转换格式，兼容对象数组、列式对象和字符串形式的JSON数组
+// 输入格式：[[{"id":"1","val":"这里是分包1板卡执行结果"},{"id":"2","val":"这里是分包2板卡执行结果"}],[...]]
+// 输出格式：[{"id":"1","val":"这里是分包1板卡执行结果"},{"id":"2","val":"这里是分包2板卡执行结果"}...]
+func TransformHardwareFormat(allResults []string) (string, error) {
+	var finalResult []map[string]interface{}
+
+	for _, result := range allResults {
+		if result == "" {
+			continue
+		}
+
+		var inputMap map[string]interface{}
+		if err := json.Unmarshal([]byte(result), &inputMap); err != nil {
+			// 如果不是对象，尝试按原来的方式解析为数组
+			var arrayResult []map[string]interface{}
+			if err := json.Unmarshal([]byte(result), &arrayResult); err != nil {
+				return "", fmt.Errorf("TransformHardware | unmarshal input JSON failed: %v", err)
+			}
+			finalResult = append(finalResult, arrayResult...)
+			continue
+		}
+
+		// 检测是否为列式数据（所有值都是数组）
+		isColumnFormat := true
+		columnLengths := make(map[string]int)
+
+		for key, value := range inputMap {
+			if arrayValue, isArray := value.([]interface{}); isArray {
+				columnLengths[key] = len(arrayValue)
+			} else {
+				isColumnFormat = false
+				break
+			}
+		}
+
+		// 如果是列式数据，转换为行式数据
+		if isColumnFormat && len(columnLengths) > 0 {
+			// 找出最大长度
+			maxLength := 0
+			for _, length := range columnLengths {
+				if length > maxLength {
+					maxLength = length
+				}
+			}
+
+			// 创建行式数据
+			for i := 0; i < maxLength; i++ {
+				row := make(map[string]interface{})
+
+				for key, value := range inputMap {
+					if arrayValue, isArray := value.([]interface{}); isArray && i < len(arrayValue) {
+						row[key] = arrayValue[i]
+					}
+				}
+
+				if len(row) > 0 {
+					finalResult = append(finalResult, row)
+				}
+			}
+		} else {
+			// 原有的处理逻辑
+			for key, value := range inputMap {
+				// 支持直接数组
+				if arrayValue, isArray := value.([]interface{}); isArray {
+					for _, item := range arrayValue {
+						switch v := item.(type) {
+						case map[string]interface{}:
+							finalResult = append(finalResult, map[string]interface{}{key: v})
+						default:
+							finalResult = append(finalResult, map[string]interface{}{key: v})
+						}
+					}
+					continue
+				}
+				// 兼容旧格式：字符串形式的JSON数组
+				if strValue, ok := value.(string); ok && strings.HasPrefix(strValue, "[") && strings.HasSuffix(strValue, "]") {
+					var innerArray []interface{}
+					if err := json.Unmarshal([]byte(strValue), &innerArray); err != nil {
+						continue
+					}
+					for _, innerObj := range innerArray {
+						finalResult = append(finalResult, map[string]interface{}{key: innerObj})
+					}
+				}
+			}
+		}
+	}
+
+	if len(finalResult) == 0 {
+		return "[]", nil
+	}
+	resultJSON, err := json.Marshal(finalResult)
+	if err != nil {
+		return "", fmt.Errorf("TransformHardware | marshal output JSON failed: %v", err)
+	}
+	return string(resultJSON), nil
+}
+
+// EncodeResultContent 结果内容 base64 编码
+func EncodeResultContent(content string) string {
+	return base64.StdEncoding.EncodeToString([]byte(content))
+}
+
+// 2025.02.24 变更：软件执行引擎将参与方信息、表信息写入minIO, 解析后展示给用户以区分同名属性
+//
+//	输入：{"id": {
+//		"value": [1, 2, 3, 4],
+//		"party_id": "party_id_1",
+//		"asset_name": "asset_name_1"
+//		}], "id2"...}
+//
+// 输出：{"party_id_1.asset_name_1.id":[1, 2, 3, 4], "party_id_2.asset_name_2.id2":[1, 2, 3, 4]}
+func dealRows(rows []string) (map[string][]interface{}, []string, error) {
+	type rowInfo struct {
+		Value      []interface{} `json:"value"`
+		PartyId    string        `json:"party_id"`
+		AssetName  string        `json:"asset_name"`
+		PartyName  string        `json:"party_name"`
+		ItemIdx    int           `json:"item_idx"`
+		ColumnName string        `json:"column"`
+	}
+	res := make(map[string][]interface{})
+	indexArray := make([]rowInfo, 0, len(rows))
+	for _, row := range rows {
+		rowInfoMap := make(map[string]rowInfo)
+		err := json.Unmarshal([]byte(row), &rowInfoMap)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error unmarshalling err: %v, row: %s", err, row)
+		}
+
+		for k, v := range rowInfoMap {
+			if v.PartyName == "" || v.AssetName == "" {
+				res[k] = v.Value
+				indexArray = append(indexArray, rowInfo{ColumnName: k, ItemIdx: v.ItemIdx})
+			} else {
+				res[v.PartyName+"."+v.AssetName+"."+k] = v.Value
+				indexArray = append(indexArray, rowInfo{
+					ColumnName: v.PartyName + "." + v.AssetName + "." + k,
+					ItemIdx:    v.ItemIdx,
+				})
+			}
+		}
+	}
+
+	sort.Slice(indexArray, func(i, j int) bool {
+		return indexArray[i].ItemIdx < indexArray[j].ItemIdx
+	})
+
+	columns := make([]string, 0, len(indexArray))
+	for _, v := range indexArray {
+		columns = append(columns, v.ColumnName)
+	}
+
+	return res, columns, nil
+}
+
+// 结果为空时也应该有表头之类的数据， 但各个执行引擎实现可能不一致， 这里对空结果做兼容
+func checkResultIsEmpty(result string) bool {
+	return strings.TrimSpace(result) == "" ||
+		strings.TrimSpace(result) == "[]" ||
+		strings.TrimSpace(result) == "{}"
+}
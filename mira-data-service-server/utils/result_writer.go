@@ -0,0 +1,194 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"data-service/common"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/apache/arrow/go/v15/parquet"
+	"github.com/apache/arrow/go/v15/parquet/pqarrow"
+)
+
+// resultRecordRows Arrow/Parquet 输出每个批次的行数，CSV/JSON Lines 每写出该行数刷新一次
+const resultRecordRows = 1024
+
+// ResultRowWriter 按行写出计算结果，先写表头，Close 时写出剩余数据
+type ResultRowWriter interface {
+	WriteHeader(columns []string) error
+	WriteRow(row []string) error
+	Close() error
+}
+
+// NewResultRowWriter 按输出格式创建 ResultRowWriter，Arrow/Parquet 的列均为可空字符串
+func NewResultRowWriter(format common.FileFormat, w io.Writer) (ResultRowWriter, error) {
+	switch format {
+	case common.FILE_FORMAT_CSV:
+		return &csvResultWriter{w: csv.NewWriter(w)}, nil
+	case common.FILE_FORMAT_JSONL:
+		return &jsonlResultWriter{w: w}, nil
+	case common.FILE_FORMAT_ARROW, common.FILE_FORMAT_PARQUET:
+		return &arrowResultWriter{w: w, format: format}, nil
+	default:
+		return nil, fmt.Errorf("unsupported result format: %s", format)
+	}
+}
+
+type csvResultWriter struct {
+	w    *csv.Writer
+	rows int
+}
+
+func (c *csvResultWriter) WriteHeader(columns []string) error {
+	return c.w.Write(columns)
+}
+
+func (c *csvResultWriter) WriteRow(row []string) error {
+	if err := c.w.Write(row); err != nil {
+		return err
+	}
+	c.rows++
+	if c.rows%resultRecordRows == 0 {
+		c.w.Flush()
+		return c.w.Error()
+	}
+	return nil
+}
+
+func (c *csvResultWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// jsonlResultWriter 每行一个 JSON 对象，字段顺序与表头一致
+type jsonlResultWriter struct {
+	w       io.Writer
+	columns [][]byte // 已编码的列名
+	buf     bytes.Buffer
+	rows    int
+}
+
+func (j *jsonlResultWriter) WriteHeader(columns []string) error {
+	j.columns = make([][]byte, len(columns))
+	for i, column := range columns {
+		name, err := json.Marshal(column)
+		if err != nil {
+			return err
+		}
+		j.columns[i] = name
+	}
+	return nil
+}
+
+func (j *jsonlResultWriter) WriteRow(row []string) error {
+	j.buf.WriteByte('{')
+	for i, name := range j.columns {
+		if i > 0 {
+			j.buf.WriteByte(',')
+		}
+		j.buf.Write(name)
+		j.buf.WriteByte(':')
+		value := ""
+		if i < len(row) {
+			value = row[i]
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		j.buf.Write(encoded)
+	}
+	j.buf.WriteString("}\n")
+	j.rows++
+	if j.rows%resultRecordRows == 0 {
+		return j.flush()
+	}
+	return nil
+}
+
+func (j *jsonlResultWriter) flush() error {
+	_, err := j.w.Write(j.buf.Bytes())
+	j.buf.Reset()
+	return err
+}
+
+func (j *jsonlResultWriter) Close() error {
+	return j.flush()
+}
+
+// arrowResultWriter 按批次写出 Arrow IPC 流或 Parquet 文件
+type arrowResultWriter struct {
+	w       io.Writer
+	format  common.FileFormat
+	schema  *arrow.Schema
+	builder *array.RecordBuilder
+	rows    int
+	write   func(arrow.Record) error
+	close   func() error
+}
+
+func (a *arrowResultWriter) WriteHeader(columns []string) error {
+	fields := make([]arrow.Field, len(columns))
+	for i, column := range columns {
+		fields[i] = arrow.Field{Name: column, Type: arrow.BinaryTypes.String, Nullable: true}
+	}
+	a.schema = arrow.NewSchema(fields, nil)
+	a.builder = array.NewRecordBuilder(memory.DefaultAllocator, a.schema)
+
+	if a.format == common.FILE_FORMAT_PARQUET {
+		pw, err := pqarrow.NewFileWriter(a.schema, a.w, parquet.NewWriterProperties(), pqarrow.DefaultWriterProps())
+		if err != nil {
+			return fmt.Errorf("failed to create parquet writer: %v", err)
+		}
+		a.write = pw.WriteBuffered
+		a.close = pw.Close
+		return nil
+	}
+	iw := ipc.NewWriter(a.w, ipc.WithSchema(a.schema))
+	a.write = iw.Write
+	a.close = iw.Close
+	return nil
+}
+
+func (a *arrowResultWriter) WriteRow(row []string) error {
+	for i := range a.schema.Fields() {
+		field := a.builder.Field(i).(*array.StringBuilder)
+		if i < len(row) {
+			field.Append(row[i])
+		} else {
+			field.AppendNull()
+		}
+	}
+	a.rows++
+	if a.rows%resultRecordRows == 0 {
+		return a.flush()
+	}
+	return nil
+}
+
+func (a *arrowResultWriter) flush() error {
+	record := a.builder.NewRecord()
+	defer record.Release()
+	if record.NumRows() == 0 {
+		return nil
+	}
+	return a.write(record)
+}
+
+func (a *arrowResultWriter) Close() error {
+	if a.builder == nil {
+		return nil
+	}
+	defer a.builder.Release()
+	if err := a.flush(); err != nil {
+		return err
+	}
+	return a.close()
+}
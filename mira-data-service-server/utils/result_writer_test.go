@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"data-service/common"
+
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/apache/arrow/go/v15/parquet/file"
+	"github.com/apache/arrow/go/v15/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestResult(t *testing.T, format common.FileFormat) []byte {
+	var buf bytes.Buffer
+	w, err := NewResultRowWriter(format, &buf)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteHeader([]string{"id", "name"}))
+	require.NoError(t, w.WriteRow([]string{"1", "a\"b"}))
+	require.NoError(t, w.WriteRow([]string{"2", ""}))
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestResultRowWriter_TextFormats(t *testing.T) {
+	assert.Equal(t, "id,name\n1,\"a\"\"b\"\n2,\n", string(writeTestResult(t, common.FILE_FORMAT_CSV)))
+	assert.Equal(t, "{\"id\":\"1\",\"name\":\"a\\\"b\"}\n{\"id\":\"2\",\"name\":\"\"}\n", string(writeTestResult(t, common.FILE_FORMAT_JSONL)))
+
+	_, err := NewResultRowWriter(common.FILE_FORMAT_XML, &bytes.Buffer{})
+	assert.Error(t, err)
+}
+
+func TestResultRowWriter_Arrow(t *testing.T) {
+	reader, err := ipc.NewReader(bytes.NewReader(writeTestResult(t, common.FILE_FORMAT_ARROW)))
+	require.NoError(t, err)
+	defer reader.Release()
+
+	require.True(t, reader.Next())
+	record := reader.Record()
+	assert.Equal(t, int64(2), record.NumRows())
+	assert.Equal(t, "name", record.Schema().Field(1).Name)
+	assert.Equal(t, "a\"b", record.Column(1).(*array.String).Value(0))
+	assert.False(t, reader.Next())
+}
+
+func TestResultRowWriter_Parquet(t *testing.T) {
+	pf, err := file.NewParquetReader(bytes.NewReader(writeTestResult(t, common.FILE_FORMAT_PARQUET)))
+	require.NoError(t, err)
+	defer pf.Close()
+
+	fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	table, err := fr.ReadTable(context.Background())
+	require.NoError(t, err)
+	defer table.Release()
+
+	assert.Equal(t, int64(2), table.NumRows())
+	assert.Equal(t, "id", table.Schema().Field(0).Name)
+}